@@ -0,0 +1,148 @@
+package decimal
+
+// Accumulator computes an exact running total of decimals.
+// Unlike [Sum], it does not require all decimals to be in memory,
+// and unlike repeated calls to [Decimal.Add], it never rounds or overflows
+// an intermediate result.
+// The total is kept as an arbitrary-precision coefficient at the largest
+// scale of the accumulated decimals, so an overflow can only be reported
+// by [Accumulator.Result] when the total is converted back to a decimal.
+//
+// The zero value is an empty accumulator ready to use.
+// An accumulator must not be copied after first use.
+// Accumulator is not designed to be safe for concurrent use by multiple goroutines.
+type Accumulator struct {
+	coef  bint
+	scale int
+	count int
+}
+
+// Add adds decimal d to the total.
+func (a *Accumulator) Add(d Decimal) {
+	a.add(d.neg, d.coef, 1, d.Scale())
+}
+
+// Sub subtracts decimal d from the total.
+func (a *Accumulator) Sub(d Decimal) {
+	a.add(!d.neg, d.coef, 1, d.Scale())
+}
+
+// AddMul adds the exact product of decimals d and e to the total.
+func (a *Accumulator) AddMul(d, e Decimal) {
+	a.add(d.neg != e.neg, d.coef, e.coef, d.Scale()+e.Scale())
+}
+
+// add adds (-1)^neg * dcoef * ecoef / 10^scale to the total.
+func (a *Accumulator) add(neg bool, dcoef, ecoef fint, scale int) {
+	a.count++
+
+	// Alignment
+	if scale > a.scale {
+		a.coef.lsh(&a.coef, scale-a.scale)
+		a.scale = scale
+	}
+
+	// Compute f = d * e
+	fcoef := getBint()
+	defer putBint(fcoef)
+	fcoef.setFint(dcoef)
+	if ecoef != 1 {
+		gcoef := getBint()
+		defer putBint(gcoef)
+		gcoef.setFint(ecoef)
+		fcoef.mul(fcoef, gcoef)
+	}
+	if scale < a.scale {
+		fcoef.lsh(fcoef, a.scale-scale)
+	}
+
+	// Compute total = total ± f
+	if neg {
+		a.coef.sub(&a.coef, fcoef)
+	} else {
+		a.coef.add(&a.coef, fcoef)
+	}
+}
+
+// merge adds the total and the count of accumulator b to accumulator a.
+func (a *Accumulator) merge(b *Accumulator) {
+	a.count += b.count
+
+	// Alignment
+	if b.scale > a.scale {
+		a.coef.lsh(&a.coef, b.scale-a.scale)
+		a.scale = b.scale
+	}
+
+	// Compute total = total + b
+	fcoef := getBint()
+	defer putBint(fcoef)
+	fcoef.lsh(&b.coef, a.scale-b.scale)
+	a.coef.add(&a.coef, fcoef)
+}
+
+// Count returns the number of calls to [Accumulator.Add], [Accumulator.Sub],
+// and [Accumulator.AddMul] since the accumulator was created or reset.
+func (a *Accumulator) Count() int {
+	return a.count
+}
+
+// Reset resets the accumulator to be empty.
+func (a *Accumulator) Reset() {
+	a.coef.setFint(0)
+	a.scale = 0
+	a.count = 0
+}
+
+// value returns the (possibly rounded) total with the largest scale of the
+// accumulated decimals, in the same way as [Sum] does.
+func (a *Accumulator) value() (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.abs(&a.coef)
+	return newFromBint(a.coef.sign() < 0, ecoef, a.scale, 0, HalfEven)
+}
+
+// Result returns the total rounded to the specified number of digits after
+// the decimal point using [rounding half to even] (banker's rounding).
+// If the total has fewer digits after the decimal point, it is zero-padded.
+// The total itself is not changed, so more decimals can be accumulated afterwards.
+// See also method [Decimal.Rescale].
+//
+// Result returns an error if:
+//   - the scale is negative or greater than [MaxScale];
+//   - the integer part of the result has more than [MaxPrec] - scale digits.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (a *Accumulator) Result(scale int) (Decimal, error) {
+	return a.ResultMode(scale, HalfEven)
+}
+
+// ResultMode is similar to [Accumulator.Result], but it allows you to specify
+// the rounding mode that is used if the total has to be rounded.
+func (a *Accumulator) ResultMode(scale int, mode RoundingMode) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, newOpError("accumulate", scale, ErrScaleRange)
+	}
+	if !mode.valid() {
+		return Decimal{}, newOpError("accumulate", scale, ErrModeRange)
+	}
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.abs(&a.coef)
+	eneg := a.coef.sign() < 0
+	escale := a.scale
+
+	// Rounding
+	if escale > scale {
+		ecoef.rshMode(ecoef, escale-scale, mode, eneg)
+		escale = scale
+	}
+
+	e, err := newFromBint(eneg, ecoef, escale, scale, mode)
+	if err != nil {
+		return Decimal{}, newOpError("accumulate", scale, err)
+	}
+	return e, nil
+}
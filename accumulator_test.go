@@ -0,0 +1,276 @@
+package decimal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAccumulator_ZeroValue(t *testing.T) {
+	var a Accumulator
+	got, err := a.Result(0)
+	if err != nil {
+		t.Fatalf("Accumulator{}.Result(0) failed: %v", err)
+	}
+	if got != Zero {
+		t.Errorf("Accumulator{}.Result(0) = %q, want %q", got, Zero)
+	}
+	if a.Count() != 0 {
+		t.Errorf("Accumulator{}.Count() = %v, want 0", a.Count())
+	}
+}
+
+func TestAccumulator_Add(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d     []string
+			scale int
+			want  string
+		}{
+			{[]string{"1", "1"}, 0, "2"},
+			{[]string{"5.75", "3.3"}, 2, "9.05"},
+			{[]string{"5.75", "3.3"}, 1, "9.0"},
+			{[]string{"5.75", "3.3"}, 0, "9"},
+			{[]string{"5.75", "3.3"}, 5, "9.05000"},
+			{[]string{"5", "-3"}, 0, "2"},
+			{[]string{"-7", "2.5"}, 1, "-4.5"},
+			{[]string{"-7", "2.5"}, 0, "-4"},
+			{[]string{"0.1", "0.2", "0.3"}, 1, "0.6"},
+			{[]string{"0.0000000000000000001", "-0.0000000000000000001"}, 0, "0"},
+			{[]string{"-0.4"}, 0, "0"},
+
+			// No intermediate overflow
+			{[]string{"9999999999999999999", "9999999999999999999", "-9999999999999999999"}, 0, "9999999999999999999"},
+			{[]string{"-9999999999999999999", "-9999999999999999999", "9999999999999999999", "0.5"}, 0, "-9999999999999999998"},
+
+			// No intermediate rounding
+			{[]string{"1000000000000000000", "0.0000000000000000001", "-1000000000000000000"}, 19, "0.0000000000000000001"},
+			{[]string{"0.5", "0.0000000000000000001"}, 0, "1"},
+			{[]string{"0.5"}, 0, "0"},
+		}
+		for _, tt := range tests {
+			var a Accumulator
+			d := requireFromStrings(tt.d)
+			for _, f := range d {
+				a.Add(f)
+			}
+			got, err := a.Result(tt.scale)
+			if err != nil {
+				t.Errorf("Accumulator.Add(%v).Result(%v) failed: %v", d, tt.scale, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("Accumulator.Add(%v).Result(%v) = %q, want %q", d, tt.scale, got, want)
+			}
+			if a.Count() != len(d) {
+				t.Errorf("Accumulator.Add(%v).Count() = %v, want %v", d, a.Count(), len(d))
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d     []string
+			scale int
+		}{
+			"overflow 1":    {[]string{"9999999999999999999", "1"}, 0},
+			"overflow 2":    {[]string{"9999999999999999999", "0.5"}, 0},
+			"overflow 3":    {[]string{"-9999999999999999999", "-1"}, 0},
+			"overflow 4":    {[]string{"1"}, 19},
+			"overflow 5":    {[]string{"1000000000", "0.1"}, 10},
+			"scale range 1": {[]string{"1"}, -1},
+			"scale range 2": {[]string{"1"}, MaxScale + 1},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				var a Accumulator
+				d := requireFromStrings(tt.d)
+				for _, f := range d {
+					a.Add(f)
+				}
+				_, err := a.Result(tt.scale)
+				if err == nil {
+					t.Errorf("Accumulator.Add(%v).Result(%v) did not fail", d, tt.scale)
+				}
+			})
+		}
+	})
+}
+
+func TestAccumulator_Sub(t *testing.T) {
+	tests := []struct {
+		d     []string
+		scale int
+		want  string
+	}{
+		{[]string{"1"}, 0, "-1"},
+		{[]string{"1", "1"}, 0, "-2"},
+		{[]string{"5.75", "-3.3"}, 2, "-2.45"},
+		{[]string{"-9999999999999999999", "-9999999999999999999", "9999999999999999999"}, 0, "9999999999999999999"},
+	}
+	for _, tt := range tests {
+		var a Accumulator
+		d := requireFromStrings(tt.d)
+		for _, f := range d {
+			a.Sub(f)
+		}
+		got, err := a.Result(tt.scale)
+		if err != nil {
+			t.Errorf("Accumulator.Sub(%v).Result(%v) failed: %v", d, tt.scale, err)
+			continue
+		}
+		want := RequireFromString(tt.want)
+		if got != want {
+			t.Errorf("Accumulator.Sub(%v).Result(%v) = %q, want %q", d, tt.scale, got, want)
+		}
+	}
+}
+
+func TestAccumulator_AddMul(t *testing.T) {
+	tests := []struct {
+		d, e  []string
+		scale int
+		want  string
+	}{
+		{[]string{"2"}, []string{"3"}, 0, "6"},
+		{[]string{"2", "4"}, []string{"3", "-0.5"}, 1, "4.0"},
+		{[]string{"1.25", "2.50"}, []string{"0.04", "0.02"}, 4, "0.1000"},
+		{[]string{"0.0000000000000000001"}, []string{"0.0000000000000000001"}, 19, "0.0000000000000000000"},
+		{[]string{"9999999999999999999", "9999999999999999999"}, []string{"9999999999999999999", "-9999999999999999999"}, 0, "0"},
+		{[]string{"3037000500"}, []string{"3037000500"}, 0, "9223372037000250000"},
+	}
+	for _, tt := range tests {
+		var a Accumulator
+		d := requireFromStrings(tt.d)
+		e := requireFromStrings(tt.e)
+		for i := range d {
+			a.AddMul(d[i], e[i])
+		}
+		got, err := a.Result(tt.scale)
+		if err != nil {
+			t.Errorf("Accumulator.AddMul(%v, %v).Result(%v) failed: %v", d, e, tt.scale, err)
+			continue
+		}
+		want := RequireFromString(tt.want)
+		if got != want {
+			t.Errorf("Accumulator.AddMul(%v, %v).Result(%v) = %q, want %q", d, e, tt.scale, got, want)
+		}
+	}
+}
+
+func TestAccumulator_ResultMode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			scale int
+			mode  RoundingMode
+			want  string
+		}{
+			{"2.5", 0, HalfEven, "2"},
+			{"2.5", 0, HalfUp, "3"},
+			{"2.5", 0, HalfDown, "2"},
+			{"2.5", 0, Up, "3"},
+			{"2.5", 0, Down, "2"},
+			{"-2.5", 0, Ceiling, "-2"},
+			{"-2.5", 0, Floor, "-3"},
+			{"2.51", 1, Floor, "2.5"},
+		}
+		for _, tt := range tests {
+			var a Accumulator
+			d := RequireFromString(tt.d)
+			a.Add(d)
+			got, err := a.ResultMode(tt.scale, tt.mode)
+			if err != nil {
+				t.Errorf("Accumulator.Add(%v).ResultMode(%v, %v) failed: %v", d, tt.scale, tt.mode, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("Accumulator.Add(%v).ResultMode(%v, %v) = %q, want %q", d, tt.scale, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var a Accumulator
+		_, err := a.ResultMode(0, RoundingMode(-1))
+		if !errors.Is(err, ErrModeRange) {
+			t.Errorf("Accumulator{}.ResultMode(0, -1) = %v, want %v", err, ErrModeRange)
+		}
+	})
+}
+
+func TestAccumulator_Reset(t *testing.T) {
+	var a Accumulator
+	a.Add(RequireFromString("9999999999999999999"))
+	a.AddMul(RequireFromString("1.5"), RequireFromString("0.001"))
+	a.Sub(One)
+	if a.Count() != 3 {
+		t.Errorf("Accumulator.Count() = %v, want 3", a.Count())
+	}
+	a.Reset()
+	if a.Count() != 0 {
+		t.Errorf("Accumulator.Reset().Count() = %v, want 0", a.Count())
+	}
+	got, err := a.Result(0)
+	if err != nil {
+		t.Fatalf("Accumulator.Reset().Result(0) failed: %v", err)
+	}
+	if got != Zero {
+		t.Errorf("Accumulator.Reset().Result(0) = %q, want %q", got, Zero)
+	}
+
+	// The accumulator is reusable after reset
+	a.Add(RequireFromString("1.5"))
+	got, err = a.Result(1)
+	if err != nil {
+		t.Fatalf("Accumulator.Result(1) failed: %v", err)
+	}
+	want := RequireFromString("1.5")
+	if got != want {
+		t.Errorf("Accumulator.Result(1) = %q, want %q", got, want)
+	}
+}
+
+func FuzzAccumulator_BigDecimal(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			var a Accumulator
+			a.Add(d)
+			a.Add(e)
+			got, err := a.Result(0)
+			if err != nil {
+				t.Skip() // Overflow is an expected error
+				return
+			}
+
+			s := d.BigDecimal().Add(e.BigDecimal()).Round(0)
+			want, err := s.Decimal()
+			if err != nil {
+				t.Errorf("%q.Decimal() failed: %v", s, err)
+				return
+			}
+
+			if got != want {
+				t.Errorf("Accumulator.Add(%q, %q).Result(0) = %q, want %q", d, e, got, want)
+			}
+		},
+	)
+}
@@ -0,0 +1,203 @@
+package decimal
+
+import (
+	"math/rand/v2"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// AtomicDecimal is a decimal that can be read and updated atomically
+// by multiple goroutines without additional locking.
+// Decimals are compared by their representation, so 1.0 and 1.00 are different
+// values for [AtomicDecimal.CompareAndSwap].
+// Under heavy contention, [ShardedSum] scales better for computing totals.
+//
+// The zero value is a decimal equal to 0.
+// An atomic decimal must not be copied after first use.
+type AtomicDecimal struct {
+	p atomic.Pointer[Decimal]
+}
+
+// Load atomically returns the decimal.
+func (a *AtomicDecimal) Load() Decimal {
+	if p := a.p.Load(); p != nil {
+		return *p
+	}
+	return Zero
+}
+
+// Store atomically replaces the decimal with decimal d.
+func (a *AtomicDecimal) Store(d Decimal) {
+	a.p.Store(&d)
+}
+
+// Swap atomically replaces the decimal with decimal d and returns the old decimal.
+func (a *AtomicDecimal) Swap(d Decimal) Decimal {
+	if p := a.p.Swap(&d); p != nil {
+		return *p
+	}
+	return Zero
+}
+
+// CompareAndSwap atomically replaces the decimal with decimal d if it is equal
+// to decimal old, and reports whether the replacement has been made.
+func (a *AtomicDecimal) CompareAndSwap(old, d Decimal) bool {
+	for {
+		p := a.p.Load()
+		switch {
+		case p == nil && old != Zero, p != nil && *p != old:
+			return false
+		case a.p.CompareAndSwap(p, &d):
+			return true
+		}
+	}
+}
+
+// Add atomically adds decimal d to the decimal and returns the (possibly rounded) sum.
+// See also method [Decimal.Add].
+//
+// Add returns an error if the integer part of the sum has more than [MaxPrec] digits.
+// In this case, the decimal is not changed.
+func (a *AtomicDecimal) Add(d Decimal) (Decimal, error) {
+	for {
+		p := a.p.Load()
+		e := Zero
+		if p != nil {
+			e = *p
+		}
+		f, err := e.Add(d)
+		if err != nil {
+			return Decimal{}, err
+		}
+		if a.p.CompareAndSwap(p, &f) {
+			return f, nil
+		}
+	}
+}
+
+// ShardedSum computes an exact total of decimals added concurrently
+// by multiple goroutines.
+// Each call to [ShardedSum.Add] updates one of several independently locked
+// [Accumulator] shards, so goroutines rarely contend with each other,
+// and the shards are combined only when the total is loaded.
+// Like [Accumulator], it never rounds or overflows an intermediate result.
+//
+// The zero value is a total equal to 0 with one shard per logical CPU.
+// A sharded sum must not be copied after first use.
+type ShardedSum struct {
+	once   sync.Once
+	shards []sumShard
+}
+
+// sumShard is an accumulator with its own lock.
+// It is padded to occupy its own cache lines to avoid false sharing.
+type sumShard struct {
+	mu  sync.Mutex
+	acc Accumulator
+	_   [64]byte
+}
+
+// init allocates the shards on first use.
+func (s *ShardedSum) init() {
+	s.once.Do(func() {
+		s.shards = make([]sumShard, runtime.GOMAXPROCS(0))
+	})
+}
+
+// Add adds decimal d to the total.
+func (s *ShardedSum) Add(d Decimal) {
+	sh := s.lock()
+	sh.acc.Add(d)
+	sh.mu.Unlock()
+}
+
+// Sub subtracts decimal d from the total.
+func (s *ShardedSum) Sub(d Decimal) {
+	sh := s.lock()
+	sh.acc.Sub(d)
+	sh.mu.Unlock()
+}
+
+// lock locks and returns a shard.
+// It starts at a random shard and takes the first one that is not locked,
+// waiting for the starting shard only if all of them are locked.
+func (s *ShardedSum) lock() *sumShard {
+	s.init()
+	n := uint(len(s.shards))
+	i := rand.UintN(n)
+	for j := range n {
+		sh := &s.shards[(i+j)%n]
+		if sh.mu.TryLock() {
+			return sh
+		}
+	}
+	sh := &s.shards[i]
+	sh.mu.Lock()
+	return sh
+}
+
+// lockAll locks all shards in order, so that the total can be read
+// or replaced consistently.
+func (s *ShardedSum) lockAll() {
+	s.init()
+	for i := range s.shards {
+		s.shards[i].mu.Lock()
+	}
+}
+
+// unlockAll unlocks all shards.
+func (s *ShardedSum) unlockAll() {
+	for i := range s.shards {
+		s.shards[i].mu.Unlock()
+	}
+}
+
+// total returns an accumulator with the combined total of all shards.
+// The caller must hold the locks of all shards.
+func (s *ShardedSum) total() *Accumulator {
+	a := new(Accumulator)
+	for i := range s.shards {
+		a.merge(&s.shards[i].acc)
+	}
+	return a
+}
+
+// Load returns the (possibly rounded) total.
+// The scale of the result is the largest scale of the added decimals,
+// in the same way as [Sum] does.
+//
+// Load returns an error if the integer part of the total has more than [MaxPrec] digits.
+func (s *ShardedSum) Load() (Decimal, error) {
+	s.lockAll()
+	a := s.total()
+	s.unlockAll()
+	e, err := a.value()
+	if err != nil {
+		return Decimal{}, newOpError("accumulate", 0, err)
+	}
+	return e, nil
+}
+
+// Result returns the total rounded to the specified number of digits after
+// the decimal point in the same way as [Accumulator.Result] does.
+//
+// Result returns an error if:
+//   - the scale is negative or greater than [MaxScale];
+//   - the integer part of the result has more than [MaxPrec] - scale digits.
+func (s *ShardedSum) Result(scale int) (Decimal, error) {
+	s.lockAll()
+	a := s.total()
+	s.unlockAll()
+	return a.Result(scale)
+}
+
+// Store replaces the total with decimal d.
+func (s *ShardedSum) Store(d Decimal) {
+	s.lockAll()
+	for i := range s.shards {
+		s.shards[i].acc.Reset()
+	}
+	s.shards[0].acc.Add(d)
+	s.unlockAll()
+}
@@ -0,0 +1,340 @@
+package decimal
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestAtomicDecimal_ZeroValue(t *testing.T) {
+	var a AtomicDecimal
+	got := a.Load()
+	if got != Zero {
+		t.Errorf("AtomicDecimal{}.Load() = %q, want %q", got, Zero)
+	}
+}
+
+func TestAtomicDecimal_Store(t *testing.T) {
+	var a AtomicDecimal
+	d := RequireFromString("1.23")
+	a.Store(d)
+	got := a.Load()
+	if got != d {
+		t.Errorf("AtomicDecimal.Store(%q).Load() = %q, want %q", d, got, d)
+	}
+}
+
+func TestAtomicDecimal_Swap(t *testing.T) {
+	var a AtomicDecimal
+	d := RequireFromString("1.23")
+	e := RequireFromString("-4.5")
+	if got := a.Swap(d); got != Zero {
+		t.Errorf("AtomicDecimal{}.Swap(%q) = %q, want %q", d, got, Zero)
+	}
+	if got := a.Swap(e); got != d {
+		t.Errorf("AtomicDecimal.Swap(%q) = %q, want %q", e, got, d)
+	}
+	if got := a.Load(); got != e {
+		t.Errorf("AtomicDecimal.Load() = %q, want %q", got, e)
+	}
+}
+
+func TestAtomicDecimal_CompareAndSwap(t *testing.T) {
+	tests := []struct {
+		init, old, d string
+		wantOK       bool
+		want         string
+	}{
+		{"", "0", "1", true, "1"},
+		{"", "0.0", "1", false, "0"},
+		{"", "1", "2", false, "0"},
+		{"1", "1", "2", true, "2"},
+		{"1", "1.0", "2", false, "1"},
+		{"1", "2", "3", false, "1"},
+		{"-1.5", "-1.5", "0", true, "0"},
+	}
+	for _, tt := range tests {
+		var a AtomicDecimal
+		if tt.init != "" {
+			a.Store(RequireFromString(tt.init))
+		}
+		old := RequireFromString(tt.old)
+		d := RequireFromString(tt.d)
+		ok := a.CompareAndSwap(old, d)
+		if ok != tt.wantOK {
+			t.Errorf("AtomicDecimal(%q).CompareAndSwap(%q, %q) = %v, want %v", tt.init, old, d, ok, tt.wantOK)
+		}
+		got := a.Load()
+		want := RequireFromString(tt.want)
+		if got != want {
+			t.Errorf("AtomicDecimal(%q).CompareAndSwap(%q, %q).Load() = %q, want %q", tt.init, old, d, got, want)
+		}
+	}
+}
+
+func TestAtomicDecimal_Add(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		var a AtomicDecimal
+		tests := []struct {
+			d, want string
+		}{
+			{"1", "1"},
+			{"0.25", "1.25"},
+			{"-3", "-1.75"},
+			{"1.75", "0.00"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := a.Add(d)
+			if err != nil {
+				t.Errorf("AtomicDecimal.Add(%q) failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("AtomicDecimal.Add(%q) = %q, want %q", d, got, want)
+			}
+			if got := a.Load(); got != want {
+				t.Errorf("AtomicDecimal.Add(%q).Load() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var a AtomicDecimal
+		d := RequireFromString("9999999999999999999")
+		a.Store(d)
+		_, err := a.Add(One)
+		if !errors.Is(err, ErrOverflow) {
+			t.Errorf("AtomicDecimal(%q).Add(1) = %v, want %v", d, err, ErrOverflow)
+		}
+		if got := a.Load(); got != d {
+			t.Errorf("AtomicDecimal(%q).Add(1).Load() = %q, want %q", d, got, d)
+		}
+	})
+}
+
+func TestAtomicDecimal_Concurrent(t *testing.T) {
+	const goroutines, iterations = 16, 1000
+	var a AtomicDecimal
+	d := RequireFromString("0.01")
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iterations {
+				if _, err := a.Add(d); err != nil {
+					t.Errorf("AtomicDecimal.Add(%q) failed: %v", d, err)
+					return
+				}
+				_ = a.Load()
+			}
+		}()
+	}
+	wg.Wait()
+	got := a.Load()
+	want := RequireFromString("160.00")
+	if got != want {
+		t.Errorf("AtomicDecimal.Load() = %q, want %q", got, want)
+	}
+}
+
+func TestAtomicDecimal_CompareAndSwap_Concurrent(t *testing.T) {
+	const goroutines, iterations = 16, 1000
+	var a AtomicDecimal
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iterations {
+				for {
+					old := a.Load()
+					d, err := old.Add(One)
+					if err != nil {
+						t.Errorf("%q.Add(1) failed: %v", old, err)
+						return
+					}
+					if a.CompareAndSwap(old, d) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	got := a.Load()
+	want := MustNew(goroutines*iterations, 0)
+	if got != want {
+		t.Errorf("AtomicDecimal.Load() = %q, want %q", got, want)
+	}
+}
+
+func TestShardedSum_ZeroValue(t *testing.T) {
+	var s ShardedSum
+	got, err := s.Load()
+	if err != nil {
+		t.Fatalf("ShardedSum{}.Load() failed: %v", err)
+	}
+	if got != Zero {
+		t.Errorf("ShardedSum{}.Load() = %q, want %q", got, Zero)
+	}
+}
+
+func TestShardedSum_Load(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			add, sub []string
+			want     string
+		}{
+			{[]string{"1", "2"}, nil, "3"},
+			{[]string{"5.75", "3.3"}, nil, "9.05"},
+			{[]string{"5.75"}, []string{"3.3"}, "2.45"},
+			{[]string{"0.1", "0.2"}, []string{"0.3"}, "0.0"},
+			{[]string{"9999999999999999999", "9999999999999999999"}, []string{"9999999999999999999"}, "9999999999999999999"},
+			{[]string{"1", "0.0000000000000000001"}, nil, "1.000000000000000000"},
+		}
+		for _, tt := range tests {
+			var s ShardedSum
+			add := requireFromStrings(tt.add)
+			sub := requireFromStrings(tt.sub)
+			for _, d := range add {
+				s.Add(d)
+			}
+			for _, d := range sub {
+				s.Sub(d)
+			}
+			got, err := s.Load()
+			if err != nil {
+				t.Errorf("ShardedSum.Add(%v).Sub(%v).Load() failed: %v", add, sub, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("ShardedSum.Add(%v).Sub(%v).Load() = %q, want %q", add, sub, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var s ShardedSum
+		s.Add(RequireFromString("9999999999999999999"))
+		s.Add(One)
+		_, err := s.Load()
+		if !errors.Is(err, ErrOverflow) {
+			t.Errorf("ShardedSum.Load() = %v, want %v", err, ErrOverflow)
+		}
+	})
+}
+
+func TestShardedSum_Result(t *testing.T) {
+	var s ShardedSum
+	s.Add(RequireFromString("1.005"))
+	s.Add(RequireFromString("2"))
+	tests := []struct {
+		scale int
+		want  string
+	}{
+		{0, "3"},
+		{2, "3.00"},
+		{3, "3.005"},
+		{5, "3.00500"},
+	}
+	for _, tt := range tests {
+		got, err := s.Result(tt.scale)
+		if err != nil {
+			t.Errorf("ShardedSum.Result(%v) failed: %v", tt.scale, err)
+			continue
+		}
+		want := RequireFromString(tt.want)
+		if got != want {
+			t.Errorf("ShardedSum.Result(%v) = %q, want %q", tt.scale, got, want)
+		}
+	}
+}
+
+func TestShardedSum_Store(t *testing.T) {
+	var s ShardedSum
+	s.Add(RequireFromString("1.005"))
+	s.Add(RequireFromString("2"))
+	d := RequireFromString("-7.5")
+	s.Store(d)
+	got, err := s.Load()
+	if err != nil {
+		t.Fatalf("ShardedSum.Store(%q).Load() failed: %v", d, err)
+	}
+	if got != d {
+		t.Errorf("ShardedSum.Store(%q).Load() = %q, want %q", d, got, d)
+	}
+}
+
+func TestShardedSum_Concurrent(t *testing.T) {
+	const goroutines, iterations = 16, 1000
+	var s ShardedSum
+	d := RequireFromString("9999999999999999999")
+	var wg sync.WaitGroup
+	for i := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range iterations {
+				// Odd goroutines undo the work of even ones
+				if i%2 == 0 {
+					s.Add(d)
+				} else {
+					s.Sub(d)
+				}
+			}
+			_, _ = s.Result(0)
+		}()
+	}
+	wg.Wait()
+	got, err := s.Load()
+	if err != nil {
+		t.Fatalf("ShardedSum.Load() failed: %v", err)
+	}
+	if got != Zero {
+		t.Errorf("ShardedSum.Load() = %q, want %q", got, Zero)
+	}
+}
+
+func BenchmarkAtomicDecimal_Add(b *testing.B) {
+	var a AtomicDecimal
+	d := RequireFromString("0.01")
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := a.Add(d); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkShardedSum_Add(b *testing.B) {
+	var s ShardedSum
+	d := RequireFromString("0.01")
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s.Add(d)
+		}
+	})
+}
+
+func BenchmarkMutex_Add(b *testing.B) {
+	var mu sync.Mutex
+	var total Decimal
+	d := RequireFromString("0.01")
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			mu.Lock()
+			e, err := total.Add(d)
+			if err != nil {
+				mu.Unlock()
+				b.Fatal(err)
+			}
+			total = e
+			mu.Unlock()
+		}
+	})
+}
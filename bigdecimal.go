@@ -0,0 +1,1308 @@
+package decimal
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// BigDecimal represents an arbitrary-precision floating-point decimal number.
+// Its numeric value is coef / 10^scale, where the coefficient is a *big.Int
+// of unlimited size and the scale is a 32-bit integer, which can be negative.
+// Its zero value corresponds to the numeric value of 0.
+//
+// Addition, subtraction, and multiplication of BigDecimal values are exact.
+// Operations whose results may have infinitely many digits, such as division,
+// square root, exponential and logarithm, accept an explicit precision, which
+// is the number of significant digits in the rounded result.
+// Note that the cost of exact operations grows with the difference between
+// the scales of their operands, so adding 1e1000000 to 1 creates a
+// coefficient with a million digits.
+//
+// BigDecimal is immutable: its methods never modify the receiver or the
+// arguments, so it is safe for concurrent use by multiple goroutines.
+type BigDecimal struct {
+	neg   bool  // indicates whether the decimal is negative
+	scale int32 // position of the floating decimal point
+	coef  *bint // numeric value without decimal point, nil for zero
+}
+
+// bzero is a coefficient of zero decimals.
+// It must not be modified.
+var bzero = (*bint)(new(big.Int))
+
+// newBigDecimal creates a new decimal and checks its scale.
+// The coefficient must not be negative, and it must not be shared with
+// other decimals, since it becomes part of the result.
+func newBigDecimal(neg bool, coef *bint, scale int64) (BigDecimal, error) {
+	// Special case: zero
+	if coef.sign() == 0 {
+		scale = min(max(scale, math.MinInt32), math.MaxInt32)
+		return BigDecimal{scale: int32(scale)}, nil
+	}
+	// General case
+	switch {
+	case scale < math.MinInt32:
+		return BigDecimal{}, fmt.Errorf("%w: the scale of a %T can be at least %v, but it is %v", ErrOverflow, BigDecimal{}, math.MinInt32, scale)
+	case scale > math.MaxInt32:
+		return BigDecimal{}, fmt.Errorf("%w: the scale of a %T can be at most %v, but it is %v", ErrScaleRange, BigDecimal{}, math.MaxInt32, scale)
+	}
+	return BigDecimal{neg: neg, scale: int32(scale), coef: coef}, nil
+}
+
+// newBigDecimalFromPrec creates a new decimal and rounds its coefficient
+// to the given number of significant digits using the given rounding mode.
+// The coefficient is modified in place.
+func newBigDecimalFromPrec(neg bool, coef *bint, scale int64, prec int, mode RoundingMode) (BigDecimal, error) {
+	if p := coef.prec(); p > prec {
+		coef.rshMode(coef, p-prec, mode, neg)
+		scale -= int64(p - prec)
+		// Handling the rare case when rshMode rounded
+		// a coefficient of 99...9 to 100...0.
+		if coef.hasPrec(prec + 1) {
+			coef.rshDown(coef, 1)
+			scale--
+		}
+	}
+	return newBigDecimal(neg, coef, scale)
+}
+
+// NewBigDecimal returns a decimal equal to coef / 10^scale.
+// NewBigDecimal keeps trailing zeros in the fractional part to preserve scale.
+// Negative scales multiply the coefficient by a power of ten.
+//
+// NewBigDecimal returns an error if scale is outside the range of int32.
+func NewBigDecimal(coef int64, scale int) (BigDecimal, error) {
+	bcoef := new(bint)
+	bcoef.setInt64(coef)
+	return newBigDecimalFromBint(bcoef, int64(scale))
+}
+
+// MustNewBigDecimal is like [NewBigDecimal] but panics if the decimal cannot be constructed.
+// It simplifies safe initialization of global variables holding decimals.
+func MustNewBigDecimal(coef int64, scale int) BigDecimal {
+	d, err := NewBigDecimal(coef, scale)
+	if err != nil {
+		panic(fmt.Sprintf("NewBigDecimal(%v, %v) failed: %v", coef, scale, err))
+	}
+	return d
+}
+
+// NewBigDecimalFromBigInt returns a decimal equal to coef / 10^scale.
+// The coefficient is copied, so it can be modified after the call.
+//
+// NewBigDecimalFromBigInt returns an error if scale is outside the range of int32.
+func NewBigDecimalFromBigInt(coef *big.Int, scale int) (BigDecimal, error) {
+	bcoef := new(bint)
+	bcoef.setBint((*bint)(coef))
+	return newBigDecimalFromBint(bcoef, int64(scale))
+}
+
+// newBigDecimalFromBint creates a new decimal from a signed coefficient.
+func newBigDecimalFromBint(coef *bint, scale int64) (BigDecimal, error) {
+	neg := coef.sign() < 0
+	if neg {
+		(*big.Int)(coef).Neg((*big.Int)(coef))
+	}
+	return newBigDecimal(neg, coef, scale)
+}
+
+// NewBigDecimalFromString converts a string to a decimal.
+// The input string must be in one of the formats described in [NewFromString].
+// Unlike [NewFromString], the conversion is always exact, so the string
+// can contain any number of digits, and the exponent can be any value
+// within the range of int32.
+//
+// NewBigDecimalFromString returns an error if:
+//   - the string contains any whitespaces;
+//   - the string does not represent a valid decimal number;
+//   - the resulting scale is outside the range of int32.
+func NewBigDecimalFromString(s string) (BigDecimal, error) {
+	bcoef := new(bint)
+	neg, scale, err := scanBint(s, bcoef, math.MaxInt32)
+	if err != nil {
+		return BigDecimal{}, newParseError(s, 0, err)
+	}
+	d, err := newBigDecimal(neg, bcoef, int64(scale))
+	if err != nil {
+		return BigDecimal{}, newParseError(s, 0, err)
+	}
+	return d, nil
+}
+
+// MustNewBigDecimalFromString is like [NewBigDecimalFromString] but panics
+// if the string cannot be parsed.
+// It simplifies safe initialization of global variables holding decimals.
+func MustNewBigDecimalFromString(s string) BigDecimal {
+	d, err := NewBigDecimalFromString(s)
+	if err != nil {
+		panic(fmt.Sprintf("NewBigDecimalFromString(%q) failed: %v", s, err))
+	}
+	return d
+}
+
+// NewBigDecimalFromFloat64 converts a float to a decimal.
+// The result is the shortest decimal that converts back to the same float.
+//
+// NewBigDecimalFromFloat64 returns an error wrapping [ErrInvalidOperation]
+// if the float is a special value (NaN or Inf).
+func NewBigDecimalFromFloat64(f float64) (BigDecimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return BigDecimal{}, newBigOpError("fromfloat", fmt.Errorf("%w: special value %v", ErrInvalidOperation, f))
+	}
+	d, err := NewBigDecimalFromString(strconv.FormatFloat(f, 'e', -1, 64))
+	if err != nil {
+		return BigDecimal{}, newBigOpError("fromfloat", err)
+	}
+	return d, nil
+}
+
+// BigDecimal converts a decimal to a [BigDecimal].
+// This conversion is always exact.
+// See also method [BigDecimal.Decimal].
+func (d Decimal) BigDecimal() BigDecimal {
+	coef := new(bint)
+	coef.setFint(d.coef)
+	//nolint:errcheck
+	e, _ := newBigDecimal(d.IsNeg(), coef, int64(d.Scale()))
+	return e
+}
+
+// BigDecimal converts a decimal to a [BigDecimal].
+// This conversion is always exact.
+// See also method [BigDecimal.Decimal128].
+func (d Decimal128) BigDecimal() BigDecimal {
+	coef := new(bint)
+	coef.setDint(d.coef)
+	//nolint:errcheck
+	e, _ := newBigDecimal(d.IsNeg(), coef, int64(d.Scale()))
+	return e
+}
+
+// Decimal converts a decimal to a (possibly rounded) [Decimal].
+// The conversion is exact if the decimal has no more than [MaxScale] digits
+// after the decimal point and no more than [MaxPrec] digits in total.
+// Otherwise, the result is rounded using [rounding half to even] (banker's rounding).
+// See also method [Decimal.BigDecimal].
+//
+// Decimal returns an overflow error if the integer part of the decimal
+// has more than [MaxPrec] digits.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d BigDecimal) Decimal() (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newUnsafe(false, 0, min(max(d.Scale(), MinScale), MaxScale)), nil
+	}
+
+	// Overflow validation
+	prec, scale := d.Prec(), d.Scale()
+	if prec-scale > MaxPrec {
+		err := overflowCause(Decimal{}, MaxPrec, prec, scale, 0)
+		return Decimal{}, newBigOpError("convert", err, d)
+	}
+
+	// General case
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setBint(d.coef)
+	if scale-prec > MaxScale+1 {
+		// All digits are discarded, and the result is rounded
+		// the same way as a single non-zero digit far beyond the scale.
+		dcoef.setFint(1)
+		scale = 2*MaxScale + 1
+	}
+	e, err := newFromBint(d.IsNeg(), dcoef, scale, 0, HalfEven)
+	if err != nil {
+		return Decimal{}, newBigOpError("convert", err, d)
+	}
+	return e, nil
+}
+
+// Decimal128 converts a decimal to a (possibly rounded) [Decimal128].
+// The conversion is exact if the decimal has no more than [MaxScale128] digits
+// after the decimal point and no more than [MaxPrec128] digits in total.
+// Otherwise, the result is rounded using [rounding half to even] (banker's rounding).
+// See also method [Decimal128.BigDecimal].
+//
+// Decimal128 returns an overflow error if the integer part of the decimal
+// has more than [MaxPrec128] digits.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d BigDecimal) Decimal128() (Decimal128, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newUnsafe128(false, dint{}, min(max(d.Scale(), MinScale), MaxScale128)), nil
+	}
+
+	// Overflow validation
+	prec, scale := d.Prec(), d.Scale()
+	if prec-scale > MaxPrec128 {
+		err := overflowCause(Decimal128{}, MaxPrec128, prec, scale, 0)
+		return Decimal128{}, newBigOpError("convert", err, d)
+	}
+
+	// General case
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setBint(d.coef)
+	if scale-prec > MaxScale128+1 {
+		// All digits are discarded, and the result is rounded
+		// the same way as a single non-zero digit far beyond the scale.
+		dcoef.setFint(1)
+		scale = 2*MaxScale128 + 1
+	}
+	e, err := newFromBint128(d.IsNeg(), dcoef, scale, 0, HalfEven)
+	if err != nil {
+		return Decimal128{}, newBigOpError("convert", err, d)
+	}
+	return e, nil
+}
+
+// bcoef returns the coefficient of the decimal.
+// The result must not be modified.
+func (d BigDecimal) bcoef() *bint {
+	if d.coef == nil {
+		return bzero
+	}
+	return d.coef
+}
+
+// digits returns the decimal digits of the coefficient.
+// The result is empty if the decimal is zero.
+func (d BigDecimal) digits() []byte {
+	if d.IsZero() {
+		return nil
+	}
+	return (*big.Int)(d.coef).Append(nil, 10)
+}
+
+// String implements the [fmt.Stringer] interface and returns
+// a string representation of the decimal.
+// Trailing zeros in the fractional part are preserved.
+// Decimals with a negative scale or with more than 6 leading zeros
+// after the decimal point are represented in scientific notation,
+// as described in [ANSI X3.274-1996], for example "1.23E+5" or "1E-10".
+// The returned string can be parsed by [NewBigDecimalFromString].
+// See also method [BigDecimal.Format].
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+// [ANSI X3.274-1996]: https://speleotrove.com/decimal/daconvs.html#reftostr
+func (d BigDecimal) String() string {
+	digits := d.digits()
+	if len(digits) == 0 {
+		digits = []byte{'0'}
+	}
+	scale := int64(d.scale)
+	adjusted := int64(len(digits)) - 1 - scale
+
+	buf := make([]byte, 0, len(digits)+16)
+
+	// Sign
+	if d.IsNeg() {
+		buf = append(buf, '-')
+	}
+
+	// Scientific notation
+	if scale < 0 || adjusted < -6 {
+		buf = append(buf, digits[0])
+		if len(digits) > 1 {
+			buf = append(buf, '.')
+			buf = append(buf, digits[1:]...)
+		}
+		buf = append(buf, 'E')
+		if adjusted >= 0 {
+			buf = append(buf, '+')
+		}
+		buf = strconv.AppendInt(buf, adjusted, 10)
+		return string(buf)
+	}
+
+	// Integer part
+	if n := int64(len(digits)) - scale; n > 0 {
+		buf = append(buf, digits[:n]...)
+		digits = digits[n:]
+	} else {
+		buf = append(buf, '0')
+	}
+
+	// Fractional part
+	if scale > 0 {
+		buf = append(buf, '.')
+		for range scale - int64(len(digits)) {
+			buf = append(buf, '0')
+		}
+		buf = append(buf, digits...)
+	}
+
+	return string(buf)
+}
+
+// Format implements the [fmt.Formatter] interface.
+// The available verbs, flags, and precisions are the same as in [Decimal.Format].
+// Unlike [BigDecimal.String], Format never uses scientific notation,
+// so formatting a decimal with a large exponent produces a long string.
+//
+// [fmt.Formatter]: https://pkg.go.dev/fmt#Formatter
+func (d BigDecimal) Format(state fmt.State, verb rune) {
+	var err error
+
+	// Percentage multiplier
+	if verb == 'k' || verb == 'K' {
+		d, err = newBigDecimal(d.IsNeg(), d.bcoef(), int64(d.scale)-2)
+		if err != nil {
+			// This panic is handled inside the fmt package.
+			panic(fmt.Errorf("formatting percent: %w", err))
+		}
+	}
+
+	// Rescaling
+	var tzeros int
+	if verb == 'f' || verb == 'F' || verb == 'k' || verb == 'K' {
+		scale := d.Scale()
+		if p, ok := state.Precision(); ok {
+			scale = p
+		}
+		scale = max(scale, MinScale)
+		switch {
+		case scale < d.Scale():
+			d = d.Round(scale)
+		case scale > d.Scale():
+			tzeros = scale - max(d.Scale(), MinScale)
+		}
+	}
+
+	// Coefficient digits
+	digits := d.digits()
+	scale := d.Scale()
+	if scale < 0 {
+		if len(digits) > 0 {
+			for range -scale {
+				digits = append(digits, '0')
+			}
+		}
+		scale = 0
+	}
+
+	writeDecimal(state, verb, d.IsNeg(), digits, scale, tzeros, "decimal.BigDecimal")
+}
+
+// Float64 returns the nearest binary floating-point number rounded
+// using [rounding half to even] (banker's rounding).
+//
+// This conversion may lose data, as float64 has a smaller precision
+// than the decimal type.
+// If the decimal is outside the range of float64, ok is false.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d BigDecimal) Float64() (f float64, ok bool) {
+	f, err := strconv.ParseFloat(d.String(), 64)
+	if err != nil {
+		return 0, false
+	}
+	return f, true
+}
+
+// GobEncode implements the gob.GobEncoder interface for gob serialization.
+func (d BigDecimal) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for gob serialization.
+func (d *BigDecimal) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// See also constructor [NewBigDecimalFromString].
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (d *BigDecimal) UnmarshalText(text []byte) error {
+	var err error
+	*d, err = NewBigDecimalFromString(string(text))
+	return err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// See also method [BigDecimal.String].
+//
+// [encoding.TextMarshaler]: https://pkg.go.dev/encoding#TextMarshaler
+func (d BigDecimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// See also method [BigDecimal.MarshalBinary].
+//
+// [encoding.BinaryUnmarshaler]: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (d *BigDecimal) UnmarshalBinary(data []byte) error {
+	if len(data) < 5 || data[0] > 1 {
+		return newParseError("", 0, ErrInvalidDecimal)
+	}
+	coef := new(bint)
+	(*big.Int)(coef).SetBytes(data[5:])
+	//nolint:gosec
+	scale := int32(binary.BigEndian.Uint32(data[1:5]))
+	e, err := newBigDecimal(data[0] == 1, coef, int64(scale))
+	if err != nil {
+		return newParseError("", 0, err)
+	}
+	*d = e
+	return nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The returned bytes consist of a sign byte (0 for positive numbers and 1
+// for negative numbers), the scale as a 32-bit big-endian integer, and
+// the absolute value of the coefficient as a big-endian byte slice.
+//
+// [encoding.BinaryMarshaler]: https://pkg.go.dev/encoding#BinaryMarshaler
+func (d BigDecimal) MarshalBinary() ([]byte, error) {
+	coef := (*big.Int)(d.bcoef())
+	buf := make([]byte, 5+(coef.BitLen()+7)/8)
+	if d.IsNeg() {
+		buf[0] = 1
+	}
+	//nolint:gosec
+	binary.BigEndian.PutUint32(buf[1:5], uint32(d.scale))
+	coef.FillBytes(buf[5:])
+	return buf, nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// Both JSON strings and JSON numbers are accepted.
+// As is customary for the [json.Unmarshaler] interface,
+// the JSON null value is a no-op.
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (d *BigDecimal) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	var err error
+	*d, err = NewBigDecimalFromString(string(data))
+	return err
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The decimal is encoded as a JSON string, since JSON numbers are usually
+// decoded as float64 values, which cannot hold arbitrary precision.
+// See also method [BigDecimal.String].
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (d BigDecimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// Scan implements the [sql.Scanner] interface.
+// See also constructor [NewBigDecimalFromString].
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+func (d *BigDecimal) Scan(value any) error {
+	var err error
+	switch value := value.(type) {
+	case string:
+		*d, err = NewBigDecimalFromString(value)
+	case []byte:
+		*d, err = NewBigDecimalFromString(string(value))
+	case int64:
+		*d, err = NewBigDecimal(value, 0)
+	case float64:
+		*d, err = NewBigDecimalFromFloat64(value)
+	case nil:
+		err = fmt.Errorf("converting to %T: nil is not supported", d)
+	default:
+		err = fmt.Errorf("converting from %T to %T: type %T is not supported", value, d, value)
+	}
+	return err
+}
+
+// Value implements the [driver.Valuer] interface.
+// See also method [BigDecimal.String].
+//
+// [driver.Valuer]: https://pkg.go.dev/database/sql/driver#Valuer
+func (d BigDecimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Coef returns the coefficient of the decimal with the sign of the decimal.
+// The result is a copy, so it can be modified.
+// See also method [BigDecimal.Scale].
+func (d BigDecimal) Coef() *big.Int {
+	coef := new(big.Int).Set((*big.Int)(d.bcoef()))
+	if d.IsNeg() {
+		coef.Neg(coef)
+	}
+	return coef
+}
+
+// Prec returns the number of digits in the coefficient.
+// See also method [BigDecimal.Scale].
+func (d BigDecimal) Prec() int {
+	return d.bcoef().prec()
+}
+
+// Scale returns the number of digits after the decimal point.
+// The scale is negative if the coefficient has to be multiplied
+// by a power of ten.
+// See also methods [BigDecimal.Prec], [BigDecimal.MinScale].
+func (d BigDecimal) Scale() int {
+	return int(d.scale)
+}
+
+// MinScale returns the smallest scale that the decimal can be rescaled to
+// without rounding.
+// For zero, MinScale returns the lesser of zero and the scale of the decimal.
+// See also method [BigDecimal.Trim].
+func (d BigDecimal) MinScale() int {
+	// Special case: zero
+	if d.IsZero() {
+		return min(d.Scale(), MinScale)
+	}
+	// General case
+	return d.Scale() - d.coef.ntz()
+}
+
+// Sign returns:
+//
+//	-1 if d < 0
+//	 0 if d = 0
+//	+1 if d > 0
+func (d BigDecimal) Sign() int {
+	switch {
+	case d.neg:
+		return -1
+	case d.IsZero():
+		return 0
+	}
+	return 1
+}
+
+// IsPos returns:
+//
+//	true  if d > 0
+//	false otherwise
+func (d BigDecimal) IsPos() bool {
+	return !d.IsZero() && !d.neg
+}
+
+// IsNeg returns:
+//
+//	true  if d < 0
+//	false otherwise
+func (d BigDecimal) IsNeg() bool {
+	return d.neg
+}
+
+// IsZero returns:
+//
+//	true  if d = 0
+//	false otherwise
+func (d BigDecimal) IsZero() bool {
+	return d.coef == nil
+}
+
+// Neg returns a decimal with the opposite sign.
+func (d BigDecimal) Neg() BigDecimal {
+	if d.IsZero() {
+		return d
+	}
+	return BigDecimal{neg: !d.neg, scale: d.scale, coef: d.coef}
+}
+
+// Abs returns the absolute value of the decimal.
+func (d BigDecimal) Abs() BigDecimal {
+	return BigDecimal{scale: d.scale, coef: d.coef}
+}
+
+// Round returns a decimal rounded to the specified number of digits after
+// the decimal point using [rounding half to even] (banker's rounding).
+// A negative scale rounds the decimal to a multiple of a power of ten.
+// See also method [BigDecimal.RoundMode].
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d BigDecimal) Round(scale int) BigDecimal {
+	return d.RoundMode(scale, HalfEven)
+}
+
+// RoundMode returns a decimal rounded to the specified number of digits after
+// the decimal point using the given rounding mode.
+// A negative scale rounds the decimal to a multiple of a power of ten.
+// If the given rounding mode is not valid, [HalfEven] is used instead.
+func (d BigDecimal) RoundMode(scale int, mode RoundingMode) BigDecimal {
+	scale = max(scale, math.MinInt32)
+	if scale >= d.Scale() {
+		return d
+	}
+	coef := new(bint)
+	if !d.IsZero() {
+		// If all digits are discarded, the discarded part is less than
+		// a half of the unit in the last remaining place, so shifting by
+		// one more digit than the precision gives the same result.
+		shift := min(d.Scale()-scale, d.Prec()+1)
+		coef.rshMode(d.coef, shift, mode, d.IsNeg())
+	}
+	//nolint:errcheck
+	e, _ := newBigDecimal(d.IsNeg(), coef, int64(scale))
+	return e
+}
+
+// Trunc returns a decimal truncated to the specified number of digits
+// after the decimal point using [rounding toward zero].
+// A negative scale truncates the decimal to a multiple of a power of ten.
+//
+// [rounding toward zero]: https://en.wikipedia.org/wiki/Rounding#Rounding_toward_zero
+func (d BigDecimal) Trunc(scale int) BigDecimal {
+	return d.RoundMode(scale, Down)
+}
+
+// Pad returns a decimal zero-padded to the specified number of digits after
+// the decimal point.
+// See also method [BigDecimal.Trim].
+func (d BigDecimal) Pad(scale int) BigDecimal {
+	scale = min(scale, math.MaxInt32)
+	if scale <= d.Scale() {
+		return d
+	}
+	coef := new(bint)
+	if !d.IsZero() {
+		coef.lsh(d.coef, scale-d.Scale())
+	}
+	//nolint:errcheck
+	e, _ := newBigDecimal(d.IsNeg(), coef, int64(scale))
+	return e
+}
+
+// Trim returns a decimal with trailing zeros removed up to the given number of
+// digits after the decimal point.
+// See also method [BigDecimal.Pad].
+func (d BigDecimal) Trim(scale int) BigDecimal {
+	if d.Scale() <= scale {
+		return d
+	}
+	scale = max(scale, d.MinScale())
+	return d.Trunc(scale)
+}
+
+// Add returns the exact sum of decimals d and e.
+// The scale of the result is the larger of the scales of d and e.
+func (d BigDecimal) Add(e BigDecimal) BigDecimal {
+	return d.add(e, e.IsNeg())
+}
+
+// Sub returns the exact difference between decimals d and e.
+// The scale of the result is the larger of the scales of d and e.
+func (d BigDecimal) Sub(e BigDecimal) BigDecimal {
+	return d.add(e, !e.IsNeg() && !e.IsZero())
+}
+
+// add calculates d + e, where the sign of e is replaced with eneg.
+func (d BigDecimal) add(e BigDecimal, eneg bool) BigDecimal {
+	dcoef, ecoef := d.bcoef(), e.bcoef()
+	scale := max(d.Scale(), e.Scale())
+
+	// Alignment
+	switch {
+	case d.Scale() < e.Scale() && !d.IsZero():
+		dcoef = new(bint)
+		dcoef.lsh(d.coef, e.Scale()-d.Scale())
+	case d.Scale() > e.Scale() && !e.IsZero():
+		ecoef = new(bint)
+		ecoef.lsh(e.coef, d.Scale()-e.Scale())
+	}
+
+	// Addition
+	fcoef := new(bint)
+	fneg := d.IsNeg()
+	switch {
+	case d.IsNeg() == eneg:
+		fcoef.add(dcoef, ecoef)
+	case dcoef.cmp(ecoef) >= 0:
+		fcoef.sub(dcoef, ecoef)
+	default:
+		fcoef.sub(ecoef, dcoef)
+		fneg = eneg
+	}
+
+	//nolint:errcheck
+	f, _ := newBigDecimal(fneg, fcoef, int64(scale))
+	return f
+}
+
+// Mul returns the exact product of decimals d and e.
+// The scale of the result is the sum of the scales of d and e.
+//
+// Mul returns an error if the scale of the result is outside the range of int32.
+func (d BigDecimal) Mul(e BigDecimal) (BigDecimal, error) {
+	fcoef := new(bint)
+	fcoef.mul(d.bcoef(), e.bcoef())
+	f, err := newBigDecimal(d.IsNeg() != e.IsNeg(), fcoef, int64(d.scale)+int64(e.scale))
+	if err != nil {
+		return BigDecimal{}, newBigOpError("mul", err, d, e)
+	}
+	return f, nil
+}
+
+// Quo returns the quotient of decimals d and e rounded to prec significant
+// digits using [rounding half to even] (banker's rounding).
+// Trailing zeros are removed up to the scale of d minus the scale of e.
+// See also method [BigDecimal.QuoMode].
+//
+// Quo returns an error if:
+//   - the precision is less than 1;
+//   - the divisor is 0;
+//   - the scale of the result is outside the range of int32.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d BigDecimal) Quo(e BigDecimal, prec int) (BigDecimal, error) {
+	return d.QuoMode(e, prec, HalfEven)
+}
+
+// QuoMode is similar to [BigDecimal.Quo], but it allows you to specify
+// the rounding mode that is used if the result has to be rounded.
+func (d BigDecimal) QuoMode(e BigDecimal, prec int, mode RoundingMode) (BigDecimal, error) {
+	f, err := d.quoMode(e, prec, mode)
+	if err != nil {
+		return BigDecimal{}, newBigOpError("quo", err, d, e)
+	}
+	return f, nil
+}
+
+func (d BigDecimal) quoMode(e BigDecimal, prec int, mode RoundingMode) (BigDecimal, error) {
+	// Validation
+	switch {
+	case prec < 1:
+		return BigDecimal{}, ErrPrecRange
+	case !mode.valid():
+		return BigDecimal{}, ErrModeRange
+	case e.IsZero():
+		return BigDecimal{}, ErrDivisionByZero
+	}
+
+	// Preferred scale
+	scale := int64(d.scale) - int64(e.scale)
+	if d.IsZero() {
+		return newBigDecimal(false, new(bint), scale)
+	}
+
+	// Alignment
+	// The quotient must have at least prec+1 digits.
+	shift := max(0, prec+e.Prec()-d.Prec()+1)
+	fcoef := new(bint)
+	fcoef.lsh(d.coef, shift)
+
+	// Division
+	rcoef := getBint()
+	defer putBint(rcoef)
+	fcoef.quoRem(fcoef, e.coef, rcoef)
+
+	// Sticky digit
+	fcoef.fsa(fcoef, 1, sticky(rcoef))
+
+	f, err := newBigDecimalFromPrec(d.IsNeg() != e.IsNeg(), fcoef, scale+int64(shift)+1, prec, mode)
+	if err != nil {
+		return BigDecimal{}, err
+	}
+	return f.trim(scale), nil
+}
+
+// trim is similar to [BigDecimal.Trim], but it accepts a scale outside
+// the range of int32.
+func (d BigDecimal) trim(scale int64) BigDecimal {
+	scale = min(max(scale, math.MinInt32), math.MaxInt32)
+	return d.Trim(int(scale))
+}
+
+// Sqrt computes the square root of a decimal rounded to prec significant
+// digits using [rounding half to even] (banker's rounding).
+// Trailing zeros are removed up to a half of the scale of d.
+//
+// Sqrt returns an error if:
+//   - the precision is less than 1;
+//   - the decimal is negative.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d BigDecimal) Sqrt(prec int) (BigDecimal, error) {
+	f, err := d.sqrt(prec)
+	if err != nil {
+		return BigDecimal{}, newBigOpError("sqrt", err, d)
+	}
+	return f, nil
+}
+
+func (d BigDecimal) sqrt(prec int) (BigDecimal, error) {
+	// Validation
+	switch {
+	case prec < 1:
+		return BigDecimal{}, ErrPrecRange
+	case d.IsNeg():
+		return BigDecimal{}, ErrInvalidOperation
+	}
+
+	// Preferred scale
+	scale := int64(d.scale)
+	pref := (scale + 1) >> 1
+	if d.IsZero() {
+		return newBigDecimal(false, new(bint), pref)
+	}
+
+	// Alignment
+	// The scale must be even, and the square root must have
+	// at least prec+1 digits.
+	shift := max(0, 2*prec+2-d.Prec())
+	if (scale+int64(shift))%2 != 0 {
+		shift++
+	}
+	xcoef := getBint()
+	defer putBint(xcoef)
+	xcoef.lsh(d.coef, shift)
+
+	// Square root
+	fcoef := new(bint)
+	(*big.Int)(fcoef).Sqrt((*big.Int)(xcoef))
+
+	// Sticky digit
+	rcoef := getBint()
+	defer putBint(rcoef)
+	rcoef.mul(fcoef, fcoef)
+	rcoef.sub(xcoef, rcoef)
+	fcoef.fsa(fcoef, 1, sticky(rcoef))
+
+	f, err := newBigDecimalFromPrec(false, fcoef, (scale+int64(shift))/2+1, prec, HalfEven)
+	if err != nil {
+		return BigDecimal{}, err
+	}
+	return f.trim(pref), nil
+}
+
+// Exp calculates the exponential of a decimal rounded to prec significant
+// digits using [rounding half to even] (banker's rounding).
+// Trailing zeros in the fractional part are removed.
+//
+// Exp returns an error if:
+//   - the precision is less than 1;
+//   - the scale of the result is outside the range of int32.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d BigDecimal) Exp(prec int) (BigDecimal, error) {
+	f, err := d.exp(prec)
+	if err != nil {
+		return BigDecimal{}, newBigOpError("exp", err, d)
+	}
+	return f, nil
+}
+
+func (d BigDecimal) exp(prec int) (BigDecimal, error) {
+	// Validation
+	if prec < 1 {
+		return BigDecimal{}, ErrPrecRange
+	}
+
+	// Special case: zero
+	if d.IsZero() {
+		return MustNewBigDecimal(1, 0), nil
+	}
+
+	// Special case: overflow
+	// The exponential of 10^10 has about 4.3 * 10^9 digits before
+	// the decimal point, which is close to the limit of the scale.
+	intdigs := int64(d.Prec()) - int64(d.scale)
+	if intdigs > 10 {
+		if d.IsNeg() {
+			return BigDecimal{}, fmt.Errorf("%w: the result is too close to zero", ErrScaleRange)
+		}
+		return BigDecimal{}, fmt.Errorf("%w: the result has too many digits", ErrOverflow)
+	}
+
+	// Special case: |d| < 10^(-prec-2)
+	// The result is so close to 1 that it is rounded the same way as
+	// 1.00...01 if d is positive, or 0.99...99 if d is negative.
+	if intdigs < -int64(prec)-1 {
+		fcoef := new(bint)
+		if d.IsNeg() {
+			fcoef.pow10(prec + 2)
+			fcoef.sub(fcoef, bpow10[0])
+			f, err := newBigDecimalFromPrec(false, fcoef, int64(prec)+2, prec, HalfEven)
+			if err != nil {
+				return BigDecimal{}, err
+			}
+			return f.Trim(0), nil
+		}
+		fcoef.pow10(prec + 1)
+		fcoef.add(fcoef, bpow10[0])
+		f, err := newBigDecimalFromPrec(false, fcoef, int64(prec)+1, prec, HalfEven)
+		if err != nil {
+			return BigDecimal{}, err
+		}
+		return f.Trim(0), nil
+	}
+
+	// General case
+	f, err := roundIrrational(prec, HalfEven, func(guard int) (bool, *bint, int64) {
+		// Working precision
+		// 10 digits are reserved for the integer part of d.
+		w := prec + guard + 10
+		g := len(strconv.Itoa(w)) + 8
+		x := d.fixed(w + g)
+
+		// Argument reduction: d = q * ln(10) + r, where 0 <= r < ln(10)
+		// Logarithm of 10 has 11 extra digits, because q has at most 10 digits.
+		ln10 := fixedLn10(w + g + 11)
+		q, r := new(big.Int), new(big.Int)
+		x.lsh(x, 11)
+		q.DivMod((*big.Int)(x), (*big.Int)(ln10), r)
+		x.rshDown((*bint)(r), 11)
+
+		// Further reduction: r = 2^8 * s
+		k := new(bint)
+		k.setInt64(256)
+		x.quo(x, k)
+
+		// Taylor series: exp(s) = 1 + s + s^2 / 2! + s^3 / 3! + ...
+		one := new(bint)
+		one.pow10(w + g)
+		sum := new(bint)
+		sum.setBint(one)
+		term := new(bint)
+		term.setBint(one)
+		for i := int64(1); term.sign() != 0; i++ {
+			k.setInt64(i)
+			term.mul(term, x)
+			term.quo(term, one)
+			term.quo(term, k)
+			sum.add(sum, term)
+		}
+
+		// Reconstruction: exp(r) = exp(s)^(2^8)
+		for range 8 {
+			sum.mul(sum, sum)
+			sum.quo(sum, one)
+		}
+		sum.rshDown(sum, g)
+
+		return false, sum, int64(w) - q.Int64()
+	})
+	if err != nil {
+		return BigDecimal{}, err
+	}
+	return f.Trim(0), nil
+}
+
+// Log calculates the natural logarithm of a decimal rounded to prec significant
+// digits using [rounding half to even] (banker's rounding).
+// Trailing zeros in the fractional part are removed.
+//
+// Log returns an error if:
+//   - the precision is less than 1;
+//   - the decimal is 0 or negative.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d BigDecimal) Log(prec int) (BigDecimal, error) {
+	f, err := d.log(prec)
+	if err != nil {
+		return BigDecimal{}, newBigOpError("log", err, d)
+	}
+	return f, nil
+}
+
+func (d BigDecimal) log(prec int) (BigDecimal, error) {
+	// Validation
+	switch {
+	case prec < 1:
+		return BigDecimal{}, ErrPrecRange
+	case !d.IsPos():
+		return BigDecimal{}, ErrInvalidOperation
+	}
+
+	// Special case: one
+	n := d.Prec()
+	intdigs := int64(n) - int64(d.scale)
+	unit := new(bint)
+	unit.pow10(n - 1)
+	if intdigs == 1 && d.coef.cmp(unit) == 0 {
+		return newBigDecimal(false, new(bint), 0)
+	}
+
+	// Reduction: d = (1 + z) / (1 - z) * 2^j * 10^a
+	// If 0.5 < d < 1.5, then j = 0 and a = 0, otherwise d = m * 10^a,
+	// where 1 <= m < 10, and j is chosen so that 0.75 <= m / 2^j < 1.5.
+	// Then log(d) = a * log(10) + j * log(2) + 2 * atanh(z),
+	// where z = (c - b) / (c + b), c is the coefficient of d,
+	// and b is the coefficient of 2^j * 10^a with the scale of d.
+	var a int64
+	var j int
+	c2 := new(bint)
+	c2.add(d.coef, d.coef)
+	b := new(bint)
+	switch {
+	case intdigs == 1 && c2.cmp(b.mulInt64(unit, 3)) < 0:
+		b.setBint(unit)
+	case intdigs == 0 && c2.cmp(b.mulInt64(unit, 10)) > 0:
+		// b = 10 * unit
+	default:
+		a = intdigs - 1
+		switch {
+		case c2.cmp(b.mulInt64(unit, 3)) < 0:
+			j = 0
+		case d.coef.cmp(b.mulInt64(unit, 3)) < 0:
+			j = 1
+		case d.coef.cmp(b.mulInt64(unit, 6)) < 0:
+			j = 2
+		default:
+			j = 3
+		}
+		b.mulInt64(unit, 1<<j)
+	}
+	num := new(bint)
+	num.sub(d.coef, b)
+	den := new(bint)
+	den.add(d.coef, b)
+
+	// Leading zeros in the result
+	var lzeros int
+	if a == 0 && j == 0 {
+		numabs := new(bint)
+		(*big.Int)(numabs).Abs((*big.Int)(num))
+		lzeros = den.prec() - numabs.prec()
+	}
+
+	f, err := roundIrrational(prec, HalfEven, func(guard int) (bool, *bint, int64) {
+		// Working precision
+		w := prec + guard + lzeros + 1
+		g := len(strconv.Itoa(w)) + 3
+
+		// Logarithm of 10 has 11 extra digits, because a has at most 10 digits.
+		sum := fixedAtanh(num, den, w+g)
+		sum.add(sum, sum)
+		if j != 0 {
+			ln2 := fixedLn2(w + g)
+			sum.add(sum, ln2.mulInt64(ln2, int64(j)))
+		}
+		if a != 0 {
+			ln10 := fixedLn10(w + g + 11)
+			ln10.mulInt64(ln10, a)
+			ln10.rshDown(ln10, 11)
+			sum.add(sum, ln10)
+		}
+
+		neg := sum.sign() < 0
+		if neg {
+			(*big.Int)(sum).Neg((*big.Int)(sum))
+		}
+		sum.rshDown(sum, g)
+		return neg, sum, int64(w)
+	})
+	if err != nil {
+		return BigDecimal{}, err
+	}
+	return f.Trim(0), nil
+}
+
+// Cmp compares decimals and returns:
+//
+//	-1 if d < e
+//	 0 if d = e
+//	+1 if d > e
+//
+// See also methods [BigDecimal.Equal], [BigDecimal.Less].
+func (d BigDecimal) Cmp(e BigDecimal) int {
+	// Special case: different signs
+	switch {
+	case d.Sign() > e.Sign():
+		return 1
+	case d.Sign() < e.Sign():
+		return -1
+	case d.IsZero():
+		return 0
+	}
+
+	// Special case: different number of digits in the integer part
+	dintdigs := int64(d.Prec()) - int64(d.scale)
+	eintdigs := int64(e.Prec()) - int64(e.scale)
+	switch {
+	case dintdigs > eintdigs:
+		return d.Sign()
+	case dintdigs < eintdigs:
+		return -e.Sign()
+	}
+
+	// General case
+	dcoef, ecoef := d.coef, e.coef
+
+	// Alignment
+	switch {
+	case d.Scale() > e.Scale():
+		ecoef = getBint()
+		defer putBint(ecoef)
+		ecoef.lsh(e.coef, d.Scale()-e.Scale())
+	case d.Scale() < e.Scale():
+		dcoef = getBint()
+		defer putBint(dcoef)
+		dcoef.lsh(d.coef, e.Scale()-d.Scale())
+	}
+
+	// Comparison
+	switch dcoef.cmp(ecoef) {
+	case 1:
+		return d.Sign()
+	case -1:
+		return -e.Sign()
+	}
+	return 0
+}
+
+// Equal compares decimals and returns:
+//
+//	 true if d = e
+//	false otherwise
+//
+// See also method [BigDecimal.Cmp].
+func (d BigDecimal) Equal(e BigDecimal) bool {
+	return d.Cmp(e) == 0
+}
+
+// Less compares decimals and returns:
+//
+//	 true if d < e
+//	false otherwise
+//
+// See also method [BigDecimal.Cmp].
+func (d BigDecimal) Less(e BigDecimal) bool {
+	return d.Cmp(e) < 0
+}
+
+// fixed returns the decimal as a fixed-point number with the given number
+// of digits after the decimal point.
+// The result is truncated towards zero.
+func (d BigDecimal) fixed(scale int) *bint {
+	x := new(bint)
+	shift := int64(scale) - int64(d.scale)
+	switch {
+	case shift >= 0:
+		x.lsh(d.bcoef(), int(shift))
+	case -shift > int64(d.Prec()):
+		x.setFint(0)
+	default:
+		x.rshDown(d.bcoef(), int(-shift))
+	}
+	if d.IsNeg() {
+		(*big.Int)(x).Neg((*big.Int)(x))
+	}
+	return x
+}
+
+// roundIrrational rounds an irrational number to prec significant digits.
+// Function approx must return the sign, the coefficient and the scale of
+// an approximation, which has at least prec+guard digits and differs from
+// the irrational number by less than 100 units in the last place.
+// The approximation is recomputed with more guard digits until the rounding
+// direction is determined unambiguously.
+func roundIrrational(prec int, mode RoundingMode, approx func(guard int) (bool, *bint, int64)) (BigDecimal, error) {
+	unit := getBint()
+	defer putBint(unit)
+	tail := getBint()
+	defer putBint(tail)
+	quo := getBint()
+	defer putBint(quo)
+	margin := bpow10[3]
+
+	for guard := 16; ; guard *= 2 {
+		neg, coef, scale := approx(guard)
+
+		// Discarded digits
+		shift := coef.prec() - prec
+		if shift < guard {
+			continue // Should never happen
+		}
+		unit.pow10(shift)
+		quo.quoRem(coef, unit, tail)
+
+		// The approximation must not be close to 0, 1/2 or 1 of the unit
+		// in the last remaining place, otherwise the exact number could be
+		// rounded differently.
+		if tail.cmp(margin) <= 0 {
+			continue
+		}
+		quo.sub(unit, tail)
+		if quo.cmp(margin) <= 0 {
+			continue
+		}
+		quo.add(tail, tail)
+		quo.subAbs(quo, unit)
+		if quo.cmp(margin) <= 0 {
+			continue
+		}
+
+		return newBigDecimalFromPrec(neg, coef, scale, prec, mode)
+	}
+}
+
+// fixedAtanh calculates the inverse hyperbolic tangent of num / den as
+// a fixed-point number with the given number of digits after the decimal point.
+// The absolute value of num / den must be less than 1/2.
+// The result has an error of at most the number of series terms in the last place.
+func fixedAtanh(num, den *bint, scale int) *bint {
+	one := new(bint)
+	one.pow10(scale)
+
+	// z = num / den
+	z := new(bint)
+	z.mul(num, one)
+	z.quo(z, den)
+
+	// z2 = z^2
+	z2 := new(bint)
+	z2.mul(z, z)
+	z2.quo(z2, one)
+
+	// Taylor series: atanh(z) = z + z^3 / 3 + z^5 / 5 + ...
+	sum := new(bint)
+	term := new(bint)
+	term.setBint(z)
+	k := new(bint)
+	for i := int64(1); term.sign() != 0; i += 2 {
+		k.setInt64(i)
+		k.quo(term, k)
+		sum.add(sum, k)
+		term.mul(term, z2)
+		term.quo(term, one)
+	}
+	return sum
+}
+
+// fixedLn2 calculates the natural logarithm of 2 as a fixed-point number
+// with the given number of digits after the decimal point.
+// log(2) = 2 * atanh(1/3).
+func fixedLn2(scale int) *bint {
+	g := len(strconv.Itoa(scale)) + 1
+	k := new(bint)
+	k.setInt64(3)
+	x := fixedAtanh(bpow10[0], k, scale+g)
+	x.add(x, x)
+	x.rshDown(x, g)
+	return x
+}
+
+// fixedLn10 calculates the natural logarithm of 10 as a fixed-point number
+// with the given number of digits after the decimal point.
+// log(10) = 3 * log(2) + log(1.25) = 6 * atanh(1/3) + 2 * atanh(1/9).
+func fixedLn10(scale int) *bint {
+	g := len(strconv.Itoa(scale)) + 2
+	k := new(bint)
+	k.setInt64(3)
+	x := fixedAtanh(bpow10[0], k, scale+g)
+	k.setInt64(6)
+	x.mul(x, k)
+	k.setInt64(9)
+	y := fixedAtanh(bpow10[0], k, scale+g)
+	y.add(y, y)
+	x.add(x, y)
+	x.rshDown(x, g)
+	return x
+}
@@ -0,0 +1,1038 @@
+package decimal
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestBigDecimal_ZeroValue(t *testing.T) {
+	got := BigDecimal{}
+	want := MustNewBigDecimal(0, 0)
+	if got != want {
+		t.Errorf("BigDecimal{} = %q, want %q", got, want)
+	}
+}
+
+func TestBigDecimal_Interfaces(t *testing.T) {
+	var d any
+
+	d = BigDecimal{}
+	_, ok := d.(fmt.Stringer)
+	if !ok {
+		t.Errorf("%T does not implement fmt.Stringer", d)
+	}
+	_, ok = d.(fmt.Formatter)
+	if !ok {
+		t.Errorf("%T does not implement fmt.Formatter", d)
+	}
+	_, ok = d.(encoding.TextMarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.TextMarshaler", d)
+	}
+	_, ok = d.(encoding.BinaryMarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.BinaryMarshaler", d)
+	}
+	_, ok = d.(json.Marshaler)
+	if !ok {
+		t.Errorf("%T does not implement json.Marshaler", d)
+	}
+	_, ok = d.(driver.Valuer)
+	if !ok {
+		t.Errorf("%T does not implement driver.Valuer", d)
+	}
+
+	d = &BigDecimal{}
+	_, ok = d.(encoding.TextUnmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.TextUnmarshaler", d)
+	}
+	_, ok = d.(encoding.BinaryUnmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.BinaryUnmarshaler", d)
+	}
+	_, ok = d.(json.Unmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement json.Unmarshaler", d)
+	}
+	_, ok = d.(sql.Scanner)
+	if !ok {
+		t.Errorf("%T does not implement sql.Scanner", d)
+	}
+}
+
+func TestNewBigDecimal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			coef  int64
+			scale int
+			want  string
+		}{
+			{0, 0, "0"},
+			{0, 2, "0.00"},
+			{0, -2, "0E+2"},
+			{1, 50, "1E-50"},
+			{-1, 0, "-1"},
+			{15, 1, "1.5"},
+			{15, -3, "1.5E+4"},
+			{math.MaxInt64, 0, "9223372036854775807"},
+			{math.MinInt64, 0, "-9223372036854775808"},
+			{1, math.MaxInt32, "1E-2147483647"},
+			{1, math.MinInt32, "1E+2147483648"},
+		}
+		for _, tt := range tests {
+			got, err := NewBigDecimal(tt.coef, tt.scale)
+			if err != nil {
+				t.Errorf("NewBigDecimal(%v, %v) failed: %v", tt.coef, tt.scale, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("NewBigDecimal(%v, %v) = %q, want %q", tt.coef, tt.scale, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			coef    int64
+			scale   int
+			wantErr error
+		}{
+			"scale range 1": {1, math.MaxInt32 + 1, ErrScaleRange},
+			"scale range 2": {1, math.MinInt32 - 1, ErrOverflow},
+		}
+		for _, tt := range tests {
+			_, err := NewBigDecimal(tt.coef, tt.scale)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewBigDecimal(%v, %v) = %v, want %v", tt.coef, tt.scale, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestNewBigDecimalFromBigInt(t *testing.T) {
+	coef, ok := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	if !ok {
+		t.Fatal("SetString failed")
+	}
+	got, err := NewBigDecimalFromBigInt(coef, 10)
+	if err != nil {
+		t.Fatalf("NewBigDecimalFromBigInt(%v, 10) failed: %v", coef, err)
+	}
+	coef.SetInt64(0) // the decimal must not share the coefficient
+	want := "-12345678901234567890.1234567890"
+	if got.String() != want {
+		t.Errorf("NewBigDecimalFromBigInt(...) = %q, want %q", got, want)
+	}
+	if got.Coef().String() != "-123456789012345678901234567890" || got.Scale() != 10 {
+		t.Errorf("%q.Coef(), %q.Scale() = %v, %v, want -123456789012345678901234567890, 10", got, got, got.Coef(), got.Scale())
+	}
+}
+
+func TestNewBigDecimalFromString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			want string
+		}{
+			{"0", "0"},
+			{"-0", "0"},
+			{"+1.50", "1.50"},
+			{".5", "0.5"},
+			{"5.", "5"},
+			{"0.000001", "0.000001"},
+			{"0.0000001", "1E-7"},
+			{"1e5", "1E+5"},
+			{"1.23E+5", "1.23E+5"},
+			{"123.45e-2", "1.2345"},
+			{"1e-1000", "1E-1000"},
+			{"-1e+1000", "-1E+1000"},
+			{"0e-10", "0E-10"},
+			{"123456789012345678901234567890.123456789012345678901234567890", "123456789012345678901234567890.123456789012345678901234567890"},
+		}
+		for _, tt := range tests {
+			got, err := NewBigDecimalFromString(tt.s)
+			if err != nil {
+				t.Errorf("NewBigDecimalFromString(%q) failed: %v", tt.s, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("NewBigDecimalFromString(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			s       string
+			wantErr error
+		}{
+			"empty":          {"", ErrInvalidDecimal},
+			"character":      {"1.2x", ErrInvalidDecimal},
+			"no exponent":    {"1e", ErrInvalidDecimal},
+			"exponent range": {"1e2147483648", ErrInvalidDecimal},
+			"scale range 1":  {"0.1e-2147483647", ErrScaleRange},
+		}
+		for name, tt := range tests {
+			_, err := NewBigDecimalFromString(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: NewBigDecimalFromString(%q) = %v, want %v", name, tt.s, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestNewBigDecimalFromFloat64(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			f    float64
+			want string
+		}{
+			{0, "0"},
+			{0.1, "0.1"},
+			{-1.5, "-1.5"},
+			{1e100, "1E+100"},
+			{math.SmallestNonzeroFloat64, "5E-324"},
+		}
+		for _, tt := range tests {
+			got, err := NewBigDecimalFromFloat64(tt.f)
+			if err != nil {
+				t.Errorf("NewBigDecimalFromFloat64(%v) failed: %v", tt.f, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("NewBigDecimalFromFloat64(%v) = %q, want %q", tt.f, got, tt.want)
+			}
+			if f, ok := got.Float64(); !ok || f != tt.f {
+				t.Errorf("%q.Float64() = %v, %v, want %v, true", got, f, ok, tt.f)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []float64{math.NaN(), math.Inf(1), math.Inf(-1)}
+		for _, tt := range tests {
+			_, err := NewBigDecimalFromFloat64(tt)
+			if err == nil {
+				t.Errorf("NewBigDecimalFromFloat64(%v) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestBigDecimal_Decimal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0", "0"},
+			{"0E+5", "0"},
+			{"0E-50", "0.0000000000000000000"},
+			{"-1.50", "-1.50"},
+			{"1E+3", "1000"},
+			{"9999999999999999999", "9999999999999999999"},
+			{"0.0000000000000000001", "0.0000000000000000001"},
+			{"0.00000000000000000005", "0.0000000000000000000"},
+			{"0.00000000000000000015", "0.0000000000000000002"},
+			{"1E-1000", "0.0000000000000000000"},
+			{"1.23456789012345678901234567890", "1.234567890123456789"},
+			{"-12345678901234567.8950", "-12345678901234567.90"},
+		}
+		for _, tt := range tests {
+			d := MustNewBigDecimalFromString(tt.d)
+			got, err := d.Decimal()
+			if err != nil {
+				t.Errorf("%q.Decimal() failed: %v", d, err)
+				continue
+			}
+			want := MustNewFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Decimal() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"10000000000000000000",
+			"-1E+19",
+			"1E+1000000",
+		}
+		for _, tt := range tests {
+			d := MustNewBigDecimalFromString(tt)
+			_, err := d.Decimal()
+			if !errors.Is(err, ErrOverflow) {
+				t.Errorf("%q.Decimal() = %v, want %v", d, err, ErrOverflow)
+			}
+		}
+	})
+}
+
+func TestBigDecimal_Decimal128(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0", "0"},
+			{"-1.50", "-1.50"},
+			{"99999999999999999999999999999999999999", "99999999999999999999999999999999999999"},
+			{"0.000000000000000000000000000000000000005", "0.00000000000000000000000000000000000000"},
+			{"1.234567890123456789012345678901234567890123", "1.2345678901234567890123456789012345679"},
+		}
+		for _, tt := range tests {
+			d := MustNewBigDecimalFromString(tt.d)
+			got, err := d.Decimal128()
+			if err != nil {
+				t.Errorf("%q.Decimal128() failed: %v", d, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%q.Decimal128() = %q, want %q", d, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"100000000000000000000000000000000000000",
+			"-1E+38",
+		}
+		for _, tt := range tests {
+			d := MustNewBigDecimalFromString(tt)
+			_, err := d.Decimal128()
+			if !errors.Is(err, ErrOverflow) {
+				t.Errorf("%q.Decimal128() = %v, want %v", d, err, ErrOverflow)
+			}
+		}
+	})
+}
+
+func TestDecimal_BigDecimal(t *testing.T) {
+	tests := []string{
+		"0",
+		"0.000",
+		"-1.5",
+		"9999999999999999999",
+		"-0.9999999999999999999",
+	}
+	for _, tt := range tests {
+		d := MustNewFromString(tt)
+		got := d.BigDecimal()
+		if got.String() != tt {
+			t.Errorf("%q.BigDecimal() = %q, want %q", d, got, tt)
+		}
+		e, err := got.Decimal()
+		if err != nil || e != d {
+			t.Errorf("%q.BigDecimal().Decimal() = %q, %v, want %q, nil", d, e, err, d)
+		}
+		f := d.Decimal128().BigDecimal()
+		if f.String() != tt {
+			t.Errorf("%q.Decimal128().BigDecimal() = %q, want %q", d, f, tt)
+		}
+	}
+}
+
+func TestBigDecimal_Format(t *testing.T) {
+	tests := []struct {
+		d, format, want string
+	}{
+		{"0", "%v", "0"},
+		{"0.00", "%v", "0.00"},
+		{"0E+3", "%v", "0"},
+		{"1.5E+3", "%v", "1500"},
+		{"1E-10", "%v", "0.0000000001"},
+		{"-12345678901234567890.123456789", "%v", "-12345678901234567890.123456789"},
+		{"12345678901234567890.125", "%.2f", "12345678901234567890.12"},
+		{"0.5", "%.0f", "0"},
+		{"1.5", "%.0f", "2"},
+		{"1", "%.3f", "1.000"},
+		{"1E+2", "%.1f", "100.0"},
+		{"0.12345", "%k", "12.345%"},
+		{"0.12345", "%.1k", "12.3%"},
+		{"1.5", "%q", "\"1.5\""},
+		{"1.5", "%+v", "+1.5"},
+		{"1.5", "%8v", "     1.5"},
+		{"-1.5", "%08v", "-00001.5"},
+		{"1.5", "%d", "%!d(decimal.BigDecimal=1.5)"},
+	}
+	for _, tt := range tests {
+		d := MustNewBigDecimalFromString(tt.d)
+		got := fmt.Sprintf(tt.format, d)
+		if got != tt.want {
+			t.Errorf("fmt.Sprintf(%q, %q) = %q, want %q", tt.format, d, got, tt.want)
+		}
+	}
+}
+
+func TestBigDecimal_Marshaling(t *testing.T) {
+	tests := []string{
+		"0",
+		"0E-10",
+		"0E+10",
+		"1",
+		"-1.50",
+		"1.5E+100",
+		"-1E-2147483647",
+		"123456789012345678901234567890.12345678901234567890",
+	}
+	for _, tt := range tests {
+		d := MustNewBigDecimalFromString(tt)
+
+		// Text
+		text, err := d.MarshalText()
+		if err != nil {
+			t.Errorf("%q.MarshalText() failed: %v", d, err)
+			continue
+		}
+		var got BigDecimal
+		if err := got.UnmarshalText(text); err != nil || got.String() != d.String() {
+			t.Errorf("UnmarshalText(%q) = %q, %v, want %q", text, got, err, d)
+		}
+
+		// JSON
+		data, err := json.Marshal(d)
+		if err != nil {
+			t.Errorf("json.Marshal(%q) failed: %v", d, err)
+			continue
+		}
+		if want := "\"" + d.String() + "\""; string(data) != want {
+			t.Errorf("json.Marshal(%q) = %s, want %s", d, data, want)
+		}
+		got = BigDecimal{}
+		if err := json.Unmarshal(data, &got); err != nil || got.String() != d.String() {
+			t.Errorf("json.Unmarshal(%s) = %q, %v, want %q", data, got, err, d)
+		}
+
+		// Binary
+		data, err = d.MarshalBinary()
+		if err != nil {
+			t.Errorf("%q.MarshalBinary() failed: %v", d, err)
+			continue
+		}
+		got = BigDecimal{}
+		if err := got.UnmarshalBinary(data); err != nil || got.String() != d.String() {
+			t.Errorf("UnmarshalBinary(% x) = %q, %v, want %q", data, got, err, d)
+		}
+
+		// SQL
+		value, err := d.Value()
+		if err != nil {
+			t.Errorf("%q.Value() failed: %v", d, err)
+			continue
+		}
+		got = BigDecimal{}
+		if err := got.Scan(value); err != nil || got.String() != d.String() {
+			t.Errorf("Scan(%v) = %q, %v, want %q", value, got, err, d)
+		}
+	}
+}
+
+func TestBigDecimal_UnmarshalBinary(t *testing.T) {
+	tests := [][]byte{
+		nil,
+		{0, 0, 0, 0},
+		{2, 0, 0, 0, 0, 1},
+	}
+	for _, tt := range tests {
+		var got BigDecimal
+		if err := got.UnmarshalBinary(tt); !errors.Is(err, ErrInvalidDecimal) {
+			t.Errorf("UnmarshalBinary(% x) = %v, want %v", tt, err, ErrInvalidDecimal)
+		}
+	}
+}
+
+func TestBigDecimal_UnmarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s, want string
+		}{
+			{`"1.50"`, "1.50"},
+			{`1.50`, "1.50"},
+			{`1e-3`, "0.001"},
+			{`1e400`, "1E+400"},
+			{`null`, "7"},
+		}
+		for _, tt := range tests {
+			got := MustNewBigDecimal(7, 0)
+			if err := got.UnmarshalJSON([]byte(tt.s)); err != nil {
+				t.Errorf("UnmarshalJSON(%s) failed: %v", tt.s, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("UnmarshalJSON(%s) = %q, want %q", tt.s, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{`""`, `"1.5`, `true`, `{}`}
+		for _, tt := range tests {
+			var got BigDecimal
+			if err := got.UnmarshalJSON([]byte(tt)); err == nil {
+				t.Errorf("UnmarshalJSON(%s) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestBigDecimal_Round(t *testing.T) {
+	tests := []struct {
+		d     string
+		scale int
+		mode  RoundingMode
+		want  string
+	}{
+		{"1.25", 1, HalfEven, "1.2"},
+		{"1.35", 1, HalfEven, "1.4"},
+		{"1.25", 1, HalfUp, "1.3"},
+		{"-1.25", 1, HalfDown, "-1.2"},
+		{"-1.21", 1, Ceiling, "-1.2"},
+		{"-1.21", 1, Floor, "-1.3"},
+		{"1.01", 1, ZeroFiveUp, "1.1"},
+		{"1.5", 3, HalfEven, "1.5"},
+		{"1250", -2, HalfEven, "1.2E+3"},
+		{"1350", -2, HalfEven, "1.4E+3"},
+		{"1E-1000", 0, HalfEven, "0"},
+		{"1E-1000", 0, Up, "1"},
+		{"-1E-1000", 2, Floor, "-0.01"},
+		{"0.00", 1, HalfEven, "0.0"},
+	}
+	for _, tt := range tests {
+		d := MustNewBigDecimalFromString(tt.d)
+		got := d.RoundMode(tt.scale, tt.mode)
+		if got.String() != tt.want {
+			t.Errorf("%q.RoundMode(%v, %v) = %q, want %q", d, tt.scale, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestBigDecimal_Pad_Trim(t *testing.T) {
+	tests := []struct {
+		d        string
+		scale    int
+		wantPad  string
+		wantTrim string
+	}{
+		{"0", 2, "0.00", "0"},
+		{"1.500", 2, "1.500", "1.50"},
+		{"1.5", 40, "1.5000000000000000000000000000000000000000", "1.5"},
+		{"10.00", 0, "10.00", "10"},
+		{"1000", -2, "1000", "1.0E+3"},
+		{"1E+3", 0, "1000", "1E+3"},
+	}
+	for _, tt := range tests {
+		d := MustNewBigDecimalFromString(tt.d)
+		if got := d.Pad(tt.scale); got.String() != tt.wantPad {
+			t.Errorf("%q.Pad(%v) = %q, want %q", d, tt.scale, got, tt.wantPad)
+		}
+		if got := d.Trim(tt.scale); got.String() != tt.wantTrim {
+			t.Errorf("%q.Trim(%v) = %q, want %q", d, tt.scale, got, tt.wantTrim)
+		}
+	}
+}
+
+func TestBigDecimal_Add(t *testing.T) {
+	tests := []struct {
+		d, e, wantAdd, wantSub string
+	}{
+		{"1", "1", "2", "0"},
+		{"5.75", "3.3", "9.05", "2.45"},
+		{"-7", "2.5", "-4.5", "-9.5"},
+		{"0.7", "0.3", "1.0", "0.4"},
+		{"0", "-0.00", "0.00", "0.00"},
+		{"1E+3", "1", "1001", "999"},
+		{"1E-20", "1", "1.00000000000000000001", "-0.99999999999999999999"},
+		{"99999999999999999999999999999999999999", "1", "100000000000000000000000000000000000000", "99999999999999999999999999999999999998"},
+	}
+	for _, tt := range tests {
+		d := MustNewBigDecimalFromString(tt.d)
+		e := MustNewBigDecimalFromString(tt.e)
+		if got := d.Add(e); got.String() != tt.wantAdd {
+			t.Errorf("%q.Add(%q) = %q, want %q", d, e, got, tt.wantAdd)
+		}
+		if got := d.Sub(e); got.String() != tt.wantSub {
+			t.Errorf("%q.Sub(%q) = %q, want %q", d, e, got, tt.wantSub)
+		}
+	}
+}
+
+func TestBigDecimal_Mul(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e, want string
+		}{
+			{"2", "3", "6"},
+			{"-1.5", "1.5", "-2.25"},
+			{"0", "-1.50", "0.00"},
+			{"1E+3", "1E-5", "0.01"},
+			{"99999999999999999999", "99999999999999999999", "9999999999999999999800000000000000000001"},
+		}
+		for _, tt := range tests {
+			d := MustNewBigDecimalFromString(tt.d)
+			e := MustNewBigDecimalFromString(tt.e)
+			got, err := d.Mul(e)
+			if err != nil {
+				t.Errorf("%q.Mul(%q) failed: %v", d, e, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%q.Mul(%q) = %q, want %q", d, e, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e    string
+			wantErr error
+		}{
+			"overflow 1":    {"1E+2147483647", "1E+2", ErrOverflow},
+			"scale range 1": {"1E-2147483647", "0.1", ErrScaleRange},
+		}
+		for _, tt := range tests {
+			d := MustNewBigDecimalFromString(tt.d)
+			e := MustNewBigDecimalFromString(tt.e)
+			_, err := d.Mul(e)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%q.Mul(%q) = %v, want %v", d, e, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestBigDecimal_Quo(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e string
+			prec int
+			mode RoundingMode
+			want string
+		}{
+			{"6", "3", 10, HalfEven, "2"},
+			{"1", "4", 10, HalfEven, "0.25"},
+			{"1.000", "4", 10, HalfEven, "0.250"},
+			{"1", "8", 2, HalfEven, "0.12"},
+			{"1", "8", 2, HalfUp, "0.13"},
+			{"1", "3", 50, HalfEven, "0.33333333333333333333333333333333333333333333333333"},
+			{"2", "3", 50, HalfEven, "0.66666666666666666666666666666666666666666666666667"},
+			{"2", "3", 5, Down, "0.66666"},
+			{"-2", "3", 5, Floor, "-0.66667"},
+			{"-2", "3", 5, Ceiling, "-0.66666"},
+			{"1E+10", "3", 3, HalfEven, "3.33E+9"},
+			{"1", "1E+1000", 5, HalfEven, "1E-1000"},
+			{"0.000", "7", 5, HalfEven, "0.000"},
+			{"99999", "1", 4, HalfEven, "1.000E+5"},
+		}
+		for _, tt := range tests {
+			d := MustNewBigDecimalFromString(tt.d)
+			e := MustNewBigDecimalFromString(tt.e)
+			got, err := d.QuoMode(e, tt.prec, tt.mode)
+			if err != nil {
+				t.Errorf("%q.QuoMode(%q, %v, %v) failed: %v", d, e, tt.prec, tt.mode, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%q.QuoMode(%q, %v, %v) = %q, want %q", d, e, tt.prec, tt.mode, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e    string
+			prec    int
+			mode    RoundingMode
+			wantErr error
+		}{
+			"zero 1":        {"1", "0", 10, HalfEven, ErrDivisionByZero},
+			"zero 2":        {"0", "0.000", 10, HalfEven, ErrDivisionByZero},
+			"prec range 1":  {"1", "3", 0, HalfEven, ErrPrecRange},
+			"mode range 1":  {"1", "3", 10, RoundingMode(-1), ErrModeRange},
+			"scale range 1": {"1E-2147483647", "10", 10, HalfEven, ErrScaleRange},
+		}
+		for _, tt := range tests {
+			d := MustNewBigDecimalFromString(tt.d)
+			e := MustNewBigDecimalFromString(tt.e)
+			_, err := d.QuoMode(e, tt.prec, tt.mode)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%q.QuoMode(%q, %v, %v) = %v, want %v", d, e, tt.prec, tt.mode, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestBigDecimal_Sqrt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			prec int
+			want string
+		}{
+			{"0", 10, "0"},
+			{"0.00", 10, "0.0"},
+			{"4", 10, "2"},
+			{"0.04", 10, "0.2"},
+			{"400", 10, "20"},
+			{"4E+4", 10, "2E+2"},
+			{"2", 50, "1.4142135623730950488016887242096980785696718753769"},
+			{"1E-1000", 5, "1E-500"},
+			{"1E-1001", 5, "3.1623E-501"},
+		}
+		for _, tt := range tests {
+			d := MustNewBigDecimalFromString(tt.d)
+			got, err := d.Sqrt(tt.prec)
+			if err != nil {
+				t.Errorf("%q.Sqrt(%v) failed: %v", d, tt.prec, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%q.Sqrt(%v) = %q, want %q", d, tt.prec, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d       string
+			prec    int
+			wantErr error
+		}{
+			"negative 1":   {"-1", 10, ErrInvalidOperation},
+			"prec range 1": {"2", 0, ErrPrecRange},
+		}
+		for _, tt := range tests {
+			d := MustNewBigDecimalFromString(tt.d)
+			_, err := d.Sqrt(tt.prec)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%q.Sqrt(%v) = %v, want %v", d, tt.prec, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestBigDecimal_Exp(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			prec int
+			want string
+		}{
+			{"0", 10, "1"},
+			{"1", 50, "2.7182818284590452353602874713526624977572470937"},
+			{"-1", 30, "0.367879441171442321595523770161"},
+			{"-100", 30, "3.72007597602083596295969580386E-44"},
+			{"100", 30, "2.68811714181613544841262555158E+43"},
+			{"0.5", 25, "1.648721270700128146848651"},
+			{"1E-40", 30, "1"},
+			{"-1E-40", 30, "1"},
+			{"1E-29", 30, "1.00000000000000000000000000001"},
+			{"1000000", 10, "3.033215397E+434294"},
+		}
+		for _, tt := range tests {
+			d := MustNewBigDecimalFromString(tt.d)
+			got, err := d.Exp(tt.prec)
+			if err != nil {
+				t.Errorf("%q.Exp(%v) failed: %v", d, tt.prec, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%q.Exp(%v) = %q, want %q", d, tt.prec, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d       string
+			prec    int
+			wantErr error
+		}{
+			"overflow 1":    {"1E+10", 10, ErrOverflow},
+			"scale range 1": {"-1E+10", 10, ErrScaleRange},
+			"prec range 1":  {"1", 0, ErrPrecRange},
+		}
+		for _, tt := range tests {
+			d := MustNewBigDecimalFromString(tt.d)
+			_, err := d.Exp(tt.prec)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%q.Exp(%v) = %v, want %v", d, tt.prec, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestBigDecimal_Log(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			prec int
+			want string
+		}{
+			{"1", 10, "0"},
+			{"1.000", 10, "0"},
+			{"2", 50, "0.69314718055994530941723212145817656807550013436026"},
+			{"10", 50, "2.3025850929940456840179914546843642076011014886288"},
+			{"0.9", 30, "-0.105360515657826301227500980839"},
+			{"0.9999999", 30, "-1.00000005000000333333358333335E-7"},
+			{"1.0000001", 30, "9.99999950000003333333083333353E-8"},
+			{"1E-100", 30, "-230.258509299404568401799145468"},
+			{"1E+1000000", 10, "2302585.093"},
+			{"2.718281828459045235360287471352662497757", 20, "1"},
+		}
+		for _, tt := range tests {
+			d := MustNewBigDecimalFromString(tt.d)
+			got, err := d.Log(tt.prec)
+			if err != nil {
+				t.Errorf("%q.Log(%v) failed: %v", d, tt.prec, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%q.Log(%v) = %q, want %q", d, tt.prec, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d       string
+			prec    int
+			wantErr error
+		}{
+			"zero 1":       {"0", 10, ErrInvalidOperation},
+			"negative 1":   {"-1", 10, ErrInvalidOperation},
+			"prec range 1": {"2", 0, ErrPrecRange},
+		}
+		for _, tt := range tests {
+			d := MustNewBigDecimalFromString(tt.d)
+			_, err := d.Log(tt.prec)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%q.Log(%v) = %v, want %v", d, tt.prec, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestBigDecimal_Cmp(t *testing.T) {
+	tests := []struct {
+		d, e string
+		want int
+	}{
+		{"0", "0.000", 0},
+		{"0", "0E+10", 0},
+		{"1", "1.0", 0},
+		{"1E+3", "1000", 0},
+		{"-1", "1", -1},
+		{"1", "-1", 1},
+		{"-2", "-1", -1},
+		{"1E-1000", "0", 1},
+		{"1E+1000", "1E-1000", 1},
+		{"-1E+1000", "-1E-1000", -1},
+		{"1.00000000000000000000000000000000000000001", "1", 1},
+	}
+	for _, tt := range tests {
+		d := MustNewBigDecimalFromString(tt.d)
+		e := MustNewBigDecimalFromString(tt.e)
+		got := d.Cmp(e)
+		if got != tt.want {
+			t.Errorf("%q.Cmp(%q) = %v, want %v", d, e, got, tt.want)
+		}
+		if d.Equal(e) != (tt.want == 0) || d.Less(e) != (tt.want < 0) {
+			t.Errorf("%q.Equal(%q) or %q.Less(%q) is inconsistent with Cmp", d, e, d, e)
+		}
+	}
+}
+
+func FuzzBigDecimal_String_Parse(f *testing.F) {
+	for _, d := range corpus {
+		f.Add(d.neg, d.scale, d.coef)
+		f.Add(d.neg, d.scale-30, d.coef)
+	}
+
+	f.Fuzz(
+		func(t *testing.T, neg bool, scale int, coef uint64) {
+			d, err := newBigDecimalFuzz(neg, scale, coef)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			s := d.String()
+			got, err := NewBigDecimalFromString(s)
+			if err != nil {
+				t.Errorf("NewBigDecimalFromString(%q) failed: %v", s, err)
+				return
+			}
+			if got.String() != s || got.Cmp(d) != 0 {
+				t.Errorf("NewBigDecimalFromString(%q) = %q, want %q", s, got, d)
+			}
+		},
+	)
+}
+
+func newBigDecimalFuzz(neg bool, scale int, coef uint64) (BigDecimal, error) {
+	bcoef := new(bint)
+	(*big.Int)(bcoef).SetUint64(coef)
+	return newBigDecimal(neg, bcoef, int64(scale))
+}
+
+func FuzzBigDecimal_Add(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, uint64(d.coef), e.neg, e.scale, uint64(e.coef))
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			want, err := d.Add(e)
+			if err != nil {
+				t.Skip() // Decimal overflow is an expected error
+				return
+			}
+
+			got, err := d.BigDecimal().Add(e.BigDecimal()).Decimal()
+			if err != nil {
+				t.Errorf("%q.BigDecimal().Add(%q).Decimal() failed: %v", d, e, err)
+				return
+			}
+			if got != want {
+				t.Errorf("%q.BigDecimal().Add(%q).Decimal() = %q, whereas %q.Add(%q) = %q", d, e, got, d, e, want)
+			}
+		},
+	)
+}
+
+func FuzzBigDecimal_Mul(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, uint64(d.coef), e.neg, e.scale, uint64(e.coef))
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			want, err := d.Mul(e)
+			if err != nil {
+				t.Skip() // Decimal overflow is an expected error
+				return
+			}
+
+			f, err := d.BigDecimal().Mul(e.BigDecimal())
+			if err != nil {
+				t.Errorf("%q.BigDecimal().Mul(%q) failed: %v", d, e, err)
+				return
+			}
+			got, err := f.Decimal()
+			if err != nil {
+				t.Errorf("%q.Decimal() failed: %v", f, err)
+				return
+			}
+			if got != want {
+				t.Errorf("%q.BigDecimal().Mul(%q).Decimal() = %q, whereas %q.Mul(%q) = %q", d, e, got, d, e, want)
+			}
+		},
+	)
+}
+
+func FuzzBigDecimal_Quo(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, uint64(d.coef), e.neg, e.scale, uint64(e.coef))
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			want, err := d.Quo(e)
+			if err != nil {
+				t.Skip() // Decimal overflow and division by zero are expected errors
+				return
+			}
+
+			// The precision is large enough to make double rounding impossible
+			// for quotients of 19-digit coefficients.
+			f, err := d.BigDecimal().Quo(e.BigDecimal(), 2*MaxPrec+2)
+			if err != nil {
+				t.Errorf("%q.BigDecimal().Quo(%q) failed: %v", d, e, err)
+				return
+			}
+			got, err := f.Decimal()
+			if err != nil {
+				t.Errorf("%q.Decimal() failed: %v", f, err)
+				return
+			}
+			if got.Cmp(want) != 0 {
+				t.Errorf("%q.BigDecimal().Quo(%q).Decimal() = %q, whereas %q.Quo(%q) = %q", d, e, got, d, e, want)
+			}
+		},
+	)
+}
+
+func FuzzBigDecimal_Cmp(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, uint64(d.coef), e.neg, e.scale, uint64(e.coef))
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			want := d.Cmp(e)
+			got := d.BigDecimal().Cmp(e.BigDecimal())
+			if got != want {
+				t.Errorf("%q.BigDecimal().Cmp(%q) = %v, whereas %q.Cmp(%q) = %v", d, e, got, d, e, want)
+			}
+		},
+	)
+}
@@ -0,0 +1,407 @@
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Condition is a set of exceptional conditions that can be raised by
+// the methods of [Context].
+// The names and semantics of the conditions follow [ANSI X3.274-1996].
+// Condition implements the error interface, so trapped conditions can be
+// detected using [errors.Is].
+//
+// [ANSI X3.274-1996]: https://speleotrove.com/decimal/daexcep.html
+type Condition uint16
+
+const (
+	// Clamped is raised when the scale of a result has been reduced
+	// to [MaxScale] without changing its value.
+	Clamped Condition = 1 << iota
+	// DivisionByZero is raised when a decimal is divided by zero.
+	DivisionByZero
+	// Inexact is raised when a result is not equal to the exact result
+	// of the operation.
+	Inexact
+	// InvalidOperation is raised when an operation is not defined
+	// for its operands, for example, the square root of a negative decimal.
+	InvalidOperation
+	// Overflow is raised when the integer part of a result has more digits
+	// than the precision of the context allows.
+	Overflow
+	// Rounded is raised when any digits, including zeros, have been discarded
+	// from a result.
+	Rounded
+	// Subnormal is raised when the exact result of an operation is not zero,
+	// but its absolute value is less than 10^-[MaxScale].
+	Subnormal
+	// Underflow is raised when a result is both subnormal and inexact.
+	Underflow
+)
+
+var conditionNames = [...]string{
+	"Clamped",
+	"DivisionByZero",
+	"Inexact",
+	"InvalidOperation",
+	"Overflow",
+	"Rounded",
+	"Subnormal",
+	"Underflow",
+}
+
+// String implements the [fmt.Stringer] interface and returns the names of
+// the conditions separated by "|", for example, "Inexact|Rounded".
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (c Condition) String() string {
+	if c == 0 {
+		return "0"
+	}
+	var s []string
+	for i, n := range conditionNames {
+		if c&(1<<i) != 0 {
+			s = append(s, n)
+			c = c &^ (1 << i)
+		}
+	}
+	if c != 0 {
+		s = append(s, fmt.Sprintf("Condition(%#x)", uint16(c)))
+	}
+	return strings.Join(s, "|")
+}
+
+// Error implements the error interface and returns the same string as
+// [Condition.String].
+func (c Condition) Error() string {
+	return c.String()
+}
+
+// Is returns true if target is a condition and c contains all of its conditions.
+// It allows checking for a particular trapped condition using [errors.Is].
+//
+// [errors.Is]: https://pkg.go.dev/errors#Is
+func (c Condition) Is(target error) bool {
+	t, ok := target.(Condition)
+	return ok && t != 0 && c&t == t
+}
+
+// Context is an arithmetic context, which determines the precision and
+// the rounding mode of results, and records the exceptional conditions
+// raised by the operations performed in it.
+//
+// The zero value is the default context: results are rounded to [MaxPrec]
+// digits using [HalfEven], and no conditions are trapped.
+// Methods of [Decimal], such as [Decimal.Add] or [Decimal.Exp], produce
+// the same results as the corresponding methods of the default context.
+//
+// Conditions [DivisionByZero], [InvalidOperation], and [Overflow] are always
+// trapped, because a decimal cannot represent infinities or NaNs.
+//
+// Context is not designed to be safe for concurrent use by multiple goroutines,
+// since every operation updates its flags.
+type Context struct {
+	// Precision is the maximum number of significant digits in a result.
+	// Zero means [MaxPrec].
+	Precision int
+	// Rounding is the rounding mode used to round results.
+	Rounding RoundingMode
+	// Traps is the set of conditions that cause an operation to return
+	// an error instead of a result.
+	Traps Condition
+	// Flags is the set of conditions raised by the operations performed
+	// in the context. Flags are never cleared automatically.
+	Flags Condition
+}
+
+// Add returns the (possibly rounded) sum of decimals d and e.
+// See also method [Decimal.Add].
+func (c *Context) Add(d, e Decimal) (Decimal, error) {
+	return c.calc(
+		func(mode RoundingMode) (Decimal, bool, error) { return d.addInexact(e, mode) },
+		max(d.Scale(), e.Scale()),
+		"add", d, e,
+	)
+}
+
+// Sub returns the (possibly rounded) difference between decimals d and e.
+// See also method [Decimal.Sub].
+func (c *Context) Sub(d, e Decimal) (Decimal, error) {
+	return c.calc(
+		func(mode RoundingMode) (Decimal, bool, error) { return d.addInexact(e.Neg(), mode) },
+		max(d.Scale(), e.Scale()),
+		"sub", d, e,
+	)
+}
+
+// Mul returns the (possibly rounded) product of decimals d and e.
+// See also method [Decimal.Mul].
+func (c *Context) Mul(d, e Decimal) (Decimal, error) {
+	return c.calc(
+		func(mode RoundingMode) (Decimal, bool, error) { return d.mulInexact(e, mode) },
+		d.Scale()+e.Scale(),
+		"mul", d, e,
+	)
+}
+
+// Quo returns the (possibly rounded) quotient of decimals d and e.
+// See also method [Decimal.Quo].
+func (c *Context) Quo(d, e Decimal) (Decimal, error) {
+	return c.calc(
+		func(mode RoundingMode) (Decimal, bool, error) { return d.quoExactMode(e, 0, mode) },
+		-1,
+		"quo", d, e,
+	)
+}
+
+// AddMul returns the (possibly rounded) fused multiply-addition of decimals d, e, and f.
+// See also method [Decimal.AddMul].
+func (c *Context) AddMul(d, e, f Decimal) (Decimal, error) {
+	return c.calc(
+		func(mode RoundingMode) (Decimal, bool, error) { return d.addMulInexact(e, f, mode) },
+		max(d.Scale(), e.Scale()+f.Scale()),
+		"addmul", d, e, f,
+	)
+}
+
+// SubMul returns the (possibly rounded) fused multiply-subtraction of decimals d, e, and f.
+// See also method [Decimal.SubMul].
+func (c *Context) SubMul(d, e, f Decimal) (Decimal, error) {
+	return c.calc(
+		func(mode RoundingMode) (Decimal, bool, error) { return d.addMulInexact(e.Neg(), f, mode) },
+		max(d.Scale(), e.Scale()+f.Scale()),
+		"submul", d, e, f,
+	)
+}
+
+// AddQuo returns the (possibly rounded) fused quotient-addition of decimals d, e, and f.
+// See also method [Decimal.AddQuo].
+func (c *Context) AddQuo(d, e, f Decimal) (Decimal, error) {
+	return c.calc(
+		func(mode RoundingMode) (Decimal, bool, error) { return d.addQuoExactMode(e, f, 0, mode) },
+		-1,
+		"addquo", d, e, f,
+	)
+}
+
+// SubQuo returns the (possibly rounded) fused quotient-subtraction of decimals d, e, and f.
+// See also method [Decimal.SubQuo].
+func (c *Context) SubQuo(d, e, f Decimal) (Decimal, error) {
+	return c.calc(
+		func(mode RoundingMode) (Decimal, bool, error) { return d.addQuoExactMode(e.Neg(), f, 0, mode) },
+		-1,
+		"subquo", d, e, f,
+	)
+}
+
+// Sum returns the (possibly rounded) sum of decimals.
+// See also function [Sum].
+func (c *Context) Sum(d ...Decimal) (Decimal, error) {
+	natural := 0
+	for _, f := range d {
+		natural = max(natural, f.Scale())
+	}
+	return c.calc(
+		func(mode RoundingMode) (Decimal, bool, error) { return sum(mode, d...) },
+		natural,
+		"sum", d...,
+	)
+}
+
+// Prod returns the (possibly rounded) product of decimals.
+// See also function [Prod].
+func (c *Context) Prod(d ...Decimal) (Decimal, error) {
+	natural := 0
+	for _, f := range d {
+		natural = natural + f.Scale()
+	}
+	return c.calc(
+		func(mode RoundingMode) (Decimal, bool, error) { return prod(mode, d...) },
+		natural,
+		"prod", d...,
+	)
+}
+
+// PowInt returns the (possibly rounded) decimal raised to the given integer power.
+// See also method [Decimal.PowInt].
+func (c *Context) PowInt(d Decimal, power int) (Decimal, error) {
+	natural := -1
+	if power >= 0 {
+		natural = d.Scale() * power
+	}
+	return c.calc(
+		func(mode RoundingMode) (Decimal, bool, error) { return d.powInt(power, mode) },
+		natural,
+		"pow", d, MustNew(int64(power), 0),
+	)
+}
+
+// Sqrt returns the (possibly rounded) square root of a decimal.
+// See also method [Decimal.Sqrt].
+func (c *Context) Sqrt(d Decimal) (Decimal, error) {
+	return c.calc(
+		func(mode RoundingMode) (Decimal, bool, error) { return d.sqrt(mode) },
+		-1,
+		"sqrt", d,
+	)
+}
+
+// Exp returns the (possibly rounded) exponential of a decimal.
+// See also method [Decimal.Exp].
+func (c *Context) Exp(d Decimal) (Decimal, error) {
+	return c.calc(
+		func(mode RoundingMode) (Decimal, bool, error) { return d.exp(mode) },
+		-1,
+		"exp", d,
+	)
+}
+
+// Log returns the (possibly rounded) natural logarithm of a decimal.
+// See also method [Decimal.Log].
+func (c *Context) Log(d Decimal) (Decimal, error) {
+	return c.calc(
+		func(mode RoundingMode) (Decimal, bool, error) { return d.log(mode) },
+		-1,
+		"log", d,
+	)
+}
+
+// Round returns a decimal rounded to the precision of the context.
+func (c *Context) Round(d Decimal) (Decimal, error) {
+	return c.calc(
+		func(RoundingMode) (Decimal, bool, error) { return d, false, nil },
+		d.Scale(),
+		"round", d,
+	)
+}
+
+// minDecimal is the smallest positive decimal.
+var minDecimal = MustNew(1, MaxScale)
+
+// calc performs an operation in the context and records the raised conditions.
+//
+// Function f computes the result of the operation rounded to [MaxPrec] digits
+// using the given rounding mode, and reports whether the result is inexact.
+// Natural is the scale of the exact result, or -1 if it cannot be determined.
+// Op and operands describe the operation in errors.
+func (c *Context) calc(
+	f func(RoundingMode) (Decimal, bool, error),
+	natural int,
+	op string,
+	operands ...Decimal,
+) (Decimal, error) {
+	prec, mode, err := c.validate()
+	if err != nil {
+		return Decimal{}, newOpError(op, 0, err, operands...)
+	}
+
+	// Double rounding is avoided by rounding to MaxPrec digits using
+	// ZeroFiveUp mode first, since such a result can be rounded again
+	// to fewer digits using any other rounding mode.
+	first := mode
+	if prec < MaxPrec {
+		first = ZeroFiveUp
+	}
+	d, inexact, err := f(first)
+	if err != nil {
+		c.Flags = c.Flags | condition(err)
+		return Decimal{}, newOpError(op, 0, err, operands...)
+	}
+
+	if prec < MaxPrec {
+		switch {
+		case d.Prec() > prec:
+			e := d
+			scale := e.Scale() - e.Prec() + prec
+			if scale >= MinScale {
+				e = e.RoundMode(scale, mode)
+			}
+			// Handling the rare case when rounding added a digit
+			if e.Prec() > prec && e.Scale() > MinScale {
+				e = e.Trunc(e.Scale() - 1)
+			}
+			if e.Prec() > prec {
+				c.Flags = c.Flags | Overflow | Inexact | Rounded
+				err = fmt.Errorf("%w: the integer part of a %T can have at most %v digits in a context with precision %v, but it has %v digits", ErrOverflow, e, prec, prec, e.Prec()-e.Scale())
+				return Decimal{}, newOpError(op, 0, err, operands...)
+			}
+			inexact = inexact || e.Cmp(d) != 0
+			d = e
+		case inexact:
+			// The result was rounded due to the scale limit,
+			// so it must be computed again using the context's rounding mode.
+			d, inexact, err = f(mode)
+			if err != nil {
+				c.Flags = c.Flags | condition(err)
+				return Decimal{}, newOpError(op, 0, err, operands...)
+			}
+		}
+	}
+
+	// Conditions
+	var cond Condition
+	if inexact {
+		cond = cond | Inexact | Rounded
+	}
+	if natural > d.Scale() {
+		cond = cond | Rounded
+		if !inexact && natural > MaxScale {
+			cond = cond | Clamped
+		}
+	}
+	// An exact result is never subnormal, and an inexact result is subnormal
+	// if it was rounded to zero, or rounded up to the smallest positive decimal.
+	tiny := false
+	if inexact {
+		switch d.Abs().Cmp(minDecimal) {
+		case -1:
+			tiny = true
+		case 0:
+			e, _, err := f(Down)
+			tiny = err == nil && e.IsZero()
+		}
+	}
+	if tiny {
+		cond = cond | Subnormal
+		if inexact {
+			cond = cond | Underflow
+		}
+	}
+	c.Flags = c.Flags | cond
+
+	// Traps
+	if t := cond & c.Traps; t != 0 {
+		return Decimal{}, newOpError(op, 0, t, operands...)
+	}
+
+	return d, nil
+}
+
+// validate returns the precision and the rounding mode of the context.
+func (c *Context) validate() (int, RoundingMode, error) {
+	prec := c.Precision
+	if prec == 0 {
+		prec = MaxPrec
+	}
+	if prec < 1 || prec > MaxPrec {
+		return 0, 0, ErrPrecRange
+	}
+	if !c.Rounding.valid() {
+		return 0, 0, ErrModeRange
+	}
+	return prec, c.Rounding, nil
+}
+
+// condition returns the conditions that correspond to an error.
+func condition(err error) Condition {
+	switch {
+	case errors.Is(err, ErrDivisionByZero):
+		return DivisionByZero
+	case errors.Is(err, ErrInvalidOperation):
+		return InvalidOperation
+	case errors.Is(err, ErrOverflow):
+		return Overflow | Inexact | Rounded
+	}
+	return 0
+}
@@ -0,0 +1,371 @@
+package decimal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCondition_String(t *testing.T) {
+	tests := []struct {
+		c    Condition
+		want string
+	}{
+		{0, "0"},
+		{Inexact, "Inexact"},
+		{Inexact | Rounded, "Inexact|Rounded"},
+		{Clamped | DivisionByZero | Inexact | InvalidOperation | Overflow | Rounded | Subnormal | Underflow, "Clamped|DivisionByZero|Inexact|InvalidOperation|Overflow|Rounded|Subnormal|Underflow"},
+		{Underflow | 0x100, "Underflow|Condition(0x100)"},
+	}
+	for _, tt := range tests {
+		got := tt.c.String()
+		if got != tt.want {
+			t.Errorf("Condition(%#x).String() = %q, want %q", uint16(tt.c), got, tt.want)
+		}
+	}
+}
+
+func TestCondition_Is(t *testing.T) {
+	tests := []struct {
+		c, target Condition
+		want      bool
+	}{
+		{Inexact | Rounded, Inexact, true},
+		{Inexact | Rounded, Rounded, true},
+		{Inexact | Rounded, Inexact | Rounded, true},
+		{Inexact | Rounded, Subnormal, false},
+		{Inexact | Rounded, Inexact | Subnormal, false},
+		{Inexact, 0, false},
+	}
+	for _, tt := range tests {
+		got := errors.Is(tt.c, tt.target)
+		if got != tt.want {
+			t.Errorf("errors.Is(%v, %v) = %v, want %v", tt.c, tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Default(t *testing.T) {
+	tests := []string{
+		"0", "0.0", "1", "-1", "0.5", "-0.5", "2", "3.00", "0.1", "0.0000000000000000001",
+		"1.000000000000000001", "9999999999999999999", "-999999999.9999999999", "0.999999999999999999",
+		"1234567.89", "3.141592653589793238", "0.0000000000000000009",
+	}
+	for _, s := range tests {
+		d := RequireFromString(s)
+		for _, s := range tests {
+			e := RequireFromString(s)
+			ctx := Context{}
+
+			got, gotErr := ctx.Add(d, e)
+			want, wantErr := d.Add(e)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Context{}.Add(%q, %q) = (%q, %v), want (%q, %v)", d, e, got, gotErr, want, wantErr)
+			}
+
+			got, gotErr = ctx.Mul(d, e)
+			want, wantErr = d.Mul(e)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Context{}.Mul(%q, %q) = (%q, %v), want (%q, %v)", d, e, got, gotErr, want, wantErr)
+			}
+
+			got, gotErr = ctx.Quo(d, e)
+			want, wantErr = d.Quo(e)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Context{}.Quo(%q, %q) = (%q, %v), want (%q, %v)", d, e, got, gotErr, want, wantErr)
+			}
+
+			got, gotErr = ctx.AddMul(d, e, d)
+			want, wantErr = d.AddMul(e, d)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Context{}.AddMul(%q, %q, %q) = (%q, %v), want (%q, %v)", d, e, d, got, gotErr, want, wantErr)
+			}
+
+			got, gotErr = ctx.AddQuo(d, d, e)
+			want, wantErr = d.AddQuo(d, e)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Context{}.AddQuo(%q, %q, %q) = (%q, %v), want (%q, %v)", d, d, e, got, gotErr, want, wantErr)
+			}
+		}
+
+		ctx := Context{}
+
+		for _, power := range []int{-3, -1, 0, 1, 2, 5} {
+			got, gotErr := ctx.PowInt(d, power)
+			want, wantErr := d.PowInt(power)
+			if got != want || (gotErr == nil) != (wantErr == nil) {
+				t.Errorf("Context{}.PowInt(%q, %v) = (%q, %v), want (%q, %v)", d, power, got, gotErr, want, wantErr)
+			}
+		}
+
+		got, gotErr := ctx.Sqrt(d)
+		want, wantErr := d.Sqrt()
+		if got != want || (gotErr == nil) != (wantErr == nil) {
+			t.Errorf("Context{}.Sqrt(%q) = (%q, %v), want (%q, %v)", d, got, gotErr, want, wantErr)
+		}
+
+		got, gotErr = ctx.Exp(d)
+		want, wantErr = d.Exp()
+		if got != want || (gotErr == nil) != (wantErr == nil) {
+			t.Errorf("Context{}.Exp(%q) = (%q, %v), want (%q, %v)", d, got, gotErr, want, wantErr)
+		}
+
+		got, gotErr = ctx.Log(d)
+		want, wantErr = d.Log()
+		if got != want || (gotErr == nil) != (wantErr == nil) {
+			t.Errorf("Context{}.Log(%q) = (%q, %v), want (%q, %v)", d, got, gotErr, want, wantErr)
+		}
+	}
+}
+
+func TestContext_Quo(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e      string
+			prec      int
+			mode      RoundingMode
+			want      string
+			wantFlags Condition
+		}{
+			{"1", "4", 0, HalfEven, "0.25", 0},
+			{"1", "4", 1, HalfEven, "0.2", Inexact | Rounded},
+			{"1", "4", 1, HalfUp, "0.3", Inexact | Rounded},
+			{"1", "3", 0, HalfEven, "0.3333333333333333333", Inexact | Rounded},
+			{"1", "3", 5, HalfEven, "0.33333", Inexact | Rounded},
+			{"2", "3", 5, Down, "0.66666", Inexact | Rounded},
+			{"2", "3", 5, HalfEven, "0.66667", Inexact | Rounded},
+			{"-2", "3", 5, Ceiling, "-0.66666", Inexact | Rounded},
+			{"-2", "3", 5, Floor, "-0.66667", Inexact | Rounded},
+			{"2", "3", 5, ZeroFiveUp, "0.66666", Inexact | Rounded},
+			{"1", "8", 2, HalfEven, "0.12", Inexact | Rounded},
+			{"3", "8", 2, HalfEven, "0.38", Inexact | Rounded},
+			{"1", "3000", 3, Down, "0.000333", Inexact | Rounded},
+			{"1000", "3", 5, Down, "333.33", Inexact | Rounded},
+			{"1", "0.0000000000000000003", 0, Down, "3333333333333333333", Inexact | Rounded},
+			{"99999", "1", 5, Up, "99999", 0},
+			{"99999", "1.000001", 5, Up, "99999", Inexact | Rounded},
+			{"9.9999", "1.000001", 4, Up, "10.00", Inexact | Rounded},
+
+			// Subnormal results
+			{"1", "3000000000000000000", 0, HalfEven, "0.0000000000000000003", Inexact | Rounded},
+			{"0.1", "3000000000000000000", 0, HalfEven, "0.0", Inexact | Rounded | Subnormal | Underflow},
+			{"0.1", "3000000000000000000", 0, Up, "0.0000000000000000001", Inexact | Rounded | Subnormal | Underflow},
+			{"0.1", "3000000000000000000", 1, Up, "0.0000000000000000001", Inexact | Rounded | Subnormal | Underflow},
+			{"0.1", "3000000000000000000", 1, HalfEven, "0.0", Inexact | Rounded | Subnormal | Underflow},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			e := RequireFromString(tt.e)
+			ctx := Context{Precision: tt.prec, Rounding: tt.mode}
+			got, err := ctx.Quo(d, e)
+			if err != nil {
+				t.Errorf("Context{%v, %v}.Quo(%q, %q) failed: %v", tt.prec, tt.mode, d, e, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want || ctx.Flags != tt.wantFlags {
+				t.Errorf("Context{%v, %v}.Quo(%q, %q) = (%q, %v), want (%q, %v)", tt.prec, tt.mode, d, e, got, ctx.Flags, want, tt.wantFlags)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e      string
+			prec      int
+			mode      RoundingMode
+			traps     Condition
+			wantFlags Condition
+		}{
+			"zero 1":     {"1", "0", 0, HalfEven, 0, DivisionByZero},
+			"overflow 1": {"9999999999999999999", "0.1", 0, HalfEven, 0, Overflow | Inexact | Rounded},
+			"overflow 2": {"99999", "1", 4, HalfEven, 0, Overflow | Inexact | Rounded},
+			"overflow 3": {"9999.5", "1", 4, Up, 0, Overflow | Inexact | Rounded},
+			"trap 1":     {"1", "3", 0, HalfEven, Inexact, Inexact | Rounded},
+			"trap 2":     {"1", "4", 1, HalfEven, Rounded, Inexact | Rounded},
+			"prec 1":     {"1", "3", -1, HalfEven, 0, 0},
+			"prec 2":     {"1", "3", MaxPrec + 1, HalfEven, 0, 0},
+			"mode 1":     {"1", "3", 0, ZeroFiveUp + 1, 0, 0},
+		}
+		for name, tt := range tests {
+			d := RequireFromString(tt.d)
+			e := RequireFromString(tt.e)
+			ctx := Context{Precision: tt.prec, Rounding: tt.mode, Traps: tt.traps}
+			_, err := ctx.Quo(d, e)
+			if err == nil {
+				t.Errorf("Context{%v, %v}.Quo(%q, %q) did not fail", tt.prec, tt.mode, d, e)
+				continue
+			}
+			if ctx.Flags != tt.wantFlags {
+				t.Errorf("%v: Context{%v, %v}.Quo(%q, %q) raised %v, want %v", name, tt.prec, tt.mode, d, e, ctx.Flags, tt.wantFlags)
+			}
+			if tt.traps != 0 && !errors.Is(err, tt.traps) {
+				t.Errorf("%v: Context{%v, %v}.Quo(%q, %q) returned %v, want %v", name, tt.prec, tt.mode, d, e, err, tt.traps)
+			}
+		}
+	})
+}
+
+func TestContext_Mul(t *testing.T) {
+	tests := []struct {
+		d, e      string
+		prec      int
+		mode      RoundingMode
+		want      string
+		wantFlags Condition
+	}{
+		{"1.5", "2.0", 0, HalfEven, "3.00", 0},
+		{"1.5", "2.0", 2, HalfEven, "3.0", Rounded},
+		{"1.25", "1", 2, HalfEven, "1.2", Inexact | Rounded},
+		{"1.25", "1", 2, HalfUp, "1.3", Inexact | Rounded},
+		{"-1.25", "1", 2, HalfDown, "-1.2", Inexact | Rounded},
+		{"0.0000000001", "0.0000000010", 0, HalfEven, "0.0000000000000000001", Rounded | Clamped},
+		{"1.0000000000", "1.0000000000", 0, HalfEven, "1.0000000000000000000", Rounded | Clamped},
+		{"0.0000000001", "0.0000000001", 0, HalfEven, "0.0000000000000000000", Inexact | Rounded | Subnormal | Underflow},
+		{"0.0000000001", "0.0000000001", 0, Up, "0.0000000000000000001", Inexact | Rounded | Subnormal | Underflow},
+		{"0.0000000001", "-0.0000000001", 0, Floor, "-0.0000000000000000001", Inexact | Rounded | Subnormal | Underflow},
+		{"9999999999", "9999999999", 0, HalfEven, "0", Overflow | Inexact | Rounded},
+	}
+	for _, tt := range tests {
+		d := RequireFromString(tt.d)
+		e := RequireFromString(tt.e)
+		ctx := Context{Precision: tt.prec, Rounding: tt.mode}
+		got, err := ctx.Mul(d, e)
+		if tt.wantFlags&Overflow != 0 {
+			if err == nil || ctx.Flags != tt.wantFlags {
+				t.Errorf("Context{%v, %v}.Mul(%q, %q) = (%q, %v), want overflow", tt.prec, tt.mode, d, e, got, ctx.Flags)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Context{%v, %v}.Mul(%q, %q) failed: %v", tt.prec, tt.mode, d, e, err)
+			continue
+		}
+		want := RequireFromString(tt.want)
+		if got != want || ctx.Flags != tt.wantFlags {
+			t.Errorf("Context{%v, %v}.Mul(%q, %q) = (%q, %v), want (%q, %v)", tt.prec, tt.mode, d, e, got, ctx.Flags, want, tt.wantFlags)
+		}
+	}
+}
+
+func TestContext_Add(t *testing.T) {
+	tests := []struct {
+		d, e      string
+		prec      int
+		mode      RoundingMode
+		want      string
+		wantFlags Condition
+	}{
+		{"1", "2", 0, HalfEven, "3", 0},
+		{"1.000", "0", 3, HalfEven, "1.00", Rounded},
+		{"9.9999", "0.00005", 5, HalfEven, "10.000", Inexact | Rounded},
+		{"9.9999", "0.00005", 5, Down, "9.9999", Inexact | Rounded},
+		{"9999999999999999999", "0.5", 0, HalfEven, "10000000000000000000", Overflow | Inexact | Rounded},
+		{"9999999999999999998", "0.5", 0, HalfEven, "9999999999999999998", Inexact | Rounded},
+		{"9999999999999999998", "0.5", 0, Up, "9999999999999999999", Inexact | Rounded},
+		{"0.0000000000000000001", "-0.0000000000000000001", 0, HalfEven, "0.0000000000000000000", 0},
+	}
+	for _, tt := range tests {
+		d := RequireFromString(tt.d)
+		e := RequireFromString(tt.e)
+		ctx := Context{Precision: tt.prec, Rounding: tt.mode}
+		got, err := ctx.Add(d, e)
+		if tt.wantFlags&Overflow != 0 {
+			if err == nil || ctx.Flags != tt.wantFlags {
+				t.Errorf("Context{%v, %v}.Add(%q, %q) = (%q, %v), want overflow", tt.prec, tt.mode, d, e, got, ctx.Flags)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Context{%v, %v}.Add(%q, %q) failed: %v", tt.prec, tt.mode, d, e, err)
+			continue
+		}
+		want := RequireFromString(tt.want)
+		if got != want || ctx.Flags != tt.wantFlags {
+			t.Errorf("Context{%v, %v}.Add(%q, %q) = (%q, %v), want (%q, %v)", tt.prec, tt.mode, d, e, got, ctx.Flags, want, tt.wantFlags)
+		}
+	}
+}
+
+func TestContext_Math(t *testing.T) {
+	tests := []struct {
+		name      string
+		f         func(*Context) (Decimal, error)
+		prec      int
+		mode      RoundingMode
+		want      string
+		wantFlags Condition
+	}{
+		{"sqrt(4)", func(c *Context) (Decimal, error) { return c.Sqrt(RequireFromString("4")) }, 0, HalfEven, "2", 0},
+		{"sqrt(0.04)", func(c *Context) (Decimal, error) { return c.Sqrt(RequireFromString("0.04")) }, 0, Up, "0.2", 0},
+		{"sqrt(2)", func(c *Context) (Decimal, error) { return c.Sqrt(RequireFromString("2")) }, 5, HalfEven, "1.4142", Inexact | Rounded},
+		{"sqrt(2)", func(c *Context) (Decimal, error) { return c.Sqrt(RequireFromString("2")) }, 5, Up, "1.4143", Inexact | Rounded},
+		{"sqrt(2)", func(c *Context) (Decimal, error) { return c.Sqrt(RequireFromString("2")) }, 0, Down, "1.414213562373095048", Inexact | Rounded},
+		{"exp(0)", func(c *Context) (Decimal, error) { return c.Exp(RequireFromString("0")) }, 0, HalfEven, "1", 0},
+		{"exp(1)", func(c *Context) (Decimal, error) { return c.Exp(RequireFromString("1")) }, 0, HalfEven, "2.718281828459045235", Inexact | Rounded},
+		{"exp(1)", func(c *Context) (Decimal, error) { return c.Exp(RequireFromString("1")) }, 0, Up, "2.718281828459045236", Inexact | Rounded},
+		{"exp(1)", func(c *Context) (Decimal, error) { return c.Exp(RequireFromString("1")) }, 3, Floor, "2.71", Inexact | Rounded},
+		{"exp(-50)", func(c *Context) (Decimal, error) { return c.Exp(RequireFromString("-50")) }, 0, HalfEven, "0", Inexact | Rounded | Subnormal | Underflow},
+		{"exp(-50)", func(c *Context) (Decimal, error) { return c.Exp(RequireFromString("-50")) }, 0, Up, "0.0000000000000000001", Inexact | Rounded | Subnormal | Underflow},
+		{"exp(-43.7)", func(c *Context) (Decimal, error) { return c.Exp(RequireFromString("-43.7")) }, 0, HalfEven, "0.0000000000000000001", Inexact | Rounded},
+		{"exp(-43.8)", func(c *Context) (Decimal, error) { return c.Exp(RequireFromString("-43.8")) }, 0, HalfEven, "0.0000000000000000001", Inexact | Rounded | Subnormal | Underflow},
+		{"log(1)", func(c *Context) (Decimal, error) { return c.Log(RequireFromString("1")) }, 0, HalfEven, "0", 0},
+		{"log(10)", func(c *Context) (Decimal, error) { return c.Log(RequireFromString("10")) }, 0, HalfEven, "2.302585092994045684", Inexact | Rounded},
+		{"log(10)", func(c *Context) (Decimal, error) { return c.Log(RequireFromString("10")) }, 0, Up, "2.302585092994045685", Inexact | Rounded},
+		{"2^10", func(c *Context) (Decimal, error) { return c.PowInt(RequireFromString("2"), 10) }, 4, HalfEven, "1024", 0},
+		{"1.1^2", func(c *Context) (Decimal, error) { return c.PowInt(RequireFromString("1.1"), 2) }, 2, HalfEven, "1.2", Inexact | Rounded},
+		{"1.0^100", func(c *Context) (Decimal, error) { return c.PowInt(RequireFromString("1.0"), 100) }, 0, Up, "1.0000000000000000000", Rounded | Clamped},
+		{"3^-1", func(c *Context) (Decimal, error) { return c.PowInt(RequireFromString("3"), -1) }, 3, Ceiling, "0.334", Inexact | Rounded},
+		{"0.5^100", func(c *Context) (Decimal, error) { return c.PowInt(RequireFromString("0.5"), 100) }, 0, HalfEven, "0.0000000000000000000", Inexact | Rounded | Subnormal | Underflow},
+		{"sum", func(c *Context) (Decimal, error) {
+			return c.Sum(RequireFromString("0.1"), RequireFromString("0.2"), RequireFromString("0.3"))
+		}, 0, HalfEven, "0.6", 0},
+		{"sum", func(c *Context) (Decimal, error) { return c.Sum(RequireFromString("0.15"), RequireFromString("0.2")) }, 1, HalfEven, "0.4", Inexact | Rounded},
+		{"prod", func(c *Context) (Decimal, error) {
+			return c.Prod(RequireFromString("1.5"), RequireFromString("1.5"), RequireFromString("1.5"))
+		}, 0, HalfEven, "3.375", 0},
+		{"prod", func(c *Context) (Decimal, error) {
+			return c.Prod(RequireFromString("1.5"), RequireFromString("1.5"), RequireFromString("1.5"))
+		}, 2, Down, "3.3", Inexact | Rounded},
+		{"round", func(c *Context) (Decimal, error) { return c.Round(RequireFromString("1234.5678")) }, 6, HalfUp, "1234.57", Inexact | Rounded},
+		{"round", func(c *Context) (Decimal, error) { return c.Round(RequireFromString("1234.5678")) }, 0, HalfUp, "1234.5678", 0},
+	}
+	for _, tt := range tests {
+		ctx := Context{Precision: tt.prec, Rounding: tt.mode}
+		got, err := tt.f(&ctx)
+		if err != nil {
+			t.Errorf("Context{%v, %v}: %v failed: %v", tt.prec, tt.mode, tt.name, err)
+			continue
+		}
+		want := RequireFromString(tt.want)
+		if got != want || ctx.Flags != tt.wantFlags {
+			t.Errorf("Context{%v, %v}: %v = (%q, %v), want (%q, %v)", tt.prec, tt.mode, tt.name, got, ctx.Flags, want, tt.wantFlags)
+		}
+	}
+}
+
+func TestContext_Flags(t *testing.T) {
+	ctx := Context{Precision: 5}
+	_, err := ctx.Add(RequireFromString("1"), RequireFromString("2"))
+	if err != nil {
+		t.Fatalf("Context.Add failed: %v", err)
+	}
+	if ctx.Flags != 0 {
+		t.Errorf("Context.Flags = %v, want 0", ctx.Flags)
+	}
+	_, err = ctx.Quo(RequireFromString("1"), RequireFromString("3"))
+	if err != nil {
+		t.Fatalf("Context.Quo failed: %v", err)
+	}
+	_, err = ctx.Sqrt(RequireFromString("-1"))
+	if err == nil {
+		t.Fatalf("Context.Sqrt did not fail")
+	}
+	_, err = ctx.Add(RequireFromString("1"), RequireFromString("2"))
+	if err != nil {
+		t.Fatalf("Context.Add failed: %v", err)
+	}
+	want := Inexact | Rounded | InvalidOperation
+	if ctx.Flags != want {
+		t.Errorf("Context.Flags = %v, want %v", ctx.Flags, want)
+	}
+}
@@ -0,0 +1,331 @@
+package daycount
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/govalues/decimal"
+)
+
+// Scale is the minimum number of digits after the decimal point
+// preserved by [Convention.YearFrac].
+// It leaves room for year fractions of up to 9999 years.
+const Scale = 15
+
+// kind identifies the rule of a day-count convention.
+type kind int
+
+const (
+	act360 kind = iota + 1
+	act365Fixed
+	actActISDA
+	actActICMA
+	thirty360US
+	thirtyE360
+	thirtyE360ISDA
+)
+
+// Convention represents a day-count convention.
+// Its zero value is not a valid convention.
+type Convention struct {
+	kind     kind
+	refStart time.Time // start of the coupon period, for ACT/ACT ICMA
+	refEnd   time.Time // end of the coupon period, for ACT/ACT ICMA
+	freq     int       // number of coupon periods per year, for ACT/ACT ICMA
+	maturity time.Time // maturity date, for 30E/360 ISDA
+}
+
+// Predefined conventions.
+var (
+	// Act360 is the ACT/360 convention, also known as Actual/360 or French.
+	Act360 = Convention{kind: act360}
+	// Act365Fixed is the ACT/365F convention, also known as Actual/365 Fixed or English.
+	Act365Fixed = Convention{kind: act365Fixed}
+	// ActActISDA is the ACT/ACT ISDA convention, also known as Actual/Actual or Actual/365.
+	ActActISDA = Convention{kind: actActISDA}
+	// Thirty360US is the 30/360 US convention, also known as 30U/360 or Bond basis,
+	// including the end-of-February adjustments.
+	Thirty360US = Convention{kind: thirty360US}
+	// ThirtyE360 is the 30E/360 convention, also known as 30/360 ICMA or Eurobond basis.
+	ThirtyE360 = Convention{kind: thirtyE360}
+)
+
+// ActActICMA returns the ACT/ACT ICMA convention, also known as Actual/Actual ICMA
+// or ISMA-99, for accrual within the coupon period from refStart to refEnd
+// of a bond that pays freq coupons per year.
+// Year fractions of periods that do not lie within the coupon period
+// cannot be computed under this convention.
+func ActActICMA(refStart, refEnd time.Time, freq int) Convention {
+	return Convention{kind: actActICMA, refStart: refStart, refEnd: refEnd, freq: freq}
+}
+
+// ThirtyE360ISDA returns the 30E/360 ISDA convention, also known as German,
+// for a bond or a deposit with the given maturity date.
+// The last day of February is not adjusted to 30 when it is the maturity date.
+func ThirtyE360ISDA(maturity time.Time) Convention {
+	return Convention{kind: thirtyE360ISDA, maturity: maturity}
+}
+
+// String implements the [fmt.Stringer] interface and returns
+// the name of the convention, for example, "ACT/360".
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (c Convention) String() string {
+	switch c.kind {
+	case act360:
+		return "ACT/360"
+	case act365Fixed:
+		return "ACT/365F"
+	case actActISDA:
+		return "ACT/ACT ISDA"
+	case actActICMA:
+		return "ACT/ACT ICMA"
+	case thirty360US:
+		return "30/360 US"
+	case thirtyE360:
+		return "30E/360"
+	case thirtyE360ISDA:
+		return "30E/360 ISDA"
+	default:
+		return "Convention(0)"
+	}
+}
+
+// YearFrac returns the (possibly rounded) fraction of a year from start to end
+// under the convention c.
+// The result is the quotient of [Convention.Fraction] rounded to
+// [decimal.MaxPrec] digits using [rounding half to even] (banker's rounding),
+// while keeping at least [Scale] digits after the decimal point.
+// Most fractions, such as 1/360 or 1/365, are rounded.
+// Use [Convention.Fraction] to divide by the length of a year only once,
+// after all multiplications.
+//
+// YearFrac returns an error if:
+//   - the convention is not valid;
+//   - the end date precedes the start date;
+//   - under [ActActICMA], the dates are outside the coupon period;
+//   - the integer part of the result has more than [decimal.MaxPrec] - [Scale] digits.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (c Convention) YearFrac(start, end time.Time) (decimal.Decimal, error) {
+	num, den, err := c.fraction(start, end)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing YearFrac(%v, %v, %v): %w", formatDate(start), formatDate(end), c, err)
+	}
+	f, err := num.QuoExact(den, Scale)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing YearFrac(%v, %v, %v): %w", formatDate(start), formatDate(end), c, err)
+	}
+	return f, nil
+}
+
+// AccruedInterest returns the (possibly rounded) interest accrued on the principal
+// at the given annual rate from start to end under the convention c.
+// The interest is computed as principal × rate × num / den, where num / den
+// is the year fraction returned by [Convention.Fraction].
+// The product principal × rate × num is computed exactly and divided only once,
+// so the result is correctly rounded to [decimal.MaxPrec] digits using
+// [rounding half to even] (banker's rounding).
+// The result has at least as many digits after the decimal point as the principal.
+//
+// AccruedInterest returns an error if:
+//   - the convention is not valid;
+//   - the end date precedes the start date;
+//   - under [ActActICMA], the dates are outside the coupon period;
+//   - the integer part of the result has more than [decimal.MaxPrec] digits
+//     minus the number of digits after the decimal point in the principal.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func AccruedInterest(principal, rate decimal.Decimal, start, end time.Time, c Convention) (decimal.Decimal, error) {
+	interest, err := accruedInterest(principal, rate, start, end, c)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing AccruedInterest(%v, %v, %v, %v, %v): %w", principal, rate, formatDate(start), formatDate(end), c, err)
+	}
+	return interest, nil
+}
+
+// accruedInterest is similar to [AccruedInterest], but it does not wrap errors.
+func accruedInterest(principal, rate decimal.Decimal, start, end time.Time, c Convention) (decimal.Decimal, error) {
+	num, den, err := c.fraction(start, end)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	// Compute p = principal × rate × num exactly
+	p, err := principal.BigDecimal().Mul(rate.BigDecimal())
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	p, err = p.Mul(num.BigDecimal())
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+
+	// Compute q = p / den
+	// The quotient is computed with more digits than a decimal can hold
+	// using ZeroFiveUp, so converting it to a decimal rounds it only once.
+	q, err := p.QuoMode(den.BigDecimal(), 2*decimal.MaxPrec, decimal.ZeroFiveUp)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	interest, err := q.Decimal()
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if interest.Scale() < principal.Scale() {
+		return decimal.Decimal{}, fmt.Errorf("%w: the integer part of the result has more than %v digits", decimal.ErrOverflow, decimal.MaxPrec-principal.Scale())
+	}
+	return interest, nil
+}
+
+// Fraction returns the exact fraction of a year from start to end under
+// the convention c as the ratio num / den of two whole numbers.
+// Unlike [Convention.YearFrac], it never rounds.
+//
+// Fraction returns an error if:
+//   - the convention is not valid;
+//   - the end date precedes the start date;
+//   - under [ActActICMA], the dates are outside the coupon period;
+//   - the numerator or the denominator has more than [decimal.MaxPrec] digits.
+func (c Convention) Fraction(start, end time.Time) (num, den decimal.Decimal, err error) {
+	num, den, err = c.fraction(start, end)
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("computing Fraction(%v, %v, %v): %w", formatDate(start), formatDate(end), c, err)
+	}
+	return num, den, nil
+}
+
+// fraction is similar to [Convention.Fraction], but it does not wrap errors.
+func (c Convention) fraction(start, end time.Time) (num, den decimal.Decimal, err error) {
+	if c.kind < act360 || c.kind > thirtyE360ISDA {
+		return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("%w: unknown convention", decimal.ErrInvalidOperation)
+	}
+	if daysBetween(start, end) < 0 {
+		return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("%w: end date %v precedes start date %v", decimal.ErrInvalidOperation, formatDate(end), formatDate(start))
+	}
+	var n, d int64
+	switch c.kind {
+	case act360:
+		n, d = daysBetween(start, end), 360
+	case act365Fixed:
+		n, d = daysBetween(start, end), 365
+	case actActISDA:
+		n, d = actActISDAFraction(start, end)
+	case actActICMA:
+		if c.freq <= 0 {
+			return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("%w: number of coupon periods per year must be positive", decimal.ErrInvalidOperation)
+		}
+		ref := daysBetween(c.refStart, c.refEnd)
+		if ref <= 0 {
+			return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("%w: coupon period from %v to %v is empty", decimal.ErrInvalidOperation, formatDate(c.refStart), formatDate(c.refEnd))
+		}
+		if daysBetween(c.refStart, start) < 0 || daysBetween(end, c.refEnd) < 0 {
+			return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("%w: period from %v to %v is outside coupon period from %v to %v", decimal.ErrInvalidOperation, formatDate(start), formatDate(end), formatDate(c.refStart), formatDate(c.refEnd))
+		}
+		n, d = daysBetween(start, end), ref*int64(c.freq)
+	case thirty360US, thirtyE360, thirtyE360ISDA:
+		n, d = c.thirty360Days(start, end), 360
+	}
+	num, err = decimal.New(n, 0)
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, err
+	}
+	den, err = decimal.New(d, 0)
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, err
+	}
+	return num, den, nil
+}
+
+// actActISDAFraction returns the ACT/ACT ISDA year fraction from start to end
+// as a ratio of two whole numbers:
+//
+//	n + d₁ / y₁ + d₂ / y₂ = (n × y₁ × y₂ + d₁ × y₂ + d₂ × y₁) / (y₁ × y₂)
+//
+// where d₁ and d₂ are the days in the first and the last calendar years,
+// y₁ and y₂ are the lengths of these years, and n is the number of
+// whole calendar years in between.
+func actActISDAFraction(start, end time.Time) (num, den int64) {
+	y1, y2 := start.Year(), end.Year()
+	if y1 == y2 {
+		return daysBetween(start, end), daysInYear(y1)
+	}
+	d1 := daysBetween(start, newYear(y1+1))
+	d2 := daysBetween(newYear(y2), end)
+	l1, l2 := daysInYear(y1), daysInYear(y2)
+	n := int64(y2 - y1 - 1)
+	return n*l1*l2 + d1*l2 + d2*l1, l1 * l2
+}
+
+// thirty360Days returns the number of days from start to end,
+// assuming that every month has 30 days, after adjusting the days
+// of the month according to the convention c.
+func (c Convention) thirty360Days(start, end time.Time) int64 {
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	switch c.kind {
+	case thirty360US:
+		if isLastOfFeb(start) {
+			if isLastOfFeb(end) {
+				d2 = 30
+			}
+			d1 = 30
+		}
+		if d2 == 31 && d1 >= 30 {
+			d2 = 30
+		}
+		if d1 == 31 {
+			d1 = 30
+		}
+	case thirtyE360:
+		d1 = min(d1, 30)
+		d2 = min(d2, 30)
+	case thirtyE360ISDA:
+		if isLastOfMonth(start) {
+			d1 = 30
+		}
+		if isLastOfMonth(end) && (m2 != time.February || !sameDate(end, c.maturity)) {
+			d2 = 30
+		}
+	}
+	return 360*int64(y2-y1) + 30*int64(m2-m1) + int64(d2-d1)
+}
+
+// daysBetween returns the number of calendar days from date a to date b.
+// The time of day and the location of the dates are ignored.
+func daysBetween(a, b time.Time) int64 {
+	ua := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	ub := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	// Unix seconds are used instead of time.Duration, which overflows after 292 years.
+	return (ub.Unix() - ua.Unix()) / (24 * 60 * 60)
+}
+
+// newYear returns January 1 of the given year.
+func newYear(y int) time.Time {
+	return time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// daysInYear returns 366 for leap years and 365 for other years.
+func daysInYear(y int) int64 {
+	return daysBetween(newYear(y), newYear(y+1))
+}
+
+// isLastOfMonth returns true if t is the last day of its month.
+func isLastOfMonth(t time.Time) bool {
+	return t.AddDate(0, 0, 1).Day() == 1
+}
+
+// isLastOfFeb returns true if t is the last day of February.
+func isLastOfFeb(t time.Time) bool {
+	return t.Month() == time.February && isLastOfMonth(t)
+}
+
+// sameDate returns true if a and b are the same calendar date.
+func sameDate(a, b time.Time) bool {
+	return daysBetween(a, b) == 0
+}
+
+// formatDate returns a date in the ISO 8601 format for error messages.
+func formatDate(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
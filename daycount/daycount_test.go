@@ -0,0 +1,230 @@
+package daycount
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/govalues/decimal"
+)
+
+func date(s string) time.Time {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestConvention_String(t *testing.T) {
+	tests := []struct {
+		c    Convention
+		want string
+	}{
+		{Act360, "ACT/360"},
+		{Act365Fixed, "ACT/365F"},
+		{ActActISDA, "ACT/ACT ISDA"},
+		{ActActICMA(date("2003-11-01"), date("2004-05-01"), 2), "ACT/ACT ICMA"},
+		{Thirty360US, "30/360 US"},
+		{ThirtyE360, "30E/360"},
+		{ThirtyE360ISDA(date("2009-02-28")), "30E/360 ISDA"},
+		{Convention{}, "Convention(0)"},
+	}
+	for _, tt := range tests {
+		got := tt.c.String()
+		if got != tt.want {
+			t.Errorf("%q.String() = %q, want %q", tt.want, got, tt.want)
+		}
+	}
+}
+
+func TestConvention_YearFrac(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			c          Convention
+			start, end string
+			want       string
+		}{
+			// Actual
+			{Act360, "2003-11-01", "2004-05-01", "0.5055555555555555556"},
+			{Act360, "2024-01-15", "2024-01-15", "0"},
+			{Act365Fixed, "2003-11-01", "2004-05-01", "0.4986301369863013699"},
+			{Act365Fixed, "2023-01-01", "2024-01-01", "1"},
+			{Act365Fixed, "2024-01-01", "2025-01-01", "1.002739726027397260"},
+
+			// ACT/ACT ISDA
+			{ActActISDA, "2003-11-01", "2004-05-01", "0.4977243805674077401"},
+			{ActActISDA, "2003-11-01", "2005-03-01", "1.328767123287671233"},
+			{ActActISDA, "2024-01-01", "2025-01-01", "1"},
+			{ActActISDA, "2024-03-01", "2024-09-01", "0.5027322404371584699"},
+			{ActActISDA, "1999-02-01", "1999-07-01", "0.4109589041095890411"},
+
+			// ACT/ACT ICMA
+			{ActActICMA(date("2003-11-01"), date("2004-05-01"), 2), "2003-11-01", "2004-05-01", "0.5"},
+			{ActActICMA(date("1999-01-15"), date("2000-01-15"), 1), "1999-02-01", "1999-07-01", "0.4109589041095890411"},
+			{ActActICMA(date("2000-01-30"), date("2000-06-30"), 2), "2000-01-30", "2000-06-30", "0.5"},
+			{ActActICMA(date("2024-01-15"), date("2024-04-15"), 4), "2024-01-15", "2024-02-15", "0.08516483516483516484"},
+
+			// 30/360 US
+			{Thirty360US, "2006-08-20", "2007-02-20", "0.5"},
+			{Thirty360US, "2007-02-28", "2007-08-31", "0.5"},
+			{Thirty360US, "2008-02-29", "2009-02-28", "1"},
+			{Thirty360US, "2007-01-31", "2007-02-28", "0.07777777777777777778"},
+			{Thirty360US, "2007-03-30", "2007-03-31", "0"},
+			{Thirty360US, "2007-03-29", "2007-03-31", "0.005555555555555555556"},
+
+			// 30E/360
+			{ThirtyE360, "2006-08-20", "2007-02-20", "0.5"},
+			{ThirtyE360, "2007-02-28", "2007-08-31", "0.5055555555555555556"},
+			{ThirtyE360, "2008-02-29", "2009-02-28", "0.9972222222222222222"},
+			{ThirtyE360, "2007-01-31", "2007-02-28", "0.07777777777777777778"},
+			{ThirtyE360, "2007-03-29", "2007-03-31", "0.002777777777777777778"},
+
+			// 30E/360 ISDA
+			{ThirtyE360ISDA(date("2010-08-31")), "2006-08-20", "2007-02-20", "0.5"},
+			{ThirtyE360ISDA(date("2010-08-31")), "2007-02-28", "2007-08-31", "0.5"},
+			{ThirtyE360ISDA(date("2010-08-31")), "2008-02-29", "2009-02-28", "1"},
+			{ThirtyE360ISDA(date("2009-02-28")), "2008-02-29", "2009-02-28", "0.9944444444444444444"},
+			{ThirtyE360ISDA(date("2010-08-31")), "2007-01-31", "2007-02-28", "0.08333333333333333333"},
+		}
+		for _, tt := range tests {
+			start, end := date(tt.start), date(tt.end)
+			got, err := tt.c.YearFrac(start, end)
+			if err != nil {
+				t.Errorf("%v.YearFrac(%v, %v) failed: %v", tt.c, tt.start, tt.end, err)
+				continue
+			}
+			want := decimal.MustNewFromString(tt.want)
+			if got.Cmp(want) != 0 || got.Scale() < Scale {
+				t.Errorf("%v.YearFrac(%v, %v) = %v, want %v", tt.c, tt.start, tt.end, got, want)
+			}
+		}
+	})
+
+	t.Run("location", func(t *testing.T) {
+		loc := time.FixedZone("UTC+14", 14*60*60)
+		start := time.Date(2024, 1, 1, 23, 59, 0, 0, time.UTC)
+		end := time.Date(2024, 1, 2, 0, 1, 0, 0, loc)
+		got, err := Act360.YearFrac(start, end)
+		if err != nil {
+			t.Fatalf("Act360.YearFrac(%v, %v) failed: %v", start, end, err)
+		}
+		want := decimal.MustNewFromString("0.002777777777777777778")
+		if got.Cmp(want) != 0 {
+			t.Errorf("Act360.YearFrac(%v, %v) = %v, want %v", start, end, got, want)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			c          Convention
+			start, end string
+			wantErr    error
+		}{
+			"convention": {Convention{}, "2024-01-01", "2024-07-01", decimal.ErrInvalidOperation},
+			"dates":      {Act360, "2024-07-01", "2024-01-01", decimal.ErrInvalidOperation},
+			"freq 1":     {ActActICMA(date("2024-01-01"), date("2024-07-01"), 0), "2024-01-01", "2024-03-01", decimal.ErrInvalidOperation},
+			"freq 2":     {ActActICMA(date("2024-01-01"), date("2024-07-01"), -2), "2024-01-01", "2024-03-01", decimal.ErrInvalidOperation},
+			"period":     {ActActICMA(date("2024-07-01"), date("2024-01-01"), 2), "2024-01-01", "2024-03-01", decimal.ErrInvalidOperation},
+			"before":     {ActActICMA(date("2024-01-01"), date("2024-07-01"), 2), "2023-12-31", "2024-03-01", decimal.ErrInvalidOperation},
+			"after":      {ActActICMA(date("2024-01-01"), date("2024-07-01"), 2), "2024-03-01", "2024-07-02", decimal.ErrInvalidOperation},
+			"overflow":   {Act360, "0001-01-01", "9999-12-31", decimal.ErrOverflow},
+		}
+		for name, tt := range tests {
+			_, err := tt.c.YearFrac(date(tt.start), date(tt.end))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: %v.YearFrac(%v, %v) = %v, want %v", name, tt.c, tt.start, tt.end, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestConvention_Fraction(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			c                Convention
+			start, end       string
+			wantNum, wantDen int64
+		}{
+			{Act360, "2024-01-01", "2024-01-02", 1, 360},
+			{Act365Fixed, "2024-01-01", "2024-01-02", 1, 365},
+			{ActActISDA, "2023-12-31", "2024-01-01", 366, 365 * 366},
+			{ActActICMA(date("1999-01-15"), date("2000-01-15"), 1), "1999-02-01", "1999-07-01", 150, 365},
+			{Thirty360US, "2024-01-01", "2024-07-01", 180, 360},
+		}
+		for _, tt := range tests {
+			num, den, err := tt.c.Fraction(date(tt.start), date(tt.end))
+			if err != nil {
+				t.Errorf("%v.Fraction(%v, %v) failed: %v", tt.c, tt.start, tt.end, err)
+				continue
+			}
+			if num != decimal.MustNew(tt.wantNum, 0) || den != decimal.MustNew(tt.wantDen, 0) {
+				t.Errorf("%v.Fraction(%v, %v) = %v / %v, want %v / %v", tt.c, tt.start, tt.end, num, den, tt.wantNum, tt.wantDen)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		c := ActActICMA(date("2024-01-01"), date("2024-07-01"), 2)
+		_, _, err := c.Fraction(date("2024-03-01"), date("2024-07-02"))
+		if !errors.Is(err, decimal.ErrInvalidOperation) {
+			t.Errorf("%v.Fraction(2024-03-01, 2024-07-02) = %v, want %v", c, err, decimal.ErrInvalidOperation)
+		}
+	})
+}
+
+func TestAccruedInterest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			principal, rate string
+			c               Convention
+			start, end      string
+			want            string
+		}{
+			{"1000000.00", "0.05", Act360, "2024-01-15", "2024-04-15", "12638.88888888888889"},
+			{"1000000.00", "0.05", Act365Fixed, "2024-01-15", "2024-04-15", "12465.75342465753425"},
+			{"1000000.00", "0.05", Thirty360US, "2024-01-15", "2024-04-15", "12500.00"},
+			{"100", "0.03", ActActISDA, "2003-11-01", "2004-05-01", "1.493173141702223220"},
+			{"100", "0.03", ActActICMA(date("2003-11-01"), date("2004-05-01"), 2), "2003-11-01", "2004-05-01", "1.50"},
+			{"-250000", "0.0425", ThirtyE360, "2024-02-28", "2024-08-28", "-5312.5"},
+			{"1000", "0.05", Act360, "2024-01-15", "2024-01-15", "0"},
+			{"7919477794.10", "0.0674665223082153551", Act360, "2024-01-15", "2024-09-19", "368073075.1825994934"},
+		}
+		for _, tt := range tests {
+			principal := decimal.MustNewFromString(tt.principal)
+			rate := decimal.MustNewFromString(tt.rate)
+			start, end := date(tt.start), date(tt.end)
+			got, err := AccruedInterest(principal, rate, start, end, tt.c)
+			if err != nil {
+				t.Errorf("AccruedInterest(%v, %v, %v, %v, %v) failed: %v", principal, rate, tt.start, tt.end, tt.c, err)
+				continue
+			}
+			want := decimal.MustNewFromString(tt.want)
+			if got.Cmp(want) != 0 || got.Scale() < principal.Scale() {
+				t.Errorf("AccruedInterest(%v, %v, %v, %v, %v) = %v, want %v", principal, rate, tt.start, tt.end, tt.c, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			principal, rate string
+			c               Convention
+			start, end      string
+			wantErr         error
+		}{
+			"convention": {"1000", "0.05", Convention{}, "2024-01-01", "2024-07-01", decimal.ErrInvalidOperation},
+			"dates":      {"1000", "0.05", Act360, "2024-07-01", "2024-01-01", decimal.ErrInvalidOperation},
+			"period":     {"1000", "0.05", ActActICMA(date("2024-01-01"), date("2024-07-01"), 2), "2023-12-01", "2024-03-01", decimal.ErrInvalidOperation},
+			"overflow":   {"9999999999999999999", "10", Act360, "2024-01-01", "2024-07-01", decimal.ErrOverflow},
+		}
+		for name, tt := range tests {
+			principal := decimal.MustNewFromString(tt.principal)
+			rate := decimal.MustNewFromString(tt.rate)
+			_, err := AccruedInterest(principal, rate, date(tt.start), date(tt.end), tt.c)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: AccruedInterest(%v, %v, %v, %v, %v) = %v, want %v", name, principal, rate, tt.start, tt.end, tt.c, err, tt.wantErr)
+			}
+		}
+	})
+}
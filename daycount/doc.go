@@ -0,0 +1,34 @@
+/*
+Package daycount implements day-count conventions for [decimal.Decimal].
+A day-count convention determines the fraction of a year between two dates,
+which is then used to compute the interest that accrues over that time.
+
+# Conventions
+
+The following conventions are supported:
+
+  - [Act360] and [Act365Fixed] divide the actual number of days by 360 or 365.
+  - [ActActISDA] divides the days that fall into each calendar year
+    by the actual length of that year.
+  - [ActActICMA] divides the actual number of days by the actual length
+    of the coupon period times the number of coupon periods per year.
+  - [Thirty360US], [ThirtyE360], and [ThirtyE360ISDA] assume that every month
+    has 30 days and a year has 360 days, and differ in how they adjust
+    the last days of months.
+
+Conventions that need more than two dates, such as [ActActICMA] and
+[ThirtyE360ISDA], are created by functions that take the extra parameters.
+
+# Precision
+
+A year fraction is the ratio of two whole numbers of days, which
+[Convention.Fraction] returns exactly.
+[Convention.YearFrac] divides them using [decimal.Decimal.QuoExact],
+so year fractions are rounded to [decimal.MaxPrec] digits,
+but preserve at least [Scale] digits after the decimal point.
+[AccruedInterest] divides only once, after multiplying the principal
+and the rate, so interest is never computed from a rounded year fraction.
+Dates are compared as calendar dates: the time of day and the location
+are ignored.
+*/
+package daycount
@@ -0,0 +1,79 @@
+package daycount_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/decimal/daycount"
+)
+
+func ExampleConvention_YearFrac() {
+	start := time.Date(2003, time.November, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2004, time.May, 1, 0, 0, 0, 0, time.UTC)
+	for _, c := range []daycount.Convention{
+		daycount.Act360,
+		daycount.Act365Fixed,
+		daycount.ActActISDA,
+		daycount.ActActICMA(start, end, 2),
+		daycount.Thirty360US,
+	} {
+		f, err := c.YearFrac(start, end)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("%-12v %.12f\n", c, f)
+	}
+	// Output:
+	// ACT/360      0.505555555556
+	// ACT/365F     0.498630136986
+	// ACT/ACT ISDA 0.497724380567
+	// ACT/ACT ICMA 0.500000000000
+	// 30/360 US    0.500000000000
+}
+
+func ExampleConvention_Fraction() {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)
+	num, den, err := daycount.Act360.Fraction(start, end)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(num, den)
+	f, err := daycount.Act360.YearFrac(start, end)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(f)
+	// Output:
+	// 1 360
+	// 0.0027777777777777778
+}
+
+func ExampleThirtyE360ISDA() {
+	start := time.Date(2008, time.February, 29, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2009, time.February, 28, 0, 0, 0, 0, time.UTC)
+	c := daycount.ThirtyE360ISDA(end)
+	f, err := c.YearFrac(start, end)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%.6f\n", f)
+	// Output: 0.994444
+}
+
+func ExampleAccruedInterest() {
+	principal := decimal.MustNew(100000000, 2)
+	rate := decimal.MustNew(5, 2)
+	start := time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, time.April, 15, 0, 0, 0, 0, time.UTC)
+	interest, err := daycount.AccruedInterest(principal, rate, start, end, daycount.Act360)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(interest)
+	fmt.Println(interest.Round(2))
+	// Output:
+	// 12638.88888888888889
+	// 12638.89
+}
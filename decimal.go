@@ -2,9 +2,10 @@ package decimal
 
 import (
 	"database/sql/driver"
-	"errors"
+	"encoding/xml"
 	"fmt"
 	"math"
+	"slices"
 	"strconv"
 )
 
@@ -25,23 +26,17 @@ const (
 )
 
 var (
-	NegOne              = MustNew(-1, 0)                               // NegOne represents the decimal value of -1.
-	Zero                = MustNew(0, 0)                                // Zero represents the decimal value of 0. For comparison purposes, use IsZero method.
-	One                 = MustNew(1, 0)                                // One represents the decimal value of 1.
-	Two                 = MustNew(2, 0)                                // Two represents the decimal value of 2.
-	Ten                 = MustNew(10, 0)                               // Ten represents the decimal value of 10.
-	Hundred             = MustNew(100, 0)                              // Hundred represents the decimal value of 100.
-	Thousand            = MustNew(1_000, 0)                            // Thousand represents the decimal value of 1,000.
-	E                   = MustNew(2_718_281_828_459_045_235, 18)       // E represents Euler’s number rounded to 18 digits.
-	Pi                  = MustNew(3_141_592_653_589_793_238, 18)       // Pi represents the value of π rounded to 18 digits.\
-	MaxNumber           = Decimal{neg: false, scale: 0, coef: maxFint} // Max represents the maximum decimal value.
-	errDecimalOverflow  = errors.New("decimal overflow")
-	errInvalidDecimal   = errors.New("invalid decimal")
-	errScaleRange       = errors.New("scale out of range")
-	errInvalidOperation = errors.New("invalid operation")
-	errInexactDivision  = errors.New("inexact division")
-	errDivisionByZero   = errors.New("division by zero")
-	powerOf10           = []Decimal{
+	NegOne    = MustNew(-1, 0)                               // NegOne represents the decimal value of -1.
+	Zero      = MustNew(0, 0)                                // Zero represents the decimal value of 0. For comparison purposes, use IsZero method.
+	One       = MustNew(1, 0)                                // One represents the decimal value of 1.
+	Two       = MustNew(2, 0)                                // Two represents the decimal value of 2.
+	Ten       = MustNew(10, 0)                               // Ten represents the decimal value of 10.
+	Hundred   = MustNew(100, 0)                              // Hundred represents the decimal value of 100.
+	Thousand  = MustNew(1_000, 0)                            // Thousand represents the decimal value of 1,000.
+	E         = MustNew(2_718_281_828_459_045_235, 18)       // E represents Euler’s number rounded to 18 digits.
+	Pi        = MustNew(3_141_592_653_589_793_238, 18)       // Pi represents the value of π rounded to 18 digits.\
+	MaxNumber = Decimal{neg: false, scale: 0, coef: maxFint} // Max represents the maximum decimal value.
+	powerOf10 = []Decimal{
 		{neg: false, scale: 18, coef: 1},
 		{neg: false, scale: 17, coef: 1},
 		{neg: false, scale: 16, coef: 1},
@@ -103,36 +98,58 @@ func newUnsafe(neg bool, coef fint, scale int) Decimal {
 func newSafe(neg bool, coef fint, scale int) (Decimal, error) {
 	switch {
 	case scale < MinScale || scale > MaxScale:
-		return Decimal{}, errScaleRange
+		return Decimal{}, ErrScaleRange
 	case coef > maxCoef:
-		return Decimal{}, errDecimalOverflow
+		return Decimal{}, ErrOverflow
 	}
 	return newUnsafe(neg, coef, scale), nil
 }
 
 // newFromFint creates a new decimal from uint64 coefficient.
+// If the coefficient has to be rounded, the given rounding mode is used.
 // This method does not use overflowError to return descriptive errors,
 // as it must be as fast as possible.
-func newFromFint(neg bool, coef fint, scale, minScale int) (Decimal, error) {
+func newFromFint(neg bool, coef fint, scale, minScale int, mode RoundingMode) (Decimal, error) {
 	var ok bool
 	// Scale normalization
 	switch {
 	case scale < minScale:
 		coef, ok = coef.lsh(minScale - scale)
 		if !ok {
-			return Decimal{}, errDecimalOverflow
+			return Decimal{}, ErrOverflow
 		}
 		scale = minScale
 	case scale > MaxScale:
-		coef = coef.rshHalfEven(scale - MaxScale)
+		coef = coef.rshMode(scale-MaxScale, mode, neg)
 		scale = MaxScale
 	}
 	return newSafe(neg, coef, scale)
 }
 
+// newFromFintInexact is similar to newFromFint, but it also reports
+// whether any of the discarded digits is not zero.
+func newFromFintInexact(neg bool, coef fint, scale, minScale int, mode RoundingMode) (Decimal, bool, error) {
+	var ok, inexact bool
+	// Scale normalization
+	switch {
+	case scale < minScale:
+		coef, ok = coef.lsh(minScale - scale)
+		if !ok {
+			return Decimal{}, false, ErrOverflow
+		}
+		scale = minScale
+	case scale > MaxScale:
+		coef, inexact = coef.rshModeInexact(scale-MaxScale, mode, neg)
+		scale = MaxScale
+	}
+	d, err := newSafe(neg, coef, scale)
+	return d, inexact, err
+}
+
 // newFromBint creates a new decimal from *big.Int coefficient.
+// If the coefficient has to be rounded, the given rounding mode is used.
 // This method uses overflowError to return descriptive errors.
-func newFromBint(neg bool, coef *bint, scale, minScale int) (Decimal, error) {
+func newFromBint(neg bool, coef *bint, scale, minScale int, mode RoundingMode) (Decimal, error) {
 	// Overflow validation
 	prec := coef.prec()
 	if prec-scale > MaxPrec-minScale {
@@ -144,39 +161,50 @@ func newFromBint(neg bool, coef *bint, scale, minScale int) (Decimal, error) {
 		coef.lsh(coef, minScale-scale)
 		scale = minScale
 	case scale >= prec && scale > MaxScale: // no integer part
-		coef.rshHalfEven(coef, scale-MaxScale)
+		coef.rshMode(coef, scale-MaxScale, mode, neg)
 		scale = MaxScale
 	case prec > scale && prec > MaxPrec: // there is an integer part
-		coef.rshHalfEven(coef, prec-MaxPrec)
+		coef.rshMode(coef, prec-MaxPrec, mode, neg)
 		scale = MaxPrec - prec + scale
 	}
-	// Handling the rare case when rshHalfEven rounded
+	// Handling the rare case when rshMode rounded
 	// a 19-digit coefficient to a 20-digit coefficient.
 	if coef.hasPrec(MaxPrec + 1) {
-		return newFromBint(neg, coef, scale, minScale)
+		return newFromBint(neg, coef, scale, minScale, mode)
 	}
 	return newSafe(neg, coef.fint(), scale)
 }
 
-func overflowError(gotPrec, gotScale, wantScale int) error {
-	maxDigits := MaxPrec - wantScale
-	gotDigits := gotPrec - gotScale
-	switch wantScale {
-	case 0:
-		return fmt.Errorf("%w: the integer part of a %T can have at most %v digits, but it has %v digits", errDecimalOverflow, Decimal{}, maxDigits, gotDigits)
-	default:
-		return fmt.Errorf("%w: with %v significant digits after the decimal point, the integer part of a %T can have at most %v digits, but it has %v digits", errDecimalOverflow, wantScale, Decimal{}, maxDigits, gotDigits)
+// newFromBintInexact is similar to newFromBint, but it also reports
+// whether any of the discarded digits is not zero.
+func newFromBintInexact(neg bool, coef *bint, scale, minScale int, mode RoundingMode) (Decimal, bool, error) {
+	// Overflow validation
+	prec := coef.prec()
+	if prec-scale > MaxPrec-minScale {
+		return Decimal{}, false, overflowError(prec, scale, minScale)
 	}
-}
-
-func unknownOverflowError(wantScale int) error {
-	maxDigits := MaxPrec - wantScale
-	switch wantScale {
-	case 0:
-		return fmt.Errorf("%w: the integer part of a %T can have at most %v digits, but it has significantly more digits", errDecimalOverflow, Decimal{}, maxDigits)
-	default:
-		return fmt.Errorf("%w: with %v significant digits after the decimal point, the integer part of a %T can have at most %v digits, but it has significantly more digits", errDecimalOverflow, wantScale, Decimal{}, maxDigits)
+	// Scale normalization
+	inexact := false
+	switch {
+	case scale < minScale:
+		coef.lsh(coef, minScale-scale)
+		scale = minScale
+	case scale >= prec && scale > MaxScale: // no integer part
+		inexact = coef.rshMode(coef, scale-MaxScale, mode, neg)
+		scale = MaxScale
+	case prec > scale && prec > MaxPrec: // there is an integer part
+		inexact = coef.rshMode(coef, prec-MaxPrec, mode, neg)
+		scale = MaxPrec - prec + scale
+	}
+	// Handling the rare case when rshMode rounded
+	// a 19-digit coefficient to a 20-digit coefficient.
+	// The discarded digit is zero, so inexactness does not change.
+	if coef.hasPrec(MaxPrec + 1) {
+		d, _, err := newFromBintInexact(neg, coef, scale, minScale, mode)
+		return d, inexact, err
 	}
+	d, err := newSafe(neg, coef.fint(), scale)
+	return d, inexact, err
 }
 
 // New returns a decimal equal to coef / 10^scale.
@@ -335,16 +363,16 @@ func NewFromString(s string) (Decimal, error) {
 // equal to or greater than the currency's scale.
 func NewFromStringExact(s string, scale int) (Decimal, error) {
 	if len(s) > 330 {
-		return Decimal{}, fmt.Errorf("parsing decimal: %w", errInvalidDecimal)
+		return Decimal{}, newParseError(s, scale, ErrInvalidDecimal)
 	}
 	if scale < MinScale || scale > MaxScale {
-		return Decimal{}, fmt.Errorf("parsing decimal: %w", errScaleRange)
+		return Decimal{}, newParseError(s, scale, ErrScaleRange)
 	}
 	d, err := parseFint(s, scale)
 	if err != nil {
 		d, err = parseBint(s, scale)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("parsing decimal: %w", err)
+			return Decimal{}, newParseError(s, scale, err)
 		}
 	}
 	return d, nil
@@ -379,7 +407,7 @@ func parseFint(s string, minScale int) (Decimal, error) {
 	for pos < width && s[pos] >= '0' && s[pos] <= '9' {
 		coef, ok = coef.fsa(1, s[pos]-'0')
 		if !ok {
-			return Decimal{}, errDecimalOverflow
+			return Decimal{}, ErrOverflow
 		}
 		pos++
 		hasCoef = true
@@ -391,7 +419,7 @@ func parseFint(s string, minScale int) (Decimal, error) {
 		for pos < width && s[pos] >= '0' && s[pos] <= '9' {
 			coef, ok = coef.fsa(1, s[pos]-'0')
 			if !ok {
-				return Decimal{}, errDecimalOverflow
+				return Decimal{}, ErrOverflow
 			}
 			pos++
 			scale++
@@ -400,24 +428,39 @@ func parseFint(s string, minScale int) (Decimal, error) {
 	}
 
 	if pos != width {
-		return Decimal{}, fmt.Errorf("%w: unexpected character %q", errInvalidDecimal, s[pos])
+		return Decimal{}, fmt.Errorf("%w: unexpected character %q", ErrInvalidDecimal, s[pos])
 	}
 	if !hasCoef {
-		return Decimal{}, fmt.Errorf("%w: no coefficient", errInvalidDecimal)
+		return Decimal{}, fmt.Errorf("%w: no coefficient", ErrInvalidDecimal)
 	}
-	return newFromFint(neg, coef, scale, minScale)
+	return newFromFint(neg, coef, scale, minScale, HalfEven)
 }
 
 // parseBint parses a decimal string using *big.Int arithmetic.
 // parseBint supports exponential notation.
+func parseBint(s string, minScale int) (Decimal, error) {
+	bcoef := getBint()
+	defer putBint(bcoef)
+	neg, scale, err := scanBint(s, bcoef, 330)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return newFromBint(neg, bcoef, scale, minScale, HalfEven)
+}
+
+// scanBint scans a decimal string into the *big.Int coefficient bcoef and
+// returns the sign and the scale of the decimal.
+// The scale is negative if the exponent exceeds the number of digits
+// after the decimal point.
+// The absolute value of the exponent must not exceed maxExp.
+// scanBint is shared by [Decimal], [Decimal128] and [BigDecimal] parsers.
 //
 //nolint:gocyclo
-func parseBint(s string, minScale int) (Decimal, error) {
+func scanBint(s string, bcoef *bint, maxExp int) (neg bool, scale int, err error) {
 	var pos int
 	width := len(s)
 
 	// Sign
-	var neg bool
 	switch {
 	case pos == width:
 		// skip
@@ -429,11 +472,9 @@ func parseBint(s string, minScale int) (Decimal, error) {
 	}
 
 	// Coefficient
-	bcoef := getBint()
-	defer putBint(bcoef)
 	bcoef.setFint(0)
 	var fcoef fint
-	var shift, scale int
+	var shift int
 	var hasCoef, ok bool
 
 	// Algorithm:
@@ -446,7 +487,7 @@ func parseBint(s string, minScale int) (Decimal, error) {
 	for pos < width && s[pos] >= '0' && s[pos] <= '9' {
 		fcoef, ok = fcoef.fsa(1, s[pos]-'0')
 		if !ok {
-			return Decimal{}, errDecimalOverflow // Should never happen
+			return false, 0, ErrOverflow // Should never happen
 		}
 		pos++
 		shift++
@@ -463,7 +504,7 @@ func parseBint(s string, minScale int) (Decimal, error) {
 		for pos < width && s[pos] >= '0' && s[pos] <= '9' {
 			fcoef, ok = fcoef.fsa(1, s[pos]-'0')
 			if !ok {
-				return Decimal{}, errDecimalOverflow // Should never happen
+				return false, 0, ErrOverflow // Should never happen
 			}
 			pos++
 			scale++
@@ -497,23 +538,24 @@ func parseBint(s string, minScale int) (Decimal, error) {
 		}
 		// Integer
 		for pos < width && s[pos] >= '0' && s[pos] <= '9' {
-			exp = exp*10 + int(s[pos]-'0')
-			if exp > 330 {
-				return Decimal{}, errInvalidDecimal
+			digit := int(s[pos] - '0')
+			if exp > (maxExp-digit)/10 {
+				return false, 0, ErrInvalidDecimal
 			}
+			exp = exp*10 + digit
 			pos++
 			hasExp = true
 		}
 	}
 
 	if pos != width {
-		return Decimal{}, fmt.Errorf("%w: unexpected character %q", errInvalidDecimal, s[pos])
+		return false, 0, fmt.Errorf("%w: unexpected character %q", ErrInvalidDecimal, s[pos])
 	}
 	if !hasCoef {
-		return Decimal{}, fmt.Errorf("%w: no coefficient", errInvalidDecimal)
+		return false, 0, fmt.Errorf("%w: no coefficient", ErrInvalidDecimal)
 	}
 	if hasE && !hasExp {
-		return Decimal{}, fmt.Errorf("%w: no exponent", errInvalidDecimal)
+		return false, 0, fmt.Errorf("%w: no exponent", ErrInvalidDecimal)
 	}
 
 	if eneg {
@@ -522,7 +564,7 @@ func parseBint(s string, minScale int) (Decimal, error) {
 		scale = scale - exp
 	}
 
-	return newFromBint(neg, bcoef, scale, minScale)
+	return neg, scale, nil
 }
 
 // RequireFromString is like [NewFromString] but panics if the string cannot be parsed.
@@ -583,18 +625,6 @@ func (d Decimal) String() string {
 		pos--
 	}
 
-	if d.Scale() > 0 {
-		// Trailing 0
-		var end = len(buf) - 1
-		for buf[end] == '0' {
-			end--
-		}
-		if buf[end] == '.' {
-			end--
-		}
-		return string(buf[pos+1 : end+1])
-	}
-
 	return string(buf[pos+1:])
 }
 
@@ -602,56 +632,70 @@ func (d Decimal) String() string {
 //
 // [packed BCD]: https://en.wikipedia.org/wiki/Binary-coded_decimal#Packed_BCD
 func parseBCD(b []byte) (Decimal, error) {
+	var coef fint
+	neg, scale, err := scanBCD(b, func(digit byte) bool {
+		var ok bool
+		coef, ok = coef.fsa(1, digit)
+		return ok
+	})
+	if err != nil {
+		return Decimal{}, err
+	}
+	return newSafe(neg, coef, scale)
+}
+
+// scanBCD scans a [packed BCD] representation of a decimal, passing the digits
+// of the coefficient to the given function one by one, and returns the sign
+// and the scale of the decimal.
+// The function returns false if the coefficient overflows.
+// scanBCD is shared by [Decimal] and [Decimal128] parsers.
+//
+// [packed BCD]: https://en.wikipedia.org/wiki/Binary-coded_decimal#Packed_BCD
+func scanBCD(b []byte, fsa func(digit byte) bool) (neg bool, scale int, err error) {
 	var pos int
 	width := len(b)
 
 	// Coefficient and sign
-	var neg bool
-	var coef fint
-	var ok bool
 	for pos < width {
 		hi := b[pos] >> 4
 		lo := b[pos] & 0x0f
 
 		if hi > 9 {
-			return Decimal{}, fmt.Errorf("%w: invalid high nibble \"%x\"", errInvalidDecimal, b[pos])
+			return false, 0, fmt.Errorf("%w: invalid high nibble \"%x\"", ErrInvalidDecimal, b[pos])
 		}
-		coef, ok = coef.fsa(1, hi)
-		if !ok {
-			return Decimal{}, errDecimalOverflow
+		if !fsa(hi) {
+			return false, 0, ErrOverflow
 		}
 
 		if lo > 9 {
 			if lo == 0x0d {
 				neg = true
 			} else if lo != 0x0c {
-				return Decimal{}, fmt.Errorf("%w: invalid low nibble \"%x\"", errInvalidDecimal, b[pos])
+				return false, 0, fmt.Errorf("%w: invalid low nibble \"%x\"", ErrInvalidDecimal, b[pos])
 			}
 			pos++
 			break
 		}
-		coef, ok = coef.fsa(1, lo)
-		if !ok {
-			return Decimal{}, errDecimalOverflow
+		if !fsa(lo) {
+			return false, 0, ErrOverflow
 		}
 		pos++
 	}
 
 	// Scale
-	var scale int
 	var hasScale bool
 	if pos < width {
 		hi := b[pos] >> 4
 		lo := b[pos] & 0x0f
 		hasScale = true
 
-		if hi > 1 {
-			return Decimal{}, fmt.Errorf("%w: invalid high nibble \"%x\"", errInvalidDecimal, b[pos])
+		if hi > 9 {
+			return false, 0, fmt.Errorf("%w: invalid high nibble \"%x\"", ErrInvalidDecimal, b[pos])
 		}
 		scale = int(hi) * 10
 
 		if lo > 9 {
-			return Decimal{}, fmt.Errorf("%w: invalid low nibble \"%x\"", errInvalidDecimal, b[pos])
+			return false, 0, fmt.Errorf("%w: invalid low nibble \"%x\"", ErrInvalidDecimal, b[pos])
 		}
 		scale += int(lo)
 
@@ -659,13 +703,13 @@ func parseBCD(b []byte) (Decimal, error) {
 	}
 
 	if pos != width {
-		return Decimal{}, fmt.Errorf("%w: unexpected byte \"%x\"", errInvalidDecimal, b[pos])
+		return false, 0, fmt.Errorf("%w: unexpected byte \"%x\"", ErrInvalidDecimal, b[pos])
 	}
 	if !hasScale {
-		return Decimal{}, fmt.Errorf("%w: no scale", errInvalidDecimal)
+		return false, 0, fmt.Errorf("%w: no scale", ErrInvalidDecimal)
 	}
 
-	return newSafe(neg, coef, scale)
+	return neg, scale, nil
 }
 
 // bcd returns a [packed BCD] representation of a decimal.
@@ -700,6 +744,125 @@ func (d Decimal) bcd() []byte {
 	return buf[pos+1:]
 }
 
+// AvroBytes returns the unscaled value of the decimal in the format of the
+// [Avro decimal] logical type with the "bytes" underlying type, that is,
+// d * 10^scale as a big-endian two's complement integer with the minimum
+// number of bytes.
+// The scale must be equal to the scale of the Avro schema.
+// If the scale of the decimal is greater than the given scale, the decimal is
+// rounded using [rounding half to even] (banker's rounding).
+// See also method [Decimal.AvroBytesMode] and constructor [FromAvroBytes].
+//
+// AvroBytes returns an error if the scale is negative.
+//
+// [Avro decimal]: https://avro.apache.org/docs/current/specification/#decimal
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d Decimal) AvroBytes(scale int) ([]byte, error) {
+	return d.AvroBytesMode(scale, HalfEven)
+}
+
+// AvroBytesMode is similar to [Decimal.AvroBytes], but it allows you to specify
+// the rounding mode that is used if the decimal has to be rounded.
+//
+// AvroBytesMode returns an error if:
+//   - the scale is negative;
+//   - the rounding mode is not valid.
+func (d Decimal) AvroBytesMode(scale int, mode RoundingMode) ([]byte, error) {
+	return d.AvroFixedMode(scale, 0, mode)
+}
+
+// AvroFixed is similar to [Decimal.AvroBytes], but it returns exactly size
+// bytes, as required by the [Avro decimal] logical type with the "fixed"
+// underlying type.
+// The unscaled value is sign-extended to the given size.
+// See also method [Decimal.AvroFixedMode] and constructor [FromAvroFixed].
+//
+// AvroFixed returns an error if:
+//   - the scale is negative;
+//   - the unscaled value does not fit into size bytes.
+//
+// [Avro decimal]: https://avro.apache.org/docs/current/specification/#decimal
+func (d Decimal) AvroFixed(scale, size int) ([]byte, error) {
+	return d.AvroFixedMode(scale, size, HalfEven)
+}
+
+// AvroFixedMode is similar to [Decimal.AvroFixed], but it allows you to specify
+// the rounding mode that is used if the decimal has to be rounded.
+//
+// AvroFixedMode returns an error if:
+//   - the scale is negative;
+//   - the rounding mode is not valid;
+//   - the unscaled value does not fit into size bytes.
+func (d Decimal) AvroFixedMode(scale, size int, mode RoundingMode) ([]byte, error) {
+	if scale < MinScale {
+		return nil, newOpError("encodeavro", scale, ErrScaleRange, d)
+	}
+	if !mode.valid() {
+		return nil, newOpError("encodeavro", scale, ErrModeRange, d)
+	}
+	coef := getBint()
+	defer putBint(coef)
+	coef.setDecimal(d.RoundMode(scale, mode), scale)
+	b := coef.twos(size)
+	if size > 0 && len(b) > size {
+		return nil, newOpError("encodeavro", scale, fmt.Errorf("%w: unscaled value needs %v bytes, got %v", ErrOverflow, len(b), size), d)
+	}
+	return b, nil
+}
+
+// FromAvroBytes converts the unscaled value of the [Avro decimal] logical type
+// with the "bytes" underlying type to a decimal.
+// The byte slice must contain a big-endian two's complement integer,
+// and the scale must be equal to the scale of the Avro schema.
+// If the scale is greater than [MaxScale] or the value has more than [MaxPrec]
+// digits, the result is rounded using [rounding half to even] (banker's rounding).
+// See also method [Decimal.AvroBytes].
+//
+// FromAvroBytes returns an error if:
+//   - the byte slice is empty;
+//   - the scale is negative;
+//   - the integer part of the result has more than [MaxPrec] digits.
+//
+// [Avro decimal]: https://avro.apache.org/docs/current/specification/#decimal
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func FromAvroBytes(b []byte, scale int) (Decimal, error) {
+	if len(b) == 0 {
+		return Decimal{}, newOpError("decodeavro", scale, fmt.Errorf("%w: empty unscaled value", ErrInvalidDecimal))
+	}
+	if scale < MinScale {
+		return Decimal{}, newOpError("decodeavro", scale, ErrScaleRange)
+	}
+	coef := getBint()
+	defer putBint(coef)
+	coef.setTwos(b)
+	neg := coef.sign() < 0
+	if neg {
+		coef.neg(coef)
+	}
+	d, err := newFromBint(neg, coef, scale, 0, HalfEven)
+	if err != nil {
+		return Decimal{}, newOpError("decodeavro", scale, err)
+	}
+	return d, nil
+}
+
+// FromAvroFixed is similar to [FromAvroBytes], but it converts the unscaled
+// value of the [Avro decimal] logical type with the "fixed" underlying type.
+// See also method [Decimal.AvroFixed].
+//
+// FromAvroFixed returns an error if:
+//   - the length of the byte slice is not equal to size;
+//   - the scale is negative;
+//   - the integer part of the result has more than [MaxPrec] digits.
+//
+// [Avro decimal]: https://avro.apache.org/docs/current/specification/#decimal
+func FromAvroFixed(b []byte, scale, size int) (Decimal, error) {
+	if len(b) != size {
+		return Decimal{}, newOpError("decodeavro", scale, fmt.Errorf("%w: got %v bytes, want %v", ErrInvalidDecimal, len(b), size))
+	}
+	return FromAvroBytes(b, scale)
+}
+
 // Float64 returns the nearest binary floating-point number rounded
 // using [rounding half to even] (banker's rounding).
 // See also constructor [NewFromFloat64].
@@ -812,42 +975,10 @@ func (d *Decimal) UnmarshalBinary(data []byte) error {
 // MarshalBinary implements the [encoding.BinaryMarshaler] interface.
 //
 // [encoding.BinaryMarshaler]: https://pkg.go.dev/encoding#BinaryMarshaler
-func (d *Decimal) MarshalBinary() ([]byte, error) {
+func (d Decimal) MarshalBinary() ([]byte, error) {
 	return d.bcd(), nil
 }
 
-func unquoteIfQuoted(value []byte) (string, error) {
-	// If the amount is quoted, strip the quotes
-	if len(value) > 2 && value[0] == '"' && value[len(value)-1] == '"' {
-		value = value[1 : len(value)-1]
-	}
-	return string(value), nil
-}
-
-// UnmarshalJSON implements the json.Unmarshaler interface.
-func (d *Decimal) UnmarshalJSON(decimalBytes []byte) error {
-	if string(decimalBytes) == "null" {
-		return nil
-	}
-
-	str, err := unquoteIfQuoted(decimalBytes)
-	if err != nil {
-		return fmt.Errorf("error decoding string '%s': %s", decimalBytes, err)
-	}
-
-	decimal, err := NewFromString(str)
-	*d = decimal
-	if err != nil {
-		return fmt.Errorf("error decoding string '%s': %s", str, err)
-	}
-	return nil
-}
-
-// MarshalJSON implements the json.Marshaler interface.
-func (d Decimal) MarshalJSON() ([]byte, error) {
-	return []byte(d.String()), nil
-}
-
 // Scan implements the [sql.Scanner] interface.
 // See also constructor [NewFromString].
 //
@@ -931,13 +1062,31 @@ func (d Decimal) Format(state fmt.State, verb rune) {
 		}
 	}
 
+	// Coefficient digits
+	var buf [MaxPrec]byte
+	digits := buf[:0]
+	if !d.IsZero() {
+		digits = strconv.AppendUint(digits, d.Coef(), 10)
+	}
+
+	writeDecimal(state, verb, d.IsNeg(), digits, d.Scale(), tzeros, "decimal.Decimal")
+}
+
+// writeDecimal writes a decimal with the given sign, coefficient digits, and
+// scale, followed by tzeros trailing zeros, according to the verb and flags
+// described in [Decimal.Format].
+// The coefficient digits of 0 are expected to be empty.
+// writeDecimal is shared by [Decimal.Format] and [Decimal128.Format].
+//
+//nolint:gocyclo
+func writeDecimal(state fmt.State, verb rune, neg bool, digits []byte, scale, tzeros int, typ string) {
 	// Integer and fractional digits
 	var intdigs int
-	fracdigs := d.Scale()
-	if dprec := d.Prec(); dprec > fracdigs {
+	fracdigs := scale
+	if dprec := len(digits); dprec > fracdigs {
 		intdigs = dprec - fracdigs
 	}
-	if d.WithinOne() {
+	if len(digits) <= scale {
 		intdigs++ // leading 0
 	}
 
@@ -949,7 +1098,7 @@ func (d Decimal) Format(state fmt.State, verb rune) {
 
 	// Arithmetic sign
 	var rsign int
-	if d.IsNeg() || state.Flag('+') || state.Flag(' ') {
+	if neg || state.Flag('+') || state.Flag(' ') {
 		rsign = 1
 	}
 
@@ -1008,11 +1157,15 @@ func (d Decimal) Format(state fmt.State, verb rune) {
 	}
 
 	// Fractional digits
-	dcoef := d.Coef()
+	dpos := len(digits) - 1
 	for range fracdigs {
-		buf[pos] = byte(dcoef%10) + '0'
+		if dpos >= 0 {
+			buf[pos] = digits[dpos]
+			dpos--
+		} else {
+			buf[pos] = '0'
+		}
 		pos--
-		dcoef /= 10
 	}
 
 	// Decimal point
@@ -1023,9 +1176,13 @@ func (d Decimal) Format(state fmt.State, verb rune) {
 
 	// Integer digits
 	for range intdigs {
-		buf[pos] = byte(dcoef%10) + '0'
+		if dpos >= 0 {
+			buf[pos] = digits[dpos]
+			dpos--
+		} else {
+			buf[pos] = '0'
+		}
 		pos--
-		dcoef /= 10
 	}
 
 	// Leading zeros
@@ -1036,7 +1193,7 @@ func (d Decimal) Format(state fmt.State, verb rune) {
 
 	// Arithmetic sign
 	for range rsign {
-		if d.IsNeg() {
+		if neg {
 			buf[pos] = '-'
 		} else if state.Flag(' ') {
 			buf[pos] = ' '
@@ -1066,7 +1223,7 @@ func (d Decimal) Format(state fmt.State, verb rune) {
 	default:
 		state.Write([]byte("%!"))
 		state.Write([]byte{byte(verb)})
-		state.Write([]byte("(decimal.Decimal="))
+		state.Write([]byte("(" + typ + "="))
 		state.Write(buf)
 		state.Write([]byte(")"))
 	}
@@ -1142,6 +1299,23 @@ func (d Decimal) Round(scale int) Decimal {
 	return newUnsafe(d.IsNeg(), coef, scale)
 }
 
+// RoundMode returns a decimal rounded to the specified number of digits after
+// the decimal point using the given rounding mode.
+// If the given scale is negative, it is redefined to zero.
+// If the given rounding mode is not valid, [HalfEven] is used instead.
+// For financial calculations, the scale should be equal to or greater than
+// the scale of the currency.
+// See also methods [Decimal.Round], [Decimal.RescaleMode].
+func (d Decimal) RoundMode(scale int, mode RoundingMode) Decimal {
+	scale = max(scale, MinScale)
+	if scale >= d.Scale() {
+		return d
+	}
+	coef := d.coef
+	coef = coef.rshMode(d.Scale()-scale, mode, d.IsNeg())
+	return newUnsafe(d.IsNeg(), coef, scale)
+}
+
 // Pad returns a decimal zero-padded to the specified number of digits after
 // the decimal point.
 // The total number of digits in the result is limited by [MaxPrec].
@@ -1172,6 +1346,16 @@ func (d Decimal) Rescale(scale int) Decimal {
 	return d.Round(scale)
 }
 
+// RescaleMode is similar to [Decimal.Rescale], but it allows you to specify
+// the rounding mode that is used if the decimal has to be rounded.
+// See also methods [Decimal.RoundMode], [Decimal.Pad].
+func (d Decimal) RescaleMode(scale int, mode RoundingMode) Decimal {
+	if scale > d.Scale() {
+		return d.Pad(scale)
+	}
+	return d.RoundMode(scale, mode)
+}
+
 // Quantize returns a decimal rescaled to the same scale as decimal e.
 // The sign and the coefficient of decimal e are ignored.
 // See also methods [Decimal.SameScale] and [Decimal.Rescale].
@@ -1179,6 +1363,13 @@ func (d Decimal) Quantize(e Decimal) Decimal {
 	return d.Rescale(e.Scale())
 }
 
+// QuantizeMode is similar to [Decimal.Quantize], but it allows you to specify
+// the rounding mode that is used if the decimal has to be rounded.
+// See also method [Decimal.RescaleMode].
+func (d Decimal) QuantizeMode(e Decimal, mode RoundingMode) Decimal {
+	return d.RescaleMode(e.Scale(), mode)
+}
+
 // SameScale returns true if decimals have the same scale.
 // See also methods [Decimal.Scale], [Decimal.Quantize].
 func (d Decimal) SameScale(e Decimal) bool {
@@ -1326,28 +1517,39 @@ func (d Decimal) IsZero() bool {
 //   - no arguments are provided
 //   - the integer part of the result has more than [MaxPrec] digits.
 func Prod(d ...Decimal) (Decimal, error) {
+	e, _, err := prod(HalfEven, d...)
+	if err != nil {
+		return Decimal{}, newOpError("prod", 0, err, d...)
+	}
+	return e, nil
+}
+
+// prod is similar to [Prod], but it allows you to specify the rounding mode.
+// It also reports whether the result is inexact, and it does not wrap errors,
+// so that it can be shared with [Context].
+func prod(mode RoundingMode, d ...Decimal) (Decimal, bool, error) {
 	// Special cases
 	switch len(d) {
 	case 0:
-		return Decimal{}, fmt.Errorf("computing [prod([])]: %w: no arguments", errInvalidOperation)
+		return Decimal{}, false, fmt.Errorf("%w: no arguments", ErrInvalidOperation)
 	case 1:
-		return d[0], nil
+		return d[0], false, nil
 	}
 
 	// General case
-	e, err := prodFint(d...)
+	e, inexact, err := prodFint(mode, d...)
 	if err != nil {
-		e, err = prodBint(d...)
+		e, inexact, err = prodBint(mode, d...)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [prod(%v)]: %w", d, err)
+			return Decimal{}, false, err
 		}
 	}
 
-	return e, nil
+	return e, inexact, nil
 }
 
 // prodFint computes the product of decimals using uint64 arithmetic.
-func prodFint(d ...Decimal) (Decimal, error) {
+func prodFint(mode RoundingMode, d ...Decimal) (Decimal, bool, error) {
 	ecoef := One.coef
 	escale := One.Scale()
 	eneg := One.IsNeg()
@@ -1359,17 +1561,17 @@ func prodFint(d ...Decimal) (Decimal, error) {
 		var ok bool
 		ecoef, ok = ecoef.mul(fcoef)
 		if !ok {
-			return Decimal{}, errDecimalOverflow
+			return Decimal{}, false, ErrOverflow
 		}
 		eneg = eneg != f.IsNeg()
 		escale = escale + f.Scale()
 	}
 
-	return newFromFint(eneg, ecoef, escale, 0)
+	return newFromFintInexact(eneg, ecoef, escale, 0, mode)
 }
 
 // prodBint computes the product of decimals using *big.Int arithmetic.
-func prodBint(d ...Decimal) (Decimal, error) {
+func prodBint(mode RoundingMode, d ...Decimal) (Decimal, bool, error) {
 	ecoef := getBint()
 	defer putBint(ecoef)
 	ecoef.setFint(One.coef)
@@ -1379,6 +1581,7 @@ func prodBint(d ...Decimal) (Decimal, error) {
 	fcoef := getBint()
 	defer putBint(fcoef)
 
+	inexact := false
 	for _, f := range d {
 		fcoef.setFint(f.coef)
 
@@ -1390,12 +1593,18 @@ func prodBint(d ...Decimal) (Decimal, error) {
 		// Intermediate truncation
 		if escale > 2*MaxScale {
 			shift := escale - 2*MaxScale
-			ecoef.rshDown(ecoef, shift)
+			inexact = ecoef.rshDownInexact(ecoef, shift) || inexact
 			escale = 2 * MaxScale
 		}
 	}
 
-	return newFromBint(eneg, ecoef, escale, 0)
+	// Sticky digit
+	if inexact {
+		ecoef.fsa(ecoef, 1, 1)
+		escale = escale + 1
+	}
+
+	return newFromBintInexact(eneg, ecoef, escale, 0, mode)
 }
 
 func (d Decimal) MulIgnoreError(e Decimal) Decimal {
@@ -1427,22 +1636,59 @@ func (d Decimal) Mul(e Decimal) (Decimal, error) {
 // equal to or greater than the currency's scale.
 func (d Decimal) MulExact(e Decimal, scale int) (Decimal, error) {
 	if scale < MinScale || scale > MaxScale {
-		return Decimal{}, fmt.Errorf("computing [%v * %v]: %w", d, e, errScaleRange)
+		return Decimal{}, newOpError("mul", scale, ErrScaleRange, d, e)
+	}
+
+	// General case
+	f, err := d.mulFint(e, scale, HalfEven)
+	if err != nil {
+		f, _, err = d.mulBint(e, scale, HalfEven)
+		if err != nil {
+			return Decimal{}, newOpError("mul", scale, err, d, e)
+		}
+	}
+
+	return f, nil
+}
+
+// MulExactMode is similar to [Decimal.MulExact], but it allows you to specify
+// the rounding mode that is used if the result has to be rounded.
+func (d Decimal) MulExactMode(e Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, newOpError("mul", scale, ErrScaleRange, d, e)
+	}
+	if !mode.valid() {
+		return Decimal{}, newOpError("mul", scale, ErrModeRange, d, e)
 	}
 
 	// General case
-	f, err := d.mulFint(e, scale)
+	f, err := d.mulFint(e, scale, mode)
 	if err != nil {
-		f, err = d.mulBint(e, scale)
+		f, _, err = d.mulBint(e, scale, mode)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [%v * %v]: %w", d, e, err)
+			return Decimal{}, newOpError("mul", scale, err, d, e)
 		}
 	}
+
 	return f, nil
 }
 
+// mulInexact computes d * e rounded to [MaxPrec] digits and reports whether
+// the result is inexact.
+// It is used by [Context], so it does not wrap errors.
+func (d Decimal) mulInexact(e Decimal, mode RoundingMode) (Decimal, bool, error) {
+	// The result is rounded using uint64 arithmetic only if its scale
+	// exceeds MaxScale, so results with scale MaxScale are recomputed
+	// using *big.Int arithmetic, which reports inexactness.
+	f, err := d.mulFint(e, 0, mode)
+	if err != nil || f.Scale() == MaxScale {
+		return d.mulBint(e, 0, mode)
+	}
+	return f, false, nil
+}
+
 // mulFint computes the product of two decimals using uint64 arithmetic.
-func (d Decimal) mulFint(e Decimal, minScale int) (Decimal, error) {
+func (d Decimal) mulFint(e Decimal, minScale int, mode RoundingMode) (Decimal, error) {
 	dcoef := d.coef
 	dscale := d.Scale()
 	dneg := d.IsNeg()
@@ -1452,16 +1698,16 @@ func (d Decimal) mulFint(e Decimal, minScale int) (Decimal, error) {
 	// Compute d = d * e
 	dcoef, ok := dcoef.mul(ecoef)
 	if !ok {
-		return Decimal{}, errDecimalOverflow
+		return Decimal{}, ErrOverflow
 	}
 	dscale = dscale + e.Scale()
 	dneg = dneg != e.IsNeg()
 
-	return newFromFint(dneg, dcoef, dscale, minScale)
+	return newFromFint(dneg, dcoef, dscale, minScale, mode)
 }
 
 // mulBint computes the product of two decimals using *big.Int arithmetic.
-func (d Decimal) mulBint(e Decimal, minScale int) (Decimal, error) {
+func (d Decimal) mulBint(e Decimal, minScale int, mode RoundingMode) (Decimal, bool, error) {
 	dcoef := getBint()
 	defer putBint(dcoef)
 	dcoef.setFint(d.coef)
@@ -1477,7 +1723,7 @@ func (d Decimal) mulBint(e Decimal, minScale int) (Decimal, error) {
 	dneg = dneg != e.IsNeg()
 	dscale = dscale + e.Scale()
 
-	return newFromBint(dneg, dcoef, dscale, minScale)
+	return newFromBintInexact(dneg, dcoef, dscale, minScale, mode)
 }
 
 func (d Decimal) PowIntIgnoreError(e int) Decimal {
@@ -1506,17 +1752,29 @@ func (d Decimal) Pow(power int) (Decimal, error) {
 //   - the integer part of the result has more than [MaxPrec] digits;
 //   - zero is raised to a negative power.
 func (d Decimal) PowInt(power int) (Decimal, error) {
+	e, _, err := d.powInt(power, HalfEven)
+	if err != nil {
+		return Decimal{}, newOpError("pow", 0, err, d, MustNew(int64(power), 0))
+	}
+	return e, nil
+}
+
+// powInt is similar to [Decimal.PowInt], but it allows you to specify
+// the rounding mode.
+// It also reports whether the result is inexact, and it does not wrap errors,
+// so that it can be shared with [Context].
+func (d Decimal) powInt(power int, mode RoundingMode) (Decimal, bool, error) {
 	// Special case: zero to a negative power
 	if power < 0 && d.IsZero() {
-		return Decimal{}, fmt.Errorf("computing [%v^%v]: %w", d, power, errInvalidOperation)
+		return Decimal{}, false, ErrInvalidOperation
 	}
 
 	// General case
-	e, err := d.powIntFint(power)
+	e, inexact, err := d.powIntFint(power, 0, mode)
 	if err != nil {
-		e, err = d.powIntBint(power)
+		e, inexact, err = d.powIntBint(power, 0, mode)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [%v^%v]: %w", d, power, err)
+			return Decimal{}, false, err
 		}
 	}
 
@@ -1525,12 +1783,12 @@ func (d Decimal) PowInt(power int) (Decimal, error) {
 		e = e.Trim(0)
 	}
 
-	return e, nil
+	return e, inexact, nil
 }
 
 // powIntFint computes the integer power of a decimal using uint64 arithmetic.
 // powIntFint does not support negative powers.
-func (d Decimal) powIntFint(power int) (Decimal, error) {
+func (d Decimal) powIntFint(power, minScale int, mode RoundingMode) (Decimal, bool, error) {
 	dcoef := d.coef
 	dneg := d.IsNeg()
 	dscale := d.Scale()
@@ -1540,7 +1798,7 @@ func (d Decimal) powIntFint(power int) (Decimal, error) {
 	escale := One.Scale()
 
 	if power < 0 {
-		return Decimal{}, errInvalidOperation
+		return Decimal{}, false, ErrInvalidOperation
 	}
 
 	// Exponentiation by squaring
@@ -1552,7 +1810,7 @@ func (d Decimal) powIntFint(power int) (Decimal, error) {
 			// Compute e = e * d
 			ecoef, ok = ecoef.mul(dcoef)
 			if !ok {
-				return Decimal{}, errDecimalOverflow
+				return Decimal{}, false, ErrOverflow
 			}
 			eneg = eneg != dneg
 			escale = escale + dscale
@@ -1563,19 +1821,19 @@ func (d Decimal) powIntFint(power int) (Decimal, error) {
 			// Compute d = d * d
 			dcoef, ok = dcoef.mul(dcoef)
 			if !ok {
-				return Decimal{}, errDecimalOverflow
+				return Decimal{}, false, ErrOverflow
 			}
 			dneg = false
 			dscale = dscale * 2
 		}
 	}
 
-	return newFromFint(eneg, ecoef, escale, 0)
+	return newFromFintInexact(eneg, ecoef, escale, minScale, mode)
 }
 
 // powIntBint computes the integer power of a decimal using *big.Int arithmetic.
 // powIntBint supports negative powers.
-func (d Decimal) powIntBint(power int) (Decimal, error) {
+func (d Decimal) powIntBint(power, minScale int, mode RoundingMode) (Decimal, bool, error) {
 	dcoef := getBint()
 	defer putBint(dcoef)
 	dcoef.setFint(d.coef)
@@ -1595,6 +1853,7 @@ func (d Decimal) powIntBint(power int) (Decimal, error) {
 	}
 
 	// Exponentiation by squaring
+	dinexact, einexact := false, false
 	for power > 0 {
 		if power%2 == 1 {
 			power = power - 1
@@ -1603,11 +1862,12 @@ func (d Decimal) powIntBint(power int) (Decimal, error) {
 			ecoef.mul(ecoef, dcoef)
 			eneg = eneg != dneg
 			escale = escale + dscale
+			einexact = einexact || dinexact
 
 			// Intermediate truncation
 			if escale > 3*MaxScale {
 				shift := escale - 3*MaxScale
-				ecoef.rshDown(ecoef, shift)
+				einexact = ecoef.rshDownInexact(ecoef, shift) || einexact
 				escale = 3 * MaxScale
 			}
 		}
@@ -1622,7 +1882,7 @@ func (d Decimal) powIntBint(power int) (Decimal, error) {
 			// Intermediate truncation
 			if dscale > 3*MaxScale {
 				shift := dscale - 3*MaxScale
-				dcoef.rshDown(dcoef, shift)
+				dinexact = dcoef.rshDownInexact(dcoef, shift) || dinexact
 				dscale = 3 * MaxScale
 			}
 		}
@@ -1630,118 +1890,1877 @@ func (d Decimal) powIntBint(power int) (Decimal, error) {
 
 	if inv {
 		if ecoef.sign() == 0 {
-			return Decimal{}, unknownOverflowError(0)
+			return Decimal{}, false, unknownOverflowError(0)
 		}
 
 		// Compute e = 1 / e
-		ecoef.quo(bpow10[2*MaxScale+escale], ecoef)
+		rcoef := getBint()
+		defer putBint(rcoef)
+		ecoef.quoRem(bpow10[2*MaxScale+escale], ecoef, rcoef)
 		escale = 2 * MaxScale
+		einexact = einexact || rcoef.sign() != 0
+	}
+
+	// Sticky digit
+	if einexact {
+		ecoef.fsa(ecoef, 1, 1)
+		escale = escale + 1
 	}
 
-	return newFromBint(eneg, ecoef, escale, 0)
+	return newFromBintInexact(eneg, ecoef, escale, minScale, mode)
 }
 
-func (d Decimal) SqrtIgnoreError() Decimal {
-	res, _ := d.Sqrt()
+func (d Decimal) PowDecIgnoreError(e Decimal) Decimal {
+	res, _ := d.PowDec(e)
 	return res
 }
 
-func (d Decimal) MustSqrt() Decimal {
-	res, err := d.Sqrt()
+func (d Decimal) MustPowDec(e Decimal) Decimal {
+	res, err := d.PowDec(e)
 	if err != nil {
 		panic(err)
 	}
 	return res
 }
 
-// Sqrt computes the square root of a decimal.
+// PowDec returns the (possibly rounded) decimal raised to the given decimal power.
+// If the power is an integer, the result is the same as for [Decimal.PowInt].
+// Otherwise, the result is computed as exp(e × ln(d)) with at least 38 digits
+// of intermediate precision, which is increased until the result can be
+// correctly rounded to [MaxPrec] significant digits.
+// If zero is raised to zero power then the result is one.
 //
-// Sqrt returns an error if the decimal is negative.
-func (d Decimal) Sqrt() (Decimal, error) {
-	// Special case: negative
-	if d.IsNeg() {
-		return Decimal{}, fmt.Errorf("computing sqrt(%v): %w", d, errInvalidOperation)
+// PowDec returns an error if:
+//   - the integer part of the result has more than [MaxPrec] digits;
+//   - zero is raised to a negative power;
+//   - a negative decimal is raised to a non-integer power.
+func (d Decimal) PowDec(e Decimal) (Decimal, error) {
+	return d.PowDecExact(e, 0)
+}
+
+// PowDecExact is similar to [Decimal.PowDec], but it allows you to specify the number of digits
+// after the decimal point that should be considered significant.
+// If any of the significant digits are lost during rounding, the method will return an error.
+// This method is useful for financial calculations where the scale should be
+// equal to or greater than the currency's scale.
+func (d Decimal) PowDecExact(e Decimal, scale int) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, newOpError("pow", scale, ErrScaleRange, d, e)
+	}
+	f, err := d.powDec(e, scale, HalfEven)
+	if err != nil {
+		return Decimal{}, newOpError("pow", scale, err, d, e)
 	}
+	return f, nil
+}
 
-	// Special case: zero
+// powDec is similar to [Decimal.PowDecExact], but it allows you to specify
+// the rounding mode and does not wrap errors.
+func (d Decimal) powDec(e Decimal, minScale int, mode RoundingMode) (Decimal, error) {
+	// Special case: zero power
+	if e.IsZero() {
+		return newSafe(false, 1, 0)
+	}
+
+	// Special case: integer power
+	if e.IsInt() {
+		if n, _, ok := e.Int64(0); ok && n >= math.MinInt && n <= math.MaxInt {
+			if d.IsZero() && n < 0 {
+				return Decimal{}, ErrInvalidOperation
+			}
+			f, _, err := d.powIntFint(int(n), minScale, mode)
+			if err != nil {
+				f, _, err = d.powIntBint(int(n), minScale, mode)
+				if err != nil {
+					return Decimal{}, err
+				}
+			}
+			if n < 0 {
+				f = f.Trim(minScale)
+			}
+			return f, nil
+		}
+	}
+
+	// Special case: zero base
 	if d.IsZero() {
-		return newSafe(false, 0, d.Scale()/2)
+		if e.IsNeg() {
+			return Decimal{}, ErrInvalidOperation
+		}
+		return newSafe(false, 0, 0)
+	}
+
+	neg := false
+	if e.IsInt() {
+		// Powers that do not fit into an int are so large that the base
+		// can only be close to one, so the sign is the parity of the power.
+		neg = d.IsNeg() && e.coef%2 == 1
+	} else if d.IsNeg() {
+		return Decimal{}, ErrInvalidOperation
 	}
 
 	// General case
-	e, err := d.sqrtBint()
+	f, err := d.Abs().powDecBint(e, minScale, mode)
 	if err != nil {
-		return Decimal{}, fmt.Errorf("computing sqrt(%v): %w", d, err)
+		return Decimal{}, err
+	}
+	if neg {
+		f = f.Neg()
 	}
 
 	// Preferred scale
-	e = e.Trim(d.Scale() / 2)
+	f = f.Trim(minScale)
 
-	return e, nil
+	return f, nil
 }
 
-// sqrtBint computes the square root of a decimal using *big.Int arithmetic.
-func (d Decimal) sqrtBint() (Decimal, error) {
-	dcoef := getBint()
-	defer putBint(dcoef)
-	dcoef.setFint(d.coef)
+// powDecBint computes the decimal power of a positive decimal using *big.Int arithmetic.
+// The power is computed as exp(e × ln(d)) with increasing working precision
+// until the rounding of the result is determined unambiguously.
+func (d Decimal) powDecBint(e Decimal, minScale int, mode RoundingMode) (Decimal, error) {
+	x, y := d.BigDecimal(), e.BigDecimal()
+	lim := MustNewBigDecimal(int64(len(bexp)), 0)
 
-	ecoef := getBint()
-	defer putBint(ecoef)
-	escale := 2 * MaxScale
+	lo := getBint()
+	defer putBint(lo)
+	hi := getBint()
+	defer putBint(hi)
 
-	fcoef := getBint()
-	defer putBint(fcoef)
-	fcoef.setFint(0)
+	var fcoef *bint
+	var fscale int
+	for prec := 2 * MaxPrec; prec <= 16*MaxPrec; prec = 2 * prec {
+		// Compute z = e * ln(d)
+		z, err := x.log(prec)
+		if err != nil {
+			return Decimal{}, err
+		}
+		z, err = z.Mul(y)
+		if err != nil {
+			return Decimal{}, err
+		}
 
-	// Alignment
-	dcoef.lsh(dcoef, 4*MaxScale-d.Scale())
+		// Check underflow and overflow
+		if z.Abs().Cmp(lim) >= 0 {
+			if z.IsNeg() {
+				// The result is positive, but much smaller than the smallest
+				// representable decimal, so 10^-(2*MaxScale+1) rounds the same way.
+				lo.setFint(1)
+				return newFromBint(false, lo, 2*MaxScale+1, minScale, mode)
+			}
+			return Decimal{}, unknownOverflowError(minScale)
+		}
 
-	// Initial guess is calculated as 10^(n/2), where n is the position of
-	// the most significant digit (n is negative if -1 < d < 1).
-	n := dcoef.prec() - 4*MaxScale
-	ecoef.setBint(bpow10[n/2+escale])
+		// Compute f = exp(z)
+		f, err := z.exp(prec)
+		if err != nil {
+			return Decimal{}, err
+		}
+		fcoef = f.bcoef()
+		fscale = f.Scale()
+		if shift := prec - f.Prec(); shift > 0 {
+			fcoef = new(bint)
+			fcoef.lsh(f.bcoef(), shift)
+			fscale = fscale + shift
+		}
 
-	// Newton's method
-	for range 50 {
-		if ecoef.cmp(fcoef) == 0 {
-			break
+		// Since |z| < 50, both ln(d) and exp(z) are rounded to prec digits,
+		// the relative error of f is less than 10^(3-prec), so the exact
+		// result differs from f by less than 1000 units in the last place.
+		lo.sub(fcoef, bpow10[3])
+		hi.add(fcoef, bpow10[3])
+		g, lerr := newFromBint(false, lo, fscale, minScale, mode)
+		h, herr := newFromBint(false, hi, fscale, minScale, mode)
+		if lerr == nil && herr == nil && g == h {
+			return g, nil
+		}
+		if lerr != nil && herr != nil {
+			return Decimal{}, herr
 		}
-		fcoef.setBint(ecoef)
-		ecoef.quo(dcoef, ecoef)
-		ecoef.add(ecoef, fcoef)
-		ecoef.hlf(ecoef)
 	}
 
-	return newFromBint(false, ecoef, escale, 0)
+	// The rounding is still ambiguous, so the exact result must be either
+	// a decimal itself or a midpoint between two decimals, and rounding f
+	// to one more digit than a decimal can hold gives the exact result.
+	lo.setBint(fcoef)
+	if shift := max(lo.prec()-MaxPrec-1, fscale-MaxScale-1); shift > 0 {
+		lo.rshMode(lo, shift, HalfEven, false)
+		fscale = fscale - shift
+	}
+	return newFromBint(false, lo, fscale, minScale, mode)
 }
 
-func (d Decimal) ExpIgnoreError() Decimal {
-	res, _ := d.Exp()
+func (d Decimal) SqrtIgnoreError() Decimal {
+	res, _ := d.Sqrt()
+	return res
+}
+
+func (d Decimal) MustSqrt() Decimal {
+	res, err := d.Sqrt()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Sqrt computes the square root of a decimal.
+//
+// Sqrt returns an error if the decimal is negative.
+func (d Decimal) Sqrt() (Decimal, error) {
+	e, _, err := d.sqrt(HalfEven)
+	if err != nil {
+		return Decimal{}, newOpError("sqrt", 0, err, d)
+	}
+	return e, nil
+}
+
+// sqrt is similar to [Decimal.Sqrt], but it allows you to specify
+// the rounding mode.
+// It also reports whether the result is inexact, and it does not wrap errors,
+// so that it can be shared with [Context].
+func (d Decimal) sqrt(mode RoundingMode) (Decimal, bool, error) {
+	// Special case: negative
+	if d.IsNeg() {
+		return Decimal{}, false, ErrInvalidOperation
+	}
+
+	// Special case: zero
+	if d.IsZero() {
+		e, err := newSafe(false, 0, d.Scale()/2)
+		return e, false, err
+	}
+
+	// General case
+	e, inexact, err := d.sqrtBint(mode)
+	if err != nil {
+		return Decimal{}, false, err
+	}
+
+	// Preferred scale
+	e = e.Trim(d.Scale() / 2)
+
+	return e, inexact, nil
+}
+
+// sqrtBint computes the square root of a decimal using *big.Int arithmetic.
+func (d Decimal) sqrtBint(mode RoundingMode) (Decimal, bool, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	escale := 2 * MaxScale
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+	fcoef.setFint(0)
+
+	// Alignment
+	dcoef.lsh(dcoef, 4*MaxScale-d.Scale())
+
+	// Initial guess is calculated as 10^(n/2), where n is the position of
+	// the most significant digit (n is negative if -1 < d < 1).
+	n := dcoef.prec() - 4*MaxScale
+	ecoef.setBint(bpow10[n/2+escale])
+
+	// Newton's method
+	for range 50 {
+		if ecoef.cmp(fcoef) == 0 {
+			break
+		}
+		fcoef.setBint(ecoef)
+		ecoef.quo(dcoef, ecoef)
+		ecoef.add(ecoef, fcoef)
+		ecoef.hlf(ecoef)
+	}
+
+	// Newton's method may stop one step above ⌊√d⌋
+	fcoef.mul(ecoef, ecoef)
+	if fcoef.cmp(dcoef) > 0 {
+		ecoef.sub(ecoef, bpow10[0])
+		fcoef.mul(ecoef, ecoef)
+	}
+
+	// Sticky digit
+	ecoef.fsa(ecoef, 1, 0)
+	escale = escale + 1
+	if fcoef.cmp(dcoef) != 0 {
+		ecoef.inc(ecoef)
+	}
+
+	return newFromBintInexact(false, ecoef, escale, 0, mode)
+}
+
+func (d Decimal) CbrtIgnoreError() Decimal {
+	res, _ := d.Cbrt()
+	return res
+}
+
+func (d Decimal) MustCbrt() Decimal {
+	res, err := d.Cbrt()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Cbrt computes the cube root of a decimal.
+// The result is exact if the root can be represented as a decimal.
+func (d Decimal) Cbrt() (Decimal, error) {
+	e, err := d.root(3, HalfEven)
+	if err != nil {
+		return Decimal{}, newOpError("cbrt", 0, err, d)
+	}
+	return e, nil
+}
+
+func (d Decimal) RootIgnoreError(n int) Decimal {
+	res, _ := d.Root(n)
+	return res
+}
+
+func (d Decimal) MustRoot(n int) Decimal {
+	res, err := d.Root(n)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Root computes the n-th root of a decimal.
+// The result is exact if the root can be represented as a decimal.
+//
+// Root returns an error if:
+//   - n is zero or negative;
+//   - n is even and the decimal is negative.
+func (d Decimal) Root(n int) (Decimal, error) {
+	e, err := d.root(n, HalfEven)
+	if err != nil {
+		return Decimal{}, newOpError("root", 0, err, d, MustNew(int64(n), 0))
+	}
+	return e, nil
+}
+
+// root is similar to [Decimal.Root], but it allows you to specify
+// the rounding mode.
+func (d Decimal) root(n int, mode RoundingMode) (Decimal, error) {
+	// Special case: invalid index or even root of a negative decimal
+	if n <= 0 || d.IsNeg() && n%2 == 0 {
+		return Decimal{}, ErrInvalidOperation
+	}
+
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, d.Scale()/n)
+	}
+
+	// Special case: first root
+	if n == 1 {
+		return d, nil
+	}
+
+	// General case
+	var e Decimal
+	var err error
+	if n <= 64 {
+		e, err = d.rootBint(n, mode)
+	} else {
+		// For larger indices, only the roots of -1 and 1 are representable
+		// as decimals, so there is no need to search for exact results.
+		e, err = d.rootLogBint(n, mode)
+	}
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	// Preferred scale
+	e = e.Trim(d.Scale() / n)
+
+	return e, nil
+}
+
+// rootBint computes the n-th root of a decimal using *big.Int arithmetic.
+func (d Decimal) rootBint(n int, mode RoundingMode) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	escale := 2 * MaxScale
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+
+	gcoef := getBint()
+	defer putBint(gcoef)
+
+	ncoef := getBint()
+	defer putBint(ncoef)
+	ncoef.setInt64(int64(n - 1))
+
+	// Alignment
+	dcoef.lsh(dcoef, 2*MaxScale*n-d.Scale())
+
+	// Initial guess is calculated as 10^⌈p/n⌉, where p is the number of
+	// digits in the aligned coefficient, so it is never below ⌊ⁿ√d⌋.
+	p := dcoef.prec()
+	ecoef.setBint(bpow10[(p+n-1)/n])
+
+	// Newton's method, which decreases monotonically towards ⌊ⁿ√d⌋
+	for range 1000 {
+		// Compute f = ((n - 1) * e + d / e^(n - 1)) / n
+		fcoef.exp(ecoef, ncoef)
+		fcoef.quo(dcoef, fcoef)
+		gcoef.mulInt64(ecoef, int64(n-1))
+		fcoef.add(fcoef, gcoef)
+		gcoef.setInt64(int64(n))
+		fcoef.quo(fcoef, gcoef)
+
+		if fcoef.cmp(ecoef) >= 0 {
+			break
+		}
+		ecoef.setBint(fcoef)
+	}
+
+	// Sticky digit
+	ncoef.setInt64(int64(n))
+	fcoef.exp(ecoef, ncoef)
+	ecoef.fsa(ecoef, 1, 0)
+	escale = escale + 1
+	if fcoef.cmp(dcoef) != 0 {
+		ecoef.inc(ecoef)
+	}
+
+	return newFromBint(d.IsNeg(), ecoef, escale, 0, mode)
+}
+
+// rootLogBint computes the n-th root of a decimal as exp(ln(|d|) / n)
+// using *big.Int arithmetic.
+func (d Decimal) rootLogBint(n int, mode RoundingMode) (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+	eneg := d.Abs().lnBint(ecoef)
+	escale := 2 * MaxScale
+
+	ncoef := getBint()
+	defer putBint(ncoef)
+	ncoef.setInt64(int64(n))
+
+	// Compute |ln(|d|) / n|, which is always less than one
+	ecoef.quo(ecoef, ncoef)
+
+	// Compute exp(ln(|d|) / n)
+	ecoef.e(ecoef)
+	if eneg {
+		ecoef.quo(bpow10[4*MaxScale], ecoef)
+	}
+
+	// Sticky digit, since the root is irrational
+	ecoef.fsa(ecoef, 1, 1)
+	escale = escale + 1
+
+	return newFromBint(d.IsNeg(), ecoef, escale, 0, mode)
+}
+
+func (d Decimal) ExpIgnoreError() Decimal {
+	res, _ := d.Exp()
+	return res
+}
+
+func (d Decimal) MustExp() Decimal {
+	res, err := d.Exp()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Exp returns the (possibly rounded) exponential of a decimal.
+//
+// Exp returns an error if the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) Exp() (Decimal, error) {
+	e, _, err := d.exp(HalfEven)
+	if err != nil {
+		return Decimal{}, newOpError("exp", 0, err, d)
+	}
+	return e, nil
+}
+
+// exp is similar to [Decimal.Exp], but it allows you to specify
+// the rounding mode.
+// It also reports whether the result is inexact, and it does not wrap errors,
+// so that it can be shared with [Context].
+func (d Decimal) exp(mode RoundingMode) (Decimal, bool, error) {
+	// Special case: zero
+	if d.IsZero() {
+		e, err := newSafe(false, 1, 0)
+		return e, false, err
+	}
+
+	// General case
+	e, inexact, err := d.expBint(mode)
+	if err != nil {
+		return Decimal{}, false, err
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, inexact, nil
+}
+
+// expBint computes exponential of a decimal using *big.Int arithmetic.
+func (d Decimal) expBint(mode RoundingMode) (Decimal, bool, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+	escale := 2 * MaxScale
+
+	if !d.eBint(ecoef) {
+		if d.IsNeg() {
+			// The result is positive, but much smaller than the smallest
+			// representable decimal, so 10^-(2*MaxScale+1) rounds the same way.
+			ecoef.setFint(1)
+			return newFromBintInexact(false, ecoef, 2*MaxScale+1, 0, mode)
+		}
+		return Decimal{}, false, unknownOverflowError(0)
+	}
+
+	// Sticky digit, since the exponential of a non-zero decimal is irrational
+	ecoef.fsa(ecoef, 1, 1)
+	escale = escale + 1
+
+	return newFromBintInexact(false, ecoef, escale, 0, mode)
+}
+
+// eBint sets z to the exponential of a decimal with 2 * [MaxScale] digits
+// after the decimal point.
+// It returns false if the integer part of the decimal is too large
+// in absolute value for the result to be computed.
+func (d Decimal) eBint(z *bint) bool {
+	dcoef := d.coef
+	dscale := d.Scale()
+
+	// Split |d| into integer part q and fractional part r
+	q, r, ok := dcoef.quoRem(pow10[dscale])
+	if !ok {
+		return false // Should never happen
+	}
+
+	// Check underflow and overflow
+	if q >= fint(len(bexp)) {
+		return false
+	}
+
+	// Retrieve e = exp(q) from precomputed cache
+	z.setBint(bexp[q])
+	escale := 2 * MaxScale
+
+	if r != 0 {
+		// Compute f = exp(r) using Taylor series expansion
+		fcoef := getBint()
+		defer putBint(fcoef)
+		fcoef.setFint(0)
+		fscale := 2 * MaxScale
+
+		rcoef := getBint()
+		defer putBint(rcoef)
+		rcoef.setFint(r)
+		rscale := dscale
+
+		gcoef := getBint()
+		defer putBint(gcoef)
+		gcoef.setBint(bpow10[2*MaxScale])
+		gscale := 2 * MaxScale
+
+		hcoef := getBint()
+		defer putBint(hcoef)
+
+		// Alignment
+		if rscale < 2*MaxScale {
+			rcoef.lsh(rcoef, 2*MaxScale-rscale)
+			rscale = 2 * MaxScale
+		}
+
+		// Compute f = exp(r) = r^0 / 0! + r^1 / 1! + ... + r^n / n!
+		for i := range len(bfact) {
+			// Accumulate f = f + r^i / i!
+			hcoef.quo(gcoef, bfact[i])
+			if hcoef.sign() == 0 {
+				break
+			}
+			fcoef.add(fcoef, hcoef)
+
+			// Compute g = r^(i+1)
+			gcoef.mul(gcoef, rcoef)
+			gscale = gscale + rscale
+
+			// Intermediate truncation
+			if gscale > 2*MaxScale {
+				shift := gscale - 2*MaxScale
+				gcoef.rshDown(gcoef, shift)
+				gscale = 2 * MaxScale
+			}
+		}
+
+		// Compute exp(|d|) = exp(q) * exp(r)
+		z.mul(z, fcoef)
+		escale = escale + fscale
+
+		// Intermediate truncation
+		if escale > 2*MaxScale {
+			shift := escale - 2*MaxScale
+			z.rshDown(z, shift)
+			escale = 2 * MaxScale
+		}
+	}
+
+	if d.IsNeg() {
+		if z.sign() == 0 {
+			return false
+		}
+
+		// Compute exp(d) = 1 / exp(|d|)
+		z.quo(bpow10[2*MaxScale+escale], z)
+	}
+
+	return true
+}
+
+func (d Decimal) Expm1IgnoreError() Decimal {
+	res, _ := d.Expm1()
+	return res
+}
+
+func (d Decimal) MustExpm1() Decimal {
+	res, err := d.Expm1()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Expm1 returns the (possibly rounded) exponential of a decimal minus one.
+// Unlike d.Exp().Sub(One), it does not round the exponential before
+// the subtraction, so it is accurate for decimals close to zero.
+//
+// Expm1 returns an error if the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) Expm1() (Decimal, error) {
+	return d.expm1(HalfEven)
+}
+
+// expm1 is similar to [Decimal.Expm1], but it allows you to specify
+// the rounding mode.
+func (d Decimal) expm1(mode RoundingMode) (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.expm1Bint(mode)
+	if err != nil {
+		return Decimal{}, newOpError("expm1", 0, err, d)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// expm1Bint computes exponential minus one of a decimal using *big.Int arithmetic.
+func (d Decimal) expm1Bint(mode RoundingMode) (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+	escale := 2 * MaxScale
+
+	if !d.eBint(ecoef) {
+		if d.IsNeg() {
+			// The result is negative, but much closer to negative one than
+			// the smallest representable decimal, so -1 + 10^-(2*MaxScale+1)
+			// rounds the same way.
+			ecoef.setFint(1)
+			ecoef.sub(bpow10[2*MaxScale+1], ecoef)
+			return newFromBint(true, ecoef, 2*MaxScale+1, 0, mode)
+		}
+		return Decimal{}, unknownOverflowError(0)
+	}
+
+	// Compute |exp(d) - 1|
+	if d.IsNeg() {
+		ecoef.sub(bpow10[2*MaxScale], ecoef)
+	} else {
+		ecoef.sub(ecoef, bpow10[2*MaxScale])
+	}
+
+	// Sticky digit, since the exponential of a non-zero decimal is irrational
+	ecoef.fsa(ecoef, 1, 1)
+	escale = escale + 1
+
+	return newFromBint(d.IsNeg(), ecoef, escale, 0, mode)
+}
+
+func (d Decimal) LogIgnoreError() Decimal {
+	res, _ := d.Log()
+	return res
+}
+
+func (d Decimal) MustLog() Decimal {
+	res, err := d.Log()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Log returns the (possibly rounded) natural logarithm of a decimal.
+//
+// Log returns an error if the decimal is zero or negative.
+func (d Decimal) Log() (Decimal, error) {
+	e, _, err := d.log(HalfEven)
+	if err != nil {
+		return Decimal{}, newOpError("log", 0, err, d)
+	}
+	return e, nil
+}
+
+// log is similar to [Decimal.Log], but it allows you to specify
+// the rounding mode.
+// It also reports whether the result is inexact, and it does not wrap errors,
+// so that it can be shared with [Context].
+func (d Decimal) log(mode RoundingMode) (Decimal, bool, error) {
+	// Special case: zero or negative
+	if !d.IsPos() {
+		return Decimal{}, false, ErrInvalidOperation
+	}
+
+	// Special case: one
+	if d.IsOne() {
+		e, err := newSafe(false, 0, 0)
+		return e, false, err
+	}
+
+	// General case
+	e, inexact, err := d.logBint(mode)
+	if err != nil {
+		return Decimal{}, false, err
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, inexact, nil
+}
+
+// logBint computes the natural logarithm of a decimal using *big.Int arithmetic.
+func (d Decimal) logBint(mode RoundingMode) (Decimal, bool, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+	eneg := d.lnBint(ecoef)
+	escale := 2 * MaxScale
+
+	// Sticky digit, since the logarithm of a decimal other than one is irrational
+	ecoef.fsa(ecoef, 1, 1)
+	escale = escale + 1
+
+	return newFromBintInexact(eneg, ecoef, escale, 0, mode)
+}
+
+func (d Decimal) Log1pIgnoreError() Decimal {
+	res, _ := d.Log1p()
+	return res
+}
+
+func (d Decimal) MustLog1p() Decimal {
+	res, err := d.Log1p()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Log1p returns the (possibly rounded) natural logarithm of one plus a decimal.
+// Unlike d.Add(One).Log(), it does not round the sum before taking
+// the logarithm, so it is accurate for decimals close to zero.
+//
+// Log1p returns an error if the decimal is less than or equal to -1.
+func (d Decimal) Log1p() (Decimal, error) {
+	return d.log1p(HalfEven)
+}
+
+// log1p is similar to [Decimal.Log1p], but it allows you to specify
+// the rounding mode.
+func (d Decimal) log1p(mode RoundingMode) (Decimal, error) {
+	// Special case: less than or equal to negative one
+	if d.Cmp(NegOne) <= 0 {
+		return Decimal{}, newOpError("log1p", 0, ErrInvalidOperation, d)
+	}
+
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.log1pBint(mode)
+	if err != nil {
+		return Decimal{}, newOpError("log1p", 0, err, d)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// log1pBint computes the natural logarithm of one plus a decimal
+// using *big.Int arithmetic.
+func (d Decimal) log1pBint(mode RoundingMode) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+	dcoef.lsh(dcoef, 2*MaxScale-d.Scale())
+
+	// Compute x = 1 + d, which is exact, and the alignment and sign
+	// of its logarithm
+	if d.IsNeg() {
+		dcoef.sub(bpow10[2*MaxScale], dcoef)
+		dcoef.quo(bpow10[4*MaxScale], dcoef)
+	} else {
+		dcoef.add(dcoef, bpow10[2*MaxScale])
+	}
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.ln(dcoef)
+	escale := 2 * MaxScale
+
+	// Sticky digit, since the logarithm of a decimal other than one is irrational
+	ecoef.fsa(ecoef, 1, 1)
+	escale = escale + 1
+
+	return newFromBint(d.IsNeg(), ecoef, escale, 0, mode)
+}
+
+func (d Decimal) Log2IgnoreError() Decimal {
+	res, _ := d.Log2()
+	return res
+}
+
+func (d Decimal) MustLog2() Decimal {
+	res, err := d.Log2()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Log2 returns the (possibly rounded) binary logarithm of a decimal.
+//
+// Log2 returns an error if the decimal is zero or negative.
+func (d Decimal) Log2() (Decimal, error) {
+	return d.log2(HalfEven)
+}
+
+// log2 is similar to [Decimal.Log2], but it allows you to specify
+// the rounding mode.
+func (d Decimal) log2(mode RoundingMode) (Decimal, error) {
+	// Special case: zero or negative
+	if !d.IsPos() {
+		return Decimal{}, newOpError("log2", 0, ErrInvalidOperation, d)
+	}
+
+	// Special case: one
+	if d.IsOne() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.logQuoBint(bnlog2, false, mode)
+	if err != nil {
+		return Decimal{}, newOpError("log2", 0, err, d)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+func (d Decimal) Log10IgnoreError() Decimal {
+	res, _ := d.Log10()
+	return res
+}
+
+func (d Decimal) MustLog10() Decimal {
+	res, err := d.Log10()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Log10 returns the (possibly rounded) decimal logarithm of a decimal.
+// The result is exact if the decimal is a power of ten.
+//
+// Log10 returns an error if the decimal is zero or negative.
+func (d Decimal) Log10() (Decimal, error) {
+	return d.log10(HalfEven)
+}
+
+// log10 is similar to [Decimal.Log10], but it allows you to specify
+// the rounding mode.
+func (d Decimal) log10(mode RoundingMode) (Decimal, error) {
+	// Special case: zero or negative
+	if !d.IsPos() {
+		return Decimal{}, newOpError("log10", 0, ErrInvalidOperation, d)
+	}
+
+	// Special case: powers of ten
+	if d.coef == pow10[d.coef.prec()-1] {
+		n := d.Prec() - d.Scale() - 1
+		if n < 0 {
+			return newSafe(true, fint(-n), 0)
+		}
+		return newSafe(false, fint(n), 0)
+	}
+
+	// General case
+	e, err := d.logQuoBint(bnlog10[1], false, mode)
+	if err != nil {
+		return Decimal{}, newOpError("log10", 0, err, d)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+func (d Decimal) LogBaseIgnoreError(b Decimal) Decimal {
+	res, _ := d.LogBase(b)
+	return res
+}
+
+func (d Decimal) MustLogBase(b Decimal) Decimal {
+	res, err := d.LogBase(b)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// LogBase returns the (possibly rounded) logarithm of a decimal to base b.
+// When the base is very close to one, its logarithm is known to fewer
+// significant digits, and the last digits of the result may be inaccurate.
+//
+// LogBase returns an error if:
+//   - the decimal is zero or negative;
+//   - the base is zero, negative, or one;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) LogBase(b Decimal) (Decimal, error) {
+	return d.logBase(b, HalfEven)
+}
+
+// logBase is similar to [Decimal.LogBase], but it allows you to specify
+// the rounding mode.
+func (d Decimal) logBase(b Decimal, mode RoundingMode) (Decimal, error) {
+	// Special case: zero or negative
+	if !d.IsPos() || !b.IsPos() || b.IsOne() {
+		return Decimal{}, newOpError("logbase", 0, ErrInvalidOperation, d, b)
+	}
+
+	// Special case: one
+	if d.IsOne() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	bcoef := getBint()
+	defer putBint(bcoef)
+	bneg := b.lnBint(bcoef)
+
+	e, err := d.logQuoBint(bcoef, bneg, mode)
+	if err != nil {
+		return Decimal{}, newOpError("logbase", 0, err, d, b)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// logQuoBint computes the logarithm of a decimal to the base, whose natural
+// logarithm has absolute value b with 2 * [MaxScale] digits after
+// the decimal point and sign bneg, using *big.Int arithmetic.
+func (d Decimal) logQuoBint(b *bint, bneg bool, mode RoundingMode) (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+	eneg := d.lnBint(ecoef)
+	escale := 2 * MaxScale
+
+	// Compute log_b(d) = ln(d) / ln(b)
+	ecoef.lsh(ecoef, 2*MaxScale)
+	ecoef.quo(ecoef, b)
+
+	// Sticky digit, since the quotient of logarithms is rational only
+	// in special cases and is not computed exactly
+	ecoef.fsa(ecoef, 1, 1)
+	escale = escale + 1
+
+	return newFromBint(eneg != bneg, ecoef, escale, 0, mode)
+}
+
+// lnBint sets z to the absolute value of the natural logarithm of a positive
+// decimal with 2 * [MaxScale] digits after the decimal point, and returns
+// true if the logarithm is negative.
+func (d Decimal) lnBint(z *bint) bool {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+
+	// Alignment and sign
+	zneg := true
+	if d.WithinOne() {
+		dcoef.quo(bpow10[2*MaxScale+d.Scale()], dcoef)
+	} else {
+		dcoef.lsh(dcoef, 2*MaxScale-d.Scale())
+		zneg = false
+	}
+
+	z.ln(dcoef)
+	return zneg
+}
+
+// ln computes the natural logarithm of a decimal using *big.Int arithmetic.
+// Both x and z have 2 * [MaxScale] digits after the decimal point,
+// and x must be greater than or equal to one.
+func (z *bint) ln(x *bint) {
+	zcoef := getBint()
+	defer putBint(zcoef)
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+	fcoef.setFint(0)
+
+	// The initial guess is calculated as n * ln(10),
+	// where n is the position of the most significant digit.
+	n := x.prec() - 2*MaxScale
+	zcoef.setBint(bnlog10[n])
+
+	Ecoef := getBint()
+	defer putBint(Ecoef)
+
+	ncoef := getBint()
+	defer putBint(ncoef)
+
+	mcoef := getBint()
+	defer putBint(mcoef)
+
+	// Halley's method
+	for range 50 {
+		Ecoef.e(zcoef)
+
+		ncoef.sub(Ecoef, x)
+		ncoef.dbl(ncoef)
+
+		mcoef.add(Ecoef, x)
+
+		ncoef.lsh(ncoef, 2*MaxScale)
+		ncoef.quo(ncoef, mcoef)
+
+		fcoef.sub(zcoef, ncoef)
+
+		if zcoef.cmp(fcoef) == 0 {
+			break
+		}
+
+		zcoef.setBint(fcoef)
+	}
+
+	z.setBint(zcoef)
+}
+
+// e computes the exponential of a decimal using *big.Int arithmetic.
+// TODO: refactor to improve performance even more.
+func (z *bint) e(x *bint) {
+	qcoef := getBint()
+	defer putBint(qcoef)
+
+	rcoef := getBint()
+	defer putBint(rcoef)
+	rscale := 2 * MaxScale
+
+	qcoef.quoRem(x, bpow10[rscale], rcoef)
+
+	zcoef := getBint()
+	defer putBint(zcoef)
+	zcoef.setFint(0)
+
+	gcoef := getBint()
+	defer putBint(gcoef)
+	gcoef.setBint(bpow10[2*MaxScale])
+	gscale := 2 * MaxScale
+
+	hcoef := getBint()
+	defer putBint(hcoef)
+
+	// Compute f = exp(r) = r^0 / 0! + r^1 / 1! + ... + r^n / n!
+	for i := range len(bfact) {
+		// Accumulate f = f + r^i / i!
+		hcoef.quo(gcoef, bfact[i])
+		if hcoef.sign() == 0 {
+			break
+		}
+		zcoef.add(zcoef, hcoef)
+
+		// Compute g = r^(i+1)
+		gcoef.mul(gcoef, rcoef)
+		gscale = gscale + rscale
+
+		// Intermediate truncation
+		if gscale > 2*MaxScale {
+			shift := gscale - 2*MaxScale
+			gcoef.rshDown(gcoef, shift)
+			gscale = 2 * MaxScale
+		}
+	}
+
+	// nolint:gosec
+	zcoef.mul(zcoef, bexp[int(qcoef.fint())])
+	zcoef.quo(zcoef, bpow10[2*MaxScale])
+
+	z.setBint(zcoef)
+}
+
+func (d Decimal) SinIgnoreError() Decimal {
+	res, _ := d.Sin()
+	return res
+}
+
+func (d Decimal) MustSin() Decimal {
+	res, err := d.Sin()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Sin returns the (possibly rounded) sine of a decimal,
+// where the decimal is an angle in radians.
+func (d Decimal) Sin() (Decimal, error) {
+	return d.sin(HalfEven)
+}
+
+// sin is similar to [Decimal.Sin], but it allows you to specify
+// the rounding mode.
+func (d Decimal) sin(mode RoundingMode) (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.sinBint(mode)
+	if err != nil {
+		return Decimal{}, newOpError("sin", 0, err, d)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// sinBint computes the sine of a decimal using *big.Int arithmetic.
+func (d Decimal) sinBint(mode RoundingMode) (Decimal, error) {
+	scoef := getBint()
+	defer putBint(scoef)
+
+	ccoef := getBint()
+	defer putBint(ccoef)
+
+	sneg, _ := d.sinCosBint(scoef, ccoef)
+	sscale := 4 * MaxScale
+
+	// Sticky digit, since the sine of a non-zero decimal is irrational
+	scoef.fsa(scoef, 1, 1)
+	sscale = sscale + 1
+
+	return newFromBint(sneg, scoef, sscale, 0, mode)
+}
+
+func (d Decimal) CosIgnoreError() Decimal {
+	res, _ := d.Cos()
+	return res
+}
+
+func (d Decimal) MustCos() Decimal {
+	res, err := d.Cos()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Cos returns the (possibly rounded) cosine of a decimal,
+// where the decimal is an angle in radians.
+func (d Decimal) Cos() (Decimal, error) {
+	return d.cos(HalfEven)
+}
+
+// cos is similar to [Decimal.Cos], but it allows you to specify
+// the rounding mode.
+func (d Decimal) cos(mode RoundingMode) (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 1, 0)
+	}
+
+	// General case
+	e, err := d.cosBint(mode)
+	if err != nil {
+		return Decimal{}, newOpError("cos", 0, err, d)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// cosBint computes the cosine of a decimal using *big.Int arithmetic.
+func (d Decimal) cosBint(mode RoundingMode) (Decimal, error) {
+	scoef := getBint()
+	defer putBint(scoef)
+
+	ccoef := getBint()
+	defer putBint(ccoef)
+
+	_, cneg := d.sinCosBint(scoef, ccoef)
+	cscale := 4 * MaxScale
+
+	// Sticky digit, since the cosine of a non-zero decimal is irrational
+	ccoef.fsa(ccoef, 1, 1)
+	cscale = cscale + 1
+
+	return newFromBint(cneg, ccoef, cscale, 0, mode)
+}
+
+func (d Decimal) TanIgnoreError() Decimal {
+	res, _ := d.Tan()
+	return res
+}
+
+func (d Decimal) MustTan() Decimal {
+	res, err := d.Tan()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Tan returns the (possibly rounded) tangent of a decimal,
+// where the decimal is an angle in radians.
+//
+// Tan returns an error if the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) Tan() (Decimal, error) {
+	return d.tan(HalfEven)
+}
+
+// tan is similar to [Decimal.Tan], but it allows you to specify
+// the rounding mode.
+func (d Decimal) tan(mode RoundingMode) (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.tanBint(mode)
+	if err != nil {
+		return Decimal{}, newOpError("tan", 0, err, d)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// tanBint computes the tangent of a decimal using *big.Int arithmetic.
+func (d Decimal) tanBint(mode RoundingMode) (Decimal, error) {
+	scoef := getBint()
+	defer putBint(scoef)
+
+	ccoef := getBint()
+	defer putBint(ccoef)
+
+	sneg, cneg := d.sinCosBint(scoef, ccoef)
+	if ccoef.sign() == 0 {
+		return Decimal{}, unknownOverflowError(0) // Should never happen
+	}
+
+	// Compute tan(d) = sin(d) / cos(d)
+	scoef.lsh(scoef, 4*MaxScale)
+	scoef.quo(scoef, ccoef)
+	sscale := 4 * MaxScale
+
+	// Sticky digit, since the tangent of a non-zero decimal is irrational
+	scoef.fsa(scoef, 1, 1)
+	sscale = sscale + 1
+
+	return newFromBint(sneg != cneg, scoef, sscale, 0, mode)
+}
+
+func (d Decimal) AsinIgnoreError() Decimal {
+	res, _ := d.Asin()
+	return res
+}
+
+func (d Decimal) MustAsin() Decimal {
+	res, err := d.Asin()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Asin returns the (possibly rounded) arcsine of a decimal in radians.
+//
+// Asin returns an error if the decimal is less than -1 or greater than 1.
+func (d Decimal) Asin() (Decimal, error) {
+	return d.asin(HalfEven)
+}
+
+// asin is similar to [Decimal.Asin], but it allows you to specify
+// the rounding mode.
+func (d Decimal) asin(mode RoundingMode) (Decimal, error) {
+	// Special case: outside of [-1, 1]
+	if d.Abs().Cmp(One) > 0 {
+		return Decimal{}, newOpError("asin", 0, ErrInvalidOperation, d)
+	}
+
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.asinBint(mode)
+	if err != nil {
+		return Decimal{}, newOpError("asin", 0, err, d)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// asinBint computes the arcsine of a decimal using *big.Int arithmetic.
+func (d Decimal) asinBint(mode RoundingMode) (Decimal, error) {
+	ycoef := getBint()
+	defer putBint(ycoef)
+
+	xcoef := getBint()
+	defer putBint(xcoef)
+
+	d.sinCosInvBint(ycoef, xcoef)
+
+	// Compute asin(d) = atan(|d| / √(1 - d²))
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.atan(ycoef, xcoef)
+	escale := 4 * MaxScale
+
+	// Sticky digit, since the arcsine of a non-zero decimal is irrational
+	ecoef.fsa(ecoef, 1, 1)
+	escale = escale + 1
+
+	return newFromBint(d.IsNeg(), ecoef, escale, 0, mode)
+}
+
+func (d Decimal) AcosIgnoreError() Decimal {
+	res, _ := d.Acos()
+	return res
+}
+
+func (d Decimal) MustAcos() Decimal {
+	res, err := d.Acos()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Acos returns the (possibly rounded) arccosine of a decimal in radians.
+//
+// Acos returns an error if the decimal is less than -1 or greater than 1.
+func (d Decimal) Acos() (Decimal, error) {
+	return d.acos(HalfEven)
+}
+
+// acos is similar to [Decimal.Acos], but it allows you to specify
+// the rounding mode.
+func (d Decimal) acos(mode RoundingMode) (Decimal, error) {
+	// Special case: outside of [-1, 1]
+	if d.Abs().Cmp(One) > 0 {
+		return Decimal{}, newOpError("acos", 0, ErrInvalidOperation, d)
+	}
+
+	// Special case: one
+	if d.IsOne() && d.IsPos() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.acosBint(mode)
+	if err != nil {
+		return Decimal{}, newOpError("acos", 0, err, d)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// acosBint computes the arccosine of a decimal using *big.Int arithmetic.
+func (d Decimal) acosBint(mode RoundingMode) (Decimal, error) {
+	ycoef := getBint()
+	defer putBint(ycoef)
+
+	xcoef := getBint()
+	defer putBint(xcoef)
+
+	d.sinCosInvBint(ycoef, xcoef)
+
+	// Compute acos(|d|) = atan(√(1 - d²) / |d|)
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.atan(xcoef, ycoef)
+	escale := 4 * MaxScale
+
+	// Compute acos(d) = π - acos(|d|) for negative decimals
+	if d.IsNeg() {
+		ecoef.sub(bpi4, ecoef)
+	}
+
+	// Sticky digit, since the arccosine of a decimal other than one is irrational
+	ecoef.fsa(ecoef, 1, 1)
+	escale = escale + 1
+
+	return newFromBint(false, ecoef, escale, 0, mode)
+}
+
+// sinCosInvBint sets y to |d| and x to √(1 - d²), both with
+// 4 * [MaxScale] digits after the decimal point.
+// The decimal must be between -1 and 1.
+func (d Decimal) sinCosInvBint(y, x *bint) {
+	// Alignment
+	y.setFint(d.coef)
+	y.lsh(y, 4*MaxScale-d.Scale())
+
+	// Compute x = √(1 - d²), where the radicand is exact
+	ocoef := getBint()
+	defer putBint(ocoef)
+	ocoef.pow10(8 * MaxScale)
+	x.mul(y, y)
+	x.sub(ocoef, x)
+	x.sqrt(x)
+}
+
+func (d Decimal) AtanIgnoreError() Decimal {
+	res, _ := d.Atan()
+	return res
+}
+
+func (d Decimal) MustAtan() Decimal {
+	res, err := d.Atan()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Atan returns the (possibly rounded) arctangent of a decimal in radians.
+func (d Decimal) Atan() (Decimal, error) {
+	return d.atan(HalfEven)
+}
+
+// atan is similar to [Decimal.Atan], but it allows you to specify
+// the rounding mode.
+func (d Decimal) atan(mode RoundingMode) (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	e, err := d.atanBint(mode)
+	if err != nil {
+		return Decimal{}, newOpError("atan", 0, err, d)
+	}
+
+	// Preferred scale
+	e = e.Trim(0)
+
+	return e, nil
+}
+
+// atanBint computes the arctangent of a decimal using *big.Int arithmetic.
+func (d Decimal) atanBint(mode RoundingMode) (Decimal, error) {
+	ycoef := getBint()
+	defer putBint(ycoef)
+	ycoef.setFint(d.coef)
+
+	// Alignment
+	ycoef.lsh(ycoef, 4*MaxScale-d.Scale())
+
+	// Compute atan(|d|) = atan(|d| / 1)
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.atan(ycoef, bpow10[4*MaxScale])
+	escale := 4 * MaxScale
+
+	// Sticky digit, since the arctangent of a non-zero decimal is irrational
+	ecoef.fsa(ecoef, 1, 1)
+	escale = escale + 1
+
+	return newFromBint(d.IsNeg(), ecoef, escale, 0, mode)
+}
+
+func (d Decimal) Atan2IgnoreError(e Decimal) Decimal {
+	res, _ := d.Atan2(e)
+	return res
+}
+
+func (d Decimal) MustAtan2(e Decimal) Decimal {
+	res, err := d.Atan2(e)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Atan2 returns the (possibly rounded) arctangent of d / e in radians,
+// using the signs of both decimals to determine the quadrant of the result.
+// The result is between -π and π, and it is zero if both decimals are zero.
+func (d Decimal) Atan2(e Decimal) (Decimal, error) {
+	return d.atan2(e, HalfEven)
+}
+
+// atan2 is similar to [Decimal.Atan2], but it allows you to specify
+// the rounding mode.
+func (d Decimal) atan2(e Decimal, mode RoundingMode) (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() && !e.IsNeg() {
+		return newSafe(false, 0, 0)
+	}
+
+	// General case
+	f, err := d.atan2Bint(e, mode)
+	if err != nil {
+		return Decimal{}, newOpError("atan2", 0, err, d, e)
+	}
+
+	// Preferred scale
+	f = f.Trim(0)
+
+	return f, nil
+}
+
+// atan2Bint computes the arctangent of d / e using *big.Int arithmetic.
+func (d Decimal) atan2Bint(e Decimal, mode RoundingMode) (Decimal, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setFint(d.coef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(e.coef)
+
+	// Alignment
+	dcoef.lsh(dcoef, 4*MaxScale-d.Scale())
+	ecoef.lsh(ecoef, 4*MaxScale-e.Scale())
+
+	// Compute atan(|d| / |e|)
+	fcoef := getBint()
+	defer putBint(fcoef)
+	fcoef.atan(dcoef, ecoef)
+	fscale := 4 * MaxScale
+
+	// Compute atan2(|d|, e) = π - atan(|d| / |e|) for negative e
+	if e.IsNeg() {
+		fcoef.sub(bpi4, fcoef)
+	}
+
+	// Sticky digit, since the result is irrational unless it is zero
+	fcoef.fsa(fcoef, 1, 1)
+	fscale = fscale + 1
+
+	return newFromBint(d.IsNeg(), fcoef, fscale, 0, mode)
+}
+
+// sinCosBint sets s and c to the absolute values of the sine and cosine
+// of a decimal with 4 * [MaxScale] digits after the decimal point,
+// and reports whether the sine and cosine are negative.
+func (d Decimal) sinCosBint(s, c *bint) (sneg, cneg bool) {
+	xcoef := getBint()
+	defer putBint(xcoef)
+	xcoef.setFint(d.coef)
+
+	// Alignment
+	xcoef.lsh(xcoef, bpiScale-d.Scale())
+
+	hcoef := getBint()
+	defer putBint(hcoef)
+	hcoef.hlf(bpi)
+
+	// Argument reduction: |d| = k * π/2 + r, where |r| ≤ π/4
+	kcoef := getBint()
+	defer putBint(kcoef)
+	kcoef.hlf(hcoef)
+	kcoef.add(kcoef, xcoef)
+	kcoef.quo(kcoef, hcoef)
+
+	rcoef := getBint()
+	defer putBint(rcoef)
+	rcoef.mul(kcoef, hcoef)
+	rneg := xcoef.cmp(rcoef) < 0
+	rcoef.subAbs(xcoef, rcoef)
+	rcoef.rshDown(rcoef, bpiScale-4*MaxScale)
+
+	// Compute m = k mod 4
+	mcoef := getBint()
+	defer putBint(mcoef)
+	hcoef.setInt64(4)
+	kcoef.quoRem(kcoef, hcoef, mcoef)
+
+	// Compute sin(|r|) and cos(|r|) using Taylor series expansion
+	s.sin(rcoef)
+	c.cos(rcoef)
+
+	// Compute sin(|d|) and cos(|d|) from sin(r) and cos(r)
+	m := mcoef.fint()
+	if m%2 == 1 {
+		rcoef.setBint(s)
+		s.setBint(c)
+		c.setBint(rcoef)
+	}
+	switch m {
+	case 0:
+		sneg, cneg = rneg, false
+	case 1:
+		sneg, cneg = false, !rneg
+	case 2:
+		sneg, cneg = !rneg, true
+	default:
+		sneg, cneg = true, rneg
+	}
+
+	// Compute sin(d) = -sin(|d|) for negative decimals
+	return sneg != d.IsNeg(), cneg
+}
+
+// sin computes the sine of a decimal using *big.Int arithmetic.
+// Both x and z have 4 * [MaxScale] digits after the decimal point,
+// and x must be non-negative and less than one.
+func (z *bint) sin(x *bint) {
+	zcoef := getBint()
+	defer putBint(zcoef)
+	zcoef.setBint(x)
+
+	gcoef := getBint()
+	defer putBint(gcoef)
+	gcoef.setBint(x)
+
+	x2coef := getBint()
+	defer putBint(x2coef)
+	x2coef.mul(x, x)
+	x2coef.rshDown(x2coef, 4*MaxScale)
+
+	ncoef := getBint()
+	defer putBint(ncoef)
+
+	// Compute sin(x) = x^1 / 1! - x^3 / 3! + ... + (-1)^n * x^(2n+1) / (2n+1)!
+	for i := 1; ; i++ {
+		// Compute g = x^(2i+1) / (2i+1)!
+		gcoef.mul(gcoef, x2coef)
+		gcoef.rshDown(gcoef, 4*MaxScale)
+		ncoef.setInt64(int64(2 * i * (2*i + 1)))
+		gcoef.quo(gcoef, ncoef)
+		if gcoef.sign() == 0 {
+			break
+		}
+
+		// Accumulate z = z ± g
+		if i%2 == 1 {
+			zcoef.sub(zcoef, gcoef)
+		} else {
+			zcoef.add(zcoef, gcoef)
+		}
+	}
+
+	z.setBint(zcoef)
+}
+
+// cos computes the cosine of a decimal using *big.Int arithmetic.
+// Both x and z have 4 * [MaxScale] digits after the decimal point,
+// and x must be non-negative and less than one.
+func (z *bint) cos(x *bint) {
+	zcoef := getBint()
+	defer putBint(zcoef)
+	zcoef.setBint(bpow10[4*MaxScale])
+
+	gcoef := getBint()
+	defer putBint(gcoef)
+	gcoef.setBint(bpow10[4*MaxScale])
+
+	x2coef := getBint()
+	defer putBint(x2coef)
+	x2coef.mul(x, x)
+	x2coef.rshDown(x2coef, 4*MaxScale)
+
+	ncoef := getBint()
+	defer putBint(ncoef)
+
+	// Compute cos(x) = x^0 / 0! - x^2 / 2! + ... + (-1)^n * x^(2n) / (2n)!
+	for i := 1; ; i++ {
+		// Compute g = x^(2i) / (2i)!
+		gcoef.mul(gcoef, x2coef)
+		gcoef.rshDown(gcoef, 4*MaxScale)
+		ncoef.setInt64(int64((2*i - 1) * 2 * i))
+		gcoef.quo(gcoef, ncoef)
+		if gcoef.sign() == 0 {
+			break
+		}
+
+		// Accumulate z = z ± g
+		if i%2 == 1 {
+			zcoef.sub(zcoef, gcoef)
+		} else {
+			zcoef.add(zcoef, gcoef)
+		}
+	}
+
+	z.setBint(zcoef)
+}
+
+// atan computes the arctangent of y / x using *big.Int arithmetic.
+// Both y and x must be non-negative, at least one of them must be positive,
+// and they must have the same number of digits after the decimal point.
+// The result z is between 0 and π/2 and has 4 * [MaxScale] digits
+// after the decimal point.
+func (z *bint) atan(y, x *bint) {
+	// Compute atan(y / x) = π/2 - atan(x / y), so that the argument
+	// of the arctangent series does not exceed one
+	if y.cmp(x) > 0 {
+		z.atan(x, y)
+		hcoef := getBint()
+		defer putBint(hcoef)
+		hcoef.hlf(bpi4)
+		z.sub(hcoef, z)
+		return
+	}
+
+	tcoef := getBint()
+	defer putBint(tcoef)
+	tcoef.lsh(y, 4*MaxScale)
+	tcoef.quo(tcoef, x)
+
+	ucoef := getBint()
+	defer putBint(ucoef)
+
+	ocoef := getBint()
+	defer putBint(ocoef)
+	ocoef.pow10(8 * MaxScale)
+
+	// Argument reduction: atan(t) = 2 * atan(t / (1 + √(1 + t²))).
+	// After 4 halvings, t ≤ tan(π/64) < 0.05.
+	for range 4 {
+		ucoef.mul(tcoef, tcoef)
+		ucoef.add(ucoef, ocoef)
+		ucoef.sqrt(ucoef)
+		ucoef.add(ucoef, bpow10[4*MaxScale])
+		tcoef.lsh(tcoef, 4*MaxScale)
+		tcoef.quo(tcoef, ucoef)
+	}
+
+	zcoef := getBint()
+	defer putBint(zcoef)
+	zcoef.setBint(tcoef)
+
+	gcoef := getBint()
+	defer putBint(gcoef)
+	gcoef.setBint(tcoef)
+
+	t2coef := getBint()
+	defer putBint(t2coef)
+	t2coef.mul(tcoef, tcoef)
+	t2coef.rshDown(t2coef, 4*MaxScale)
+
+	ncoef := getBint()
+	defer putBint(ncoef)
+
+	// Compute atan(t) = t^1 / 1 - t^3 / 3 + ... + (-1)^n * t^(2n+1) / (2n+1)
+	for i := 1; ; i++ {
+		// Compute g = t^(2i+1)
+		gcoef.mul(gcoef, t2coef)
+		gcoef.rshDown(gcoef, 4*MaxScale)
+		if gcoef.sign() == 0 {
+			break
+		}
+
+		// Accumulate z = z ± g / (2i+1)
+		ncoef.setInt64(int64(2*i + 1))
+		ucoef.quo(gcoef, ncoef)
+		if i%2 == 1 {
+			zcoef.sub(zcoef, ucoef)
+		} else {
+			zcoef.add(zcoef, ucoef)
+		}
+	}
+
+	// Undo argument reduction: atan(y / x) = 2^4 * atan(t)
+	z.mulInt64(zcoef, 16)
+}
+
+func (d Decimal) SinhIgnoreError() Decimal {
+	res, _ := d.Sinh()
 	return res
 }
 
-func (d Decimal) MustExp() Decimal {
-	res, err := d.Exp()
+func (d Decimal) MustSinh() Decimal {
+	res, err := d.Sinh()
 	if err != nil {
 		panic(err)
 	}
 	return res
 }
 
-// Exp returns the (possibly rounded) exponential of a decimal.
+// Sinh returns the (possibly rounded) hyperbolic sine of a decimal.
 //
-// Exp returns an error if the integer part of the result has more than [MaxPrec] digits.
-func (d Decimal) Exp() (Decimal, error) {
+// Sinh returns an error if the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) Sinh() (Decimal, error) {
+	return d.sinh(HalfEven)
+}
+
+// sinh is similar to [Decimal.Sinh], but it allows you to specify
+// the rounding mode.
+func (d Decimal) sinh(mode RoundingMode) (Decimal, error) {
 	// Special case: zero
 	if d.IsZero() {
-		return newSafe(false, 1, 0)
+		return newSafe(false, 0, 0)
 	}
 
 	// General case
-	e, err := d.expBint()
+	e, err := d.sinhBint(mode)
 	if err != nil {
-		return Decimal{}, fmt.Errorf("computing exp(%v): %w", d, err)
+		return Decimal{}, newOpError("sinh", 0, err, d)
 	}
 
 	// Preferred scale
@@ -1750,134 +3769,64 @@ func (d Decimal) Exp() (Decimal, error) {
 	return e, nil
 }
 
-// expBint computes exponential of a decimal using *big.Int arithmetic.
-func (d Decimal) expBint() (Decimal, error) {
-	dcoef := d.coef
-	dscale := d.Scale()
-
-	// Split |d| into integer part q and fractional part r
-	q, r, ok := dcoef.quoRem(pow10[dscale])
-	if !ok {
-		return Decimal{}, errDecimalOverflow // Should never happen
-	}
-
-	// Check underflow and overflow
-	if q >= fint(len(bexp)) {
-		if d.IsNeg() {
-			return newSafe(false, 0, 0)
-		}
-		return Decimal{}, unknownOverflowError(0)
-	}
-
-	// Retrieve e = exp(q) from precomputed cache
+// sinhBint computes the hyperbolic sine of a decimal using *big.Int arithmetic.
+func (d Decimal) sinhBint(mode RoundingMode) (Decimal, error) {
 	ecoef := getBint()
 	defer putBint(ecoef)
-	ecoef.setBint(bexp[q])
 	escale := 2 * MaxScale
 
-	if r != 0 {
-		// Compute f = exp(r) using Taylor series expansion
-		fcoef := getBint()
-		defer putBint(fcoef)
-		fcoef.setFint(0)
-		fscale := 2 * MaxScale
-
-		rcoef := getBint()
-		defer putBint(rcoef)
-		rcoef.setFint(r)
-		rscale := dscale
-
-		gcoef := getBint()
-		defer putBint(gcoef)
-		gcoef.setBint(bpow10[2*MaxScale])
-		gscale := 2 * MaxScale
-
-		hcoef := getBint()
-		defer putBint(hcoef)
-
-		// Alignment
-		if rscale < 2*MaxScale {
-			rcoef.lsh(rcoef, 2*MaxScale-rscale)
-			rscale = 2 * MaxScale
-		}
-
-		// Compute f = exp(r) = r^0 / 0! + r^1 / 1! + ... + r^n / n!
-		for i := range len(bfact) {
-			// Accumulate f = f + r^i / i!
-			hcoef.quo(gcoef, bfact[i])
-			if hcoef.sign() == 0 {
-				break
-			}
-			fcoef.add(fcoef, hcoef)
-
-			// Compute g = r^(i+1)
-			gcoef.mul(gcoef, rcoef)
-			gscale = gscale + rscale
-
-			// Intermediate truncation
-			if gscale > 2*MaxScale {
-				shift := gscale - 2*MaxScale
-				gcoef.rshDown(gcoef, shift)
-				gscale = 2 * MaxScale
-			}
-		}
-
-		// Compute exp(|d|) = exp(q) * exp(r)
-		ecoef.mul(ecoef, fcoef)
-		escale = escale + fscale
+	fcoef := getBint()
+	defer putBint(fcoef)
 
-		// Intermediate truncation
-		if escale > 2*MaxScale {
-			shift := escale - 2*MaxScale
-			ecoef.rshDown(ecoef, shift)
-			escale = 2 * MaxScale
-		}
+	// Compute exp(|d|) and exp(-|d|)
+	if !d.Abs().eBint(ecoef) {
+		return Decimal{}, unknownOverflowError(0)
 	}
+	fcoef.quo(bpow10[4*MaxScale], ecoef)
 
-	if d.IsNeg() {
-		if ecoef.sign() == 0 {
-			return Decimal{}, unknownOverflowError(0)
-		}
+	// Compute sinh(|d|) = (exp(|d|) - exp(-|d|)) / 2
+	ecoef.sub(ecoef, fcoef)
+	ecoef.hlf(ecoef)
 
-		// Compute exp(d) = 1 / exp(|d|)
-		ecoef.quo(bpow10[2*MaxScale+escale], ecoef)
-		escale = 2 * MaxScale
-	}
+	// Sticky digit, since the hyperbolic sine of a non-zero decimal is irrational
+	ecoef.fsa(ecoef, 1, 1)
+	escale = escale + 1
 
-	return newFromBint(false, ecoef, escale, 0)
+	return newFromBint(d.IsNeg(), ecoef, escale, 0, mode)
 }
 
-func (d Decimal) LogIgnoreError() Decimal {
-	res, _ := d.Log()
+func (d Decimal) CoshIgnoreError() Decimal {
+	res, _ := d.Cosh()
 	return res
 }
 
-func (d Decimal) MustLog() Decimal {
-	res, err := d.Log()
+func (d Decimal) MustCosh() Decimal {
+	res, err := d.Cosh()
 	if err != nil {
 		panic(err)
 	}
 	return res
 }
 
-// Log returns the (possibly rounded) natural logarithm of a decimal.
+// Cosh returns the (possibly rounded) hyperbolic cosine of a decimal.
 //
-// Log returns an error if the decimal is zero or negative.
-func (d Decimal) Log() (Decimal, error) {
-	// Special case: zero or negative
-	if !d.IsPos() {
-		return Decimal{}, fmt.Errorf("computing log(%v): %w", d, errInvalidOperation)
-	}
+// Cosh returns an error if the integer part of the result has more than [MaxPrec] digits.
+func (d Decimal) Cosh() (Decimal, error) {
+	return d.cosh(HalfEven)
+}
 
-	// Special case: one
-	if d.IsOne() {
-		return newSafe(false, 0, 0)
+// cosh is similar to [Decimal.Cosh], but it allows you to specify
+// the rounding mode.
+func (d Decimal) cosh(mode RoundingMode) (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 1, 0)
 	}
 
 	// General case
-	e, err := d.logBint()
+	e, err := d.coshBint(mode)
 	if err != nil {
-		return Decimal{}, fmt.Errorf("computing log(%v): %w", d, err)
+		return Decimal{}, newOpError("cosh", 0, err, d)
 	}
 
 	// Preferred scale
@@ -1886,117 +3835,103 @@ func (d Decimal) Log() (Decimal, error) {
 	return e, nil
 }
 
-// logBint computes the natural logarithm of a decimal using *big.Int arithmetic.
-func (d Decimal) logBint() (Decimal, error) {
-	dcoef := getBint()
-	defer putBint(dcoef)
-	dcoef.setFint(d.coef)
-
+// coshBint computes the hyperbolic cosine of a decimal using *big.Int arithmetic.
+func (d Decimal) coshBint(mode RoundingMode) (Decimal, error) {
 	ecoef := getBint()
 	defer putBint(ecoef)
 	escale := 2 * MaxScale
 
 	fcoef := getBint()
 	defer putBint(fcoef)
-	fcoef.setFint(0)
 
-	// Alignment and sign
-	eneg := true
-	if d.WithinOne() {
-		dcoef.quo(bpow10[2*MaxScale+d.Scale()], dcoef)
-	} else {
-		dcoef.lsh(dcoef, 2*MaxScale-d.Scale())
-		eneg = false
+	// Compute exp(|d|) and exp(-|d|)
+	if !d.Abs().eBint(ecoef) {
+		return Decimal{}, unknownOverflowError(0)
 	}
+	fcoef.quo(bpow10[4*MaxScale], ecoef)
 
-	// The initial guess is calculated as n * ln(10),
-	// where n is the position of the most significant digit.
-	n := dcoef.prec() - 2*MaxScale
-	ecoef.setBint(bnlog10[n])
-
-	Ecoef := getBint()
-	defer putBint(Ecoef)
-
-	ncoef := getBint()
-	defer putBint(ncoef)
-
-	mcoef := getBint()
-	defer putBint(mcoef)
+	// Compute cosh(d) = (exp(|d|) + exp(-|d|)) / 2
+	ecoef.add(ecoef, fcoef)
+	ecoef.hlf(ecoef)
 
-	// Halley's method
-	for range 50 {
-		Ecoef.e(ecoef)
+	// Sticky digit, since the hyperbolic cosine of a non-zero decimal is irrational
+	ecoef.fsa(ecoef, 1, 1)
+	escale = escale + 1
 
-		ncoef.sub(Ecoef, dcoef)
-		ncoef.dbl(ncoef)
+	return newFromBint(false, ecoef, escale, 0, mode)
+}
 
-		mcoef.add(Ecoef, dcoef)
+func (d Decimal) TanhIgnoreError() Decimal {
+	res, _ := d.Tanh()
+	return res
+}
 
-		ncoef.lsh(ncoef, 2*MaxScale)
-		ncoef.quo(ncoef, mcoef)
+func (d Decimal) MustTanh() Decimal {
+	res, err := d.Tanh()
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
 
-		fcoef.sub(ecoef, ncoef)
+// Tanh returns the (possibly rounded) hyperbolic tangent of a decimal.
+func (d Decimal) Tanh() (Decimal, error) {
+	return d.tanh(HalfEven)
+}
 
-		if ecoef.cmp(fcoef) == 0 {
-			break
-		}
+// tanh is similar to [Decimal.Tanh], but it allows you to specify
+// the rounding mode.
+func (d Decimal) tanh(mode RoundingMode) (Decimal, error) {
+	// Special case: zero
+	if d.IsZero() {
+		return newSafe(false, 0, 0)
+	}
 
-		ecoef.setBint(fcoef)
+	// General case
+	e, err := d.tanhBint(mode)
+	if err != nil {
+		return Decimal{}, newOpError("tanh", 0, err, d)
 	}
 
-	return newFromBint(eneg, ecoef, escale, 0)
-}
+	// Preferred scale
+	e = e.Trim(0)
 
-// e computes the exponential of a decimal using *big.Int arithmetic.
-// TODO: refactor to improve performance even more.
-func (z *bint) e(x *bint) {
-	qcoef := getBint()
-	defer putBint(qcoef)
+	return e, nil
+}
 
-	rcoef := getBint()
-	defer putBint(rcoef)
-	rscale := 2 * MaxScale
+// tanhBint computes the hyperbolic tangent of a decimal using *big.Int arithmetic.
+func (d Decimal) tanhBint(mode RoundingMode) (Decimal, error) {
+	ecoef := getBint()
+	defer putBint(ecoef)
+	escale := 2 * MaxScale
 
-	qcoef.quoRem(x, bpow10[rscale], rcoef)
+	// Compute exp(|d|)
+	if !d.Abs().eBint(ecoef) {
+		// The result is much closer to one than the smallest representable
+		// decimal, so 1 - 10^-(2*MaxScale+1) rounds the same way.
+		ecoef.setFint(1)
+		ecoef.sub(bpow10[2*MaxScale+1], ecoef)
+		return newFromBint(d.IsNeg(), ecoef, 2*MaxScale+1, 0, mode)
+	}
 
-	zcoef := getBint()
-	defer putBint(zcoef)
-	zcoef.setFint(0)
+	// Compute exp(-|d|)
+	fcoef := getBint()
+	defer putBint(fcoef)
+	fcoef.quo(bpow10[4*MaxScale], ecoef)
 
+	// Compute tanh(|d|) = (exp(|d|) - exp(-|d|)) / (exp(|d|) + exp(-|d|))
 	gcoef := getBint()
 	defer putBint(gcoef)
-	gcoef.setBint(bpow10[2*MaxScale])
-	gscale := 2 * MaxScale
-
-	hcoef := getBint()
-	defer putBint(hcoef)
-
-	// Compute f = exp(r) = r^0 / 0! + r^1 / 1! + ... + r^n / n!
-	for i := range len(bfact) {
-		// Accumulate f = f + r^i / i!
-		hcoef.quo(gcoef, bfact[i])
-		if hcoef.sign() == 0 {
-			break
-		}
-		zcoef.add(zcoef, hcoef)
-
-		// Compute g = r^(i+1)
-		gcoef.mul(gcoef, rcoef)
-		gscale = gscale + rscale
-
-		// Intermediate truncation
-		if gscale > 2*MaxScale {
-			shift := gscale - 2*MaxScale
-			gcoef.rshDown(gcoef, shift)
-			gscale = 2 * MaxScale
-		}
-	}
+	gcoef.add(ecoef, fcoef)
+	ecoef.sub(ecoef, fcoef)
+	ecoef.lsh(ecoef, 2*MaxScale)
+	ecoef.quo(ecoef, gcoef)
 
-	// nolint:gosec
-	zcoef.mul(zcoef, bexp[int(qcoef.fint())])
-	zcoef.quo(zcoef, bpow10[2*MaxScale])
+	// Sticky digit, since the hyperbolic tangent of a non-zero decimal is irrational
+	ecoef.fsa(ecoef, 1, 1)
+	escale = escale + 1
 
-	z.setBint(zcoef)
+	return newFromBint(d.IsNeg(), ecoef, escale, 0, mode)
 }
 
 // Sum returns the (possibly rounded) sum of decimals without any
@@ -2006,28 +3941,39 @@ func (z *bint) e(x *bint) {
 //   - no argements are provided;
 //   - the integer part of the result has more than [MaxPrec] digits.
 func Sum(d ...Decimal) (Decimal, error) {
+	e, _, err := sum(HalfEven, d...)
+	if err != nil {
+		return Decimal{}, newOpError("sum", 0, err, d...)
+	}
+	return e, nil
+}
+
+// sum is similar to [Sum], but it allows you to specify the rounding mode.
+// It also reports whether the result is inexact, and it does not wrap errors,
+// so that it can be shared with [Context].
+func sum(mode RoundingMode, d ...Decimal) (Decimal, bool, error) {
 	// Special cases
 	switch len(d) {
 	case 0:
-		return Decimal{}, fmt.Errorf("computing [sum([])]: %w: no arguments", errInvalidOperation)
+		return Decimal{}, false, fmt.Errorf("%w: no arguments", ErrInvalidOperation)
 	case 1:
-		return d[0], nil
+		return d[0], false, nil
 	}
 
 	// General case
-	e, err := sumFint(d...)
+	e, inexact, err := sumFint(mode, d...)
 	if err != nil {
-		e, err = sumBint(d...)
+		e, inexact, err = sumBint(mode, d...)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [sum(%v)]: %w", d, err)
+			return Decimal{}, false, err
 		}
 	}
 
-	return e, nil
+	return e, inexact, nil
 }
 
 // sumFint computes the sum of decimals using uint64 arithmetic.
-func sumFint(d ...Decimal) (Decimal, error) {
+func sumFint(mode RoundingMode, d ...Decimal) (Decimal, bool, error) {
 	ecoef := Zero.coef
 	escale := Zero.Scale()
 	eneg := Zero.IsNeg()
@@ -2041,12 +3987,12 @@ func sumFint(d ...Decimal) (Decimal, error) {
 		case escale > f.Scale():
 			fcoef, ok = fcoef.lsh(escale - f.Scale())
 			if !ok {
-				return Decimal{}, errDecimalOverflow
+				return Decimal{}, false, ErrOverflow
 			}
 		case escale < f.Scale():
 			ecoef, ok = ecoef.lsh(f.Scale() - escale)
 			if !ok {
-				return Decimal{}, errDecimalOverflow
+				return Decimal{}, false, ErrOverflow
 			}
 			escale = f.Scale()
 		}
@@ -2055,7 +4001,7 @@ func sumFint(d ...Decimal) (Decimal, error) {
 		if eneg == f.IsNeg() {
 			ecoef, ok = ecoef.add(fcoef)
 			if !ok {
-				return Decimal{}, errDecimalOverflow
+				return Decimal{}, false, ErrOverflow
 			}
 		} else {
 			if fcoef > ecoef {
@@ -2065,11 +4011,11 @@ func sumFint(d ...Decimal) (Decimal, error) {
 		}
 	}
 
-	return newFromFint(eneg, ecoef, escale, 0)
+	return newFromFintInexact(eneg, ecoef, escale, 0, mode)
 }
 
 // sumBint computes the sum of decimals using *big.Int arithmetic.
-func sumBint(d ...Decimal) (Decimal, error) {
+func sumBint(mode RoundingMode, d ...Decimal) (Decimal, bool, error) {
 	ecoef := getBint()
 	defer putBint(ecoef)
 	ecoef.setFint(Zero.coef)
@@ -2102,7 +4048,7 @@ func sumBint(d ...Decimal) (Decimal, error) {
 		}
 	}
 
-	return newFromBint(eneg, ecoef, escale, 0)
+	return newFromBintInexact(eneg, ecoef, escale, 0, mode)
 }
 
 // SubAbs returns the (possibly rounded) absolute difference between decimals d and e.
@@ -2111,7 +4057,7 @@ func sumBint(d ...Decimal) (Decimal, error) {
 func (d Decimal) SubAbs(e Decimal) (Decimal, error) {
 	f, err := d.Sub(e)
 	if err != nil {
-		return Decimal{}, fmt.Errorf("computing [abs(%v - %v)]: %w", d, e, err)
+		return Decimal{}, newOpError("subabs", 0, err, d, e)
 	}
 	return f.Abs(), nil
 }
@@ -2133,7 +4079,7 @@ func (d Decimal) MustSub(e Decimal) Decimal {
 //
 // Sub returns an error if the integer part of the result has more than [MaxPrec] digits.
 func (d Decimal) Sub(e Decimal) (Decimal, error) {
-	return d.AddExact(e.Neg(), 0)
+	return d.SubExact(e, 0)
 }
 
 // SubExact is similar to [Decimal.Sub], but it allows you to specify the number of digits
@@ -2142,7 +4088,42 @@ func (d Decimal) Sub(e Decimal) (Decimal, error) {
 // This method is useful for financial calculations where the scale should be
 // equal to or greater than the currency's scale.
 func (d Decimal) SubExact(e Decimal, scale int) (Decimal, error) {
-	return d.AddExact(e.Neg(), scale)
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, newOpError("sub", scale, ErrScaleRange, d, e)
+	}
+
+	// General case
+	f, err := d.addFint(e.Neg(), scale, HalfEven)
+	if err != nil {
+		f, _, err = d.addBint(e.Neg(), scale, HalfEven)
+		if err != nil {
+			return Decimal{}, newOpError("sub", scale, err, d, e)
+		}
+	}
+
+	return f, nil
+}
+
+// SubExactMode is similar to [Decimal.SubExact], but it allows you to specify
+// the rounding mode that is used if the result has to be rounded.
+func (d Decimal) SubExactMode(e Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, newOpError("sub", scale, ErrScaleRange, d, e)
+	}
+	if !mode.valid() {
+		return Decimal{}, newOpError("sub", scale, ErrModeRange, d, e)
+	}
+
+	// General case
+	f, err := d.addFint(e.Neg(), scale, mode)
+	if err != nil {
+		f, _, err = d.addBint(e.Neg(), scale, mode)
+		if err != nil {
+			return Decimal{}, newOpError("sub", scale, err, d, e)
+		}
+	}
+
+	return f, nil
 }
 
 func (d Decimal) AddIgnoreError(e Decimal) Decimal {
@@ -2165,30 +4146,66 @@ func (d Decimal) Add(e Decimal) (Decimal, error) {
 	return d.AddExact(e, 0)
 }
 
-// AddExact is similar to [Decimal.Add], but it allows you to specify the number of digits
-// after the decimal point that should be considered significant.
-// If any of the significant digits are lost during rounding, the method will return an error.
-// This method is useful for financial calculations where the scale should be
-// equal to or greater than the currency's scale.
-func (d Decimal) AddExact(e Decimal, scale int) (Decimal, error) {
+// AddExact is similar to [Decimal.Add], but it allows you to specify the number of digits
+// after the decimal point that should be considered significant.
+// If any of the significant digits are lost during rounding, the method will return an error.
+// This method is useful for financial calculations where the scale should be
+// equal to or greater than the currency's scale.
+func (d Decimal) AddExact(e Decimal, scale int) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, newOpError("add", scale, ErrScaleRange, d, e)
+	}
+
+	// General case
+	f, err := d.addFint(e, scale, HalfEven)
+	if err != nil {
+		f, _, err = d.addBint(e, scale, HalfEven)
+		if err != nil {
+			return Decimal{}, newOpError("add", scale, err, d, e)
+		}
+	}
+
+	return f, nil
+}
+
+// AddExactMode is similar to [Decimal.AddExact], but it allows you to specify
+// the rounding mode that is used if the result has to be rounded.
+func (d Decimal) AddExactMode(e Decimal, scale int, mode RoundingMode) (Decimal, error) {
 	if scale < MinScale || scale > MaxScale {
-		return Decimal{}, fmt.Errorf("computing [%v + %v]: %w", d, e, errScaleRange)
+		return Decimal{}, newOpError("add", scale, ErrScaleRange, d, e)
+	}
+	if !mode.valid() {
+		return Decimal{}, newOpError("add", scale, ErrModeRange, d, e)
 	}
 
 	// General case
-	f, err := d.addFint(e, scale)
+	f, err := d.addFint(e, scale, mode)
 	if err != nil {
-		f, err = d.addBint(e, scale)
+		f, _, err = d.addBint(e, scale, mode)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [%v + %v]: %w", d, e, err)
+			return Decimal{}, newOpError("add", scale, err, d, e)
 		}
 	}
 
 	return f, nil
 }
 
+// addInexact computes d + e rounded to [MaxPrec] digits and reports whether
+// the result is inexact.
+// It is used by [Context], so it does not wrap errors.
+func (d Decimal) addInexact(e Decimal, mode RoundingMode) (Decimal, bool, error) {
+	// The result is rounded using uint64 arithmetic only if its scale
+	// exceeds MaxScale, so results with scale MaxScale are recomputed
+	// using *big.Int arithmetic, which reports inexactness.
+	f, err := d.addFint(e, 0, mode)
+	if err != nil || f.Scale() == MaxScale {
+		return d.addBint(e, 0, mode)
+	}
+	return f, false, nil
+}
+
 // addFint computes the sum of two decimals using uint64 arithmetic.
-func (d Decimal) addFint(e Decimal, minScale int) (Decimal, error) {
+func (d Decimal) addFint(e Decimal, minScale int, mode RoundingMode) (Decimal, error) {
 	dcoef := d.coef
 	dscale := d.Scale()
 	dneg := d.IsNeg()
@@ -2201,12 +4218,12 @@ func (d Decimal) addFint(e Decimal, minScale int) (Decimal, error) {
 	case dscale > e.Scale():
 		ecoef, ok = ecoef.lsh(dscale - e.Scale())
 		if !ok {
-			return Decimal{}, errDecimalOverflow
+			return Decimal{}, ErrOverflow
 		}
 	case dscale < e.Scale():
 		dcoef, ok = dcoef.lsh(e.Scale() - dscale)
 		if !ok {
-			return Decimal{}, errDecimalOverflow
+			return Decimal{}, ErrOverflow
 		}
 		dscale = e.Scale()
 	}
@@ -2215,7 +4232,7 @@ func (d Decimal) addFint(e Decimal, minScale int) (Decimal, error) {
 	if dneg == e.IsNeg() {
 		dcoef, ok = dcoef.add(ecoef)
 		if !ok {
-			return Decimal{}, errDecimalOverflow
+			return Decimal{}, ErrOverflow
 		}
 	} else {
 		if ecoef > dcoef {
@@ -2224,11 +4241,11 @@ func (d Decimal) addFint(e Decimal, minScale int) (Decimal, error) {
 		dcoef = dcoef.subAbs(ecoef)
 	}
 
-	return newFromFint(dneg, dcoef, dscale, minScale)
+	return newFromFint(dneg, dcoef, dscale, minScale, mode)
 }
 
 // addBint computes the sum of two decimals using *big.Int arithmetic.
-func (d Decimal) addBint(e Decimal, minScale int) (Decimal, error) {
+func (d Decimal) addBint(e Decimal, minScale int, mode RoundingMode) (Decimal, bool, error) {
 	dcoef := getBint()
 	defer putBint(dcoef)
 	dcoef.setFint(d.coef)
@@ -2258,7 +4275,7 @@ func (d Decimal) addBint(e Decimal, minScale int) (Decimal, error) {
 		dcoef.subAbs(dcoef, ecoef)
 	}
 
-	return newFromBint(dneg, dcoef, dscale, minScale)
+	return newFromBintInexact(dneg, dcoef, dscale, minScale, mode)
 }
 
 // Deprecated: use [Decimal.AddMul] instead.
@@ -2299,7 +4316,7 @@ func (d Decimal) MustSubMul(e, f Decimal) Decimal {
 //
 // [fused multiply-subtraction]: https://en.wikipedia.org/wiki/Multiply%E2%80%93accumulate_operation#Fused_multiply%E2%80%93add
 func (d Decimal) SubMul(e, f Decimal) (Decimal, error) {
-	return d.AddMulExact(e.Neg(), f, 0)
+	return d.SubMulExact(e, f, 0)
 }
 
 // SubMulExact is similar to [Decimal.SubMul], but it allows you to specify the number of digits
@@ -2308,7 +4325,42 @@ func (d Decimal) SubMul(e, f Decimal) (Decimal, error) {
 // This method is useful for financial calculations where the scale should be
 // equal to or greater than the currency's scale.
 func (d Decimal) SubMulExact(e, f Decimal, scale int) (Decimal, error) {
-	return d.AddMulExact(e.Neg(), f, scale)
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, newOpError("submul", scale, ErrScaleRange, d, e, f)
+	}
+
+	// General case
+	g, err := d.addMulFint(e.Neg(), f, scale, HalfEven)
+	if err != nil {
+		g, _, err = d.addMulBint(e.Neg(), f, scale, HalfEven)
+		if err != nil {
+			return Decimal{}, newOpError("submul", scale, err, d, e, f)
+		}
+	}
+
+	return g, nil
+}
+
+// SubMulExactMode is similar to [Decimal.SubMulExact], but it allows you to specify
+// the rounding mode that is used if the result has to be rounded.
+func (d Decimal) SubMulExactMode(e, f Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, newOpError("submul", scale, ErrScaleRange, d, e, f)
+	}
+	if !mode.valid() {
+		return Decimal{}, newOpError("submul", scale, ErrModeRange, d, e, f)
+	}
+
+	// General case
+	g, err := d.addMulFint(e.Neg(), f, scale, mode)
+	if err != nil {
+		g, _, err = d.addMulBint(e.Neg(), f, scale, mode)
+		if err != nil {
+			return Decimal{}, newOpError("submul", scale, err, d, e, f)
+		}
+	}
+
+	return g, nil
 }
 
 func (d Decimal) AddMulIgnoreError(e, f Decimal) Decimal {
@@ -2343,23 +4395,59 @@ func (d Decimal) AddMul(e, f Decimal) (Decimal, error) {
 // equal to or greater than the currency's scale.
 func (d Decimal) AddMulExact(e, f Decimal, scale int) (Decimal, error) {
 	if scale < MinScale || scale > MaxScale {
-		return Decimal{}, fmt.Errorf("computing [%v + %v * %v]: %w", d, e, f, errScaleRange)
+		return Decimal{}, newOpError("addmul", scale, ErrScaleRange, d, e, f)
+	}
+
+	// General case
+	g, err := d.addMulFint(e, f, scale, HalfEven)
+	if err != nil {
+		g, _, err = d.addMulBint(e, f, scale, HalfEven)
+		if err != nil {
+			return Decimal{}, newOpError("addmul", scale, err, d, e, f)
+		}
+	}
+
+	return g, nil
+}
+
+// AddMulExactMode is similar to [Decimal.AddMulExact], but it allows you to specify
+// the rounding mode that is used if the result has to be rounded.
+func (d Decimal) AddMulExactMode(e, f Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return Decimal{}, newOpError("addmul", scale, ErrScaleRange, d, e, f)
+	}
+	if !mode.valid() {
+		return Decimal{}, newOpError("addmul", scale, ErrModeRange, d, e, f)
 	}
 
 	// General case
-	g, err := d.addMulFint(e, f, scale)
+	g, err := d.addMulFint(e, f, scale, mode)
 	if err != nil {
-		g, err = d.addMulBint(e, f, scale)
+		g, _, err = d.addMulBint(e, f, scale, mode)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [%v + %v * %v]: %w", d, e, f, err)
+			return Decimal{}, newOpError("addmul", scale, err, d, e, f)
 		}
 	}
 
 	return g, nil
 }
 
+// addMulInexact computes d + e * f rounded to [MaxPrec] digits and reports
+// whether the result is inexact.
+// It is used by [Context], so it does not wrap errors.
+func (d Decimal) addMulInexact(e, f Decimal, mode RoundingMode) (Decimal, bool, error) {
+	// The result is rounded using uint64 arithmetic only if its scale
+	// exceeds MaxScale, so results with scale MaxScale are recomputed
+	// using *big.Int arithmetic, which reports inexactness.
+	g, err := d.addMulFint(e, f, 0, mode)
+	if err != nil || g.Scale() == MaxScale {
+		return d.addMulBint(e, f, 0, mode)
+	}
+	return g, false, nil
+}
+
 // addMulFint computes the fused multiply-addition of three decimals using uint64 arithmetic.
-func (d Decimal) addMulFint(e, f Decimal, minScale int) (Decimal, error) {
+func (d Decimal) addMulFint(e, f Decimal, minScale int, mode RoundingMode) (Decimal, error) {
 	dcoef := d.coef
 	dscale := d.Scale()
 	dneg := d.IsNeg()
@@ -2374,7 +4462,7 @@ func (d Decimal) addMulFint(e, f Decimal, minScale int) (Decimal, error) {
 	var ok bool
 	ecoef, ok = ecoef.mul(fcoef)
 	if !ok {
-		return Decimal{}, errDecimalOverflow
+		return Decimal{}, ErrOverflow
 	}
 	escale = escale + f.Scale()
 	eneg = eneg != f.IsNeg()
@@ -2384,12 +4472,12 @@ func (d Decimal) addMulFint(e, f Decimal, minScale int) (Decimal, error) {
 	case dscale > escale:
 		ecoef, ok = ecoef.lsh(dscale - escale)
 		if !ok {
-			return Decimal{}, errDecimalOverflow
+			return Decimal{}, ErrOverflow
 		}
 	case dscale < escale:
 		dcoef, ok = dcoef.lsh(escale - dscale)
 		if !ok {
-			return Decimal{}, errDecimalOverflow
+			return Decimal{}, ErrOverflow
 		}
 		dscale = escale
 	}
@@ -2398,7 +4486,7 @@ func (d Decimal) addMulFint(e, f Decimal, minScale int) (Decimal, error) {
 	if dneg == eneg {
 		dcoef, ok = dcoef.add(ecoef)
 		if !ok {
-			return Decimal{}, errDecimalOverflow
+			return Decimal{}, ErrOverflow
 		}
 	} else {
 		if ecoef > dcoef {
@@ -2407,11 +4495,11 @@ func (d Decimal) addMulFint(e, f Decimal, minScale int) (Decimal, error) {
 		dcoef = dcoef.subAbs(ecoef)
 	}
 
-	return newFromFint(dneg, dcoef, dscale, minScale)
+	return newFromFint(dneg, dcoef, dscale, minScale, mode)
 }
 
 // addMulBint computes the fused multiply-addition of three decimals using *big.Int arithmetic.
-func (d Decimal) addMulBint(e, f Decimal, minScale int) (Decimal, error) {
+func (d Decimal) addMulBint(e, f Decimal, minScale int, mode RoundingMode) (Decimal, bool, error) {
 	dcoef := getBint()
 	defer putBint(dcoef)
 	dcoef.setFint(d.coef)
@@ -2452,7 +4540,7 @@ func (d Decimal) addMulBint(e, f Decimal, minScale int) (Decimal, error) {
 		dcoef.subAbs(dcoef, ecoef)
 	}
 
-	return newFromBint(dneg, dcoef, dscale, minScale)
+	return newFromBintInexact(dneg, dcoef, dscale, minScale, mode)
 }
 
 func (d Decimal) SubQuoIgnoreError(e, f Decimal) Decimal {
@@ -2477,7 +4565,7 @@ func (d Decimal) MustSubQuo(e, f Decimal) Decimal {
 //   - the divisor is 0;
 //   - the integer part of the result has more than [MaxPrec] digits.
 func (d Decimal) SubQuo(e, f Decimal) (Decimal, error) {
-	return d.AddQuoExact(e.Neg(), f, 0)
+	return d.SubQuoExact(e, f, 0)
 }
 
 // SubQuoExact is similar to [Decimal.SubQuo], but it allows you to specify the number of digits
@@ -2486,7 +4574,21 @@ func (d Decimal) SubQuo(e, f Decimal) (Decimal, error) {
 // This method is useful for financial calculations where the scale should be
 // equal to or greater than the currency's scale.
 func (d Decimal) SubQuoExact(e, f Decimal, scale int) (Decimal, error) {
-	return d.AddQuoExact(e.Neg(), f, scale)
+	g, _, err := d.addQuoExactMode(e.Neg(), f, scale, HalfEven)
+	if err != nil {
+		return Decimal{}, newOpError("subquo", scale, err, d, e, f)
+	}
+	return g, nil
+}
+
+// SubQuoExactMode is similar to [Decimal.SubQuoExact], but it allows you to specify
+// the rounding mode that is used if the result has to be rounded.
+func (d Decimal) SubQuoExactMode(e, f Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	g, _, err := d.addQuoExactMode(e.Neg(), f, scale, mode)
+	if err != nil {
+		return Decimal{}, newOpError("subquo", scale, err, d, e, f)
+	}
+	return g, nil
 }
 
 func (d Decimal) AddQuoIgnoreError(e, f Decimal) Decimal {
@@ -2520,27 +4622,51 @@ func (d Decimal) AddQuo(e, f Decimal) (Decimal, error) {
 // This method is useful for financial calculations where the scale should be
 // equal to or greater than the currency's scale.
 func (d Decimal) AddQuoExact(e, f Decimal, scale int) (Decimal, error) {
+	g, _, err := d.addQuoExactMode(e, f, scale, HalfEven)
+	if err != nil {
+		return Decimal{}, newOpError("addquo", scale, err, d, e, f)
+	}
+	return g, nil
+}
+
+// AddQuoExactMode is similar to [Decimal.AddQuoExact], but it allows you to specify
+// the rounding mode that is used if the result has to be rounded.
+func (d Decimal) AddQuoExactMode(e, f Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	g, _, err := d.addQuoExactMode(e, f, scale, mode)
+	if err != nil {
+		return Decimal{}, newOpError("addquo", scale, err, d, e, f)
+	}
+	return g, nil
+}
+
+// addQuoExactMode computes d + e / f without wrapping errors and reports whether
+// the result is inexact, so that it can be shared by [Decimal.AddQuoExact],
+// [Decimal.SubQuoExact], their *Mode variants, and [Context].
+func (d Decimal) addQuoExactMode(e, f Decimal, scale int, mode RoundingMode) (Decimal, bool, error) {
 	if scale < MinScale || scale > MaxScale {
-		return Decimal{}, fmt.Errorf("computing [%v + %v / %v]: %w", d, e, f, errScaleRange)
+		return Decimal{}, false, ErrScaleRange
+	}
+	if !mode.valid() {
+		return Decimal{}, false, ErrModeRange
 	}
 
 	// Special case: zero divisor
 	if f.IsZero() {
-		return Decimal{}, fmt.Errorf("computing [%v + %v / %v]: %w", d, e, f, errDivisionByZero)
+		return Decimal{}, false, ErrDivisionByZero
 	}
 
 	// Special case: zero dividend
 	if e.IsZero() {
 		scale = max(scale, e.Scale()-f.Scale())
-		return d.Pad(scale), nil
+		return d.Pad(scale), false, nil
 	}
 
 	// General case
-	g, err := d.addQuoFint(e, f, scale)
+	g, inexact, err := d.addQuoFint(e, f, scale, mode)
 	if err != nil {
-		g, err = d.addQuoBint(e, f, scale)
+		g, inexact, err = d.addQuoBint(e, f, scale, mode)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [%v + %v / %v]: %w", d, e, f, err)
+			return Decimal{}, false, err
 		}
 	}
 
@@ -2548,11 +4674,11 @@ func (d Decimal) AddQuoExact(e, f Decimal, scale int) (Decimal, error) {
 	scale = max(scale, d.Scale(), e.Scale()-f.Scale())
 	g = g.Trim(scale)
 
-	return g, nil
+	return g, inexact, nil
 }
 
 // addQuoFint computes the fused quotient-addition of three decimals using uint64 arithmetic.
-func (d Decimal) addQuoFint(e, f Decimal, minScale int) (Decimal, error) {
+func (d Decimal) addQuoFint(e, f Decimal, minScale int, mode RoundingMode) (Decimal, bool, error) {
 	dcoef := d.coef
 	dscale := d.Scale()
 	dneg := d.IsNeg()
@@ -2568,7 +4694,7 @@ func (d Decimal) addQuoFint(e, f Decimal, minScale int) (Decimal, error) {
 	if shift := MaxPrec - ecoef.prec(); shift > 0 {
 		ecoef, ok = ecoef.lsh(shift)
 		if !ok {
-			return Decimal{}, errDecimalOverflow // Should never happen
+			return Decimal{}, false, ErrOverflow // Should never happen
 		}
 		escale = escale + shift
 	}
@@ -2580,7 +4706,7 @@ func (d Decimal) addQuoFint(e, f Decimal, minScale int) (Decimal, error) {
 	// Compute e = e / f
 	ecoef, ok = ecoef.quo(fcoef)
 	if !ok {
-		return Decimal{}, errInexactDivision
+		return Decimal{}, false, ErrInexactDivision
 	}
 	escale = escale - f.Scale()
 	eneg = eneg != f.IsNeg()
@@ -2590,7 +4716,7 @@ func (d Decimal) addQuoFint(e, f Decimal, minScale int) (Decimal, error) {
 	case dscale > escale:
 		ecoef, ok = ecoef.lsh(dscale - escale)
 		if !ok {
-			return Decimal{}, errDecimalOverflow
+			return Decimal{}, false, ErrOverflow
 		}
 	case dscale < escale:
 		if shift := min(escale-e.Scale()+f.Scale(), escale-dscale, ecoef.ntz()); shift > 0 {
@@ -2599,7 +4725,7 @@ func (d Decimal) addQuoFint(e, f Decimal, minScale int) (Decimal, error) {
 		}
 		dcoef, ok = dcoef.lsh(escale - dscale)
 		if !ok {
-			return Decimal{}, errDecimalOverflow
+			return Decimal{}, false, ErrOverflow
 		}
 		dscale = escale
 	}
@@ -2608,7 +4734,7 @@ func (d Decimal) addQuoFint(e, f Decimal, minScale int) (Decimal, error) {
 	if dneg == eneg {
 		dcoef, ok = dcoef.add(ecoef)
 		if !ok {
-			return Decimal{}, errDecimalOverflow
+			return Decimal{}, false, ErrOverflow
 		}
 	} else {
 		if ecoef > dcoef {
@@ -2617,11 +4743,11 @@ func (d Decimal) addQuoFint(e, f Decimal, minScale int) (Decimal, error) {
 		dcoef = dcoef.subAbs(ecoef)
 	}
 
-	return newFromFint(dneg, dcoef, dscale, minScale)
+	return newFromFintInexact(dneg, dcoef, dscale, minScale, mode)
 }
 
 // addQuoBint computes the fused quotient-addition of three decimals using *big.Int arithmetic.
-func (d Decimal) addQuoBint(e, f Decimal, minScale int) (Decimal, error) {
+func (d Decimal) addQuoBint(e, f Decimal, minScale int, mode RoundingMode) (Decimal, bool, error) {
 	dcoef := getBint()
 	defer putBint(dcoef)
 	dcoef.setFint(d.coef)
@@ -2636,13 +4762,22 @@ func (d Decimal) addQuoBint(e, f Decimal, minScale int) (Decimal, error) {
 	defer putBint(fcoef)
 	fcoef.setFint(f.coef)
 
+	rcoef := getBint()
+	defer putBint(rcoef)
+
 	// Alignment
-	ecoef.lsh(ecoef, 2*MaxScale-e.Scale()+f.Scale())
+	// One digit is reserved for the sticky digit, so that the quotient
+	// usually fits into the same number of words as without it.
+	ecoef.lsh(ecoef, 2*MaxScale-1-e.Scale()+f.Scale())
 
 	// Compute e = ⌊e / f⌋
-	ecoef.quo(ecoef, fcoef)
+	ecoef.quoRem(ecoef, fcoef, rcoef)
 	eneg = eneg != f.IsNeg()
 
+	// Sticky digit ensures that an inexact quotient is never mistaken
+	// for an exact one or for a tie during rounding.
+	ecoef.fsa(ecoef, 1, sticky(rcoef))
+
 	// Alignment
 	dcoef.lsh(dcoef, 2*MaxScale-d.Scale())
 
@@ -2656,7 +4791,7 @@ func (d Decimal) addQuoBint(e, f Decimal, minScale int) (Decimal, error) {
 		dcoef.subAbs(dcoef, ecoef)
 	}
 
-	return newFromBint(dneg, dcoef, 2*MaxScale, minScale)
+	return newFromBintInexact(dneg, dcoef, 2*MaxScale, minScale, mode)
 }
 
 func (d Decimal) InvIgnoreError() Decimal {
@@ -2680,7 +4815,7 @@ func (d Decimal) MustInv() Decimal {
 func (d Decimal) Inv() (Decimal, error) {
 	f, err := One.Quo(d)
 	if err != nil {
-		return Decimal{}, fmt.Errorf("inverting %v: %w", d, err)
+		return Decimal{}, newOpError("inv", 0, err, d)
 	}
 	return f, nil
 }
@@ -2713,27 +4848,51 @@ func (d Decimal) Quo(e Decimal) (Decimal, error) {
 // This method is useful for financial calculations where the scale should be
 // equal to or greater than the currency's scale.
 func (d Decimal) QuoExact(e Decimal, scale int) (Decimal, error) {
+	f, _, err := d.quoExactMode(e, scale, HalfEven)
+	if err != nil {
+		return Decimal{}, newOpError("quo", scale, err, d, e)
+	}
+	return f, nil
+}
+
+// QuoExactMode is similar to [Decimal.QuoExact], but it allows you to specify
+// the rounding mode that is used if the result has to be rounded.
+func (d Decimal) QuoExactMode(e Decimal, scale int, mode RoundingMode) (Decimal, error) {
+	f, _, err := d.quoExactMode(e, scale, mode)
+	if err != nil {
+		return Decimal{}, newOpError("quo", scale, err, d, e)
+	}
+	return f, nil
+}
+
+// quoExactMode computes d / e without wrapping errors and reports whether
+// the result is inexact, so that it can be shared with [Context].
+func (d Decimal) quoExactMode(e Decimal, scale int, mode RoundingMode) (Decimal, bool, error) {
 	if scale < MinScale || scale > MaxScale {
-		return Decimal{}, fmt.Errorf("computing [%v / %v]: %w", d, e, errScaleRange)
+		return Decimal{}, false, ErrScaleRange
+	}
+	if !mode.valid() {
+		return Decimal{}, false, ErrModeRange
 	}
 
 	// Special case: zero divisor
 	if e.IsZero() {
-		return Decimal{}, fmt.Errorf("computing [%v / %v]: %w", d, e, errDivisionByZero)
+		return Decimal{}, false, ErrDivisionByZero
 	}
 
 	// Special case: zero dividend
 	if d.IsZero() {
 		scale = max(scale, d.Scale()-e.Scale())
-		return newSafe(false, 0, scale)
+		f, err := newSafe(false, 0, scale)
+		return f, false, err
 	}
 
 	// General case
-	f, err := d.quoFint(e, scale)
+	f, inexact, err := d.quoFint(e, scale, mode)
 	if err != nil {
-		f, err = d.quoBint(e, scale)
+		f, inexact, err = d.quoBint(e, scale, mode)
 		if err != nil {
-			return Decimal{}, fmt.Errorf("computing [%v / %v]: %w", d, e, err)
+			return Decimal{}, false, err
 		}
 	}
 
@@ -2741,11 +4900,11 @@ func (d Decimal) QuoExact(e Decimal, scale int) (Decimal, error) {
 	scale = max(scale, d.Scale()-e.Scale())
 	f = f.Trim(scale)
 
-	return f, nil
+	return f, inexact, nil
 }
 
 // quoFint computes the quotient of two decimals using uint64 arithmetic.
-func (d Decimal) quoFint(e Decimal, minScale int) (Decimal, error) {
+func (d Decimal) quoFint(e Decimal, minScale int, mode RoundingMode) (Decimal, bool, error) {
 	dcoef := d.coef
 	dscale := d.Scale()
 	dneg := d.IsNeg()
@@ -2757,7 +4916,7 @@ func (d Decimal) quoFint(e Decimal, minScale int) (Decimal, error) {
 	if shift := MaxPrec - dcoef.prec(); shift > 0 {
 		dcoef, ok = dcoef.lsh(shift)
 		if !ok {
-			return Decimal{}, errDecimalOverflow // Should never happen
+			return Decimal{}, false, ErrOverflow // Should never happen
 		}
 		dscale = dscale + shift
 	}
@@ -2769,16 +4928,16 @@ func (d Decimal) quoFint(e Decimal, minScale int) (Decimal, error) {
 	// Compute d = d / e
 	dcoef, ok = dcoef.quo(ecoef)
 	if !ok {
-		return Decimal{}, errInexactDivision
+		return Decimal{}, false, ErrInexactDivision
 	}
 	dscale = dscale - e.Scale()
 	dneg = dneg != e.IsNeg()
 
-	return newFromFint(dneg, dcoef, dscale, minScale)
+	return newFromFintInexact(dneg, dcoef, dscale, minScale, mode)
 }
 
 // quoBint computes the quotient of two decimals using *big.Int arithmetic.
-func (d Decimal) quoBint(e Decimal, minScale int) (Decimal, error) {
+func (d Decimal) quoBint(e Decimal, minScale int, mode RoundingMode) (Decimal, bool, error) {
 	dcoef := getBint()
 	defer putBint(dcoef)
 	dcoef.setFint(d.coef)
@@ -2788,14 +4947,25 @@ func (d Decimal) quoBint(e Decimal, minScale int) (Decimal, error) {
 	defer putBint(ecoef)
 	ecoef.setFint(e.coef)
 
+	rcoef := getBint()
+	defer putBint(rcoef)
+
 	// Alignment
 	dcoef.lsh(dcoef, 2*MaxScale+e.Scale()-d.Scale())
 
 	// Compute d = ⌊d / e⌋
-	dcoef.quo(dcoef, ecoef)
+	dcoef.quoRem(dcoef, ecoef, rcoef)
 	dneg = dneg != e.IsNeg()
 
-	return newFromBint(dneg, dcoef, 2*MaxScale, minScale)
+	// Rounding an inexact quotient in ZeroFiveUp mode ensures that it is
+	// never mistaken for an exact one or for a tie during final rounding.
+	// The quotient scale is greater than MaxScale, so at least one digit
+	// is always discarded during the final rounding.
+	if rcoef.sign() != 0 && dcoef.isMul5() {
+		dcoef.inc(dcoef)
+	}
+
+	return newFromBintInexact(dneg, dcoef, 2*MaxScale, minScale, mode)
 }
 
 func (d Decimal) QuoRemIgnoreError(e Decimal) (Decimal, Decimal) {
@@ -2821,7 +4991,7 @@ func (d Decimal) MustQuoRem(e Decimal) (Decimal, Decimal) {
 func (d Decimal) QuoRem(e Decimal) (q, r Decimal, err error) {
 	// Special case: zero divisor
 	if e.IsZero() {
-		return Decimal{}, Decimal{}, fmt.Errorf("computing [%v div %v] and [%v mod %v]: %w", d, e, d, e, errDivisionByZero)
+		return Decimal{}, Decimal{}, newOpError("quorem", 0, ErrDivisionByZero, d, e)
 	}
 
 	// General case
@@ -2829,7 +4999,7 @@ func (d Decimal) QuoRem(e Decimal) (q, r Decimal, err error) {
 	if err != nil {
 		q, r, err = d.quoRemBint(e)
 		if err != nil {
-			return Decimal{}, Decimal{}, fmt.Errorf("computing [%v div %v] and [%v mod %v]: %w", d, e, d, e, err)
+			return Decimal{}, Decimal{}, newOpError("quorem", 0, err, d, e)
 		}
 	}
 
@@ -2848,12 +5018,12 @@ func (d Decimal) quoRemFint(e Decimal) (q, r Decimal, err error) {
 	case d.Scale() > e.Scale():
 		ecoef, ok = ecoef.lsh(d.Scale() - e.Scale())
 		if !ok {
-			return Decimal{}, Decimal{}, errDecimalOverflow
+			return Decimal{}, Decimal{}, ErrOverflow
 		}
 	case d.Scale() < e.Scale():
 		dcoef, ok = dcoef.lsh(e.Scale() - d.Scale())
 		if !ok {
-			return Decimal{}, Decimal{}, errDecimalOverflow
+			return Decimal{}, Decimal{}, ErrOverflow
 		}
 		rscale = e.Scale()
 	}
@@ -2861,16 +5031,16 @@ func (d Decimal) quoRemFint(e Decimal) (q, r Decimal, err error) {
 	// Compute q = ⌊d / e⌋, r = d - e * q
 	qcoef, rcoef, ok := dcoef.quoRem(ecoef)
 	if !ok {
-		return Decimal{}, Decimal{}, errDivisionByZero // Should never happen
+		return Decimal{}, Decimal{}, ErrDivisionByZero // Should never happen
 	}
 	qsign := d.IsNeg() != e.IsNeg()
 	rsign := d.IsNeg()
 
-	q, err = newFromFint(qsign, qcoef, 0, 0)
+	q, err = newFromFint(qsign, qcoef, 0, 0, HalfEven)
 	if err != nil {
 		return Decimal{}, Decimal{}, err
 	}
-	r, err = newFromFint(rsign, rcoef, rscale, rscale)
+	r, err = newFromFint(rsign, rcoef, rscale, rscale, HalfEven)
 	if err != nil {
 		return Decimal{}, Decimal{}, err
 	}
@@ -2908,17 +5078,193 @@ func (d Decimal) quoRemBint(e Decimal) (q, r Decimal, err error) {
 	qsign := d.IsNeg() != e.IsNeg()
 	rsign := d.IsNeg()
 
-	q, err = newFromBint(qsign, qcoef, 0, 0)
+	q, err = newFromBint(qsign, qcoef, 0, 0, HalfEven)
 	if err != nil {
 		return Decimal{}, Decimal{}, err
 	}
-	r, err = newFromBint(rsign, rcoef, rscale, rscale)
+	r, err = newFromBint(rsign, rcoef, rscale, rscale, HalfEven)
 	if err != nil {
 		return Decimal{}, Decimal{}, err
 	}
 	return q, r, nil
 }
 
+// Allocate distributes decimal d proportionally to the given ratios and returns
+// parts with the specified number of digits after the decimal point.
+// Unlike dividing and rounding each part separately, Allocate never loses or
+// creates a unit in the last place: the parts always sum exactly to d.
+// Every part is first rounded toward zero, and the remaining units are then
+// handed out one at a time to the parts with the largest remainders
+// ([largest remainder method]).
+// Ties are broken in favor of the part that comes first.
+// All parts have the same sign as d.
+// See also method [Decimal.Split].
+//
+// Allocate returns an error if:
+//   - the scale is negative or greater than [MaxScale];
+//   - no ratios are provided, any ratio is negative, or all ratios are 0;
+//   - d has more digits after the decimal point than the given scale;
+//   - the integer part of d has more than [MaxPrec] - scale digits.
+//
+// Errors caused by invalid ratios, including all ratios being 0,
+// wrap [ErrInvalidOperation].
+//
+// [largest remainder method]: https://en.wikipedia.org/wiki/Largest_remainders_method
+func (d Decimal) Allocate(scale int, ratios ...Decimal) ([]Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return nil, newOpError("allocate", scale, ErrScaleRange, append([]Decimal{d}, ratios...)...)
+	}
+
+	// Special case: no ratios
+	if len(ratios) == 0 {
+		return nil, newOpError("allocate", scale, fmt.Errorf("%w: no ratios", ErrInvalidOperation), d)
+	}
+
+	dcoef, err := d.allocCoef(scale)
+	if err != nil {
+		return nil, newOpError("allocate", scale, err, append([]Decimal{d}, ratios...)...)
+	}
+
+	coefs, err := allocateBint(dcoef, ratios)
+	if err != nil {
+		return nil, newOpError("allocate", scale, err, append([]Decimal{d}, ratios...)...)
+	}
+
+	parts := make([]Decimal, len(coefs))
+	for i, c := range coefs {
+		parts[i] = newUnsafe(d.IsNeg(), c, scale)
+	}
+	return parts, nil
+}
+
+// Split divides decimal d into n parts with the specified number of digits
+// after the decimal point.
+// The parts always sum exactly to d and differ from each other by at most
+// one unit in the last place, with the larger parts coming first.
+// All parts have the same sign as d.
+// See also method [Decimal.Allocate].
+//
+// Split returns an error if:
+//   - the scale is negative or greater than [MaxScale];
+//   - n is not positive;
+//   - d has more digits after the decimal point than the given scale;
+//   - the integer part of d has more than [MaxPrec] - scale digits.
+func (d Decimal) Split(n, scale int) ([]Decimal, error) {
+	if scale < MinScale || scale > MaxScale {
+		return nil, newOpError("split", scale, ErrScaleRange, d, splitParts(n))
+	}
+	if n <= 0 {
+		return nil, newOpError("split", scale, fmt.Errorf("%w: number of parts must be positive", ErrInvalidOperation), d, splitParts(n))
+	}
+
+	dcoef, err := d.allocCoef(scale)
+	if err != nil {
+		return nil, newOpError("split", scale, err, d, splitParts(n))
+	}
+
+	// Compute q = ⌊d / n⌋, r = d - n * q
+	//nolint:gosec
+	qcoef, rcoef, ok := dcoef.quoRem(fint(n))
+	if !ok {
+		return nil, newOpError("split", scale, ErrDivisionByZero, d, splitParts(n)) // Should never happen
+	}
+
+	parts := make([]Decimal, n)
+	for i := range parts {
+		c := qcoef
+		//nolint:gosec
+		if fint(i) < rcoef {
+			c++
+		}
+		parts[i] = newUnsafe(d.IsNeg(), c, scale)
+	}
+	return parts, nil
+}
+
+// splitParts returns the number of parts as a decimal for error reporting.
+func splitParts(n int) Decimal {
+	p, _ := New(int64(n), 0)
+	return p
+}
+
+// allocCoef returns the coefficient of d padded to the given scale.
+func (d Decimal) allocCoef(scale int) (fint, error) {
+	if d.MinScale() > scale {
+		return 0, fmt.Errorf("%w: %v has more than %v digits after the decimal point", ErrInvalidOperation, d, scale)
+	}
+	if d.Scale() > scale {
+		d = d.Trim(scale)
+	}
+	coef, ok := d.coef.lsh(scale - d.Scale())
+	if !ok {
+		return 0, overflowError(d.Prec(), d.Scale(), scale)
+	}
+	return coef, nil
+}
+
+// allocateBint distributes coefficient dcoef proportionally to the given
+// ratios using *big.Int arithmetic.
+func allocateBint(dcoef fint, ratios []Decimal) ([]fint, error) {
+	// Alignment
+	rscale := 0
+	for _, r := range ratios {
+		if r.IsNeg() {
+			return nil, fmt.Errorf("%w: ratio %v is negative", ErrInvalidOperation, r)
+		}
+		rscale = max(rscale, r.Scale())
+	}
+
+	tcoef := getBint()
+	defer putBint(tcoef)
+	tcoef.setInt64(0)
+
+	rcoefs := make([]*bint, len(ratios))
+	for i, r := range ratios {
+		rcoefs[i] = new(bint)
+		rcoefs[i].setFint(r.coef)
+		rcoefs[i].lsh(rcoefs[i], rscale-r.Scale())
+		tcoef.add(tcoef, rcoefs[i])
+	}
+
+	// Special case: zero ratios
+	if tcoef.sign() == 0 {
+		return nil, fmt.Errorf("%w: all ratios are zero", ErrInvalidOperation)
+	}
+
+	bcoef := getBint()
+	defer putBint(bcoef)
+	bcoef.setFint(dcoef)
+
+	pcoef := getBint()
+	defer putBint(pcoef)
+
+	qcoef := getBint()
+	defer putBint(qcoef)
+
+	// Compute q_i = ⌊d * r_i / t⌋, and reuse r_i for the remainder
+	coefs := make([]fint, len(ratios))
+	left := dcoef
+	for i := range ratios {
+		pcoef.mul(bcoef, rcoefs[i])
+		qcoef.quoRem(pcoef, tcoef, rcoefs[i])
+		coefs[i] = qcoef.fint()
+		left -= coefs[i]
+	}
+
+	// Distribution of the remaining units, left < len(ratios)
+	order := make([]int, len(ratios))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(i, j int) int {
+		return rcoefs[j].cmp(rcoefs[i])
+	})
+	for _, i := range order[:left] {
+		coefs[i]++
+	}
+	return coefs, nil
+}
+
 // Max returns the larger decimal.
 // See also method [Decimal.CmpTotal].
 func (d Decimal) Max(e Decimal) Decimal {
@@ -3013,6 +5359,16 @@ func (d Decimal) Equal(e Decimal) bool {
 	return d.Cmp(e) == 0
 }
 
+// Less compares decimals and returns:
+//
+//	 true if d < e
+//	false otherwise
+//
+// See also method [Decimal.Cmp].
+func (d Decimal) Less(e Decimal) bool {
+	return d.Cmp(e) < 0
+}
+
 // Equals compares decimals and returns:
 //
 //	 true if d = e
@@ -3098,12 +5454,12 @@ func (d Decimal) cmpFint(e Decimal) (int, error) {
 	case d.Scale() > e.Scale():
 		ecoef, ok = ecoef.lsh(d.Scale() - e.Scale())
 		if !ok {
-			return 0, errDecimalOverflow
+			return 0, ErrOverflow
 		}
 	case d.Scale() < e.Scale():
 		dcoef, ok = dcoef.lsh(e.Scale() - d.Scale())
 		if !ok {
-			return 0, errDecimalOverflow
+			return 0, ErrOverflow
 		}
 	}
 
@@ -3153,6 +5509,38 @@ type NullDecimal struct {
 	Valid   bool
 }
 
+// NewNullDecimal returns a non-null decimal equal to decimal d.
+func NewNullDecimal(d Decimal) NullDecimal {
+	return NullDecimal{Decimal: d, Valid: true}
+}
+
+// NewNullDecimalFromPtr returns a decimal equal to the decimal pointed to by d,
+// or a null decimal if d is nil.
+func NewNullDecimalFromPtr(d *Decimal) NullDecimal {
+	if d == nil {
+		return NullDecimal{}
+	}
+	return NewNullDecimal(*d)
+}
+
+// ValueOrZero returns the decimal if it is not null, or 0 otherwise.
+func (n NullDecimal) ValueOrZero() Decimal {
+	if !n.Valid {
+		return Decimal{}
+	}
+	return n.Decimal
+}
+
+// Ptr returns a pointer to a copy of the decimal if it is not null,
+// or nil otherwise.
+func (n NullDecimal) Ptr() *Decimal {
+	if !n.Valid {
+		return nil
+	}
+	d := n.Decimal
+	return &d
+}
+
 // Scan implements the [sql.Scanner] interface.
 // See also constructor [NewFromString].
 //
@@ -3183,3 +5571,130 @@ func (n NullDecimal) Value() (driver.Value, error) {
 	}
 	return n.Decimal.Value()
 }
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The JSON null value is decoded as a null decimal regardless of
+// the package-level options, while other values are decoded in the same way
+// as [Decimal.UnmarshalJSON] does.
+// In case of an error, the decimal is not changed.
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (n *NullDecimal) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		n.Decimal = Decimal{}
+		n.Valid = false
+		return nil
+	}
+	d := n.Decimal
+	if err := d.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Decimal = d
+	n.Valid = true
+	return nil
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// A null decimal is encoded as the JSON null value, while other decimals
+// are encoded in the same way as [Decimal.MarshalJSON] does.
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (n NullDecimal) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Decimal.MarshalJSON()
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// Empty text is decoded as a null decimal.
+// See also constructor [NewFromString].
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (n *NullDecimal) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.Decimal = Decimal{}
+		n.Valid = false
+		return nil
+	}
+	d, err := NewFromString(string(text))
+	if err != nil {
+		return err
+	}
+	n.Decimal = d
+	n.Valid = true
+	return nil
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// A null decimal is encoded as empty text.
+// See also method [Decimal.String].
+//
+// [encoding.TextMarshaler]: https://pkg.go.dev/encoding#TextMarshaler
+func (n NullDecimal) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.Decimal.MarshalText()
+}
+
+// UnmarshalXMLAttr implements the [xml.UnmarshalerAttr] interface.
+// See also method [NullDecimal.UnmarshalText].
+//
+// [xml.UnmarshalerAttr]: https://pkg.go.dev/encoding/xml#UnmarshalerAttr
+func (n *NullDecimal) UnmarshalXMLAttr(attr xml.Attr) error {
+	return n.UnmarshalText([]byte(attr.Value))
+}
+
+// MarshalXMLAttr implements the [xml.MarshalerAttr] interface.
+// A null decimal is omitted, while other decimals are encoded
+// in the same way as [Decimal.MarshalText] does.
+//
+// [xml.MarshalerAttr]: https://pkg.go.dev/encoding/xml#MarshalerAttr
+func (n NullDecimal) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !n.Valid {
+		return xml.Attr{}, nil
+	}
+	return xml.Attr{Name: name, Value: n.Decimal.String()}, nil
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// Empty data is decoded as a null decimal.
+//
+// [encoding.BinaryUnmarshaler]: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (n *NullDecimal) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		n.Decimal = Decimal{}
+		n.Valid = false
+		return nil
+	}
+	d, err := parseBCD(data)
+	if err != nil {
+		return err
+	}
+	n.Decimal = d
+	n.Valid = true
+	return nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// A null decimal is encoded as empty data, while other decimals
+// are encoded in the same way as [Decimal.MarshalBinary] does.
+//
+// [encoding.BinaryMarshaler]: https://pkg.go.dev/encoding#BinaryMarshaler
+func (n NullDecimal) MarshalBinary() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.Decimal.bcd(), nil
+}
+
+// GobEncode implements the gob.GobEncoder interface for gob serialization.
+func (n NullDecimal) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for gob serialization.
+func (n *NullDecimal) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
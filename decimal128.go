@@ -0,0 +1,1104 @@
+package decimal
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+)
+
+// Decimal128 represents a finite floating-point decimal number with
+// a 128-bit coefficient.
+// It is similar to [Decimal], but its coefficient can hold up to
+// [MaxPrec128] digits, which makes it suitable for amounts that do not fit
+// into [Decimal], such as token balances with 18 digits after the decimal
+// point.
+// Its zero value corresponds to the numeric value of 0.
+// Decimal128 is designed to be safe for concurrent use by multiple goroutines.
+type Decimal128 struct {
+	neg   bool // indicates whether the decimal is negative
+	scale int8 // position of the floating decimal point
+	coef  dint // numeric value without decimal point
+}
+
+const (
+	MaxPrec128  = 38 // MaxPrec128 is a maximum length of the Decimal128 coefficient in decimal digits.
+	MaxScale128 = 38 // MaxScale128 is a maximum number of digits after the decimal point in a Decimal128.
+)
+
+// newUnsafe128 creates a new decimal without checking scale and coefficient.
+// Use it only if you are absolutely sure that the arguments are valid.
+func newUnsafe128(neg bool, coef dint, scale int) Decimal128 {
+	if coef.isZero() {
+		neg = false
+	}
+	//nolint:gosec
+	return Decimal128{neg: neg, coef: coef, scale: int8(scale)}
+}
+
+// newSafe128 creates a new decimal and checks scale and coefficient.
+func newSafe128(neg bool, coef dint, scale int) (Decimal128, error) {
+	switch {
+	case scale < MinScale || scale > MaxScale128:
+		return Decimal128{}, ErrScaleRange
+	case coef.cmp(maxDint) > 0:
+		return Decimal128{}, ErrOverflow
+	}
+	return newUnsafe128(neg, coef, scale), nil
+}
+
+// newFromDint creates a new decimal from a 128-bit coefficient.
+// If the coefficient has to be rounded, the given rounding mode is used.
+// This method does not use overflowError128 to return descriptive errors,
+// as it must be as fast as possible.
+func newFromDint(neg bool, coef dint, scale, minScale int, mode RoundingMode) (Decimal128, error) {
+	var ok bool
+	// Scale normalization
+	switch {
+	case scale < minScale:
+		coef, ok = coef.lsh(minScale - scale)
+		if !ok {
+			return Decimal128{}, ErrOverflow
+		}
+		scale = minScale
+	case scale > MaxScale128:
+		coef = coef.rshMode(scale-MaxScale128, mode, neg)
+		scale = MaxScale128
+	}
+	return newSafe128(neg, coef, scale)
+}
+
+// newFromBint128 creates a new decimal from *big.Int coefficient.
+// If the coefficient has to be rounded, the given rounding mode is used.
+// This method uses overflowError128 to return descriptive errors.
+func newFromBint128(neg bool, coef *bint, scale, minScale int, mode RoundingMode) (Decimal128, error) {
+	// Overflow validation
+	prec := coef.prec()
+	if prec-scale > MaxPrec128-minScale {
+		return Decimal128{}, overflowError128(prec, scale, minScale)
+	}
+	// Scale normalization
+	switch {
+	case scale < minScale:
+		coef.lsh(coef, minScale-scale)
+		scale = minScale
+	case scale >= prec && scale > MaxScale128: // no integer part
+		coef.rshMode(coef, scale-MaxScale128, mode, neg)
+		scale = MaxScale128
+	case prec > scale && prec > MaxPrec128: // there is an integer part
+		coef.rshMode(coef, prec-MaxPrec128, mode, neg)
+		scale = MaxPrec128 - prec + scale
+	}
+	// Handling the rare case when rshMode rounded
+	// a 38-digit coefficient to a 39-digit coefficient.
+	if coef.hasPrec(MaxPrec128 + 1) {
+		return newFromBint128(neg, coef, scale, minScale, mode)
+	}
+	return newSafe128(neg, coef.dint(), scale)
+}
+
+// NewDecimal128 returns a decimal equal to coef / 10^scale.
+// NewDecimal128 keeps trailing zeros in the fractional part to preserve scale.
+//
+// NewDecimal128 returns an error if scale is negative or greater than [MaxScale128].
+func NewDecimal128(coef int64, scale int) (Decimal128, error) {
+	var neg bool
+	if coef < 0 {
+		neg = true
+		coef = -coef
+	}
+	// nolint:gosec
+	return newSafe128(neg, dint{lo: uint64(coef)}, scale)
+}
+
+// MustNewDecimal128 is like [NewDecimal128] but panics if the decimal cannot be constructed.
+// It simplifies safe initialization of global variables holding decimals.
+func MustNewDecimal128(coef int64, scale int) Decimal128 {
+	d, err := NewDecimal128(coef, scale)
+	if err != nil {
+		panic(fmt.Sprintf("NewDecimal128(%v, %v) failed: %v", coef, scale, err))
+	}
+	return d
+}
+
+// NewDecimal128FromString converts a string to a (possibly rounded) decimal.
+// The input string must be in one of the formats described in [NewFromString].
+//
+// NewDecimal128FromString returns an error if:
+//   - the string contains any whitespaces;
+//   - the string is longer than 330 bytes;
+//   - the exponent is less than -330 or greater than 330;
+//   - the string does not represent a valid decimal number;
+//   - the integer part of the result has more than [MaxPrec128] digits.
+func NewDecimal128FromString(s string) (Decimal128, error) {
+	return NewDecimal128FromStringExact(s, 0)
+}
+
+// NewDecimal128FromStringExact is similar to [NewDecimal128FromString],
+// but it allows you to specify how many digits after the decimal point
+// should be considered significant.
+// If any of the significant digits are lost during rounding, the method will return an error.
+func NewDecimal128FromStringExact(s string, scale int) (Decimal128, error) {
+	if len(s) > 330 {
+		return Decimal128{}, fmt.Errorf("parsing decimal: %w", ErrInvalidDecimal)
+	}
+	if scale < MinScale || scale > MaxScale128 {
+		return Decimal128{}, fmt.Errorf("parsing decimal: %w", ErrScaleRange)
+	}
+	d, err := parseDint(s, scale)
+	if err != nil {
+		d, err = parseBint128(s, scale)
+		if err != nil {
+			return Decimal128{}, fmt.Errorf("parsing decimal: %w", err)
+		}
+	}
+	return d, nil
+}
+
+// MustNewDecimal128FromString is like [NewDecimal128FromString] but panics
+// if the string cannot be parsed.
+// It simplifies safe initialization of global variables holding decimals.
+func MustNewDecimal128FromString(s string) Decimal128 {
+	d, err := NewDecimal128FromString(s)
+	if err != nil {
+		panic(fmt.Sprintf("NewDecimal128FromString(%q) failed: %v", s, err))
+	}
+	return d
+}
+
+// parseDint parses a decimal string using 128-bit arithmetic.
+// parseDint does not support exponential notation to make it as fast as possible.
+func parseDint(s string, minScale int) (Decimal128, error) {
+	var pos int
+	width := len(s)
+
+	// Sign
+	var neg bool
+	switch {
+	case pos == width:
+		// skip
+	case s[pos] == '-':
+		neg = true
+		pos++
+	case s[pos] == '+':
+		pos++
+	}
+
+	// Coefficient
+	var coef dint
+	var scale int
+	var hasCoef, ok bool
+
+	// Integer
+	for pos < width && s[pos] >= '0' && s[pos] <= '9' {
+		coef, ok = coef.fsa(1, s[pos]-'0')
+		if !ok {
+			return Decimal128{}, ErrOverflow
+		}
+		pos++
+		hasCoef = true
+	}
+
+	// Fraction
+	if pos < width && s[pos] == '.' {
+		pos++
+		for pos < width && s[pos] >= '0' && s[pos] <= '9' {
+			coef, ok = coef.fsa(1, s[pos]-'0')
+			if !ok {
+				return Decimal128{}, ErrOverflow
+			}
+			pos++
+			scale++
+			hasCoef = true
+		}
+	}
+
+	if pos != width {
+		return Decimal128{}, fmt.Errorf("%w: unexpected character %q", ErrInvalidDecimal, s[pos])
+	}
+	if !hasCoef {
+		return Decimal128{}, fmt.Errorf("%w: no coefficient", ErrInvalidDecimal)
+	}
+	return newFromDint(neg, coef, scale, minScale, HalfEven)
+}
+
+// parseBint128 parses a decimal string using *big.Int arithmetic.
+// parseBint128 supports exponential notation.
+func parseBint128(s string, minScale int) (Decimal128, error) {
+	bcoef := getBint()
+	defer putBint(bcoef)
+	neg, scale, err := scanBint(s, bcoef, 330)
+	if err != nil {
+		return Decimal128{}, err
+	}
+	return newFromBint128(neg, bcoef, scale, minScale, HalfEven)
+}
+
+// Decimal128 converts a decimal to a [Decimal128].
+// This conversion is always exact.
+// See also method [Decimal128.Decimal].
+func (d Decimal) Decimal128() Decimal128 {
+	return newUnsafe128(d.IsNeg(), dint{lo: uint64(d.coef)}, d.Scale())
+}
+
+// Decimal converts a decimal to a (possibly rounded) [Decimal].
+// The conversion is exact if the decimal has no more than [MaxScale] digits
+// after the decimal point and no more than [MaxPrec] digits in total.
+// Otherwise, the result is rounded using [rounding half to even] (banker's rounding).
+// See also method [Decimal.Decimal128].
+//
+// Decimal returns an overflow error if the integer part of the decimal
+// has more than [MaxPrec] digits.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d Decimal128) Decimal() (Decimal, error) {
+	// Special case: exact conversion
+	if d.coef.hi == 0 && d.coef.lo <= uint64(maxFint) && d.Scale() <= MaxScale {
+		return newUnsafe(d.IsNeg(), fint(d.coef.lo), d.Scale()), nil
+	}
+
+	// Overflow validation
+	if prec := d.Prec(); prec-d.Scale() > MaxPrec {
+		err := overflowCause(Decimal{}, MaxPrec, prec, d.Scale(), 0)
+		return Decimal{}, fmt.Errorf("converting %v: %w", d, err)
+	}
+
+	// General case
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setDint(d.coef)
+	e, err := newFromBint(d.IsNeg(), dcoef, d.Scale(), 0, HalfEven)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("converting %v: %w", d, err)
+	}
+	return e, nil
+}
+
+// String implements the [fmt.Stringer] interface and returns
+// a string representation of the decimal.
+// The returned string does not use scientific or engineering notation and
+// follows the grammar described in [Decimal.String].
+// Trailing zeros in the fractional part are preserved.
+// See also method [Decimal128.Format].
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (d Decimal128) String() string {
+	var digs [MaxPrec128]byte
+	digits := d.coef.appendDigits(digs[:0])
+	scale := d.Scale()
+
+	buf := make([]byte, 0, MaxPrec128+3)
+
+	// Sign
+	if d.IsNeg() {
+		buf = append(buf, '-')
+	}
+
+	// Integer part
+	if n := len(digits) - scale; n > 0 {
+		buf = append(buf, digits[:n]...)
+		digits = digits[n:]
+	} else {
+		buf = append(buf, '0')
+	}
+
+	// Fractional part
+	if scale > 0 {
+		buf = append(buf, '.')
+		for range scale - len(digits) {
+			buf = append(buf, '0')
+		}
+		buf = append(buf, digits...)
+	}
+
+	return string(buf)
+}
+
+// Format implements the [fmt.Formatter] interface.
+// The available verbs, flags, and precisions are the same as in [Decimal.Format].
+//
+// [fmt.Formatter]: https://pkg.go.dev/fmt#Formatter
+func (d Decimal128) Format(state fmt.State, verb rune) {
+	var err error
+
+	// Percentage multiplier
+	if verb == 'k' || verb == 'K' {
+		d, err = d.Mul(MustNewDecimal128(100, 0))
+		if err != nil {
+			// This panic is handled inside the fmt package.
+			panic(fmt.Errorf("formatting percent: %w", err))
+		}
+	}
+
+	// Rescaling
+	var tzeros int
+	if verb == 'f' || verb == 'F' || verb == 'k' || verb == 'K' {
+		var scale int
+		switch p, ok := state.Precision(); {
+		case ok:
+			scale = p
+		case verb == 'k' || verb == 'K':
+			scale = d.Scale() - 2
+		case verb == 'f' || verb == 'F':
+			scale = d.Scale()
+		}
+		scale = max(scale, MinScale)
+		switch {
+		case scale < d.Scale():
+			d = d.Round(scale)
+		case scale > d.Scale():
+			tzeros = scale - d.Scale()
+		}
+	}
+
+	// Coefficient digits
+	var buf [MaxPrec128]byte
+	digits := d.coef.appendDigits(buf[:0])
+
+	writeDecimal(state, verb, d.IsNeg(), digits, d.Scale(), tzeros, "decimal.Decimal128")
+}
+
+// Float64 returns the nearest binary floating-point number rounded
+// using [rounding half to even] (banker's rounding).
+//
+// This conversion may lose data, as float64 has a smaller precision
+// than the decimal type.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d Decimal128) Float64() (f float64, ok bool) {
+	f, err := strconv.ParseFloat(d.String(), 64)
+	if err != nil {
+		return 0, false
+	}
+	return f, true
+}
+
+// bcd returns a [packed BCD] representation of a decimal.
+//
+// [packed BCD]: https://en.wikipedia.org/wiki/Binary-coded_decimal#Packed_BCD
+func (d Decimal128) bcd() []byte {
+	var digs [MaxPrec128]byte
+	digits := d.coef.appendDigits(digs[:0])
+	if len(digits) == 0 {
+		digits = append(digits, '0')
+	}
+	if len(digits)%2 == 0 {
+		digits = append([]byte{'0'}, digits...)
+	}
+	scale := d.Scale()
+
+	buf := make([]byte, 0, len(digits)/2+2)
+
+	// Coefficient
+	for i := 0; i+1 < len(digits); i += 2 {
+		buf = append(buf, (digits[i]-'0')<<4|(digits[i+1]-'0'))
+	}
+
+	// Sign and last digit
+	last := digits[len(digits)-1] - '0'
+	if d.IsNeg() {
+		buf = append(buf, last<<4|0x0d)
+	} else {
+		buf = append(buf, last<<4|0x0c)
+	}
+
+	// Scale
+	//nolint:gosec
+	buf = append(buf, byte(scale/10)<<4|byte(scale%10))
+
+	return buf
+}
+
+// parseBCD128 converts a [packed BCD] representation to a decimal.
+//
+// [packed BCD]: https://en.wikipedia.org/wiki/Binary-coded_decimal#Packed_BCD
+func parseBCD128(b []byte) (Decimal128, error) {
+	var coef dint
+	neg, scale, err := scanBCD(b, func(digit byte) bool {
+		var ok bool
+		coef, ok = coef.fsa(1, digit)
+		return ok
+	})
+	if err != nil {
+		return Decimal128{}, err
+	}
+	return newSafe128(neg, coef, scale)
+}
+
+// GobEncode implements the gob.GobEncoder interface for gob serialization.
+func (d Decimal128) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface for gob serialization.
+func (d *Decimal128) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// See also constructor [NewDecimal128FromString].
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (d *Decimal128) UnmarshalText(text []byte) error {
+	var err error
+	*d, err = NewDecimal128FromString(string(text))
+	return err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// See also method [Decimal128.String].
+//
+// [encoding.TextMarshaler]: https://pkg.go.dev/encoding#TextMarshaler
+func (d Decimal128) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// The expected format is the [packed BCD] representation used by [Decimal].
+//
+// [encoding.BinaryUnmarshaler]: https://pkg.go.dev/encoding#BinaryUnmarshaler
+// [packed BCD]: https://en.wikipedia.org/wiki/Binary-coded_decimal#Packed_BCD
+func (d *Decimal128) UnmarshalBinary(data []byte) error {
+	var err error
+	*d, err = parseBCD128(data)
+	if err != nil {
+		return fmt.Errorf("parsing decimal: %w", err)
+	}
+	return nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// The returned bytes are the [packed BCD] representation used by [Decimal].
+//
+// [encoding.BinaryMarshaler]: https://pkg.go.dev/encoding#BinaryMarshaler
+// [packed BCD]: https://en.wikipedia.org/wiki/Binary-coded_decimal#Packed_BCD
+func (d Decimal128) MarshalBinary() ([]byte, error) {
+	return d.bcd(), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// Both JSON strings and JSON numbers are accepted.
+// As is customary for the [json.Unmarshaler] interface,
+// the JSON null value is a no-op.
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (d *Decimal128) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	var err error
+	*d, err = NewDecimal128FromString(string(data))
+	return err
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The decimal is encoded as a JSON string, since JSON numbers are usually
+// decoded as float64 values, which cannot hold 38 digits.
+// See also method [Decimal128.String].
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (d Decimal128) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// Scan implements the [sql.Scanner] interface.
+// See also constructor [NewDecimal128FromString].
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+func (d *Decimal128) Scan(value any) error {
+	var err error
+	switch value := value.(type) {
+	case string:
+		*d, err = NewDecimal128FromString(value)
+	case []byte:
+		*d, err = NewDecimal128FromString(string(value))
+	case int64:
+		*d, err = NewDecimal128(value, 0)
+	case float64:
+		*d, err = NewDecimal128FromString(strconv.FormatFloat(value, 'f', -1, 64))
+	case nil:
+		err = fmt.Errorf("converting to %T: nil is not supported", d)
+	default:
+		err = fmt.Errorf("converting from %T to %T: type %T is not supported", value, d, value)
+	}
+	return err
+}
+
+// Value implements the [driver.Valuer] interface.
+// See also method [Decimal128.String].
+//
+// [driver.Valuer]: https://pkg.go.dev/database/sql/driver#Valuer
+func (d Decimal128) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Prec returns the number of digits in the coefficient.
+func (d Decimal128) Prec() int {
+	return d.coef.prec()
+}
+
+// Scale returns the number of digits after the decimal point.
+// See also methods [Decimal128.Prec], [Decimal128.MinScale].
+func (d Decimal128) Scale() int {
+	return int(d.scale)
+}
+
+// MinScale returns the smallest scale that the decimal can be rescaled to
+// without rounding.
+// See also method [Decimal128.Trim].
+func (d Decimal128) MinScale() int {
+	// Special case: zero
+	if d.IsZero() {
+		return MinScale
+	}
+	// General case
+	return max(MinScale, d.Scale()-d.coef.ntz())
+}
+
+// Sign returns:
+//
+//	-1 if d < 0
+//	 0 if d = 0
+//	+1 if d > 0
+func (d Decimal128) Sign() int {
+	switch {
+	case d.neg:
+		return -1
+	case d.coef.isZero():
+		return 0
+	}
+	return 1
+}
+
+// IsPos returns:
+//
+//	true  if d > 0
+//	false otherwise
+func (d Decimal128) IsPos() bool {
+	return !d.coef.isZero() && !d.neg
+}
+
+// IsNeg returns:
+//
+//	true  if d < 0
+//	false otherwise
+func (d Decimal128) IsNeg() bool {
+	return d.neg
+}
+
+// IsZero returns:
+//
+//	true  if d = 0
+//	false otherwise
+func (d Decimal128) IsZero() bool {
+	return d.coef.isZero()
+}
+
+// Neg returns a decimal with the opposite sign.
+func (d Decimal128) Neg() Decimal128 {
+	return newUnsafe128(!d.IsNeg(), d.coef, d.Scale())
+}
+
+// Abs returns the absolute value of the decimal.
+func (d Decimal128) Abs() Decimal128 {
+	return newUnsafe128(false, d.coef, d.Scale())
+}
+
+// Round returns a decimal rounded to the specified number of digits after
+// the decimal point using [rounding half to even] (banker's rounding).
+// If the given scale is negative, it is redefined to zero.
+// See also method [Decimal128.RoundMode].
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (d Decimal128) Round(scale int) Decimal128 {
+	return d.RoundMode(scale, HalfEven)
+}
+
+// RoundMode returns a decimal rounded to the specified number of digits after
+// the decimal point using the given rounding mode.
+// If the given scale is negative, it is redefined to zero.
+// If the given rounding mode is not valid, [HalfEven] is used instead.
+func (d Decimal128) RoundMode(scale int, mode RoundingMode) Decimal128 {
+	scale = max(scale, MinScale)
+	if scale >= d.Scale() {
+		return d
+	}
+	coef := d.coef.rshMode(d.Scale()-scale, mode, d.IsNeg())
+	return newUnsafe128(d.IsNeg(), coef, scale)
+}
+
+// Trunc returns a decimal truncated to the specified number of digits
+// after the decimal point using [rounding toward zero].
+// If the given scale is negative, it is redefined to zero.
+//
+// [rounding toward zero]: https://en.wikipedia.org/wiki/Rounding#Rounding_toward_zero
+func (d Decimal128) Trunc(scale int) Decimal128 {
+	return d.RoundMode(scale, Down)
+}
+
+// Pad returns a decimal zero-padded to the specified number of digits after
+// the decimal point.
+// The total number of digits in the result is limited by [MaxPrec128].
+// See also method [Decimal128.Trim].
+func (d Decimal128) Pad(scale int) Decimal128 {
+	scale = min(scale, MaxScale128, MaxPrec128-d.Prec()+d.Scale())
+	if scale <= d.Scale() {
+		return d
+	}
+	coef, ok := d.coef.lsh(scale - d.Scale())
+	if !ok {
+		return d // Should never happen
+	}
+	return newUnsafe128(d.IsNeg(), coef, scale)
+}
+
+// Trim returns a decimal with trailing zeros removed up to the given number of
+// digits after the decimal point.
+// If the given scale is negative, it is redefined to zero.
+// See also method [Decimal128.Pad].
+func (d Decimal128) Trim(scale int) Decimal128 {
+	if d.Scale() <= scale {
+		return d
+	}
+	scale = max(scale, d.MinScale())
+	return d.Trunc(scale)
+}
+
+// Add returns the (possibly rounded) sum of decimals d and e.
+//
+// Add returns an error if the integer part of the result has more than
+// [MaxPrec128] digits.
+func (d Decimal128) Add(e Decimal128) (Decimal128, error) {
+	return d.AddExact(e, 0)
+}
+
+// AddExact is similar to [Decimal128.Add], but it allows you to specify the number of digits
+// after the decimal point that should be considered significant.
+// If any of the significant digits are lost during rounding, the method will return an error.
+func (d Decimal128) AddExact(e Decimal128, scale int) (Decimal128, error) {
+	return d.AddExactMode(e, scale, HalfEven)
+}
+
+// AddExactMode is similar to [Decimal128.AddExact], but it allows you to specify
+// the rounding mode that is used if the result has to be rounded.
+func (d Decimal128) AddExactMode(e Decimal128, scale int, mode RoundingMode) (Decimal128, error) {
+	f, err := d.addExactMode(e, scale, mode)
+	if err != nil {
+		return Decimal128{}, newOpError128("add", scale, err, d, e)
+	}
+	return f, nil
+}
+
+// Sub returns the (possibly rounded) difference between decimals d and e.
+//
+// Sub returns an error if the integer part of the result has more than
+// [MaxPrec128] digits.
+func (d Decimal128) Sub(e Decimal128) (Decimal128, error) {
+	return d.SubExact(e, 0)
+}
+
+// SubExact is similar to [Decimal128.Sub], but it allows you to specify the number of digits
+// after the decimal point that should be considered significant.
+// If any of the significant digits are lost during rounding, the method will return an error.
+func (d Decimal128) SubExact(e Decimal128, scale int) (Decimal128, error) {
+	return d.SubExactMode(e, scale, HalfEven)
+}
+
+// SubExactMode is similar to [Decimal128.SubExact], but it allows you to specify
+// the rounding mode that is used if the result has to be rounded.
+func (d Decimal128) SubExactMode(e Decimal128, scale int, mode RoundingMode) (Decimal128, error) {
+	f, err := d.addExactMode(e.Neg(), scale, mode)
+	if err != nil {
+		return Decimal128{}, newOpError128("sub", scale, err, d, e)
+	}
+	return f, nil
+}
+
+// addExactMode computes d + e without wrapping errors,
+// so that it can be shared by [Decimal128.AddExactMode] and [Decimal128.SubExactMode].
+func (d Decimal128) addExactMode(e Decimal128, scale int, mode RoundingMode) (Decimal128, error) {
+	if scale < MinScale || scale > MaxScale128 {
+		return Decimal128{}, ErrScaleRange
+	}
+	if !mode.valid() {
+		return Decimal128{}, ErrModeRange
+	}
+
+	// General case
+	f, err := d.addDint(e, scale, mode)
+	if err != nil {
+		f, err = d.addBint(e, scale, mode)
+		if err != nil {
+			return Decimal128{}, err
+		}
+	}
+	return f, nil
+}
+
+// addDint computes the sum of two decimals using 128-bit arithmetic.
+func (d Decimal128) addDint(e Decimal128, minScale int, mode RoundingMode) (Decimal128, error) {
+	dcoef := d.coef
+	dscale := d.Scale()
+	dneg := d.IsNeg()
+
+	ecoef := e.coef
+
+	// Alignment
+	var ok bool
+	switch {
+	case dscale > e.Scale():
+		ecoef, ok = ecoef.lsh(dscale - e.Scale())
+		if !ok {
+			return Decimal128{}, ErrOverflow
+		}
+	case dscale < e.Scale():
+		dcoef, ok = dcoef.lsh(e.Scale() - dscale)
+		if !ok {
+			return Decimal128{}, ErrOverflow
+		}
+		dscale = e.Scale()
+	}
+
+	// Compute d = d + e
+	if dneg == e.IsNeg() {
+		dcoef, ok = dcoef.add(ecoef)
+		if !ok {
+			return Decimal128{}, ErrOverflow
+		}
+	} else {
+		if ecoef.cmp(dcoef) > 0 {
+			dneg = e.IsNeg()
+		}
+		dcoef = dcoef.subAbs(ecoef)
+	}
+
+	return newFromDint(dneg, dcoef, dscale, minScale, mode)
+}
+
+// addBint computes the sum of two decimals using *big.Int arithmetic.
+func (d Decimal128) addBint(e Decimal128, minScale int, mode RoundingMode) (Decimal128, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setDint(d.coef)
+	dscale := d.Scale()
+	dneg := d.IsNeg()
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setDint(e.coef)
+
+	// Alignment
+	switch {
+	case dscale > e.Scale():
+		ecoef.lsh(ecoef, dscale-e.Scale())
+	case dscale < e.Scale():
+		dcoef.lsh(dcoef, e.Scale()-dscale)
+		dscale = e.Scale()
+	}
+
+	// Compute d = d + e
+	if dneg == e.IsNeg() {
+		dcoef.add(dcoef, ecoef)
+	} else {
+		if ecoef.cmp(dcoef) > 0 {
+			dneg = e.IsNeg()
+		}
+		dcoef.subAbs(dcoef, ecoef)
+	}
+
+	return newFromBint128(dneg, dcoef, dscale, minScale, mode)
+}
+
+// Mul returns the (possibly rounded) product of decimals d and e.
+//
+// Mul returns an overflow error if the integer part of the result has
+// more than [MaxPrec128] digits.
+func (d Decimal128) Mul(e Decimal128) (Decimal128, error) {
+	return d.MulExact(e, 0)
+}
+
+// MulExact is similar to [Decimal128.Mul], but it allows you to specify the number
+// of digits after the decimal point that should be considered significant.
+// If any of the significant digits are lost during rounding, the method will
+// return an overflow error.
+func (d Decimal128) MulExact(e Decimal128, scale int) (Decimal128, error) {
+	return d.MulExactMode(e, scale, HalfEven)
+}
+
+// MulExactMode is similar to [Decimal128.MulExact], but it allows you to specify
+// the rounding mode that is used if the result has to be rounded.
+func (d Decimal128) MulExactMode(e Decimal128, scale int, mode RoundingMode) (Decimal128, error) {
+	if scale < MinScale || scale > MaxScale128 {
+		return Decimal128{}, newOpError128("mul", scale, ErrScaleRange, d, e)
+	}
+	if !mode.valid() {
+		return Decimal128{}, newOpError128("mul", scale, ErrModeRange, d, e)
+	}
+
+	// General case
+	f, err := d.mulDint(e, scale, mode)
+	if err != nil {
+		f, err = d.mulBint(e, scale, mode)
+		if err != nil {
+			return Decimal128{}, newOpError128("mul", scale, err, d, e)
+		}
+	}
+	return f, nil
+}
+
+// mulDint computes the product of two decimals using 128-bit arithmetic.
+func (d Decimal128) mulDint(e Decimal128, minScale int, mode RoundingMode) (Decimal128, error) {
+	dcoef := d.coef
+	dscale := d.Scale()
+	dneg := d.IsNeg()
+
+	ecoef := e.coef
+
+	// Compute d = d * e
+	dcoef, ok := dcoef.mul(ecoef)
+	if !ok {
+		return Decimal128{}, ErrOverflow
+	}
+	dscale = dscale + e.Scale()
+	dneg = dneg != e.IsNeg()
+
+	return newFromDint(dneg, dcoef, dscale, minScale, mode)
+}
+
+// mulBint computes the product of two decimals using *big.Int arithmetic.
+func (d Decimal128) mulBint(e Decimal128, minScale int, mode RoundingMode) (Decimal128, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setDint(d.coef)
+	dscale := d.Scale()
+	dneg := d.IsNeg()
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setDint(e.coef)
+
+	// Compute d = d * e
+	dcoef.mul(dcoef, ecoef)
+	dneg = dneg != e.IsNeg()
+	dscale = dscale + e.Scale()
+
+	return newFromBint128(dneg, dcoef, dscale, minScale, mode)
+}
+
+// Quo returns the (possibly rounded) quotient of decimals d and e.
+//
+// Quo returns an error if:
+//   - the divisor is 0;
+//   - the integer part of the result has more than [MaxPrec128] digits.
+func (d Decimal128) Quo(e Decimal128) (Decimal128, error) {
+	return d.QuoExact(e, 0)
+}
+
+// QuoExact is similar to [Decimal128.Quo], but it allows you to specify the number of digits
+// after the decimal point that should be considered significant.
+// If any of the significant digits are lost during rounding, the method will return an error.
+func (d Decimal128) QuoExact(e Decimal128, scale int) (Decimal128, error) {
+	return d.QuoExactMode(e, scale, HalfEven)
+}
+
+// QuoExactMode is similar to [Decimal128.QuoExact], but it allows you to specify
+// the rounding mode that is used if the result has to be rounded.
+func (d Decimal128) QuoExactMode(e Decimal128, scale int, mode RoundingMode) (Decimal128, error) {
+	if scale < MinScale || scale > MaxScale128 {
+		return Decimal128{}, newOpError128("quo", scale, ErrScaleRange, d, e)
+	}
+	if !mode.valid() {
+		return Decimal128{}, newOpError128("quo", scale, ErrModeRange, d, e)
+	}
+
+	// Special case: zero divisor
+	if e.IsZero() {
+		return Decimal128{}, newOpError128("quo", scale, ErrDivisionByZero, d, e)
+	}
+
+	// Special case: zero dividend
+	if d.IsZero() {
+		scale = max(scale, d.Scale()-e.Scale())
+		return newSafe128(false, dint{}, scale)
+	}
+
+	// General case
+	f, err := d.quoDint(e, scale, mode)
+	if err != nil {
+		f, err = d.quoBint(e, scale, mode)
+		if err != nil {
+			return Decimal128{}, newOpError128("quo", scale, err, d, e)
+		}
+	}
+
+	// Preferred scale
+	scale = max(scale, d.Scale()-e.Scale())
+	f = f.Trim(scale)
+
+	return f, nil
+}
+
+// quoDint computes the quotient of two decimals using 128-bit arithmetic.
+func (d Decimal128) quoDint(e Decimal128, minScale int, mode RoundingMode) (Decimal128, error) {
+	dcoef := d.coef
+	dscale := d.Scale()
+	dneg := d.IsNeg()
+
+	ecoef := e.coef
+
+	// Alignment
+	var ok bool
+	if shift := MaxPrec128 - dcoef.prec(); shift > 0 {
+		dcoef, ok = dcoef.lsh(shift)
+		if !ok {
+			return Decimal128{}, ErrOverflow // Should never happen
+		}
+		dscale = dscale + shift
+	}
+	if shift := ecoef.ntz(); shift > 0 {
+		ecoef = ecoef.rshMode(shift, Down, false)
+		dscale = dscale + shift
+	}
+
+	// Compute d = d / e
+	dcoef, ok = dcoef.quo(ecoef)
+	if !ok {
+		return Decimal128{}, ErrInexactDivision
+	}
+	dscale = dscale - e.Scale()
+	dneg = dneg != e.IsNeg()
+
+	return newFromDint(dneg, dcoef, dscale, minScale, mode)
+}
+
+// quoBint computes the quotient of two decimals using *big.Int arithmetic.
+func (d Decimal128) quoBint(e Decimal128, minScale int, mode RoundingMode) (Decimal128, error) {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setDint(d.coef)
+	dneg := d.IsNeg()
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setDint(e.coef)
+
+	rcoef := getBint()
+	defer putBint(rcoef)
+
+	// Alignment
+	dcoef.lsh(dcoef, 2*MaxScale128+e.Scale()-d.Scale())
+
+	// Compute d = ⌊d / e⌋
+	dcoef.quoRem(dcoef, ecoef, rcoef)
+	dneg = dneg != e.IsNeg()
+
+	// Sticky digit ensures that an inexact quotient is never mistaken
+	// for an exact one or for a tie during rounding.
+	dcoef.fsa(dcoef, 1, sticky(rcoef))
+
+	return newFromBint128(dneg, dcoef, 2*MaxScale128+1, minScale, mode)
+}
+
+// Cmp compares decimals and returns:
+//
+//	-1 if d < e
+//	 0 if d = e
+//	+1 if d > e
+//
+// See also methods [Decimal128.Equal], [Decimal128.Less].
+func (d Decimal128) Cmp(e Decimal128) int {
+	// Special case: different signs
+	switch {
+	case d.Sign() > e.Sign():
+		return 1
+	case d.Sign() < e.Sign():
+		return -1
+	}
+
+	// General case
+	r, err := d.cmpDint(e)
+	if err != nil {
+		r = d.cmpBint(e)
+	}
+	return r
+}
+
+// cmpDint compares decimals using 128-bit arithmetic.
+func (d Decimal128) cmpDint(e Decimal128) (int, error) {
+	dcoef := d.coef
+	ecoef := e.coef
+
+	// Alignment
+	var ok bool
+	switch {
+	case d.Scale() > e.Scale():
+		ecoef, ok = ecoef.lsh(d.Scale() - e.Scale())
+		if !ok {
+			return 0, ErrOverflow
+		}
+	case d.Scale() < e.Scale():
+		dcoef, ok = dcoef.lsh(e.Scale() - d.Scale())
+		if !ok {
+			return 0, ErrOverflow
+		}
+	}
+
+	// Comparison
+	switch dcoef.cmp(ecoef) {
+	case 1:
+		return d.Sign(), nil
+	case -1:
+		return -e.Sign(), nil
+	}
+	return 0, nil
+}
+
+// cmpBint compares decimals using *big.Int arithmetic.
+func (d Decimal128) cmpBint(e Decimal128) int {
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.setDint(d.coef)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setDint(e.coef)
+
+	// Alignment
+	switch {
+	case d.Scale() > e.Scale():
+		ecoef.lsh(ecoef, d.Scale()-e.Scale())
+	case d.Scale() < e.Scale():
+		dcoef.lsh(dcoef, e.Scale()-d.Scale())
+	}
+
+	// Comparison
+	switch dcoef.cmp(ecoef) {
+	case 1:
+		return d.Sign()
+	case -1:
+		return -e.Sign()
+	}
+	return 0
+}
+
+// Equal compares decimals and returns:
+//
+//	 true if d = e
+//	false otherwise
+//
+// See also method [Decimal128.Cmp].
+func (d Decimal128) Equal(e Decimal128) bool {
+	return d.Cmp(e) == 0
+}
+
+// Less compares decimals and returns:
+//
+//	 true if d < e
+//	false otherwise
+//
+// See also method [Decimal128.Cmp].
+func (d Decimal128) Less(e Decimal128) bool {
+	return d.Cmp(e) < 0
+}
@@ -0,0 +1,869 @@
+package decimal
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"unsafe"
+)
+
+func TestDecimal128_ZeroValue(t *testing.T) {
+	got := Decimal128{}
+	want := MustNewDecimal128(0, 0)
+	if got != want {
+		t.Errorf("Decimal128{} = %q, want %q", got, want)
+	}
+}
+
+func TestDecimal128_Size(t *testing.T) {
+	d := Decimal128{}
+	got := unsafe.Sizeof(d)
+	want := uintptr(24)
+	if got != want {
+		t.Errorf("unsafe.Sizeof(%q) = %v, want %v", d, got, want)
+	}
+}
+
+func TestDecimal128_Interfaces(t *testing.T) {
+	var d any
+
+	d = Decimal128{}
+	_, ok := d.(fmt.Stringer)
+	if !ok {
+		t.Errorf("%T does not implement fmt.Stringer", d)
+	}
+	_, ok = d.(fmt.Formatter)
+	if !ok {
+		t.Errorf("%T does not implement fmt.Formatter", d)
+	}
+	_, ok = d.(encoding.TextMarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.TextMarshaler", d)
+	}
+	_, ok = d.(encoding.BinaryMarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.BinaryMarshaler", d)
+	}
+	_, ok = d.(json.Marshaler)
+	if !ok {
+		t.Errorf("%T does not implement json.Marshaler", d)
+	}
+	_, ok = d.(driver.Valuer)
+	if !ok {
+		t.Errorf("%T does not implement driver.Valuer", d)
+	}
+
+	d = &Decimal128{}
+	_, ok = d.(encoding.TextUnmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.TextUnmarshaler", d)
+	}
+	_, ok = d.(encoding.BinaryUnmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.BinaryUnmarshaler", d)
+	}
+	_, ok = d.(json.Unmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement json.Unmarshaler", d)
+	}
+	_, ok = d.(sql.Scanner)
+	if !ok {
+		t.Errorf("%T does not implement sql.Scanner", d)
+	}
+}
+
+func TestNewDecimal128(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			coef  int64
+			scale int
+			want  string
+		}{
+			{0, 0, "0"},
+			{0, 38, "0.00000000000000000000000000000000000000"},
+			{1, 38, "0.00000000000000000000000000000000000001"},
+			{-1, 0, "-1"},
+			{math.MaxInt64, 0, "9223372036854775807"},
+			{math.MinInt64, 0, "-9223372036854775808"},
+			{math.MinInt64, 38, "-0.00000000000000000009223372036854775808"},
+		}
+		for _, tt := range tests {
+			got, err := NewDecimal128(tt.coef, tt.scale)
+			if err != nil {
+				t.Errorf("NewDecimal128(%v, %v) failed: %v", tt.coef, tt.scale, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("NewDecimal128(%v, %v) = %q, want %q", tt.coef, tt.scale, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			coef  int64
+			scale int
+		}{
+			"scale range 1": {0, -1},
+			"scale range 2": {0, MaxScale128 + 1},
+		}
+		for _, tt := range tests {
+			_, err := NewDecimal128(tt.coef, tt.scale)
+			if !errors.Is(err, ErrScaleRange) {
+				t.Errorf("NewDecimal128(%v, %v) did not fail with ErrScaleRange", tt.coef, tt.scale)
+			}
+		}
+	})
+}
+
+func TestNewDecimal128FromString(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s    string
+			want string
+		}{
+			{"0", "0"},
+			{"-0", "0"},
+			{"+1.50", "1.50"},
+			{".5", "0.5"},
+			{"5.", "5"},
+			{"123456789.123456789123456789", "123456789.123456789123456789"},
+			{"99999999999999999999999999999999999999", "99999999999999999999999999999999999999"},
+			{"-9999999999999999999.9999999999999999999", "-9999999999999999999.9999999999999999999"},
+			{"0.00000000000000000000000000000000000001", "0.00000000000000000000000000000000000001"},
+			{"0.000000000000000000000000000000000000005", "0.00000000000000000000000000000000000000"},
+			{"0.000000000000000000000000000000000000015", "0.00000000000000000000000000000000000002"},
+			{"1.000000000000000000000000000000000000005", "1.0000000000000000000000000000000000000"},
+			{"1.000000000000000000000000000000000000015", "1.0000000000000000000000000000000000000"},
+			{"1.00000000000000000000000000000000000015", "1.0000000000000000000000000000000000002"},
+			{"1e37", "10000000000000000000000000000000000000"},
+			{"1.5e-38", "0.00000000000000000000000000000000000002"},
+			{"12345678901234567890123456789012345678e-38", "0.12345678901234567890123456789012345678"},
+		}
+		for _, tt := range tests {
+			got, err := NewDecimal128FromString(tt.s)
+			if err != nil {
+				t.Errorf("NewDecimal128FromString(%q) failed: %v", tt.s, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("NewDecimal128FromString(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			s       string
+			scale   int
+			wantErr error
+		}{
+			"empty":         {"", 0, ErrInvalidDecimal},
+			"sign":          {"-", 0, ErrInvalidDecimal},
+			"character":     {"1.2x", 0, ErrInvalidDecimal},
+			"exponent":      {"1e", 0, ErrInvalidDecimal},
+			"overflow 1":    {"100000000000000000000000000000000000000", 0, ErrOverflow},
+			"overflow 2":    {"99999999999999999999999999999999999999.5", 0, ErrOverflow},
+			"overflow 3":    {"1e38", 0, ErrOverflow},
+			"overflow 4":    {"1", MaxScale128, ErrOverflow},
+			"scale range 1": {"1", -1, ErrScaleRange},
+			"scale range 2": {"1", MaxScale128 + 1, ErrScaleRange},
+		}
+		for _, tt := range tests {
+			_, err := NewDecimal128FromStringExact(tt.s, tt.scale)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewDecimal128FromStringExact(%q, %v) = %v, want %v", tt.s, tt.scale, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestDecimal128_Decimal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0", "0"},
+			{"-1.50", "-1.50"},
+			{"9999999999999999999", "9999999999999999999"},
+			{"0.0000000000000000001", "0.0000000000000000001"},
+			{"0.00000000000000000005", "0.0000000000000000000"},
+			{"0.00000000000000000015", "0.0000000000000000002"},
+			{"1.23456789012345678901234567890", "1.234567890123456789"},
+			{"-12345678901234567.8950", "-12345678901234567.90"},
+		}
+		for _, tt := range tests {
+			d := MustNewDecimal128FromString(tt.d)
+			got, err := d.Decimal()
+			if err != nil {
+				t.Errorf("%q.Decimal() failed: %v", d, err)
+				continue
+			}
+			want := MustNewFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Decimal() = %q, want %q", d, got, want)
+			}
+			if got.Decimal128().Cmp(d.Round(got.Scale())) != 0 {
+				t.Errorf("%q.Decimal().Decimal128() = %q, want %q", d, got.Decimal128(), d.Round(got.Scale()))
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{
+			"10000000000000000000",
+			"-99999999999999999999999999999999999999",
+		}
+		for _, tt := range tests {
+			d := MustNewDecimal128FromString(tt)
+			_, err := d.Decimal()
+			if !errors.Is(err, ErrOverflow) {
+				t.Errorf("%q.Decimal() = %v, want %v", d, err, ErrOverflow)
+			}
+		}
+	})
+}
+
+func TestDecimal_Decimal128(t *testing.T) {
+	tests := []string{
+		"0",
+		"0.000",
+		"-1.5",
+		"9999999999999999999",
+		"-0.9999999999999999999",
+	}
+	for _, tt := range tests {
+		d := MustNewFromString(tt)
+		got := d.Decimal128()
+		if got.String() != d.String() && got.String() != tt {
+			t.Errorf("%q.Decimal128() = %q, want %q", d, got, tt)
+		}
+		e, err := got.Decimal()
+		if err != nil || e != d {
+			t.Errorf("%q.Decimal128().Decimal() = %q, %v, want %q, nil", d, e, err, d)
+		}
+	}
+}
+
+func TestDecimal128_Format(t *testing.T) {
+	tests := []struct {
+		d, format, want string
+	}{
+		{"0", "%v", "0"},
+		{"0.00", "%v", "0.00"},
+		{"-12345678901234567890.123456789", "%v", "-12345678901234567890.123456789"},
+		{"12345678901234567890.123456789", "%.2f", "12345678901234567890.12"},
+		{"12345678901234567890.125", "%.2f", "12345678901234567890.12"},
+		{"0.5", "%.0f", "0"},
+		{"1.5", "%.0f", "2"},
+		{"1", "%.3f", "1.000"},
+		{"0.12345", "%k", "12.345%"},
+		{"0.12345", "%.1k", "12.3%"},
+		{"1.5", "%q", "\"1.5\""},
+		{"1.5", "%+v", "+1.5"},
+		{"1.5", "% v", " 1.5"},
+		{"1.5", "%8v", "     1.5"},
+		{"1.5", "%-8v|", "1.5     |"},
+		{"-1.5", "%08v", "-00001.5"},
+		{"1.5", "%d", "%!d(decimal.Decimal128=1.5)"},
+	}
+	for _, tt := range tests {
+		d := MustNewDecimal128FromString(tt.d)
+		got := fmt.Sprintf(tt.format, d)
+		if got != tt.want {
+			t.Errorf("fmt.Sprintf(%q, %q) = %q, want %q", tt.format, d, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal128_Marshaling(t *testing.T) {
+	tests := []string{
+		"0",
+		"-0.00",
+		"1",
+		"-1.50",
+		"99999999999999999999999999999999999999",
+		"-0.00000000000000000000000000000000000001",
+		"123456789012345678901.23456789",
+	}
+	for _, tt := range tests {
+		d := MustNewDecimal128FromString(tt)
+
+		// Text
+		text, err := d.MarshalText()
+		if err != nil {
+			t.Errorf("%q.MarshalText() failed: %v", d, err)
+			continue
+		}
+		var got Decimal128
+		if err := got.UnmarshalText(text); err != nil || got != d {
+			t.Errorf("UnmarshalText(%q) = %q, %v, want %q", text, got, err, d)
+		}
+
+		// JSON
+		data, err := json.Marshal(d)
+		if err != nil {
+			t.Errorf("json.Marshal(%q) failed: %v", d, err)
+			continue
+		}
+		if want := "\"" + d.String() + "\""; string(data) != want {
+			t.Errorf("json.Marshal(%q) = %s, want %s", d, data, want)
+		}
+		got = Decimal128{}
+		if err := json.Unmarshal(data, &got); err != nil || got != d {
+			t.Errorf("json.Unmarshal(%s) = %q, %v, want %q", data, got, err, d)
+		}
+
+		// Binary
+		data, err = d.MarshalBinary()
+		if err != nil {
+			t.Errorf("%q.MarshalBinary() failed: %v", d, err)
+			continue
+		}
+		got = Decimal128{}
+		if err := got.UnmarshalBinary(data); err != nil || got != d {
+			t.Errorf("UnmarshalBinary(% x) = %q, %v, want %q", data, got, err, d)
+		}
+
+		// SQL
+		value, err := d.Value()
+		if err != nil {
+			t.Errorf("%q.Value() failed: %v", d, err)
+			continue
+		}
+		got = Decimal128{}
+		if err := got.Scan(value); err != nil || got != d {
+			t.Errorf("Scan(%v) = %q, %v, want %q", value, got, err, d)
+		}
+	}
+}
+
+func TestDecimal128_BCD(t *testing.T) {
+	tests := []string{
+		"0",
+		"-1.50",
+		"1234",
+		"9999999999999999999",
+		"-0.9999999999999999999",
+	}
+	for _, tt := range tests {
+		d := MustNewFromString(tt)
+		want := d.bcd()
+		got := d.Decimal128().bcd()
+		if string(got) != string(want) {
+			t.Errorf("%q.Decimal128().bcd() = % x, want % x", d, got, want)
+		}
+	}
+}
+
+func TestDecimal128_UnmarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s, want string
+		}{
+			{`"1.50"`, "1.50"},
+			{`1.50`, "1.50"},
+			{`1e-3`, "0.001"},
+			{`null`, "7"},
+		}
+		for _, tt := range tests {
+			got := MustNewDecimal128(7, 0)
+			if err := got.UnmarshalJSON([]byte(tt.s)); err != nil {
+				t.Errorf("UnmarshalJSON(%s) failed: %v", tt.s, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("UnmarshalJSON(%s) = %q, want %q", tt.s, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{`""`, `"1.5`, `true`, `{}`}
+		for _, tt := range tests {
+			var got Decimal128
+			if err := got.UnmarshalJSON([]byte(tt)); err == nil {
+				t.Errorf("UnmarshalJSON(%s) did not fail", tt)
+			}
+		}
+	})
+}
+
+func TestDecimal128_Round(t *testing.T) {
+	tests := []struct {
+		d     string
+		scale int
+		mode  RoundingMode
+		want  string
+	}{
+		{"1.25", 1, HalfEven, "1.2"},
+		{"1.35", 1, HalfEven, "1.4"},
+		{"1.25", 1, HalfUp, "1.3"},
+		{"-1.25", 1, HalfDown, "-1.2"},
+		{"-1.21", 1, Ceiling, "-1.2"},
+		{"-1.21", 1, Floor, "-1.3"},
+		{"1.01", 1, ZeroFiveUp, "1.1"},
+		{"0.99999999999999999999999999999999999999", 37, HalfEven, "1.0000000000000000000000000000000000000"},
+		{"0.99999999999999999999999999999999999999", 0, Down, "0"},
+		{"0.00000000000000000000000000000000000001", 0, Up, "1"},
+		{"1.5", 3, HalfEven, "1.5"},
+		{"1.5", -1, HalfEven, "2"},
+	}
+	for _, tt := range tests {
+		d := MustNewDecimal128FromString(tt.d)
+		got := d.RoundMode(tt.scale, tt.mode)
+		if got.String() != tt.want {
+			t.Errorf("%q.RoundMode(%v, %v) = %q, want %q", d, tt.scale, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal128_Pad_Trim(t *testing.T) {
+	tests := []struct {
+		d        string
+		scale    int
+		wantPad  string
+		wantTrim string
+	}{
+		{"0", 2, "0.00", "0"},
+		{"1.500", 2, "1.500", "1.50"},
+		{"1.5", 38, "1.5000000000000000000000000000000000000", "1.5"},
+		{"10.00", 0, "10.00", "10"},
+	}
+	for _, tt := range tests {
+		d := MustNewDecimal128FromString(tt.d)
+		if got := d.Pad(tt.scale); got.String() != tt.wantPad {
+			t.Errorf("%q.Pad(%v) = %q, want %q", d, tt.scale, got, tt.wantPad)
+		}
+		if got := d.Trim(tt.scale); got.String() != tt.wantTrim {
+			t.Errorf("%q.Trim(%v) = %q, want %q", d, tt.scale, got, tt.wantTrim)
+		}
+	}
+}
+
+func TestDecimal128_Add(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e, want string
+		}{
+			{"1", "1", "2"},
+			{"5.75", "3.3", "9.05"},
+			{"-7", "2.5", "-4.5"},
+			{"0.7", "0.3", "1.0"},
+			{"9999999999999999999", "1", "10000000000000000000"},
+			{"18446744073709551615", "1", "18446744073709551616"},
+			{"99999999999999999999999999999999999998", "1", "99999999999999999999999999999999999999"},
+			{"99999999999999999999999999999999999999", "0.4", "99999999999999999999999999999999999999"},
+			{"1", "-99999999999999999999999999999999999999", "-99999999999999999999999999999999999998"},
+			{"1", "0.00000000000000000000000000000000000001", "1.0000000000000000000000000000000000000"},
+			{"1", "0.00000000000000000000000000000000000005", "1.0000000000000000000000000000000000000"},
+			{"1", "0.00000000000000000000000000000000000015", "1.0000000000000000000000000000000000002"},
+			{"0", "0.000", "0.000"},
+		}
+		for _, tt := range tests {
+			d := MustNewDecimal128FromString(tt.d)
+			e := MustNewDecimal128FromString(tt.e)
+			got, err := d.Add(e)
+			if err != nil {
+				t.Errorf("%q.Add(%q) failed: %v", d, e, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%q.Add(%q) = %q, want %q", d, e, got, tt.want)
+			}
+			got, err = got.Sub(e)
+			if err != nil {
+				t.Errorf("%q.Sub(%q) failed: %v", d, e, err)
+				continue
+			}
+			if got.Cmp(d) != 0 && got.Prec() < MaxPrec128 {
+				t.Errorf("%q.Add(%q).Sub(%q) = %q, want %q", d, e, e, got, d)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e    string
+			scale   int
+			mode    RoundingMode
+			wantErr error
+		}{
+			"overflow 1": {"99999999999999999999999999999999999999", "1", 0, HalfEven, ErrOverflow},
+			"overflow 2": {"99999999999999999999999999999999999999", "0.5", 0, HalfEven, ErrOverflow},
+			"overflow 3": {"-99999999999999999999999999999999999999", "-0.5", 0, HalfUp, ErrOverflow},
+			"scale 1":    {"1", "1", MaxScale128, HalfEven, ErrOverflow},
+			"scale 2":    {"0", "0", MaxScale128 + 1, HalfEven, ErrScaleRange},
+			"mode":       {"0", "0", 0, RoundingMode(-1), ErrModeRange},
+		}
+		for _, tt := range tests {
+			d := MustNewDecimal128FromString(tt.d)
+			e := MustNewDecimal128FromString(tt.e)
+			_, err := d.AddExactMode(e, tt.scale, tt.mode)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%q.AddExactMode(%q, %v, %v) = %v, want %v", d, e, tt.scale, tt.mode, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestDecimal128_Mul(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e, want string
+		}{
+			{"2", "3", "6"},
+			{"-1.5", "2", "-3.0"},
+			{"0.1", "0.1", "0.01"},
+			{"9999999999999999999", "9999999999999999999", "99999999999999999980000000000000000001"},
+			{"1000000000000000000", "1000000000000000000", "1000000000000000000000000000000000000"},
+			{"0.0000000000000000001", "0.0000000000000000001", "0.00000000000000000000000000000000000001"},
+			{"0.0000000000000000001", "0.00000000000000000005", "0.00000000000000000000000000000000000000"},
+			{"0.0000000000000000001", "0.00000000000000000015", "0.00000000000000000000000000000000000002"},
+			{"1.00000000000000000001", "1.00000000000000000001", "1.0000000000000000000200000000000000000"},
+			{"12345678901234567890.123", "1000", "12345678901234567890123.000"},
+		}
+		for _, tt := range tests {
+			d := MustNewDecimal128FromString(tt.d)
+			e := MustNewDecimal128FromString(tt.e)
+			got, err := d.Mul(e)
+			if err != nil {
+				t.Errorf("%q.Mul(%q) failed: %v", d, e, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%q.Mul(%q) = %q, want %q", d, e, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e    string
+			scale   int
+			wantErr error
+		}{
+			"overflow 1": {"10000000000000000000", "10000000000000000000", 0, ErrOverflow},
+			"overflow 2": {"99999999999999999999999999999999999999", "1.1", 0, ErrOverflow},
+			"overflow 3": {"1", "1", MaxScale128, ErrOverflow},
+			"scale 1":    {"1", "1", MaxScale128 + 1, ErrScaleRange},
+		}
+		for _, tt := range tests {
+			d := MustNewDecimal128FromString(tt.d)
+			e := MustNewDecimal128FromString(tt.e)
+			_, err := d.MulExact(e, tt.scale)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%q.MulExact(%q, %v) = %v, want %v", d, e, tt.scale, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestDecimal128_Quo(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e string
+			mode RoundingMode
+			want string
+		}{
+			{"6", "3", HalfEven, "2"},
+			{"1", "4", HalfEven, "0.25"},
+			{"1.00", "4", HalfEven, "0.25"},
+			{"1.000", "4", HalfEven, "0.250"},
+			{"1", "3", HalfEven, "0.33333333333333333333333333333333333333"},
+			{"2", "3", HalfEven, "0.66666666666666666666666666666666666667"},
+			{"2", "3", Down, "0.66666666666666666666666666666666666666"},
+			{"-2", "3", Floor, "-0.66666666666666666666666666666666666667"},
+			{"-2", "3", Ceiling, "-0.66666666666666666666666666666666666666"},
+			{"10", "3", HalfEven, "3.3333333333333333333333333333333333333"},
+			{"99999999999999999999999999999999999999", "1", HalfEven, "99999999999999999999999999999999999999"},
+			{"49999999999999999999999999999999999999", "0.5", HalfEven, "99999999999999999999999999999999999998"},
+			{"1", "99999999999999999999999999999999999999", HalfEven, "0.00000000000000000000000000000000000001"},
+			{"1", "19999999999999999999999999999999999999", HalfEven, "0.00000000000000000000000000000000000005"},
+			{"1", "39999999999999999999999999999999999999", HalfEven, "0.00000000000000000000000000000000000003"},
+			{"0.000", "7", HalfEven, "0.000"},
+		}
+		for _, tt := range tests {
+			d := MustNewDecimal128FromString(tt.d)
+			e := MustNewDecimal128FromString(tt.e)
+			got, err := d.QuoExactMode(e, 0, tt.mode)
+			if err != nil {
+				t.Errorf("%q.QuoExactMode(%q, 0, %v) failed: %v", d, e, tt.mode, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("%q.QuoExactMode(%q, 0, %v) = %q, want %q", d, e, tt.mode, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e    string
+			scale   int
+			wantErr error
+		}{
+			"zero 1":     {"1", "0", 0, ErrDivisionByZero},
+			"zero 2":     {"0", "0.000", 0, ErrDivisionByZero},
+			"overflow 1": {"99999999999999999999999999999999999999", "0.1", 0, ErrOverflow},
+			"overflow 2": {"10", "3", MaxScale128, ErrOverflow},
+			"scale 1":    {"1", "1", MaxScale128 + 1, ErrScaleRange},
+		}
+		for _, tt := range tests {
+			d := MustNewDecimal128FromString(tt.d)
+			e := MustNewDecimal128FromString(tt.e)
+			_, err := d.QuoExact(e, tt.scale)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%q.QuoExact(%q, %v) = %v, want %v", d, e, tt.scale, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestDecimal128_Cmp(t *testing.T) {
+	tests := []struct {
+		d, e string
+		want int
+	}{
+		{"0", "0.000", 0},
+		{"1", "1.0", 0},
+		{"-1", "1", -1},
+		{"1", "-1", 1},
+		{"-2", "-1", -1},
+		{"0.00000000000000000000000000000000000001", "0", 1},
+		{"99999999999999999999999999999999999999", "0.00000000000000000000000000000000000001", 1},
+		{"-99999999999999999999999999999999999999", "-0.00000000000000000000000000000000000001", -1},
+		{"1.00000000000000000000000000000000000000", "1", 0},
+	}
+	for _, tt := range tests {
+		d := MustNewDecimal128FromString(tt.d)
+		e := MustNewDecimal128FromString(tt.e)
+		got := d.Cmp(e)
+		if got != tt.want {
+			t.Errorf("%q.Cmp(%q) = %v, want %v", d, e, got, tt.want)
+		}
+		if d.Equal(e) != (tt.want == 0) || d.Less(e) != (tt.want < 0) {
+			t.Errorf("%q.Equal(%q) or %q.Less(%q) is inconsistent with Cmp", d, e, d, e)
+		}
+	}
+}
+
+func newDecimal128Fuzz(neg bool, scale int, hi, lo uint64) (Decimal128, error) {
+	return newSafe128(neg, dint{hi: hi % (maxDint.hi + 1), lo: lo}, scale)
+}
+
+func FuzzDecimal128_String_Parse(f *testing.F) {
+	for _, d := range corpus {
+		f.Add(d.neg, d.scale, uint64(0), d.coef)
+		f.Add(d.neg, d.scale+19, uint64(d.scale), d.coef)
+	}
+
+	f.Fuzz(
+		func(t *testing.T, neg bool, scale int, hi, lo uint64) {
+			d, err := newDecimal128Fuzz(neg, scale, hi, lo)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			s := d.String()
+			got, err := NewDecimal128FromString(s)
+			if err != nil {
+				t.Errorf("NewDecimal128FromString(%q) failed: %v", s, err)
+				return
+			}
+			if got != d {
+				t.Errorf("NewDecimal128FromString(%q) = %q, want %q", s, got, d)
+			}
+
+			b := d.bcd()
+			got, err = parseBCD128(b)
+			if err != nil {
+				t.Errorf("parseBCD128(% x) failed: %v", b, err)
+				return
+			}
+			if got != d {
+				t.Errorf("parseBCD128(% x) = %q, want %q", b, got, d)
+			}
+		},
+	)
+}
+
+func FuzzDecimal128_Add(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, uint64(0), d.coef, e.neg, e.scale+19, uint64(e.scale), e.coef, 0)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dhi, dlo uint64, eneg bool, escale int, ehi, elo uint64, scale int) {
+			d, err := newDecimal128Fuzz(dneg, dscale, dhi, dlo)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newDecimal128Fuzz(eneg, escale, ehi, elo)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			got, err := d.addDint(e, scale, HalfEven)
+			if err != nil {
+				switch {
+				case errors.Is(err, ErrOverflow):
+					t.Skip() // Decimal overflow is an expected error in fast addition
+				case errors.Is(err, ErrScaleRange):
+					t.Skip() // Scale range is an expected error in fast addition
+				default:
+					t.Errorf("addDint(%q, %q, %v) failed: %v", d, e, scale, err)
+				}
+				return
+			}
+
+			want, err := d.addBint(e, scale, HalfEven)
+			if err != nil {
+				t.Errorf("addBint(%q, %q, %v) failed: %v", d, e, scale, err)
+				return
+			}
+
+			if got != want {
+				t.Errorf("addBint(%q, %q, %v) = %q, whereas addDint(%q, %q, %v) = %q", d, e, scale, want, d, e, scale, got)
+			}
+		},
+	)
+}
+
+func FuzzDecimal128_Mul(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, uint64(0), d.coef, e.neg, e.scale, uint64(0), e.coef, 0)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dhi, dlo uint64, eneg bool, escale int, ehi, elo uint64, scale int) {
+			d, err := newDecimal128Fuzz(dneg, dscale, dhi, dlo)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newDecimal128Fuzz(eneg, escale, ehi, elo)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			got, err := d.mulDint(e, scale, HalfEven)
+			if err != nil {
+				switch {
+				case errors.Is(err, ErrOverflow):
+					t.Skip() // Decimal overflow is an expected error in fast multiplication
+				case errors.Is(err, ErrScaleRange):
+					t.Skip() // Scale range is an expected error in fast multiplication
+				default:
+					t.Errorf("mulDint(%q, %q, %v) failed: %v", d, e, scale, err)
+				}
+				return
+			}
+
+			want, err := d.mulBint(e, scale, HalfEven)
+			if err != nil {
+				t.Errorf("mulBint(%q, %q, %v) failed: %v", d, e, scale, err)
+				return
+			}
+
+			if got != want {
+				t.Errorf("mulBint(%q, %q, %v) = %q, whereas mulDint(%q, %q, %v) = %q", d, e, scale, want, d, e, scale, got)
+			}
+		},
+	)
+}
+
+func FuzzDecimal128_Quo(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, uint64(0), d.coef, e.neg, e.scale, uint64(0), e.coef, 0)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dhi, dlo uint64, eneg bool, escale int, ehi, elo uint64, scale int) {
+			d, err := newDecimal128Fuzz(dneg, dscale, dhi, dlo)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newDecimal128Fuzz(eneg, escale, ehi, elo)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			got, err := d.quoDint(e, scale, HalfEven)
+			if err != nil {
+				switch {
+				case errors.Is(err, ErrOverflow):
+					t.Skip() // Decimal overflow is an expected error in fast division
+				case errors.Is(err, ErrInexactDivision):
+					t.Skip() // Inexact division is an expected error in fast division
+				case errors.Is(err, ErrScaleRange):
+					t.Skip() // Scale range is an expected error in fast division
+				default:
+					t.Errorf("quoDint(%q, %q, %v) failed: %v", d, e, scale, err)
+				}
+				return
+			}
+
+			want, err := d.quoBint(e, scale, HalfEven)
+			if err != nil {
+				t.Errorf("quoBint(%q, %q, %v) failed: %v", d, e, scale, err)
+				return
+			}
+
+			if got.Cmp(want) != 0 {
+				t.Errorf("quoBint(%q, %q, %v) = %q, whereas quoDint(%q, %q, %v) = %q", d, e, scale, want, d, e, scale, got)
+			}
+		},
+	)
+}
+
+func FuzzDecimal128_Cmp(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, uint64(0), d.coef, e.neg, e.scale+19, uint64(e.scale), e.coef)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dhi, dlo uint64, eneg bool, escale int, ehi, elo uint64) {
+			d, err := newDecimal128Fuzz(dneg, dscale, dhi, dlo)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newDecimal128Fuzz(eneg, escale, ehi, elo)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			got, err := d.cmpDint(e)
+			if err != nil {
+				t.Skip() // Decimal overflow is an expected error in fast comparison
+				return
+			}
+
+			want := d.cmpBint(e)
+			if got != want {
+				t.Errorf("cmpBint(%q, %q) = %v, whereas cmpDint(%q, %q) = %v", d, e, want, d, e, got)
+			}
+		},
+	)
+}
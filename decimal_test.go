@@ -5,10 +5,14 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"slices"
 	"testing"
 	"unsafe"
 )
@@ -690,6 +694,276 @@ func TestDecimal_BCD(t *testing.T) {
 	})
 }
 
+func TestDecimal_AvroBytes(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			scale int
+			mode  RoundingMode
+			want  []byte
+		}{
+			{"0", 0, HalfEven, []byte{0x00}},
+			{"0", 4, HalfEven, []byte{0x00}},
+			{"0.00000", 4, HalfEven, []byte{0x00}},
+			{"1", 0, HalfEven, []byte{0x01}},
+			{"-1", 0, HalfEven, []byte{0xff}},
+			{"0.0127", 4, HalfEven, []byte{0x7f}},
+			{"0.0128", 4, HalfEven, []byte{0x00, 0x80}},
+			{"-0.0128", 4, HalfEven, []byte{0x80}},
+			{"-0.0129", 4, HalfEven, []byte{0xff, 0x7f}},
+			{"0.0255", 4, HalfEven, []byte{0x00, 0xff}},
+			{"1.5", 4, HalfEven, []byte{0x3a, 0x98}},
+			{"-1.5", 4, HalfEven, []byte{0xc5, 0x68}},
+			{"1234.56", 4, HalfEven, []byte{0x00, 0xbc, 0x61, 0x00}},
+			{"9999999999999999999", 4, HalfEven, []byte{0x15, 0x2d, 0x02, 0xc7, 0xe1, 0x4a, 0xf6, 0x7f, 0xd8, 0xf0}},
+			{"-9999999999999999999", 4, HalfEven, []byte{0xea, 0xd2, 0xfd, 0x38, 0x1e, 0xb5, 0x09, 0x80, 0x27, 0x10}},
+			{"1", 20, HalfEven, []byte{0x05, 0x6b, 0xc7, 0x5e, 0x2d, 0x63, 0x10, 0x00, 0x00}},
+			{"-1", 20, HalfEven, []byte{0xfa, 0x94, 0x38, 0xa1, 0xd2, 0x9c, 0xf0, 0x00, 0x00}},
+
+			// Rounding
+			{"1.23455", 4, HalfEven, []byte{0x30, 0x3a}},
+			{"1.23455", 4, HalfUp, []byte{0x30, 0x3a}},
+			{"1.23455", 4, HalfDown, []byte{0x30, 0x39}},
+			{"1.23451", 4, Up, []byte{0x30, 0x3a}},
+			{"1.23459", 4, Down, []byte{0x30, 0x39}},
+			{"-1.23455", 4, HalfEven, []byte{0xcf, 0xc6}},
+			{"-1.23459", 4, Down, []byte{0xcf, 0xc7}},
+			{"-1.23451", 4, Floor, []byte{0xcf, 0xc6}},
+			{"-1.23459", 4, Ceiling, []byte{0xcf, 0xc7}},
+			{"0.00005", 4, HalfEven, []byte{0x00}},
+			{"-0.00005", 4, Up, []byte{0xff}},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := d.AvroBytesMode(tt.scale, tt.mode)
+			if err != nil {
+				t.Errorf("%q.AvroBytesMode(%v, %v) failed: %v", d, tt.scale, tt.mode, err)
+				continue
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("%q.AvroBytesMode(%v, %v) = % x, want % x", d, tt.scale, tt.mode, got, tt.want)
+			}
+			if tt.mode == HalfEven {
+				got, err = d.AvroBytes(tt.scale)
+				if err != nil {
+					t.Errorf("%q.AvroBytes(%v) failed: %v", d, tt.scale, err)
+					continue
+				}
+				if !bytes.Equal(got, tt.want) {
+					t.Errorf("%q.AvroBytes(%v) = % x, want % x", d, tt.scale, got, tt.want)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d     string
+			scale int
+			mode  RoundingMode
+			want  error
+		}{
+			"scale 1": {"1", -1, HalfEven, ErrScaleRange},
+			"mode 1":  {"1", 4, -1, ErrModeRange},
+			"mode 2":  {"1", 4, ZeroFiveUp + 1, ErrModeRange},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := RequireFromString(tt.d)
+				_, err := d.AvroBytesMode(tt.scale, tt.mode)
+				if !errors.Is(err, tt.want) {
+					t.Errorf("%q.AvroBytesMode(%v, %v) = %v, want %v", d, tt.scale, tt.mode, err, tt.want)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_AvroFixed(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d           string
+			scale, size int
+			want        []byte
+		}{
+			{"0", 4, 1, []byte{0x00}},
+			{"0", 4, 4, []byte{0x00, 0x00, 0x00, 0x00}},
+			{"-0.0001", 4, 4, []byte{0xff, 0xff, 0xff, 0xff}},
+			{"0.0128", 4, 2, []byte{0x00, 0x80}},
+			{"-0.0128", 4, 1, []byte{0x80}},
+			{"-0.0128", 4, 3, []byte{0xff, 0xff, 0x80}},
+			{"-1.5", 4, 8, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xc5, 0x68}},
+			{"9999999999999999999", 4, 10, []byte{0x15, 0x2d, 0x02, 0xc7, 0xe1, 0x4a, 0xf6, 0x7f, 0xd8, 0xf0}},
+			{"-9999999999999999999", 4, 12, []byte{0xff, 0xff, 0xea, 0xd2, 0xfd, 0x38, 0x1e, 0xb5, 0x09, 0x80, 0x27, 0x10}},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := d.AvroFixed(tt.scale, tt.size)
+			if err != nil {
+				t.Errorf("%q.AvroFixed(%v, %v) failed: %v", d, tt.scale, tt.size, err)
+				continue
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("%q.AvroFixed(%v, %v) = % x, want % x", d, tt.scale, tt.size, got, tt.want)
+			}
+			got, err = d.AvroFixedMode(tt.scale, tt.size, HalfEven)
+			if err != nil {
+				t.Errorf("%q.AvroFixedMode(%v, %v, %v) failed: %v", d, tt.scale, tt.size, HalfEven, err)
+				continue
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("%q.AvroFixedMode(%v, %v, %v) = % x, want % x", d, tt.scale, tt.size, HalfEven, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d           string
+			scale, size int
+			mode        RoundingMode
+			want        error
+		}{
+			"overflow 1": {"0.0128", 4, 1, HalfEven, ErrOverflow},
+			"overflow 2": {"-0.0129", 4, 1, HalfEven, ErrOverflow},
+			"overflow 3": {"9999999999999999999", 4, 9, HalfEven, ErrOverflow},
+			"overflow 4": {"0.01275", 4, 1, Up, ErrOverflow},
+			"scale 1":    {"1", -1, 4, HalfEven, ErrScaleRange},
+			"mode 1":     {"1", 4, 4, ZeroFiveUp + 1, ErrModeRange},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := RequireFromString(tt.d)
+				_, err := d.AvroFixedMode(tt.scale, tt.size, tt.mode)
+				if !errors.Is(err, tt.want) {
+					t.Errorf("%q.AvroFixedMode(%v, %v, %v) = %v, want %v", d, tt.scale, tt.size, tt.mode, err, tt.want)
+				}
+				var opErr *OpError
+				if !errors.As(err, &opErr) || opErr.Op != "encodeavro" {
+					t.Errorf("%q.AvroFixedMode(%v, %v, %v) = %#v, want *OpError", d, tt.scale, tt.size, tt.mode, err)
+				}
+			})
+		}
+	})
+}
+
+func TestFromAvroBytes(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b     []byte
+			scale int
+			want  string
+		}{
+			{[]byte{0x00}, 0, "0"},
+			{[]byte{0x00}, 4, "0.0000"},
+			{[]byte{0x00, 0x00, 0x00}, 4, "0.0000"},
+			{[]byte{0x01}, 0, "1"},
+			{[]byte{0xff}, 0, "-1"},
+			{[]byte{0xff, 0xff, 0xff}, 0, "-1"},
+			{[]byte{0x7f}, 4, "0.0127"},
+			{[]byte{0x00, 0x80}, 4, "0.0128"},
+			{[]byte{0x80}, 4, "-0.0128"},
+			{[]byte{0xff, 0x7f}, 4, "-0.0129"},
+			{[]byte{0x3a, 0x98}, 4, "1.5000"},
+			{[]byte{0xc5, 0x68}, 4, "-1.5000"},
+			{[]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xc5, 0x68}, 4, "-1.5000"},
+			{[]byte{0x15, 0x2d, 0x02, 0xc7, 0xe1, 0x4a, 0xf6, 0x7f, 0xd8, 0xf0}, 4, "9999999999999999999"},
+			{[]byte{0xea, 0xd2, 0xfd, 0x38, 0x1e, 0xb5, 0x09, 0x80, 0x27, 0x10}, 4, "-9999999999999999999"},
+			{[]byte{0x05, 0x6b, 0xc7, 0x5e, 0x2d, 0x63, 0x10, 0x00, 0x00}, 20, "1.000000000000000000"},
+			{[]byte{0xfa, 0x94, 0x38, 0xa1, 0xd2, 0x9c, 0xf0, 0x00, 0x00}, 20, "-1.000000000000000000"},
+			{[]byte{0x0f}, 20, "0.0000000000000000002"},
+			{[]byte{0x19}, 20, "0.0000000000000000002"},
+			{[]byte{0x00}, 25, "0.0000000000000000000"},
+		}
+		for _, tt := range tests {
+			got, err := FromAvroBytes(tt.b, tt.scale)
+			if err != nil {
+				t.Errorf("FromAvroBytes(% x, %v) failed: %v", tt.b, tt.scale, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("FromAvroBytes(% x, %v) = %q, want %q", tt.b, tt.scale, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			b     []byte
+			scale int
+			want  error
+		}{
+			"empty":      {[]byte{}, 0, ErrInvalidDecimal},
+			"nil":        {nil, 0, ErrInvalidDecimal},
+			"scale 1":    {[]byte{0x01}, -1, ErrScaleRange},
+			"overflow 1": {[]byte{0x00, 0x8a, 0xc7, 0x23, 0x04, 0x89, 0xe8, 0x00, 0x00}, 0, ErrOverflow},
+			"overflow 2": {[]byte{0xff, 0x75, 0x38, 0xdc, 0xfb, 0x76, 0x18, 0x00, 0x00}, 0, ErrOverflow},
+			"overflow 3": {[]byte{0x15, 0x2d, 0x02, 0xc7, 0xe1, 0x4a, 0xf6, 0x7f, 0xd8, 0xf0}, 3, ErrOverflow},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := FromAvroBytes(tt.b, tt.scale)
+				if !errors.Is(err, tt.want) {
+					t.Errorf("FromAvroBytes(% x, %v) = %v, want %v", tt.b, tt.scale, err, tt.want)
+				}
+				var opErr *OpError
+				if !errors.As(err, &opErr) || opErr.Op != "decodeavro" {
+					t.Errorf("FromAvroBytes(% x, %v) = %#v, want *OpError", tt.b, tt.scale, err)
+				}
+			})
+		}
+	})
+}
+
+func TestFromAvroFixed(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b           []byte
+			scale, size int
+			want        string
+		}{
+			{[]byte{0x00, 0x00, 0x00, 0x00}, 4, 4, "0.0000"},
+			{[]byte{0xff, 0xff, 0xff, 0x80}, 4, 4, "-0.0128"},
+			{[]byte{0x00, 0x00, 0x00, 0x80}, 2, 4, "1.28"},
+		}
+		for _, tt := range tests {
+			got, err := FromAvroFixed(tt.b, tt.scale, tt.size)
+			if err != nil {
+				t.Errorf("FromAvroFixed(% x, %v, %v) failed: %v", tt.b, tt.scale, tt.size, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("FromAvroFixed(% x, %v, %v) = %q, want %q", tt.b, tt.scale, tt.size, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			b           []byte
+			scale, size int
+		}{
+			"size 1": {[]byte{0x00, 0x80}, 4, 4},
+			"size 2": {[]byte{0x00, 0x00, 0x00, 0x00, 0x80}, 4, 4},
+			"size 3": {[]byte{}, 4, 0},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := FromAvroFixed(tt.b, tt.scale, tt.size)
+				if !errors.Is(err, ErrInvalidDecimal) {
+					t.Errorf("FromAvroFixed(% x, %v, %v) = %v, want %v", tt.b, tt.scale, tt.size, err, ErrInvalidDecimal)
+				}
+				var opErr *OpError
+				if !errors.As(err, &opErr) || opErr.Op != "decodeavro" {
+					t.Errorf("FromAvroFixed(% x, %v, %v) = %#v, want *OpError", tt.b, tt.scale, tt.size, err)
+				}
+			})
+		}
+	})
+}
+
 func TestDecimal_Float64(t *testing.T) {
 	tests := []struct {
 		d         string
@@ -1419,6 +1693,72 @@ func TestDecimal_Round(t *testing.T) {
 	}
 }
 
+func TestDecimal_RoundMode(t *testing.T) {
+	tests := []struct {
+		d    string
+		want [8]string // HalfEven, HalfUp, HalfDown, Up, Down, Ceiling, Floor, ZeroFiveUp
+	}{
+		// Tests from Java
+		{"5.5", [8]string{"6", "6", "5", "6", "5", "6", "5", "6"}},
+		{"2.5", [8]string{"2", "3", "2", "3", "2", "3", "2", "2"}},
+		{"1.6", [8]string{"2", "2", "2", "2", "1", "2", "1", "1"}},
+		{"1.1", [8]string{"1", "1", "1", "2", "1", "2", "1", "1"}},
+		{"1.0", [8]string{"1", "1", "1", "1", "1", "1", "1", "1"}},
+		{"-1.0", [8]string{"-1", "-1", "-1", "-1", "-1", "-1", "-1", "-1"}},
+		{"-1.1", [8]string{"-1", "-1", "-1", "-2", "-1", "-1", "-2", "-1"}},
+		{"-1.6", [8]string{"-2", "-2", "-2", "-2", "-1", "-1", "-2", "-1"}},
+		{"-2.5", [8]string{"-2", "-3", "-2", "-3", "-2", "-2", "-3", "-2"}},
+		{"-5.5", [8]string{"-6", "-6", "-5", "-6", "-5", "-5", "-6", "-6"}},
+
+		// Some extra tests
+		{"0", [8]string{"0", "0", "0", "0", "0", "0", "0", "0"}},
+		{"0.5", [8]string{"0", "1", "0", "1", "0", "1", "0", "1"}},
+		{"-0.5", [8]string{"0", "-1", "0", "-1", "0", "0", "-1", "-1"}},
+		{"0.0000000000000000001", [8]string{"0", "0", "0", "1", "0", "1", "0", "1"}},
+		{"-0.0000000000000000001", [8]string{"0", "0", "0", "-1", "0", "0", "-1", "-1"}},
+		{"10.5000000000000000", [8]string{"10", "11", "10", "11", "10", "11", "10", "11"}},
+		{"10.5000000000000001", [8]string{"11", "11", "11", "11", "10", "11", "10", "11"}},
+		{"9999999999999999.999", [8]string{"10000000000000000", "10000000000000000", "10000000000000000", "10000000000000000", "9999999999999999", "10000000000000000", "9999999999999999", "9999999999999999"}},
+	}
+	modes := [...]RoundingMode{HalfEven, HalfUp, HalfDown, Up, Down, Ceiling, Floor, ZeroFiveUp}
+	for _, tt := range tests {
+		d := RequireFromString(tt.d)
+		for i, mode := range modes {
+			got := d.RoundMode(0, mode)
+			want := RequireFromString(tt.want[i])
+			if got != want {
+				t.Errorf("%q.RoundMode(0, %v) = %q, want %q", d, mode, got, want)
+			}
+		}
+	}
+
+	t.Run("scale", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			scale int
+			mode  RoundingMode
+			want  string
+		}{
+			{"1.2345", -1, Up, "2"},
+			{"1.2345", 2, Up, "1.24"},
+			{"1.2345", 3, HalfUp, "1.235"},
+			{"1.2345", 3, HalfDown, "1.234"},
+			{"1.2345", 3, HalfEven, "1.234"},
+			{"1.2345", 4, Up, "1.2345"},
+			{"1.2345", 5, Up, "1.2345"},
+			{"1.2345", 2, RoundingMode(100), "1.23"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got := d.RoundMode(tt.scale, tt.mode)
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.RoundMode(%v, %v) = %q, want %q", d, tt.scale, tt.mode, got, want)
+			}
+		}
+	})
+}
+
 func TestDecimal_Trunc(t *testing.T) {
 	tests := []struct {
 		d     string
@@ -2175,6 +2515,61 @@ func TestDecimal_Mul(t *testing.T) {
 	})
 }
 
+func TestDecimal_MulExactMode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e  string
+			scale int
+			mode  RoundingMode
+			want  string
+		}{
+			{"2", "3", 0, Up, "6"},
+			{"0.0000000001", "0.00000000015", 0, HalfEven, "0.0000000000000000000"},
+			{"0.0000000001", "0.00000000015", 0, HalfUp, "0.0000000000000000000"},
+			{"0.0000000001", "0.00000000015", 0, Up, "0.0000000000000000001"},
+			{"0.000000001", "0.00000000015", 0, HalfEven, "0.0000000000000000002"},
+			{"0.000000001", "0.00000000015", 0, HalfDown, "0.0000000000000000001"},
+			{"-0.000000001", "0.00000000015", 0, Floor, "-0.0000000000000000002"},
+			{"-0.000000001", "0.00000000015", 0, Ceiling, "-0.0000000000000000001"},
+			{"9999999999.999999999", "1.5", 0, Down, "14999999999.99999999"},
+			{"9999999999.999999999", "1.5", 0, Up, "15000000000.00000000"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			e := RequireFromString(tt.e)
+			got, err := d.MulExactMode(e, tt.scale, tt.mode)
+			if err != nil {
+				t.Errorf("%q.MulExactMode(%q, %v, %v) failed: %v", d, e, tt.scale, tt.mode, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.MulExactMode(%q, %v, %v) = %q, want %q", d, e, tt.scale, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e  string
+			scale int
+			mode  RoundingMode
+		}{
+			"overflow 1": {"9999999999999999999", "10", 0, Down},
+			"scale 1":    {"1", "1", MaxScale + 1, Down},
+			"mode 1":     {"1", "1", 0, RoundingMode(-1)},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			e := RequireFromString(tt.e)
+			_, err := d.MulExactMode(e, tt.scale, tt.mode)
+			if err == nil {
+				t.Errorf("%q.MulExactMode(%q, %v, %v) did not fail", d, e, tt.scale, tt.mode)
+			}
+		}
+	})
+}
+
 func TestDecimal_AddMul(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -2718,53 +3113,240 @@ func TestDecimal_PowInt(t *testing.T) {
 	})
 }
 
-func TestDecimal_Sqrt(t *testing.T) {
+func TestDecimal_PowDec(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
-			d, want string
+			d, e, want string
 		}{
 			// Zeros
-			{"0.00000000", "0.0000"},
-			{"0.0000000", "0.000"},
-			{"0.000000", "0.000"},
-			{"0.00000", "0.00"},
-			{"0.0000", "0.00"},
-			{"0.000", "0.0"},
-			{"0.00", "0.0"},
-			{"0.0", "0"},
-			{"0", "0"},
+			{"0", "0", "1"},
+			{"0", "0.0", "1"},
+			{"0", "0.5", "0"},
+			{"0.00", "2.5", "0"},
+			{"5", "0.000", "1"},
+			{"-5", "0", "1"},
 
-			// Trailing zeros
-			{"0.010000000", "0.1000"},
-			{"0.01000000", "0.1000"},
-			{"0.0100000", "0.100"},
-			{"0.010000", "0.100"},
-			{"0.01000", "0.10"},
-			{"0.0100", "0.10"},
-			{"0.010", "0.1"},
-			{"0.01", "0.1"},
+			// Ones
+			{"1", "0.5", "1"},
+			{"1", "-123.456", "1"},
+			{"1.000", "0.5", "1"},
+
+			// Integer powers
+			{"2", "10", "1024"},
+			{"2", "10.00", "1024"},
+			{"0.00", "3", "0.000000"},
+			{"-2", "3", "-8"},
+			{"-2", "3.0", "-8"},
+			{"-2", "-2", "0.25"},
+			{"1.1", "2", "1.21"},
+			{"0.85", "-267", "7000786514887173012"},
+			{"-1", "9999999999999999999", "-1"},
+			{"-1", "9999999999999999998", "1"},
+
+			// Exact results
+			{"4", "0.5", "2"},
+			{"9", "0.5", "3"},
+			{"0.25", "1.5", "0.125"},
+			{"16", "0.25", "2"},
+			{"16", "-0.25", "0.5"},
+
+			// Non-integer powers
+			{"2", "0.5", "1.414213562373095049"},
+			{"1.05", "0.5", "1.024695076595959838"},
+			{"1.05", "0.2465753424657534247", "1.012103108392931327"},
+			{"10", "-0.5", "0.3162277660168379332"},
+			{"0.5", "0.5", "0.7071067811865475244"},
+			{"100", "0.25", "3.162277660168379332"},
+			{"7", "1.1", "8.503698308273468576"},
+			{"1.5", "-40.5", "0.0000000738420947502"},
+			{"0.9", "0.1", "0.9895192582062143926"},
+			{"123.456", "2.5", "169348.1684832596518"},
+			{"1.000001", "1000000.5", "2.718281828459271759"},
+			{"2", "62.5", "6521908912666391106"},
+			{"2", "-62.5", "0.0000000000000000002"},
+			{"0.1", "19.5", "0"},
+			{"1.0001", "-0.0001", "0.9999999900005000167"},
+			{"0.0000000000000000001", "0.5", "0.000000000316227766"},
+			{"9999999999999999999", "0.5", "3162277660.168379332"},
+			{"9999999999999999999", "-0.5", "0.000000000316227766"},
+			{"1.1", "-400.5", "0.0000000000000000264"},
+			{"2", "0.0000000000000000001", "1"},
+			{"0.5", "-0.9999999999999999999", "2"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			e := RequireFromString(tt.e)
+			got, err := d.PowDec(e)
+			if err != nil {
+				t.Errorf("%q.PowDec(%q) failed: %v", d, e, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.PowDec(%q) = %q, want %q", d, e, got, want)
+			}
+		}
+	})
 
-			// Powers of ten
-			{"0.00000001", "0.0001"},
-			{"0.0000001", "0.0003162277660168379"},
-			{"0.000001", "0.001"},
-			{"0.00001", "0.0031622776601683793"},
-			{"0.0001", "0.01"},
-			{"0.001", "0.0316227766016837933"},
-			{"0.01", "0.1"},
-			{"0.1", "0.3162277660168379332"},
-			{"1", "1"},
-			{"10", "3.162277660168379332"},
-			{"100", "10"},
-			{"1000", "31.62277660168379332"},
-			{"10000", "100"},
-			{"100000", "316.2277660168379332"},
-			{"1000000", "1000"},
-			{"10000000", "3162.277660168379332"},
-			{"100000000", "10000"},
+	t.Run("mode", func(t *testing.T) {
+		// Exact results must not be rounded in any direction
+		tests := []struct {
+			d, e, want string
+		}{
+			{"4", "0.5", "2"},
+			{"1.21", "0.5", "1.1"},
+			{"0.25", "1.5", "0.125"},
+			{"16", "-0.25", "0.5"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			e := RequireFromString(tt.e)
+			want := RequireFromString(tt.want)
+			for _, mode := range []RoundingMode{Down, Up, Floor, Ceiling, HalfUp, HalfDown, HalfEven, ZeroFiveUp} {
+				got, err := d.powDec(e, 0, mode)
+				if err != nil {
+					t.Errorf("%q.powDec(%q, 0, %v) failed: %v", d, e, mode, err)
+					continue
+				}
+				if got != want {
+					t.Errorf("%q.powDec(%q, 0, %v) = %q, want %q", d, e, mode, got, want)
+				}
+			}
+		}
+	})
 
-			// Natural numbers
-			{"0", "0"},
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e    string
+			wantErr error
+		}{
+			"overflow 1": {"2", "63.5", ErrOverflow},
+			"overflow 2": {"2", "64", ErrOverflow},
+			"overflow 3": {"0.5", "-64.5", ErrOverflow},
+			"overflow 4": {"9999999999999999999", "1.5", ErrOverflow},
+			"overflow 5": {"10", "1000.5", ErrOverflow},
+			"zero 1":     {"0", "-1", ErrInvalidOperation},
+			"zero 2":     {"0", "-0.5", ErrInvalidOperation},
+			"negative 1": {"-2", "0.5", ErrInvalidOperation},
+			"negative 2": {"-0.001", "-1.5", ErrInvalidOperation},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := RequireFromString(tt.d)
+				e := RequireFromString(tt.e)
+				_, err := d.PowDec(e)
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("%q.PowDec(%q) = %v, want %v", d, e, err, tt.wantErr)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_PowDecExact(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e  string
+			scale int
+			want  string
+		}{
+			{"1.05", "0.5", 0, "1.024695076595959838"},
+			{"1.05", "0.5", 2, "1.024695076595959838"},
+			{"4", "0.5", 2, "2.00"},
+			{"4", "0.5", 18, "2.000000000000000000"},
+			{"1.1", "2", 4, "1.2100"},
+			{"2", "-62.5", 19, "0.0000000000000000002"},
+			{"2", "54.5", 0, "25476206690103090.26"},
+			{"2", "54.5", 2, "25476206690103090.26"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			e := RequireFromString(tt.e)
+			got, err := d.PowDecExact(e, tt.scale)
+			if err != nil {
+				t.Errorf("%q.PowDecExact(%q, %v) failed: %v", d, e, tt.scale, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.PowDecExact(%q, %v) = %q, want %q", d, e, tt.scale, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e    string
+			scale   int
+			wantErr error
+		}{
+			"overflow 1": {"2", "54.5", 3, ErrOverflow},
+			"overflow 2": {"10", "18", 2, ErrOverflow},
+			"overflow 3": {"4", "0.5", 19, ErrOverflow},
+			"scale 1":    {"4", "0.5", -1, ErrScaleRange},
+			"scale 2":    {"4", "0.5", MaxScale + 1, ErrScaleRange},
+			"negative":   {"-2", "0.5", 2, ErrInvalidOperation},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := RequireFromString(tt.d)
+				e := RequireFromString(tt.e)
+				_, err := d.PowDecExact(e, tt.scale)
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("%q.PowDecExact(%q, %v) = %v, want %v", d, e, tt.scale, err, tt.wantErr)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_Sqrt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			// Zeros
+			{"0.00000000", "0.0000"},
+			{"0.0000000", "0.000"},
+			{"0.000000", "0.000"},
+			{"0.00000", "0.00"},
+			{"0.0000", "0.00"},
+			{"0.000", "0.0"},
+			{"0.00", "0.0"},
+			{"0.0", "0"},
+			{"0", "0"},
+
+			// Trailing zeros
+			{"0.010000000", "0.1000"},
+			{"0.01000000", "0.1000"},
+			{"0.0100000", "0.100"},
+			{"0.010000", "0.100"},
+			{"0.01000", "0.10"},
+			{"0.0100", "0.10"},
+			{"0.010", "0.1"},
+			{"0.01", "0.1"},
+
+			// Powers of ten
+			{"0.00000001", "0.0001"},
+			{"0.0000001", "0.0003162277660168379"},
+			{"0.000001", "0.001"},
+			{"0.00001", "0.0031622776601683793"},
+			{"0.0001", "0.01"},
+			{"0.001", "0.0316227766016837933"},
+			{"0.01", "0.1"},
+			{"0.1", "0.3162277660168379332"},
+			{"1", "1"},
+			{"10", "3.162277660168379332"},
+			{"100", "10"},
+			{"1000", "31.62277660168379332"},
+			{"10000", "100"},
+			{"100000", "316.2277660168379332"},
+			{"1000000", "1000"},
+			{"10000000", "3162.277660168379332"},
+			{"100000000", "10000"},
+
+			// Natural numbers
+			{"0", "0"},
 			{"1", "1"},
 			{"2", "1.414213562373095049"},
 			{"3", "1.732050807568877294"},
@@ -2864,6 +3446,141 @@ func TestDecimal_Sqrt(t *testing.T) {
 	})
 }
 
+func TestDecimal_Cbrt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			// Zeros
+			{"0", "0"},
+			{"0.0", "0"},
+			{"0.000", "0.0"},
+			{"0.000000", "0.00"},
+
+			// Ones
+			{"1", "1"},
+			{"1.000", "1.0"},
+			{"-1", "-1"},
+			{"-1.000000", "-1.00"},
+
+			// Cubes
+			{"8", "2"},
+			{"-8", "-2"},
+			{"27", "3"},
+			{"-27", "-3"},
+			{"1000", "10"},
+			{"0.001", "0.1"},
+			{"0.008", "0.2"},
+			{"1.331", "1.1"},
+			{"-0.000125", "-0.05"},
+			{"8.000", "2.0"},
+
+			// Natural numbers
+			{"2", "1.259921049894873165"},
+			{"3", "1.442249570307408382"},
+			{"10", "2.154434690031883722"},
+			{"-2", "-1.259921049894873165"},
+
+			// Smallest and largest numbers
+			{"0.0000000000000000001", "0.0000004641588833613"},
+			{"9999999999999999999", "2154434.690031883722"},
+			{"-9999999999999999999", "-2154434.690031883722"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := d.Cbrt()
+			if err != nil {
+				t.Errorf("%q.Cbrt() failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Cbrt() = %q, want %q", d, got, want)
+			}
+		}
+	})
+}
+
+func TestDecimal_Root(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    string
+			n    int
+			want string
+		}{
+			// Zeros
+			{"0", 5, "0"},
+			{"0.0000000000", 5, "0.00"},
+
+			// Ones
+			{"1", 1, "1"},
+			{"1", 1000, "1"},
+			{"-1", 1001, "-1"},
+
+			// First roots
+			{"1.23", 1, "1.23"},
+			{"-1.23", 1, "-1.23"},
+
+			// Exact roots
+			{"4", 2, "2"},
+			{"16", 4, "2"},
+			{"-32", 5, "-2"},
+			{"0.0081", 4, "0.3"},
+			{"1.21", 2, "1.1"},
+			{"9223372036854775808", 63, "2"},
+			{"-9223372036854775808", 63, "-2"},
+			{"1853020188851841", 32, "3"},
+
+			// Inexact roots
+			{"2", 2, "1.414213562373095049"},
+			{"2", 5, "1.148698354997035007"},
+			{"0.7", 7, "0.9503227992486908979"},
+			{"123456789", 9, "7.926057519414248162"},
+			{"2", 64, "1.01088928605170046"},
+			{"0.5", 64, "0.9892280131939754841"},
+			{"-0.5", 101, "-0.9931606521582534078"},
+
+			// Smallest and largest numbers
+			{"0.0000000000000000001", 100, "0.6456542290346555159"},
+			{"9999999999999999999", 100, "1.548816618912481345"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := d.Root(tt.n)
+			if err != nil {
+				t.Errorf("%q.Root(%v) failed: %v", d, tt.n, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Root(%v) = %q, want %q", d, tt.n, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d string
+			n int
+		}{
+			"zero index":     {"2", 0},
+			"negative index": {"2", -3},
+			"even root 1":    {"-1", 2},
+			"even root 2":    {"-16", 4},
+			"even root 3":    {"-0.0000000000000000001", 100},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := RequireFromString(tt.d)
+				_, err := d.Root(tt.n)
+				if err == nil {
+					t.Errorf("%q.Root(%v) did not fail", d, tt.n)
+				}
+			})
+		}
+	})
+}
+
 func TestDecimal_Exp(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -3174,6 +3891,67 @@ func TestDecimal_Exp(t *testing.T) {
 	})
 }
 
+func TestDecimal_Expm1(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0", "0"},
+			{"0.000", "0"},
+			{"0.0000000000000000001", "0.0000000000000000001"},
+			{"0.0000000001", "0.0000000001"},
+			{"0.0001", "0.0001000050001666708"},
+			{"0.1", "0.1051709180756476248"},
+			{"0.5", "0.6487212707001281468"},
+			{"1", "1.718281828459045235"},
+			{"2", "6.389056098930650227"},
+			{"10", "22025.46579480671652"},
+			{"43", "4727839468229346560"},
+			{"-0.0000000000000000001", "-0.0000000000000000001"},
+			{"-0.0000000001", "-0.0000000001"},
+			{"-0.1", "-0.0951625819640404268"},
+			{"-0.5", "-0.3934693402873665764"},
+			{"-1", "-0.6321205588285576784"},
+			{"-10", "-0.9999546000702375151"},
+			{"-44", "-0.9999999999999999999"},
+			{"-45", "-1"},
+			{"-100", "-1"},
+			{"-9999999999999999999", "-1"},
+			{"0.6931471805599453094", "1"},
+			{"0.1234567890123456789", "0.1314011145262015187"},
+		}
+
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := d.Expm1()
+			if err != nil {
+				t.Errorf("%q.Expm1() failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Expm1() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"overflow 1": "44",
+			"overflow 2": "50",
+		}
+		for name, d := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := RequireFromString(d)
+				_, err := d.Expm1()
+				if err == nil {
+					t.Errorf("%q.Expm1() did not fail", d)
+				}
+			})
+		}
+	})
+}
+
 func TestDecimal_Log(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
@@ -3341,827 +4119,2527 @@ func TestDecimal_Log(t *testing.T) {
 	})
 }
 
-func TestDecimal_Abs(t *testing.T) {
-	tests := []struct {
-		d, want string
-	}{
-		{"1", "1"},
-		{"-1", "1"},
-		{"1.00", "1.00"},
-		{"-1.00", "1.00"},
-		{"0", "0"},
-		{"0.0", "0.0"},
-		{"0.00", "0.00"},
-	}
-	for _, tt := range tests {
-		d := RequireFromString(tt.d)
-		got := d.Abs()
-		want := RequireFromString(tt.want)
-		if got != want {
-			t.Errorf("%q.Abs() = %q, want %q", d, got, want)
+func TestDecimal_Log1p(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0", "0"},
+			{"0.000", "0"},
+			{"0.0000000000000000001", "0.0000000000000000001"},
+			{"0.000000000000000001", "0.000000000000000001"},
+			{"0.0000000001", "0.0000000001"},
+			{"0.0001", "0.0000999950003333083"},
+			{"0.05", "0.0487901641694320031"},
+			{"0.1", "0.09531017980432486"},
+			{"0.5", "0.405465108108164382"},
+			{"1", "0.6931471805599453094"},
+			{"2", "1.098612288668109691"},
+			{"1.718281828459045235", "0.9999999999999999999"},
+			{"9999999999999999999", "43.749116766886868"},
+			{"-0.0000000000000000001", "-0.0000000000000000001"},
+			{"-0.0000000001", "-0.0000000001"},
+			{"-0.1", "-0.1053605156578263012"},
+			{"-0.5", "-0.6931471805599453094"},
+			{"-0.9999999999999999999", "-43.749116766886868"},
+			{"0.1234567890123456789", "0.116410350855400286"},
+			{"-0.1234567890123456789", "-0.1317692763635177111"},
 		}
-	}
-}
 
-func TestDecimal_CopySign(t *testing.T) {
-	tests := []struct {
-		d, e, want string
-	}{
-		{"10", "1", "10"},
-		{"10", "0", "10"},
-		{"10", "-1", "-10"},
-		{"0", "1", "0"},
-		{"0", "0", "0"},
-		{"0", "-1", "0"},
-		{"-10", "1", "10"},
-		{"-10", "0", "10"},
-		{"-10", "-1", "-10"},
-	}
-	for _, tt := range tests {
-		d := RequireFromString(tt.d)
-		e := RequireFromString(tt.e)
-		got := d.CopySign(e)
-		want := RequireFromString(tt.want)
-		if got != want {
-			t.Errorf("%q.CopySign(%q) = %q, want %q", d, e, got, want)
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := d.Log1p()
+			if err != nil {
+				t.Errorf("%q.Log1p() failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Log1p() = %q, want %q", d, got, want)
+			}
 		}
-	}
-}
+	})
 
-func TestDecimal_Neg(t *testing.T) {
-	tests := []struct {
-		d, want string
-	}{
-		{"1", "-1"},
-		{"-1", "1"},
-		{"1.00", "-1.00"},
-		{"-1.00", "1.00"},
-		{"0", "0"},
-		{"0.0", "0.0"},
-		{"0.00", "0.00"},
-	}
-	for _, tt := range tests {
-		d := RequireFromString(tt.d)
-		got := d.Neg()
-		want := RequireFromString(tt.want)
-		if got != want {
-			t.Errorf("%q.Neg() = %q, want %q", d, got, want)
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"negative one":           "-1",
+			"less than negative one": "-1.5",
 		}
-	}
+		for name, d := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := RequireFromString(d)
+				_, err := d.Log1p()
+				if err == nil {
+					t.Errorf("%q.Log1p() did not fail", d)
+				}
+			})
+		}
+	})
 }
 
-func TestDecimal_Quo(t *testing.T) {
+func TestDecimal_Log2(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
-			d, e, want string
+			d, want string
 		}{
-			// Zeros
-			{"0", "1.000", "0"},
-			{"0.0", "1.000", "0"},
-			{"0.00", "1.000", "0"},
-			{"0.000", "1.000", "0"},
-			{"0.0000", "1.000", "0.0"},
-			{"0.00000", "1.000", "0.00"},
-
-			{"0.000", "1", "0.000"},
-			{"0.000", "1.0", "0.00"},
-			{"0.000", "1.00", "0.0"},
-			{"0.000", "1.000", "0"},
-			{"0.000", "1.0000", "0"},
-			{"0.000", "1.00000", "0"},
-
-			// Ones
-			{"1", "1.000", "1"},
-			{"1.0", "1.000", "1"},
-			{"1.00", "1.000", "1"},
-			{"1.000", "1.000", "1"},
-			{"1.0000", "1.000", "1.0"},
-			{"1.00000", "1.000", "1.00"},
-
-			{"1.000", "1", "1.000"},
-			{"1.000", "1.0", "1.00"},
-			{"1.000", "1.00", "1.0"},
-			{"1.000", "1.000", "1"},
-			{"1.000", "1.0000", "1"},
-			{"1.000", "1.00000", "1"},
-
-			// Simple cases
-			{"1", "1", "1"},
-			{"2", "1", "2"},
-			{"1", "2", "0.5"},
-			{"2", "2", "1"},
-			{"0", "1", "0"},
-			{"0", "2", "0"},
-			{"1.5", "3", "0.5"},
-			{"3", "3", "1"},
-			{"9999999999999999999", "1", "9999999999999999999"},
-			{"9999999999999999999", "9999999999999999999", "1"},
-
-			// Signs
-			{"2.4", "1", "2.4"},
-			{"2.4", "-1", "-2.4"},
-			{"-2.4", "1", "-2.4"},
-			{"-2.4", "-1", "2.4"},
+			{"1", "0"},
+			{"1.000", "0"},
+			{"2", "1"},
+			{"0.5", "-1"},
+			{"0.25", "-2"},
+			{"0.125", "-3"},
+			{"3", "1.584962500721156181"},
+			{"10", "3.321928094887362348"},
+			{"1024", "10"},
+			{"0.001", "-9.965784284662087044"},
+			{"0.1", "-3.321928094887362348"},
+			{"1.5", "0.5849625007211561815"},
+			{"1.000000000000000001", "0.0000000000000000014"},
+			{"9999999999999999999", "63.11663380285988461"},
+			{"0.0000000000000000001", "-63.11663380285988461"},
+		}
 
-			// Scales
-			{"2.40", "1", "2.40"},
-			{"2.400", "1", "2.400"},
-			{"2.4", "2", "1.2"},
-			{"2.400", "2", "1.200"},
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := d.Log2()
+			if err != nil {
+				t.Errorf("%q.Log2() failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Log2() = %q, want %q", d, got, want)
+			}
+		}
+	})
 
-			// 1 divided by digits
-			{"1", "1", "1"},
-			{"1", "2", "0.5"},
-			{"1", "3", "0.3333333333333333333"},
-			{"1", "4", "0.25"},
-			{"1", "5", "0.2"},
-			{"1", "6", "0.1666666666666666667"},
-			{"1", "7", "0.1428571428571428571"},
-			{"1", "8", "0.125"},
-			{"1", "9", "0.1111111111111111111"},
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"negative": "-1",
+			"zero":     "0",
+		}
+		for name, d := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := RequireFromString(d)
+				_, err := d.Log2()
+				if err == nil {
+					t.Errorf("%q.Log2() did not fail", d)
+				}
+			})
+		}
+	})
+}
 
-			// 2 divided by digits
-			{"2", "1", "2"},
-			{"2", "2", "1"},
-			{"2", "3", "0.6666666666666666667"},
-			{"2", "4", "0.5"},
-			{"2", "5", "0.4"},
-			{"2", "6", "0.3333333333333333333"},
-			{"2", "7", "0.2857142857142857143"},
-			{"2", "8", "0.25"},
-			{"2", "9", "0.2222222222222222222"},
+func TestDecimal_Log10(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"1", "0"},
+			{"1.000", "0"},
+			{"10", "1"},
+			{"10.00", "1"},
+			{"100", "2"},
+			{"0.1", "-1"},
+			{"0.0010", "-3"},
+			{"1000000000000000000", "18"},
+			{"0.0000000000000000001", "-19"},
+			{"2", "0.3010299956639811952"},
+			{"3", "0.4771212547196624373"},
+			{"5", "0.6989700043360188048"},
+			{"7", "0.8450980400142568307"},
+			{"20", "1.301029995663981195"},
+			{"0.5", "-0.3010299956639811952"},
+			{"0.3", "-0.5228787452803375627"},
+			{"123.456", "2.091512201627771681"},
+			{"9999999999999999999", "19"},
+			{"0.0000000000000000002", "-18.6989700043360188"},
+			{"1.000000000000000001", "0.0000000000000000004"},
+			{"0.999999999999999999", "-0.0000000000000000004"},
+			{"3.162277660168379332", "0.5"},
+		}
 
-			// 2 divided by 3
-			{"0.0000000000000000002", "3", "0.0000000000000000001"},
-			{"0.0000000000000000002", "3.000000000000000000", "0.0000000000000000001"},
-			{"2", "3", "0.6666666666666666667"},
-			{"2.000000000000000000", "3", "0.6666666666666666667"},
-			{"2", "3.000000000000000000", "0.6666666666666666667"},
-			{"2.000000000000000000", "3.000000000000000000", "0.6666666666666666667"},
-			{"0.0000000000000000002", "0.0000000000000000003", "0.6666666666666666667"},
-			{"2", "0.0000000000000000003", "6666666666666666667"},
-			{"2.000000000000000000", "0.0000000000000000003", "6666666666666666667"},
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := d.Log10()
+			if err != nil {
+				t.Errorf("%q.Log10() failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Log10() = %q, want %q", d, got, want)
+			}
+		}
+	})
 
-			// Interest accrual
-			{"0.0001", "365", "0.0000002739726027397"}, // no error
-			{"0.0001", "366", "0.0000002732240437158"}, // no error
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"negative": "-1",
+			"zero":     "0",
+		}
+		for name, d := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := RequireFromString(d)
+				_, err := d.Log10()
+				if err == nil {
+					t.Errorf("%q.Log10() did not fail", d)
+				}
+			})
+		}
+	})
+}
 
-			// Captured during fuzzing
-			{"9223372036854775807", "-9223372036854775808", "-0.9999999999999999999"},
-			{"0.000000000000000001", "20", "0.000000000000000000"},
-			{"105", "0.999999999999999990", "105.0000000000000011"},
-			{"0.05", "999999999999999954", "0.0000000000000000001"},
-			{"9.99999999999999998", "185", "0.0540540540540540539"},
-			{"7", "2.000000000000000002", "3.499999999999999997"},
-			{"0.000000009", "999999999999999999", "0.000000000"},
-			{"0.0000000000000000001", "9999999999999999999", "0.0000000000000000000"},
-			{"9999999999999999999", "2", "5000000000000000000"},
-			{"9999999999999999999", "5000000000000000000", "2"},
-			{"1.000000000000000049", "-99.9999999999999924", "-0.0100000000000000013"},
+func TestDecimal_LogBase(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, b, want string
+		}{
+			{"1", "2", "0"},
+			{"1.000", "10", "0"},
+			{"8", "2", "3"},
+			{"2", "8", "0.3333333333333333333"},
+			{"100", "10", "2"},
+			{"0.01", "10", "-2"},
+			{"10", "0.1", "-1"},
+			{"9", "3", "2"},
+			{"2", "3", "0.6309297535714574371"},
+			{"3", "2", "1.584962500721156181"},
+			{"10", "2.718281828459045235", "2.302585092994045684"},
+			{"1000000", "1.000001", "13815517.46571840179"},
+			{"2", "0.5", "-1"},
+			{"1.5", "1.0000000001", "4054651081.284376374"},
+			{"0.0000000000000000001", "9999999999999999999", "-1"},
 		}
+
 		for _, tt := range tests {
 			d := RequireFromString(tt.d)
-			e := RequireFromString(tt.e)
-			got, err := d.Quo(e)
+			b := RequireFromString(tt.b)
+			got, err := d.LogBase(b)
 			if err != nil {
-				t.Errorf("%q.Quo(%q) failed: %v", d, e, err)
+				t.Errorf("%q.LogBase(%q) failed: %v", d, b, err)
 				continue
 			}
 			want := RequireFromString(tt.want)
 			if got != want {
-				t.Errorf("%q.Quo(%q) = %q, want %q", d, e, got, want)
+				t.Errorf("%q.LogBase(%q) = %q, want %q", d, b, got, want)
 			}
 		}
 	})
 
 	t.Run("error", func(t *testing.T) {
 		tests := map[string]struct {
-			d, e  string
-			scale int
+			d, b string
 		}{
-			"zero 1":     {"1", "0", 0},
-			"overflow 1": {"9999999999999999999", "0.001", 0},
-			"scale 1":    {"1", "1", MaxScale},
-			"scale 2":    {"0", "1", MaxScale + 1},
+			"negative":      {"-1", "2"},
+			"zero":          {"0", "2"},
+			"base negative": {"2", "-2"},
+			"base zero":     {"2", "0"},
+			"base one":      {"2", "1"},
+			"overflow":      {"9999999999999999999", "1.000000000000000001"},
 		}
-		for _, tt := range tests {
-			d := RequireFromString(tt.d)
-			e := RequireFromString(tt.e)
-			_, err := d.QuoExact(e, tt.scale)
-			if err == nil {
-				t.Errorf("%q.QuoExact(%q, %v) did not fail", d, e, tt.scale)
-			}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := RequireFromString(tt.d)
+				b := RequireFromString(tt.b)
+				_, err := d.LogBase(b)
+				if err == nil {
+					t.Errorf("%q.LogBase(%q) did not fail", d, b)
+				}
+			})
 		}
 	})
 }
 
-func TestDecimal_Inv(t *testing.T) {
+func TestDecimal_Sin(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
 			d, want string
 		}{
-			{"0.1", "10"},
-			{"1", "1"},
-			{"10", "0.1"},
-			{"2", "0.5"},
-			{"2.0", "0.5"},
-			{"2.00", "0.5"},
+			// Zeros
+			{"0", "0"},
+			{"0.000", "0"},
+
+			// Natural numbers
+			{"1", "0.8414709848078965067"},
+			{"2", "0.9092974268256816954"},
+			{"3", "0.1411200080598672221"},
+			{"4", "-0.7568024953079282514"},
+			{"5", "-0.9589242746631384689"},
+			{"6", "-0.2794154981989258728"},
+			{"7", "0.6569865987187890904"},
+			{"-1", "-0.8414709848078965067"},
+			{"-2", "-0.9092974268256816954"},
+
+			// Multiples of π
+			{"1.570796326794896619", "1"},
+			{"3.141592653589793238", "0.0000000000000000005"},
+			{"4.712388980384689858", "-1"},
+			{"6.283185307179586477", "0.0000000000000000001"},
+			{"-3.141592653589793238", "-0.0000000000000000005"},
+
+			// Closer and closer to zero
+			{"0.1", "0.0998334166468281523"},
+			{"0.001", "0.0009999998333333417"},
+			{"0.0000000001", "0.0000000001"},
+			{"0.0000000000000000001", "0.0000000000000000001"},
+
+			// Smallest and largest numbers
+			{"9999999999999999999", "-0.185422544070089953"},
+			{"-9999999999999999999", "0.185422544070089953"},
+			{"1000000000000000000", "-0.9929693207404050762"},
 		}
 		for _, tt := range tests {
 			d := RequireFromString(tt.d)
-			got, err := d.Inv()
+			got, err := d.Sin()
 			if err != nil {
-				t.Errorf("%q.Inv() failed: %v", d, err)
+				t.Errorf("%q.Sin() failed: %v", d, err)
 				continue
 			}
 			want := RequireFromString(tt.want)
 			if got != want {
-				t.Errorf("%q.Inv() = %q, want %q", d, got, want)
+				t.Errorf("%q.Sin() = %q, want %q", d, got, want)
 			}
 		}
 	})
+}
 
-	t.Run("error", func(t *testing.T) {
-		tests := map[string]struct {
-			d string
+func TestDecimal_Cos(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
 		}{
-			"zero 1":     {"0"},
-			"overflow 1": {"0.0000000000000000001"},
+			// Zeros
+			{"0", "1"},
+			{"0.000", "1"},
+
+			// Natural numbers
+			{"1", "0.5403023058681397174"},
+			{"2", "-0.416146836547142387"},
+			{"3", "-0.9899924966004454573"},
+			{"4", "-0.6536436208636119146"},
+			{"5", "0.2836621854632262645"},
+			{"6", "0.9601702866503660205"},
+			{"7", "0.7539022543433046381"},
+			{"-1", "0.5403023058681397174"},
+			{"-2", "-0.416146836547142387"},
+
+			// Multiples of π
+			{"1.570796326794896619", "0.0000000000000000002"},
+			{"1.570796326794896620", "-0.0000000000000000008"},
+			{"3.141592653589793238", "-1"},
+			{"6.283185307179586477", "1"},
+			{"-1.570796326794896619", "0.0000000000000000002"},
+
+			// Closer and closer to zero
+			{"0.1", "0.9950041652780257661"},
+			{"0.001", "0.9999995000000416667"},
+			{"0.0000000001", "1"},
+			{"0.0000000000000000001", "1"},
+
+			// Smallest and largest numbers
+			{"9999999999999999999", "-0.9826588829042230508"},
+			{"-9999999999999999999", "-0.9826588829042230508"},
+			{"1000000000000000000", "0.1183719902187107326"},
 		}
 		for _, tt := range tests {
 			d := RequireFromString(tt.d)
-			_, err := d.Inv()
-			if err == nil {
-				t.Errorf("%q.Inv() did not fail", d)
+			got, err := d.Cos()
+			if err != nil {
+				t.Errorf("%q.Cos() failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Cos() = %q, want %q", d, got, want)
 			}
 		}
 	})
 }
 
-func TestDecimal_QuoRem(t *testing.T) {
+func TestDecimal_Tan(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
-			d, e, wantQuo, wantRem string
+			d, want string
 		}{
 			// Zeros
-			{"0", "1.000", "0", "0.000"},
-			{"0.0", "1.000", "0", "0.000"},
-			{"0.00", "1.000", "0", "0.000"},
-			{"0.000", "1.000", "0", "0.000"},
-			{"0.0000", "1.000", "0", "0.0000"},
-			{"0.00000", "1.000", "0", "0.00000"},
+			{"0", "0"},
+			{"0.000", "0"},
 
-			{"0.000", "1", "0", "0.000"},
-			{"0.000", "1.0", "0", "0.000"},
-			{"0.000", "1.00", "0", "0.000"},
-			{"0.000", "1.000", "0", "0.000"},
-			{"0.000", "1.0000", "0", "0.0000"},
-			{"0.000", "1.00000", "0", "0.00000"},
+			// Natural numbers
+			{"1", "1.557407724654902231"},
+			{"2", "-2.185039863261518992"},
+			{"3", "-0.1425465430742778053"},
+			{"4", "1.157821282349577583"},
+			{"5", "-3.380515006246585637"},
+			{"6", "-0.2910061913847491571"},
+			{"7", "0.8714479827243187365"},
+			{"-1", "-1.557407724654902231"},
+			{"-2", "2.185039863261518992"},
+
+			// Multiples of π
+			{"0.7853981633974483096", "1"},
+			{"1.570796326794896619", "4322984121858095330"},
+			{"1.570796326794896620", "-1300934329906107203"},
+			{"3.141592653589793238", "-0.0000000000000000005"},
+			{"4.712388980384689858", "-3267600911027247505"},
+			{"-1.570796326794896619", "-4322984121858095330"},
+
+			// Closer and closer to zero
+			{"0.1", "0.1003346720854505451"},
+			{"0.001", "0.0010000003333334667"},
+			{"0.0000000001", "0.0000000001"},
+			{"0.0000000000000000001", "0.0000000000000000001"},
+
+			// Smallest and largest numbers
+			{"9999999999999999999", "0.1886947213279936917"},
+			{"-9999999999999999999", "-0.1886947213279936917"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := d.Tan()
+			if err != nil {
+				t.Errorf("%q.Tan() failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Tan() = %q, want %q", d, got, want)
+			}
+		}
+	})
+}
+
+func TestDecimal_Asin(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			// Zeros
+			{"0", "0"},
+			{"0.000", "0"},
 
 			// Ones
-			{"1", "1.000", "1", "0.000"},
-			{"1.0", "1.000", "1", "0.000"},
-			{"1.00", "1.000", "1", "0.000"},
-			{"1.000", "1.000", "1", "0.000"},
-			{"1.0000", "1.000", "1", "0.0000"},
-			{"1.00000", "1.000", "1", "0.00000"},
+			{"1", "1.570796326794896619"},
+			{"1.000", "1.570796326794896619"},
+			{"-1", "-1.570796326794896619"},
 
-			{"1.000", "1", "1", "0.000"},
-			{"1.000", "1.0", "1", "0.000"},
-			{"1.000", "1.00", "1", "0.000"},
-			{"1.000", "1.000", "1", "0.000"},
-			{"1.000", "1.0000", "1", "0.0000"},
-			{"1.000", "1.00000", "1", "0.00000"},
+			// Halves
+			{"0.5", "0.5235987755982988731"},
+			{"-0.5", "-0.5235987755982988731"},
+			{"0.7071067811865475244", "0.7853981633974483096"},
+			{"0.8660254037844386468", "1.047197551196597746"},
 
-			// Signs
-			{"2.4", "1", "2", "0.4"},
-			{"2.4", "-1", "-2", "0.4"},
-			{"-2.4", "1", "-2", "-0.4"},
-			{"-2.4", "-1", "2", "-0.4"},
+			// Closer and closer to one
+			{"0.9", "1.119769514998634187"},
+			{"0.999", "1.526071239626163188"},
+			{"0.9999999999", "1.57078218465927277"},
+			{"0.9999999999999999999", "1.570796326347683024"},
+			{"-0.9999999999999999999", "-1.570796326347683024"},
 
-			// Scales
-			{"2.40", "1", "2", "0.40"},
-			{"2.400", "1", "2", "0.400"},
-			{"2.4", "2", "1", "0.4"},
-			{"2.400", "2", "1", "0.400"},
+			// Closer and closer to zero
+			{"0.1", "0.1001674211615597963"},
+			{"0.001", "0.0010000001666667417"},
+			{"0.0000000001", "0.0000000001"},
+			{"0.0000000000000000001", "0.0000000000000000001"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := d.Asin()
+			if err != nil {
+				t.Errorf("%q.Asin() failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Asin() = %q, want %q", d, got, want)
+			}
+		}
+	})
 
-			// 1 divided by natural numbers
-			{"1", "1", "1", "0"},
-			{"1", "2", "0", "1"},
-			{"1", "3", "0", "1"},
-			{"1", "4", "0", "1"},
-			{"1", "5", "0", "1"},
-			{"1", "6", "0", "1"},
-			{"1", "7", "0", "1"},
-			{"1", "8", "0", "1"},
-			{"1", "9", "0", "1"},
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"less than negative one": "-1.000000000000000001",
+			"greater than one":       "1.000000000000000001",
+			"two":                    "2",
+		}
+		for name, d := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := RequireFromString(d)
+				_, err := d.Asin()
+				if err == nil {
+					t.Errorf("%q.Asin() did not fail", d)
+				}
+			})
+		}
+	})
+}
 
-			// 2 divided by natural numbers
-			{"2", "1", "2", "0"},
-			{"2", "2", "1", "0"},
-			{"2", "3", "0", "2"},
-			{"2", "4", "0", "2"},
-			{"2", "5", "0", "2"},
-			{"2", "6", "0", "2"},
-			{"2", "7", "0", "2"},
-			{"2", "8", "0", "2"},
-			{"2", "9", "0", "2"},
+func TestDecimal_Acos(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			// Zeros
+			{"0", "1.570796326794896619"},
+			{"0.000", "1.570796326794896619"},
 
-			// Other tests
-			{"12345", "4.999", "2469", "2.469"},
-			{"12345", "4.99", "2473", "4.73"},
-			{"12345", "4.9", "2519", "1.9"},
-			{"12345", "5", "2469", "0"},
-			{"12345", "5.1", "2420", "3.0"},
-			{"12345", "5.01", "2464", "0.36"},
-			{"12345", "5.001", "2468", "2.532"},
+			// Ones
+			{"1", "0"},
+			{"1.000", "0"},
+			{"-1", "3.141592653589793238"},
 
-			{"41", "21", "1", "20"},
-			{"4.2", "3.1000003", "1", "1.0999997"},
-			{"1.000000000000000000", "0.000000000000000003", "333333333333333333", "0.000000000000000001"},
-			{"1.000000000000000001", "0.000000000000000003", "333333333333333333", "0.000000000000000002"},
-			{"3", "0.9999999999999999999", "3", "0.0000000000000000003"},
-			{"0.9999999999999999999", "3", "0", "0.9999999999999999999"},
+			// Halves
+			{"0.5", "1.047197551196597746"},
+			{"-0.5", "2.094395102393195492"},
+			{"0.7071067811865475244", "0.7853981633974483096"},
+			{"0.8660254037844386468", "0.523598775598298873"},
+
+			// Closer and closer to one
+			{"0.9", "0.4510268117962624325"},
+			{"0.999", "0.0447250871687334312"},
+			{"0.9999999999", "0.0000141421356238488"},
+			{"0.9999999999999999999", "0.0000000004472135955"},
+			{"-0.9999999999999999999", "3.141592653142579643"},
+
+			// Closer and closer to zero
+			{"0.1", "1.470628905633336823"},
+			{"0.001", "1.569796326628229878"},
+			{"0.0000000001", "1.570796326694896619"},
+			{"0.0000000000000000001", "1.570796326794896619"},
 		}
 		for _, tt := range tests {
 			d := RequireFromString(tt.d)
-			e := RequireFromString(tt.e)
-			gotQuo, gotRem, err := d.QuoRem(e)
+			got, err := d.Acos()
 			if err != nil {
-				t.Errorf("%q.QuoRem(%q) failed: %v", d, e, err)
+				t.Errorf("%q.Acos() failed: %v", d, err)
 				continue
 			}
-			wantQuo := RequireFromString(tt.wantQuo)
-			wantRem := RequireFromString(tt.wantRem)
-			if gotQuo != wantQuo || gotRem != wantRem {
-				t.Errorf("%q.QuoRem(%q) = (%q, %q), want (%q, %q)", d, e, gotQuo, gotRem, wantQuo, wantRem)
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Acos() = %q, want %q", d, got, want)
 			}
 		}
 	})
 
 	t.Run("error", func(t *testing.T) {
-		tests := map[string]struct {
-			d, e string
+		tests := map[string]string{
+			"less than negative one": "-1.000000000000000001",
+			"greater than one":       "1.000000000000000001",
+			"two":                    "2",
+		}
+		for name, d := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := RequireFromString(d)
+				_, err := d.Acos()
+				if err == nil {
+					t.Errorf("%q.Acos() did not fail", d)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_Atan(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
 		}{
-			"zero 1":     {"1", "0"},
-			"overflow 1": {"9999999999999999999", "0.0000000000000000001"},
+			// Zeros
+			{"0", "0"},
+			{"0.000", "0"},
+
+			// Ones
+			{"1", "0.7853981633974483096"},
+			{"1.000", "0.7853981633974483096"},
+			{"-1", "-0.7853981633974483096"},
+
+			// Natural numbers
+			{"2", "1.107148717794090503"},
+			{"3", "1.249045772398254426"},
+			{"10", "1.471127674303734592"},
+			{"100", "1.560796660108231381"},
+			{"-2", "-1.107148717794090503"},
+
+			// Closer and closer to zero
+			{"0.1", "0.0996686524911620274"},
+			{"0.001", "0.0009999996666668667"},
+			{"0.0000000001", "0.0000000001"},
+			{"0.0000000000000000001", "0.0000000000000000001"},
+
+			// Smallest and largest numbers
+			{"9999999999999999999", "1.570796326794896619"},
+			{"-9999999999999999999", "-1.570796326794896619"},
 		}
 		for _, tt := range tests {
 			d := RequireFromString(tt.d)
-			e := RequireFromString(tt.e)
-			_, _, err := d.QuoRem(e)
-			if err == nil {
-				t.Errorf("%q.QuoRem(%q) did not fail", d, e)
+			got, err := d.Atan()
+			if err != nil {
+				t.Errorf("%q.Atan() failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Atan() = %q, want %q", d, got, want)
 			}
 		}
 	})
 }
 
-func TestDecimal_Cmp(t *testing.T) {
-	tests := []struct {
-		d, e string
-		want int
-	}{
-		{"-2", "-2", 0},
-		{"-2", "-1", -1},
-		{"-2", "0", -1},
-		{"-2", "1", -1},
-		{"-2", "2", -1},
-		{"-1", "-2", 1},
-		{"-1", "-1", 0},
-		{"-1", "0", -1},
-		{"-1", "1", -1},
-		{"-1", "2", -1},
-		{"0", "-2", 1},
-		{"0", "-1", 1},
-		{"0", "0", 0},
-		{"0", "1", -1},
-		{"0", "2", -1},
-		{"1", "-2", 1},
-		{"1", "-1", 1},
-		{"1", "0", 1},
-		{"1", "1", 0},
-		{"1", "2", -1},
-		{"2", "-2", 1},
-		{"2", "-1", 1},
-		{"2", "0", 1},
-		{"2", "1", 1},
-		{"2", "2", 0},
-		{"2", "2.0", 0},
-		{"2", "2.00", 0},
-		{"2", "2.000", 0},
-		{"2", "2.0000", 0},
-		{"2", "2.00000", 0},
-		{"2", "2.000000", 0},
-		{"2", "2.0000000", 0},
-		{"2", "2.00000000", 0},
-		{"9999999999999999999", "0.9999999999999999999", 1},
-		{"0.9999999999999999999", "9999999999999999999", -1},
-	}
-	for _, tt := range tests {
-		d := RequireFromString(tt.d)
-		e := RequireFromString(tt.e)
-		got := d.Cmp(e)
-		if got != tt.want {
-			t.Errorf("%q.Cmp(%q) = %v, want %v", d, e, got, tt.want)
+func TestDecimal_Sinh(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			// Zeros
+			{"0", "0"},
+			{"0.000", "0"},
+
+			// Natural numbers
+			{"1", "1.175201193643801457"},
+			{"2", "3.626860407847018768"},
+			{"3", "10.0178749274099019"},
+			{"10", "11013.23287470339338"},
+			{"-1", "-1.175201193643801457"},
+			{"-10", "-11013.23287470339338"},
+
+			// Closer and closer to zero
+			{"0.1", "0.1001667500198440258"},
+			{"0.001", "0.001000000166666675"},
+			{"0.0000000001", "0.0000000001"},
+			{"0.0000000000000000001", "0.0000000000000000001"},
+
+			// Largest result
+			{"44", "6425800057179654138"},
+			{"-44", "-6425800057179654138"},
 		}
-	}
-}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := d.Sinh()
+			if err != nil {
+				t.Errorf("%q.Sinh() failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Sinh() = %q, want %q", d, got, want)
+			}
+		}
+	})
 
-func TestDecimal_Max(t *testing.T) {
-	tests := []struct {
-		d, e, want string
-	}{
-		{"-2", "-2", "-2"},
-		{"-2", "-1", "-1"},
-		{"-2", "0", "0"},
-		{"-2", "1", "1"},
-		{"-2", "2", "2"},
-		{"-1", "-2", "-1"},
-		{"-1", "-1", "-1"},
-		{"-1", "0", "0"},
-		{"-1", "1", "1"},
-		{"-1", "2", "2"},
-		{"0", "-2", "0"},
-		{"0", "-1", "0"},
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"overflow 1": "45",
+			"overflow 2": "-45",
+			"overflow 3": "100",
+		}
+		for name, d := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := RequireFromString(d)
+				_, err := d.Sinh()
+				if err == nil {
+					t.Errorf("%q.Sinh() did not fail", d)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_Cosh(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			// Zeros
+			{"0", "1"},
+			{"0.000", "1"},
+
+			// Natural numbers
+			{"1", "1.543080634815243778"},
+			{"2", "3.76219569108363146"},
+			{"3", "10.06766199577776584"},
+			{"10", "11013.23292010332314"},
+			{"-1", "1.543080634815243778"},
+			{"-10", "11013.23292010332314"},
+
+			// Closer and closer to zero
+			{"0.1", "1.005004168055803599"},
+			{"0.001", "1.000000500000041667"},
+			{"0.0000000001", "1"},
+			{"0.0000000000000000001", "1"},
+
+			// Largest result
+			{"44", "6425800057179654138"},
+			{"-44", "6425800057179654138"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := d.Cosh()
+			if err != nil {
+				t.Errorf("%q.Cosh() failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Cosh() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"overflow 1": "45",
+			"overflow 2": "-45",
+			"overflow 3": "100",
+		}
+		for name, d := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := RequireFromString(d)
+				_, err := d.Cosh()
+				if err == nil {
+					t.Errorf("%q.Cosh() did not fail", d)
+				}
+			})
+		}
+	})
+}
+
+func TestDecimal_Tanh(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			// Zeros
+			{"0", "0"},
+			{"0.000", "0"},
+
+			// Natural numbers
+			{"1", "0.7615941559557648881"},
+			{"2", "0.9640275800758168839"},
+			{"3", "0.9950547536867304513"},
+			{"10", "0.9999999958776927636"},
+			{"-1", "-0.7615941559557648881"},
+			{"-10", "-0.9999999958776927636"},
+
+			// Closer and closer to zero
+			{"0.1", "0.0996679946249558171"},
+			{"0.001", "0.0009999996666668"},
+			{"0.0000000001", "0.0000000001"},
+			{"0.0000000000000000001", "0.0000000000000000001"},
+
+			// Saturation
+			{"22", "0.9999999999999999998"},
+			{"23", "1"},
+			{"45", "1"},
+			{"-45", "-1"},
+			{"100", "1"},
+			{"9999999999999999999", "1"},
+			{"-9999999999999999999", "-1"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := d.Tanh()
+			if err != nil {
+				t.Errorf("%q.Tanh() failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Tanh() = %q, want %q", d, got, want)
+			}
+		}
+	})
+}
+
+func TestDecimal_Atan2(t *testing.T) {
+	tests := []struct {
+		d, e, want string
+	}{
+		{"0", "1", "0"},
 		{"0", "0", "0"},
-		{"0", "1", "1"},
-		{"0", "2", "2"},
-		{"1", "-2", "1"},
-		{"1", "-1", "1"},
-		{"1", "0", "1"},
-		{"1", "1", "1"},
-		{"1", "2", "2"},
-		{"2", "-2", "2"},
-		{"2", "-1", "2"},
-		{"2", "0", "2"},
-		{"2", "1", "2"},
-		{"2", "2", "2"},
-		{"0.000", "0.0", "0.0"},
-		{"0.0", "0.000", "0.0"},
-		{"-0.000", "-0.0", "0.0"},
-		{"-0.0", "-0.000", "0.0"},
-		{"1.23", "1.2300", "1.23"},
-		{"1.2300", "1.23", "1.23"},
-		{"-1.23", "-1.2300", "-1.23"},
-		{"-1.2300", "-1.23", "-1.23"},
+		{"0", "-1", "3.141592653589793238"},
+		{"1", "0", "1.570796326794896619"},
+		{"-1", "0", "-1.570796326794896619"},
+		{"1", "1", "0.7853981633974483096"},
+		{"1", "-1", "2.356194490192344929"},
+		{"-1", "-1", "-2.356194490192344929"},
+		{"-1", "1", "-0.7853981633974483096"},
+		{"3", "4", "0.6435011087932843868"},
+		{"-3", "-4", "-2.498091544796508852"},
+		{"0.0000000000000000001", "9999999999999999999", "0"},
+		{"0.0000000000000000001", "-9999999999999999999", "3.141592653589793238"},
+		{"-0.0000000000000000001", "-9999999999999999999", "-3.141592653589793238"},
+		{"9999999999999999999", "0.0000000000000000001", "1.570796326794896619"},
 	}
 	for _, tt := range tests {
 		d := RequireFromString(tt.d)
 		e := RequireFromString(tt.e)
-		got := d.Max(e)
+		got, err := d.Atan2(e)
+		if err != nil {
+			t.Errorf("%q.Atan2(%q) failed: %v", d, e, err)
+			continue
+		}
 		want := RequireFromString(tt.want)
 		if got != want {
-			t.Errorf("%q.Max(%q) = %q, want %q", d, e, got, want)
+			t.Errorf("%q.Atan2(%q) = %q, want %q", d, e, got, want)
 		}
 	}
 }
 
-func TestDecimal_Min(t *testing.T) {
+func TestDecimal_Abs(t *testing.T) {
+	tests := []struct {
+		d, want string
+	}{
+		{"1", "1"},
+		{"-1", "1"},
+		{"1.00", "1.00"},
+		{"-1.00", "1.00"},
+		{"0", "0"},
+		{"0.0", "0.0"},
+		{"0.00", "0.00"},
+	}
+	for _, tt := range tests {
+		d := RequireFromString(tt.d)
+		got := d.Abs()
+		want := RequireFromString(tt.want)
+		if got != want {
+			t.Errorf("%q.Abs() = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestDecimal_CopySign(t *testing.T) {
 	tests := []struct {
 		d, e, want string
 	}{
-		{"-2", "-2", "-2"},
-		{"-2", "-1", "-2"},
-		{"-2", "0", "-2"},
-		{"-2", "1", "-2"},
-		{"-2", "2", "-2"},
-		{"-1", "-2", "-2"},
-		{"-1", "-1", "-1"},
-		{"-1", "0", "-1"},
-		{"-1", "1", "-1"},
-		{"-1", "2", "-1"},
-		{"0", "-2", "-2"},
-		{"0", "-1", "-1"},
-		{"0", "0", "0"},
+		{"10", "1", "10"},
+		{"10", "0", "10"},
+		{"10", "-1", "-10"},
 		{"0", "1", "0"},
-		{"0", "2", "0"},
-		{"1", "-2", "-2"},
-		{"1", "-1", "-1"},
-		{"1", "0", "0"},
-		{"1", "1", "1"},
-		{"1", "2", "1"},
-		{"2", "-2", "-2"},
-		{"2", "-1", "-1"},
-		{"2", "0", "0"},
-		{"2", "1", "1"},
-		{"2", "2", "2"},
-		{"0.000", "0.0", "0.000"},
-		{"0.0", "0.000", "0.000"},
-		{"-0.000", "-0.0", "0.000"},
-		{"-0.0", "-0.000", "0.000"},
-		{"1.23", "1.2300", "1.2300"},
-		{"1.2300", "1.23", "1.2300"},
-		{"-1.23", "-1.2300", "-1.2300"},
-		{"-1.2300", "-1.23", "-1.2300"},
+		{"0", "0", "0"},
+		{"0", "-1", "0"},
+		{"-10", "1", "10"},
+		{"-10", "0", "10"},
+		{"-10", "-1", "-10"},
 	}
 	for _, tt := range tests {
 		d := RequireFromString(tt.d)
 		e := RequireFromString(tt.e)
-		got := d.Min(e)
+		got := d.CopySign(e)
 		want := RequireFromString(tt.want)
 		if got != want {
-			t.Errorf("%q.Min(%q) = %q, want %q", d, e, got, want)
+			t.Errorf("%q.CopySign(%q) = %q, want %q", d, e, got, want)
 		}
 	}
 }
 
-//nolint:revive
-func TestDecimal_Clamp(t *testing.T) {
+func TestDecimal_Neg(t *testing.T) {
+	tests := []struct {
+		d, want string
+	}{
+		{"1", "-1"},
+		{"-1", "1"},
+		{"1.00", "-1.00"},
+		{"-1.00", "1.00"},
+		{"0", "0"},
+		{"0.0", "0.0"},
+		{"0.00", "0.00"},
+	}
+	for _, tt := range tests {
+		d := RequireFromString(tt.d)
+		got := d.Neg()
+		want := RequireFromString(tt.want)
+		if got != want {
+			t.Errorf("%q.Neg() = %q, want %q", d, got, want)
+		}
+	}
+}
+
+func TestDecimal_Quo(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		tests := []struct {
-			d, min, max, want string
+			d, e, want string
 		}{
-			{"0", "-2", "-1", "-1"},
-			{"0", "-1", "1", "0"},
-			{"0", "1", "2", "1"},
-			{"0.000", "0.0", "0.000", "0.000"},
-			{"0.000", "0.000", "0.0", "0.000"},
-			{"0.0", "0.0", "0.000", "0.0"},
-			{"0.0", "0.000", "0.0", "0.0"},
-			{"0.000", "0.000", "1", "0.000"},
-			{"0.000", "0.0", "1", "0.0"},
-			{"0.0", "0.000", "1", "0.0"},
-			{"0.0", "0.0", "1", "0.0"},
-			{"0.000", "-1", "0.000", "0.000"},
-			{"0.000", "-1", "0.0", "0.000"},
-			{"0.0", "-1", "0.000", "0.000"},
-			{"0.0", "-1", "0.0", "0.0"},
-			{"1.2300", "1.2300", "2", "1.2300"},
-			{"1.2300", "1.23", "2", "1.23"},
-			{"1.23", "1.2300", "2", "1.23"},
-			{"1.23", "1.23", "2", "1.23"},
-			{"1.2300", "1", "1.2300", "1.2300"},
-			{"1.2300", "1", "1.23", "1.2300"},
-			{"1.23", "1", "1.2300", "1.2300"},
-			{"1.23", "1", "1.23", "1.23"},
+			// Zeros
+			{"0", "1.000", "0"},
+			{"0.0", "1.000", "0"},
+			{"0.00", "1.000", "0"},
+			{"0.000", "1.000", "0"},
+			{"0.0000", "1.000", "0.0"},
+			{"0.00000", "1.000", "0.00"},
+
+			{"0.000", "1", "0.000"},
+			{"0.000", "1.0", "0.00"},
+			{"0.000", "1.00", "0.0"},
+			{"0.000", "1.000", "0"},
+			{"0.000", "1.0000", "0"},
+			{"0.000", "1.00000", "0"},
+
+			// Ones
+			{"1", "1.000", "1"},
+			{"1.0", "1.000", "1"},
+			{"1.00", "1.000", "1"},
+			{"1.000", "1.000", "1"},
+			{"1.0000", "1.000", "1.0"},
+			{"1.00000", "1.000", "1.00"},
+
+			{"1.000", "1", "1.000"},
+			{"1.000", "1.0", "1.00"},
+			{"1.000", "1.00", "1.0"},
+			{"1.000", "1.000", "1"},
+			{"1.000", "1.0000", "1"},
+			{"1.000", "1.00000", "1"},
+
+			// Simple cases
+			{"1", "1", "1"},
+			{"2", "1", "2"},
+			{"1", "2", "0.5"},
+			{"2", "2", "1"},
+			{"0", "1", "0"},
+			{"0", "2", "0"},
+			{"1.5", "3", "0.5"},
+			{"3", "3", "1"},
+			{"9999999999999999999", "1", "9999999999999999999"},
+			{"9999999999999999999", "9999999999999999999", "1"},
+
+			// Signs
+			{"2.4", "1", "2.4"},
+			{"2.4", "-1", "-2.4"},
+			{"-2.4", "1", "-2.4"},
+			{"-2.4", "-1", "2.4"},
+
+			// Scales
+			{"2.40", "1", "2.40"},
+			{"2.400", "1", "2.400"},
+			{"2.4", "2", "1.2"},
+			{"2.400", "2", "1.200"},
+
+			// 1 divided by digits
+			{"1", "1", "1"},
+			{"1", "2", "0.5"},
+			{"1", "3", "0.3333333333333333333"},
+			{"1", "4", "0.25"},
+			{"1", "5", "0.2"},
+			{"1", "6", "0.1666666666666666667"},
+			{"1", "7", "0.1428571428571428571"},
+			{"1", "8", "0.125"},
+			{"1", "9", "0.1111111111111111111"},
+
+			// 2 divided by digits
+			{"2", "1", "2"},
+			{"2", "2", "1"},
+			{"2", "3", "0.6666666666666666667"},
+			{"2", "4", "0.5"},
+			{"2", "5", "0.4"},
+			{"2", "6", "0.3333333333333333333"},
+			{"2", "7", "0.2857142857142857143"},
+			{"2", "8", "0.25"},
+			{"2", "9", "0.2222222222222222222"},
+
+			// 2 divided by 3
+			{"0.0000000000000000002", "3", "0.0000000000000000001"},
+			{"0.0000000000000000002", "3.000000000000000000", "0.0000000000000000001"},
+			{"2", "3", "0.6666666666666666667"},
+			{"2.000000000000000000", "3", "0.6666666666666666667"},
+			{"2", "3.000000000000000000", "0.6666666666666666667"},
+			{"2.000000000000000000", "3.000000000000000000", "0.6666666666666666667"},
+			{"0.0000000000000000002", "0.0000000000000000003", "0.6666666666666666667"},
+			{"2", "0.0000000000000000003", "6666666666666666667"},
+			{"2.000000000000000000", "0.0000000000000000003", "6666666666666666667"},
+
+			// Interest accrual
+			{"0.0001", "365", "0.0000002739726027397"}, // no error
+			{"0.0001", "366", "0.0000002732240437158"}, // no error
+
+			// Captured during fuzzing
+			{"9223372036854775807", "-9223372036854775808", "-0.9999999999999999999"},
+			{"0.000000000000000001", "20", "0.000000000000000000"},
+			{"105", "0.999999999999999990", "105.0000000000000011"},
+			{"0.05", "999999999999999954", "0.0000000000000000001"},
+			{"9.99999999999999998", "185", "0.0540540540540540539"},
+			{"7", "2.000000000000000002", "3.499999999999999997"},
+			{"0.000000009", "999999999999999999", "0.000000000"},
+			{"0.0000000000000000001", "9999999999999999999", "0.0000000000000000000"},
+			{"9999999999999999999", "2", "5000000000000000000"},
+			{"9999999999999999999", "5000000000000000000", "2"},
+			{"1.000000000000000049", "-99.9999999999999924", "-0.0100000000000000013"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			e := RequireFromString(tt.e)
+			got, err := d.Quo(e)
+			if err != nil {
+				t.Errorf("%q.Quo(%q) failed: %v", d, e, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Quo(%q) = %q, want %q", d, e, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e  string
+			scale int
+		}{
+			"zero 1":     {"1", "0", 0},
+			"overflow 1": {"9999999999999999999", "0.001", 0},
+			"scale 1":    {"1", "1", MaxScale},
+			"scale 2":    {"0", "1", MaxScale + 1},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			e := RequireFromString(tt.e)
+			_, err := d.QuoExact(e, tt.scale)
+			if err == nil {
+				t.Errorf("%q.QuoExact(%q, %v) did not fail", d, e, tt.scale)
+			}
+		}
+	})
+}
+
+func TestDecimal_QuoExactMode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e  string
+			scale int
+			mode  RoundingMode
+			want  string
+		}{
+			// Exact quotients are not affected by rounding mode
+			{"1", "4", 0, Up, "0.25"},
+			{"1", "4", 2, Down, "0.25"},
+			{"1", "4", 5, ZeroFiveUp, "0.25000"},
+
+			// Inexact quotients
+			{"1", "3", 0, HalfEven, "0.3333333333333333333"},
+			{"1", "3", 0, Up, "0.3333333333333333334"},
+			{"1", "3", 0, Ceiling, "0.3333333333333333334"},
+			{"1", "3", 0, Floor, "0.3333333333333333333"},
+			{"-1", "3", 0, Ceiling, "-0.3333333333333333333"},
+			{"-1", "3", 0, Floor, "-0.3333333333333333334"},
+			{"2", "3", 0, Down, "0.6666666666666666666"},
+			{"2", "3", 0, HalfDown, "0.6666666666666666667"},
+			{"1", "6", 0, ZeroFiveUp, "0.1666666666666666666"},
+			{"1", "7", 0, ZeroFiveUp, "0.1428571428571428571"},
+			{"1", "7", 0, Up, "0.1428571428571428572"},
+			{"1", "9999999999999999999", 0, Up, "0.0000000000000000002"},
+			{"1", "9999999999999999999", 0, Down, "0.0000000000000000001"},
+
+			// Large quotients
+			{"9999999999999999999", "7", 0, Up, "1428571428571428572"},
+			{"9999999999999999999", "7", 0, Down, "1428571428571428571"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			e := RequireFromString(tt.e)
+			got, err := d.QuoExactMode(e, tt.scale, tt.mode)
+			if err != nil {
+				t.Errorf("%q.QuoExactMode(%q, %v, %v) failed: %v", d, e, tt.scale, tt.mode, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.QuoExactMode(%q, %v, %v) = %q, want %q", d, e, tt.scale, tt.mode, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e  string
+			scale int
+			mode  RoundingMode
+		}{
+			"zero 1":  {"1", "0", 0, Up},
+			"scale 1": {"1", "1", MaxScale + 1, Up},
+			"mode 1":  {"1", "3", 0, RoundingMode(-1)},
+			"mode 2":  {"1", "3", 0, ZeroFiveUp + 1},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			e := RequireFromString(tt.e)
+			_, err := d.QuoExactMode(e, tt.scale, tt.mode)
+			if err == nil {
+				t.Errorf("%q.QuoExactMode(%q, %v, %v) did not fail", d, e, tt.scale, tt.mode)
+			}
+		}
+	})
+}
+
+func TestDecimal_Inv(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"0.1", "10"},
+			{"1", "1"},
+			{"10", "0.1"},
+			{"2", "0.5"},
+			{"2.0", "0.5"},
+			{"2.00", "0.5"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := d.Inv()
+			if err != nil {
+				t.Errorf("%q.Inv() failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Inv() = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d string
+		}{
+			"zero 1":     {"0"},
+			"overflow 1": {"0.0000000000000000001"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			_, err := d.Inv()
+			if err == nil {
+				t.Errorf("%q.Inv() did not fail", d)
+			}
+		}
+	})
+}
+
+func TestDecimal_QuoRem(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, e, wantQuo, wantRem string
+		}{
+			// Zeros
+			{"0", "1.000", "0", "0.000"},
+			{"0.0", "1.000", "0", "0.000"},
+			{"0.00", "1.000", "0", "0.000"},
+			{"0.000", "1.000", "0", "0.000"},
+			{"0.0000", "1.000", "0", "0.0000"},
+			{"0.00000", "1.000", "0", "0.00000"},
+
+			{"0.000", "1", "0", "0.000"},
+			{"0.000", "1.0", "0", "0.000"},
+			{"0.000", "1.00", "0", "0.000"},
+			{"0.000", "1.000", "0", "0.000"},
+			{"0.000", "1.0000", "0", "0.0000"},
+			{"0.000", "1.00000", "0", "0.00000"},
+
+			// Ones
+			{"1", "1.000", "1", "0.000"},
+			{"1.0", "1.000", "1", "0.000"},
+			{"1.00", "1.000", "1", "0.000"},
+			{"1.000", "1.000", "1", "0.000"},
+			{"1.0000", "1.000", "1", "0.0000"},
+			{"1.00000", "1.000", "1", "0.00000"},
+
+			{"1.000", "1", "1", "0.000"},
+			{"1.000", "1.0", "1", "0.000"},
+			{"1.000", "1.00", "1", "0.000"},
+			{"1.000", "1.000", "1", "0.000"},
+			{"1.000", "1.0000", "1", "0.0000"},
+			{"1.000", "1.00000", "1", "0.00000"},
+
+			// Signs
+			{"2.4", "1", "2", "0.4"},
+			{"2.4", "-1", "-2", "0.4"},
+			{"-2.4", "1", "-2", "-0.4"},
+			{"-2.4", "-1", "2", "-0.4"},
+
+			// Scales
+			{"2.40", "1", "2", "0.40"},
+			{"2.400", "1", "2", "0.400"},
+			{"2.4", "2", "1", "0.4"},
+			{"2.400", "2", "1", "0.400"},
+
+			// 1 divided by natural numbers
+			{"1", "1", "1", "0"},
+			{"1", "2", "0", "1"},
+			{"1", "3", "0", "1"},
+			{"1", "4", "0", "1"},
+			{"1", "5", "0", "1"},
+			{"1", "6", "0", "1"},
+			{"1", "7", "0", "1"},
+			{"1", "8", "0", "1"},
+			{"1", "9", "0", "1"},
+
+			// 2 divided by natural numbers
+			{"2", "1", "2", "0"},
+			{"2", "2", "1", "0"},
+			{"2", "3", "0", "2"},
+			{"2", "4", "0", "2"},
+			{"2", "5", "0", "2"},
+			{"2", "6", "0", "2"},
+			{"2", "7", "0", "2"},
+			{"2", "8", "0", "2"},
+			{"2", "9", "0", "2"},
+
+			// Other tests
+			{"12345", "4.999", "2469", "2.469"},
+			{"12345", "4.99", "2473", "4.73"},
+			{"12345", "4.9", "2519", "1.9"},
+			{"12345", "5", "2469", "0"},
+			{"12345", "5.1", "2420", "3.0"},
+			{"12345", "5.01", "2464", "0.36"},
+			{"12345", "5.001", "2468", "2.532"},
+
+			{"41", "21", "1", "20"},
+			{"4.2", "3.1000003", "1", "1.0999997"},
+			{"1.000000000000000000", "0.000000000000000003", "333333333333333333", "0.000000000000000001"},
+			{"1.000000000000000001", "0.000000000000000003", "333333333333333333", "0.000000000000000002"},
+			{"3", "0.9999999999999999999", "3", "0.0000000000000000003"},
+			{"0.9999999999999999999", "3", "0", "0.9999999999999999999"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			e := RequireFromString(tt.e)
+			gotQuo, gotRem, err := d.QuoRem(e)
+			if err != nil {
+				t.Errorf("%q.QuoRem(%q) failed: %v", d, e, err)
+				continue
+			}
+			wantQuo := RequireFromString(tt.wantQuo)
+			wantRem := RequireFromString(tt.wantRem)
+			if gotQuo != wantQuo || gotRem != wantRem {
+				t.Errorf("%q.QuoRem(%q) = (%q, %q), want (%q, %q)", d, e, gotQuo, gotRem, wantQuo, wantRem)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d, e string
+		}{
+			"zero 1":     {"1", "0"},
+			"overflow 1": {"9999999999999999999", "0.0000000000000000001"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			e := RequireFromString(tt.e)
+			_, _, err := d.QuoRem(e)
+			if err == nil {
+				t.Errorf("%q.QuoRem(%q) did not fail", d, e)
+			}
+		}
+	})
+}
+
+func TestDecimal_Allocate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d      string
+			ratios []string
+			scale  int
+			want   []string
+		}{
+			{"100", []string{"1", "1", "1"}, 2, []string{"33.34", "33.33", "33.33"}},
+			{"-100", []string{"1", "1", "1"}, 2, []string{"-33.34", "-33.33", "-33.33"}},
+			{"0.05", []string{"0.3", "0.7"}, 2, []string{"0.02", "0.03"}},
+			{"0.05", []string{"0.7", "0.3"}, 2, []string{"0.04", "0.01"}},
+			{"1", []string{"1", "1", "1", "1", "1", "1"}, 1, []string{"0.2", "0.2", "0.2", "0.2", "0.1", "0.1"}},
+			{"10", []string{"0", "1", "0"}, 0, []string{"0", "10", "0"}},
+			{"0", []string{"1", "2"}, 2, []string{"0.00", "0.00"}},
+			{"1.5", []string{"1"}, 3, []string{"1.500"}},
+			{"100.00", []string{"50", "30", "20"}, 0, []string{"50", "30", "20"}},
+			{"10", []string{"0.333", "0.333", "0.334"}, 2, []string{"3.33", "3.33", "3.34"}},
+			{"0.03", []string{"1", "1", "1", "1", "1"}, 2, []string{"0.01", "0.01", "0.01", "0.00", "0.00"}},
+			{"9999999999999999999", []string{"9999999999999999999", "1"}, 0, []string{"9999999999999999998", "1"}},
+			{"0.9999999999999999999", []string{"1", "1"}, 19, []string{"0.5000000000000000000", "0.4999999999999999999"}},
+			{"1", []string{"0.0000000000000000001", "9999999999999999999"}, 2, []string{"0.00", "1.00"}},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			ratios := make([]Decimal, len(tt.ratios))
+			for i, r := range tt.ratios {
+				ratios[i] = RequireFromString(r)
+			}
+			got, err := d.Allocate(tt.scale, ratios...)
+			if err != nil {
+				t.Errorf("%q.Allocate(%v, %v) failed: %v", d, tt.scale, ratios, err)
+				continue
+			}
+			want := make([]Decimal, len(tt.want))
+			for i, w := range tt.want {
+				want[i] = RequireFromString(w)
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("%q.Allocate(%v, %v) = %v, want %v", d, tt.scale, ratios, got, want)
+			}
+			sum, err := Sum(got...)
+			if err != nil {
+				t.Errorf("Sum(%v) failed: %v", got, err)
+				continue
+			}
+			if sum.Cmp(d) != 0 {
+				t.Errorf("Sum(%v) = %q, want %q", got, sum, d)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d       string
+			ratios  []string
+			scale   int
+			wantErr error
+		}{
+			"no ratios":      {"1", nil, 2, ErrInvalidOperation},
+			"negative ratio": {"1", []string{"1", "-1"}, 2, ErrInvalidOperation},
+			"zero ratios":    {"1", []string{"0", "0"}, 2, ErrInvalidOperation},
+			"inexact":        {"1.005", []string{"1", "1"}, 2, ErrInvalidOperation},
+			"overflow":       {"9999999999999999999", []string{"1", "1"}, 2, ErrOverflow},
+			"scale 1":        {"1", []string{"1", "1"}, -1, ErrScaleRange},
+			"scale 2":        {"1", []string{"1", "1"}, MaxScale + 1, ErrScaleRange},
+		}
+		for name, tt := range tests {
+			d := RequireFromString(tt.d)
+			ratios := make([]Decimal, len(tt.ratios))
+			for i, r := range tt.ratios {
+				ratios[i] = RequireFromString(r)
+			}
+			_, err := d.Allocate(tt.scale, ratios...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: %q.Allocate(%v, %v) = %v, want %v", name, d, tt.scale, ratios, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestDecimal_Split(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d        string
+			n, scale int
+			want     []string
+		}{
+			{"100", 3, 2, []string{"33.34", "33.33", "33.33"}},
+			{"-100", 3, 2, []string{"-33.34", "-33.33", "-33.33"}},
+			{"10", 4, 0, []string{"3", "3", "2", "2"}},
+			{"0.03", 5, 2, []string{"0.01", "0.01", "0.01", "0.00", "0.00"}},
+			{"0", 2, 1, []string{"0.0", "0.0"}},
+			{"1.50", 1, 1, []string{"1.5"}},
+			{"9999999999999999999", 2, 0, []string{"5000000000000000000", "4999999999999999999"}},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := d.Split(tt.n, tt.scale)
+			if err != nil {
+				t.Errorf("%q.Split(%v, %v) failed: %v", d, tt.n, tt.scale, err)
+				continue
+			}
+			want := make([]Decimal, len(tt.want))
+			for i, w := range tt.want {
+				want[i] = RequireFromString(w)
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("%q.Split(%v, %v) = %v, want %v", d, tt.n, tt.scale, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			d        string
+			n, scale int
+			wantErr  error
+		}{
+			"parts 1":  {"1", 0, 2, ErrInvalidOperation},
+			"parts 2":  {"1", -1, 2, ErrInvalidOperation},
+			"inexact":  {"1.005", 2, 2, ErrInvalidOperation},
+			"overflow": {"9999999999999999999", 2, 1, ErrOverflow},
+			"scale 1":  {"1", 2, -1, ErrScaleRange},
+			"scale 2":  {"1", 2, MaxScale + 1, ErrScaleRange},
+		}
+		for name, tt := range tests {
+			d := RequireFromString(tt.d)
+			_, err := d.Split(tt.n, tt.scale)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: %q.Split(%v, %v) = %v, want %v", name, d, tt.n, tt.scale, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestDecimal_Cmp(t *testing.T) {
+	tests := []struct {
+		d, e string
+		want int
+	}{
+		{"-2", "-2", 0},
+		{"-2", "-1", -1},
+		{"-2", "0", -1},
+		{"-2", "1", -1},
+		{"-2", "2", -1},
+		{"-1", "-2", 1},
+		{"-1", "-1", 0},
+		{"-1", "0", -1},
+		{"-1", "1", -1},
+		{"-1", "2", -1},
+		{"0", "-2", 1},
+		{"0", "-1", 1},
+		{"0", "0", 0},
+		{"0", "1", -1},
+		{"0", "2", -1},
+		{"1", "-2", 1},
+		{"1", "-1", 1},
+		{"1", "0", 1},
+		{"1", "1", 0},
+		{"1", "2", -1},
+		{"2", "-2", 1},
+		{"2", "-1", 1},
+		{"2", "0", 1},
+		{"2", "1", 1},
+		{"2", "2", 0},
+		{"2", "2.0", 0},
+		{"2", "2.00", 0},
+		{"2", "2.000", 0},
+		{"2", "2.0000", 0},
+		{"2", "2.00000", 0},
+		{"2", "2.000000", 0},
+		{"2", "2.0000000", 0},
+		{"2", "2.00000000", 0},
+		{"9999999999999999999", "0.9999999999999999999", 1},
+		{"0.9999999999999999999", "9999999999999999999", -1},
+	}
+	for _, tt := range tests {
+		d := RequireFromString(tt.d)
+		e := RequireFromString(tt.e)
+		got := d.Cmp(e)
+		if got != tt.want {
+			t.Errorf("%q.Cmp(%q) = %v, want %v", d, e, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_Max(t *testing.T) {
+	tests := []struct {
+		d, e, want string
+	}{
+		{"-2", "-2", "-2"},
+		{"-2", "-1", "-1"},
+		{"-2", "0", "0"},
+		{"-2", "1", "1"},
+		{"-2", "2", "2"},
+		{"-1", "-2", "-1"},
+		{"-1", "-1", "-1"},
+		{"-1", "0", "0"},
+		{"-1", "1", "1"},
+		{"-1", "2", "2"},
+		{"0", "-2", "0"},
+		{"0", "-1", "0"},
+		{"0", "0", "0"},
+		{"0", "1", "1"},
+		{"0", "2", "2"},
+		{"1", "-2", "1"},
+		{"1", "-1", "1"},
+		{"1", "0", "1"},
+		{"1", "1", "1"},
+		{"1", "2", "2"},
+		{"2", "-2", "2"},
+		{"2", "-1", "2"},
+		{"2", "0", "2"},
+		{"2", "1", "2"},
+		{"2", "2", "2"},
+		{"0.000", "0.0", "0.0"},
+		{"0.0", "0.000", "0.0"},
+		{"-0.000", "-0.0", "0.0"},
+		{"-0.0", "-0.000", "0.0"},
+		{"1.23", "1.2300", "1.23"},
+		{"1.2300", "1.23", "1.23"},
+		{"-1.23", "-1.2300", "-1.23"},
+		{"-1.2300", "-1.23", "-1.23"},
+	}
+	for _, tt := range tests {
+		d := RequireFromString(tt.d)
+		e := RequireFromString(tt.e)
+		got := d.Max(e)
+		want := RequireFromString(tt.want)
+		if got != want {
+			t.Errorf("%q.Max(%q) = %q, want %q", d, e, got, want)
+		}
+	}
+}
+
+func TestDecimal_Min(t *testing.T) {
+	tests := []struct {
+		d, e, want string
+	}{
+		{"-2", "-2", "-2"},
+		{"-2", "-1", "-2"},
+		{"-2", "0", "-2"},
+		{"-2", "1", "-2"},
+		{"-2", "2", "-2"},
+		{"-1", "-2", "-2"},
+		{"-1", "-1", "-1"},
+		{"-1", "0", "-1"},
+		{"-1", "1", "-1"},
+		{"-1", "2", "-1"},
+		{"0", "-2", "-2"},
+		{"0", "-1", "-1"},
+		{"0", "0", "0"},
+		{"0", "1", "0"},
+		{"0", "2", "0"},
+		{"1", "-2", "-2"},
+		{"1", "-1", "-1"},
+		{"1", "0", "0"},
+		{"1", "1", "1"},
+		{"1", "2", "1"},
+		{"2", "-2", "-2"},
+		{"2", "-1", "-1"},
+		{"2", "0", "0"},
+		{"2", "1", "1"},
+		{"2", "2", "2"},
+		{"0.000", "0.0", "0.000"},
+		{"0.0", "0.000", "0.000"},
+		{"-0.000", "-0.0", "0.000"},
+		{"-0.0", "-0.000", "0.000"},
+		{"1.23", "1.2300", "1.2300"},
+		{"1.2300", "1.23", "1.2300"},
+		{"-1.23", "-1.2300", "-1.2300"},
+		{"-1.2300", "-1.23", "-1.2300"},
+	}
+	for _, tt := range tests {
+		d := RequireFromString(tt.d)
+		e := RequireFromString(tt.e)
+		got := d.Min(e)
+		want := RequireFromString(tt.want)
+		if got != want {
+			t.Errorf("%q.Min(%q) = %q, want %q", d, e, got, want)
+		}
+	}
+}
+
+//nolint:revive
+func TestDecimal_Clamp(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d, min, max, want string
+		}{
+			{"0", "-2", "-1", "-1"},
+			{"0", "-1", "1", "0"},
+			{"0", "1", "2", "1"},
+			{"0.000", "0.0", "0.000", "0.000"},
+			{"0.000", "0.000", "0.0", "0.000"},
+			{"0.0", "0.0", "0.000", "0.0"},
+			{"0.0", "0.000", "0.0", "0.0"},
+			{"0.000", "0.000", "1", "0.000"},
+			{"0.000", "0.0", "1", "0.0"},
+			{"0.0", "0.000", "1", "0.0"},
+			{"0.0", "0.0", "1", "0.0"},
+			{"0.000", "-1", "0.000", "0.000"},
+			{"0.000", "-1", "0.0", "0.000"},
+			{"0.0", "-1", "0.000", "0.000"},
+			{"0.0", "-1", "0.0", "0.0"},
+			{"1.2300", "1.2300", "2", "1.2300"},
+			{"1.2300", "1.23", "2", "1.23"},
+			{"1.23", "1.2300", "2", "1.23"},
+			{"1.23", "1.23", "2", "1.23"},
+			{"1.2300", "1", "1.2300", "1.2300"},
+			{"1.2300", "1", "1.23", "1.2300"},
+			{"1.23", "1", "1.2300", "1.2300"},
+			{"1.23", "1", "1.23", "1.23"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			min := RequireFromString(tt.min)
+			max := RequireFromString(tt.max)
+			got, err := d.Clamp(min, max)
+			if err != nil {
+				t.Errorf("%q.Clamp(%q, %q) failed: %v", d, min, max, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%q.Clamp(%q, %q) = %q, want %q", d, min, max, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []struct {
+			d, min, max string
+		}{
+			{"0", "1", "-1"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			min := RequireFromString(tt.min)
+			max := RequireFromString(tt.max)
+			_, err := d.Clamp(min, max)
+			if err == nil {
+				t.Errorf("%q.Clamp(%q, %q) did not fail", d, min, max)
+			}
+		}
+	})
+}
+
+func TestNullDecimal_Interfaces(t *testing.T) {
+	var n any = NullDecimal{}
+	_, ok := n.(driver.Valuer)
+	if !ok {
+		t.Errorf("%T does not implement driver.Valuer", n)
+	}
+	_, ok = n.(json.Marshaler)
+	if !ok {
+		t.Errorf("%T does not implement json.Marshaler", n)
+	}
+	_, ok = n.(encoding.TextMarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.TextMarshaler", n)
+	}
+	_, ok = n.(xml.MarshalerAttr)
+	if !ok {
+		t.Errorf("%T does not implement xml.MarshalerAttr", n)
+	}
+	_, ok = n.(encoding.BinaryMarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.BinaryMarshaler", n)
+	}
+	_, ok = n.(gob.GobEncoder)
+	if !ok {
+		t.Errorf("%T does not implement gob.GobEncoder", n)
+	}
+
+	n = &NullDecimal{}
+	_, ok = n.(sql.Scanner)
+	if !ok {
+		t.Errorf("%T does not implement sql.Scanner", n)
+	}
+	_, ok = n.(json.Unmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement json.Unmarshaler", n)
+	}
+	_, ok = n.(encoding.TextUnmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.TextUnmarshaler", n)
+	}
+	_, ok = n.(xml.UnmarshalerAttr)
+	if !ok {
+		t.Errorf("%T does not implement xml.UnmarshalerAttr", n)
+	}
+	_, ok = n.(encoding.BinaryUnmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.BinaryUnmarshaler", n)
+	}
+	_, ok = n.(gob.GobDecoder)
+	if !ok {
+		t.Errorf("%T does not implement gob.GobDecoder", n)
+	}
+}
+
+func TestNullDecimal_Ptr(t *testing.T) {
+	d := RequireFromString("1.50")
+	tests := []struct {
+		n    NullDecimal
+		want *Decimal
+	}{
+		{NullDecimal{}, nil},
+		{NullDecimal{Decimal: d}, nil},
+		{NewNullDecimal(d), &d},
+		{NewNullDecimalFromPtr(&d), &d},
+		{NewNullDecimalFromPtr(nil), nil},
+	}
+	for _, tt := range tests {
+		got := tt.n.Ptr()
+		switch {
+		case got == nil && tt.want == nil:
+		case got == nil || tt.want == nil || *got != *tt.want:
+			t.Errorf("%v.Ptr() = %v, want %v", tt.n, got, tt.want)
+		case got == &tt.n.Decimal:
+			t.Errorf("%v.Ptr() returned a pointer to the field", tt.n)
+		}
+		wantZero := Decimal{}
+		if tt.want != nil {
+			wantZero = *tt.want
+		}
+		if got := tt.n.ValueOrZero(); got != wantZero {
+			t.Errorf("%v.ValueOrZero() = %q, want %q", tt.n, got, wantZero)
+		}
+	}
+}
+
+func TestNullDecimal_JSON(t *testing.T) {
+	type object struct {
+		N NullDecimal `json:"n"`
+	}
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			n    NullDecimal
+			want string
+		}{
+			{NullDecimal{}, `{"n":null}`},
+			{NullDecimal{Decimal: One}, `{"n":null}`},
+			{NewNullDecimal(Zero), `{"n":"0"}`},
+			{NewNullDecimal(RequireFromString("-1.50")), `{"n":"-1.50"}`},
+		}
+		for _, tt := range tests {
+			data, err := json.Marshal(object{tt.n})
+			if err != nil {
+				t.Errorf("json.Marshal(%v) failed: %v", tt.n, err)
+				continue
+			}
+			if string(data) != tt.want {
+				t.Errorf("json.Marshal(%v) = %s, want %s", tt.n, data, tt.want)
+			}
+			got := object{NewNullDecimal(RequireFromString("7"))}
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Errorf("json.Unmarshal(%s) failed: %v", data, err)
+				continue
+			}
+			want := NewNullDecimalFromPtr(tt.n.Ptr())
+			if got.N != want {
+				t.Errorf("json.Unmarshal(%s) = %v, want %v", data, got.N, want)
+			}
+		}
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		t.Cleanup(func() { SetJSONOptions(JSONOptions{}) })
+		SetJSONOptions(JSONOptions{Strict: true})
+		got := NewNullDecimal(One)
+		if err := got.UnmarshalJSON([]byte("null")); err != nil {
+			t.Errorf("NullDecimal.UnmarshalJSON(null) failed: %v", err)
+		}
+		if got.Valid {
+			t.Errorf("NullDecimal.UnmarshalJSON(null) = %v, want null", got)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{``, `""`, `"."`, `true`, `"99999999999999999999"`}
+		for _, tt := range tests {
+			want := NewNullDecimal(One)
+			got := want
+			if err := got.UnmarshalJSON([]byte(tt)); err == nil {
+				t.Errorf("NullDecimal.UnmarshalJSON(%s) did not fail", tt)
+			}
+			if got != want {
+				t.Errorf("NullDecimal.UnmarshalJSON(%s) changed the decimal to %v", tt, got)
+			}
+		}
+	})
+}
+
+func TestNullDecimal_XML(t *testing.T) {
+	type entity struct {
+		XMLName xml.Name    `xml:"entity"`
+		A       NullDecimal `xml:"a,attr"`
+		E       NullDecimal `xml:"e"`
+	}
+	d := RequireFromString("-1.50")
+	tests := []struct {
+		v    entity
+		want string
+	}{
+		{entity{}, `<entity><e></e></entity>`},
+		{entity{A: NewNullDecimal(d)}, `<entity a="-1.50"><e></e></entity>`},
+		{entity{E: NewNullDecimal(d)}, `<entity><e>-1.50</e></entity>`},
+		{entity{A: NewNullDecimal(Zero), E: NewNullDecimal(d)}, `<entity a="0"><e>-1.50</e></entity>`},
+	}
+	for _, tt := range tests {
+		data, err := xml.Marshal(tt.v)
+		if err != nil {
+			t.Errorf("xml.Marshal(%v) failed: %v", tt.v, err)
+			continue
+		}
+		if string(data) != tt.want {
+			t.Errorf("xml.Marshal(%v) = %s, want %s", tt.v, data, tt.want)
+		}
+		var got entity
+		if err := xml.Unmarshal(data, &got); err != nil {
+			t.Errorf("xml.Unmarshal(%s) failed: %v", data, err)
+			continue
+		}
+		if got.A != tt.v.A || got.E != tt.v.E {
+			t.Errorf("xml.Unmarshal(%s) = %v, want %v", data, got, tt.v)
+		}
+	}
+
+	var got entity
+	if err := xml.Unmarshal([]byte(`<entity a="."></entity>`), &got); err == nil {
+		t.Errorf("xml.Unmarshal(%q) did not fail", `<entity a="."></entity>`)
+	}
+}
+
+func TestNullDecimal_Gob(t *testing.T) {
+	type object struct {
+		N NullDecimal
+		M NullDecimal
+	}
+	tests := []object{
+		{},
+		{N: NewNullDecimal(Zero)},
+		{N: NewNullDecimal(RequireFromString("-1.50")), M: NewNullDecimal(RequireFromString("9999999999999999999"))},
+		{M: NewNullDecimal(RequireFromString("0.0000000000000000001"))},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(tt); err != nil {
+			t.Errorf("gob.Encode(%v) failed: %v", tt, err)
+			continue
+		}
+		var got object
+		if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+			t.Errorf("gob.Decode(%v) failed: %v", tt, err)
+			continue
+		}
+		if got != tt {
+			t.Errorf("gob.Decode(gob.Encode(%v)) = %v", tt, got)
+		}
+	}
+
+	var n NullDecimal
+	if err := n.UnmarshalBinary([]byte{0x0f}); err == nil {
+		t.Errorf("NullDecimal.UnmarshalBinary([0x0f]) did not fail")
+	}
+}
+
+func TestNullDecimal_Scan(t *testing.T) {
+	t.Run("[]byte", func(t *testing.T) {
+		tests := []string{"."}
+		for _, tt := range tests {
+			got := NullDecimal{}
+			err := got.Scan([]byte(tt))
+			if err == nil {
+				t.Errorf("Scan(%q) did not fail", tt)
+			}
+		}
+	})
+}
+
+/******************************************************
+* Fuzzing
+******************************************************/
+
+var corpus = []struct {
+	neg   bool
+	scale int
+	coef  uint64
+}{
+	// zero
+	{false, 0, 0},
+
+	// positive
+	{false, 0, 1},
+	{false, 0, 3},
+	{false, 0, 9999999999999999999},
+	{false, 19, 3},
+	{false, 19, 1},
+	{false, 19, 9999999999999999999},
+
+	// negative
+	{true, 0, 1},
+	{true, 0, 3},
+	{true, 0, 9999999999999999999},
+	{true, 19, 1},
+	{true, 19, 3},
+	{true, 19, 9999999999999999999},
+}
+
+func FuzzParse(f *testing.F) {
+	for _, c := range corpus {
+		for s := range MaxScale + 1 {
+			d, err := newSafe(c.neg, fint(c.coef), c.scale)
+			if err != nil {
+				continue
+			}
+			f.Add(d.String(), s)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, num string, scale int) {
+			got, err := parseFint(num, scale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			want, err := parseBint(num, scale)
+			if err != nil {
+				t.Errorf("parseBint(%q) failed: %v", num, err)
+				return
+			}
+
+			if got.CmpTotal(want) != 0 {
+				t.Errorf("parseBint(%q) = %q, whereas parseFint(%q) = %q", num, want, num, got)
+			}
+		},
+	)
+}
+
+func FuzzBCD(f *testing.F) {
+	for _, c := range corpus {
+		d, err := newSafe(c.neg, fint(c.coef), c.scale)
+		if err != nil {
+			continue
+		}
+		f.Add(d.bcd())
+	}
+
+	f.Fuzz(
+		func(t *testing.T, bcd []byte) {
+			_, err := parseBCD(bcd)
+			if err != nil {
+				t.Skip()
+			}
+		},
+	)
+}
+
+func FuzzDecimal_String_Parse(f *testing.F) {
+	for _, d := range corpus {
+		f.Add(d.neg, d.scale, d.coef)
+	}
+
+	f.Fuzz(
+		func(t *testing.T, neg bool, scale int, coef uint64) {
+			want, err := newSafe(neg, fint(coef), scale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			s := want.String()
+			got, err := NewFromString(s)
+			if err != nil {
+				t.Errorf("NewFromString(%q) failed: %v", s, err)
+				return
+			}
+
+			if got.CmpTotal(want) != 0 {
+				t.Errorf("NewFromString(%q) = %v, want %v", s, got, want)
+				return
+			}
+		},
+	)
+}
+
+func FuzzDecimal_BCD_ParseBCD(f *testing.F) {
+	for _, d := range corpus {
+		f.Add(d.neg, d.scale, d.coef)
+	}
+
+	f.Fuzz(
+		func(t *testing.T, neg bool, scale int, coef uint64) {
+			want, err := newSafe(neg, fint(coef), scale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			b := want.bcd()
+			got, err := parseBCD(b)
+			if err != nil {
+				t.Errorf("parseBCD(% x) failed: %v", b, err)
+				return
+			}
+
+			if got.CmpTotal(want) != 0 {
+				t.Errorf("parseBCD(% x) = %v, want %v", b, got, want)
+				return
+			}
+		},
+	)
+}
+
+func FuzzDecimal_AvroBytes_FromAvroBytes(f *testing.F) {
+	for _, d := range corpus {
+		for _, s := range []int{0, 4, MaxScale, MaxScale + 1} {
+			f.Add(d.neg, d.scale, d.coef, s)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, neg bool, scale int, coef uint64, avroScale int) {
+			d, err := newSafe(neg, fint(coef), scale)
+			if err != nil || avroScale < 0 || avroScale > 2*MaxScale {
+				t.Skip()
+				return
+			}
+
+			b, err := d.AvroBytes(avroScale)
+			if err != nil {
+				t.Errorf("%q.AvroBytes(%v) failed: %v", d, avroScale, err)
+				return
+			}
+			got, err := FromAvroBytes(b, avroScale)
+			if err != nil {
+				t.Errorf("FromAvroBytes(% x, %v) failed: %v", b, avroScale, err)
+				return
+			}
+
+			// Rounding to the schema scale is the only expected loss
+			want := d.Round(avroScale)
+			if avroScale <= MaxScale {
+				want = want.Pad(avroScale)
+			}
+			if got.Cmp(want) != 0 || avroScale <= MaxScale && got != want {
+				t.Errorf("FromAvroBytes(% x, %v) = %q, want %q", b, avroScale, got, want)
+				return
+			}
+
+			// Fixed representation must be equal to the sign-extended bytes representation
+			size := len(b) + 2
+			fixed, err := d.AvroFixed(avroScale, size)
+			if err != nil {
+				t.Errorf("%q.AvroFixed(%v, %v) failed: %v", d, avroScale, size, err)
+				return
+			}
+			var ext byte
+			if b[0]&0x80 != 0 {
+				ext = 0xff
+			}
+			if !bytes.Equal(fixed[2:], b) || fixed[0] != ext || fixed[1] != ext {
+				t.Errorf("%q.AvroFixed(%v, %v) = % x, want sign-extended % x", d, avroScale, size, fixed, b)
+				return
+			}
+			got, err = FromAvroFixed(fixed, avroScale, size)
+			if err != nil {
+				t.Errorf("FromAvroFixed(% x, %v, %v) failed: %v", fixed, avroScale, size, err)
+				return
+			}
+			if got.Cmp(want) != 0 {
+				t.Errorf("FromAvroFixed(% x, %v, %v) = %q, want %q", fixed, avroScale, size, got, want)
+				return
+			}
+		},
+	)
+}
+
+func FuzzDecimal_Int64_NewFromInt64(f *testing.F) {
+	for _, d := range corpus {
+		for s := range MaxScale + 1 {
+			f.Add(d.neg, d.scale, d.coef, s)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, scale int) {
+			want, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			w, f, ok := want.Int64(scale)
+			if !ok {
+				t.Skip()
+				return
+			}
+			got, err := NewFromInt64(w, f, scale)
+			if err != nil {
+				t.Errorf("NewFromInt64(%v, %v, %v) failed: %v", w, f, scale, err)
+				return
+			}
+
+			want = want.Round(scale)
+			if got.Cmp(want) != 0 {
+				t.Errorf("NewFromInt64(%v, %v, %v) = %v, want %v", w, f, scale, got, want)
+				return
+			}
+		},
+	)
+}
+
+func FuzzDecimal_Float64_NewFromFloat64(f *testing.F) {
+	for _, d := range corpus {
+		f.Add(d.neg, d.scale, d.coef)
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64) {
+			want, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil || want.Prec() > 17 {
+				t.Skip()
+				return
+			}
+
+			f, ok := want.Float64()
+			if !ok {
+				t.Errorf("%q.Float64() failed", want)
+				return
+			}
+			got, err := NewFromFloat64(f)
+			if err != nil {
+				t.Logf("%q.Float64() = %v", want, f)
+				t.Errorf("NewFromFloat64(%v) failed: %v", f, err)
+				return
+			}
+
+			if got.Cmp(want) != 0 {
+				t.Errorf("NewFromFloat64(%v) = %v, want %v", f, got, want)
+				return
+			}
+		},
+	)
+}
+
+func FuzzDecimal_Mul(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			for s := range MaxScale + 1 {
+				f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, s)
+			}
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			got, err := d.mulFint(e, scale, HalfEven)
+			if err != nil {
+				switch {
+				case errors.Is(err, ErrOverflow):
+					t.Skip() // Decimal overflow is an expected error in fast multiplication
+				case errors.Is(err, ErrScaleRange):
+					t.Skip() // Scale range is an expected error in fast multiplication
+				default:
+					t.Errorf("mulFint(%q, %q, %v) failed: %v", d, e, scale, err)
+				}
+				return
+			}
+
+			want, _, err := d.mulBint(e, scale, HalfEven)
+			if err != nil {
+				t.Errorf("mulBint(%q, %q, %v) failed: %v", d, e, scale, err)
+				return
+			}
+
+			if got.CmpTotal(want) != 0 {
+				t.Errorf("mulBint(%q, %q, %v) = %q, whereas mulFint(%q, %q, %v) = %q", d, e, scale, want, d, e, scale, got)
+			}
+		},
+	)
+}
+
+func FuzzDecimal_Mul_Prod(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			got, err := d.Mul(e)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			want, err := Prod(d, e)
+			if err != nil {
+				t.Errorf("Prod(%q, %q) failed: %v", d, e, err)
+				return
+			}
+
+			if got.CmpTotal(want) != 0 {
+				t.Errorf("Prod(%q, %q) = %q, whereas Mul(%q, %q) = %q", d, e, want, d, e, got)
+			}
+		},
+	)
+}
+
+func FuzzDecimal_AddMul(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			for _, g := range corpus {
+				for s := range MaxScale + 1 {
+					f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, g.neg, g.scale, g.coef, s)
+				}
+			}
 		}
-		for _, tt := range tests {
-			d := RequireFromString(tt.d)
-			min := RequireFromString(tt.min)
-			max := RequireFromString(tt.max)
-			got, err := d.Clamp(min, max)
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, gneg bool, gscale int, gcoef uint64, scale int) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
 			if err != nil {
-				t.Errorf("%q.Clamp(%q, %q) failed: %v", d, min, max, err)
-				continue
+				t.Skip()
+				return
 			}
-			want := RequireFromString(tt.want)
-			if got != want {
-				t.Errorf("%q.Clamp(%q, %q) = %q, want %q", d, min, max, got, want)
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			g, err := newSafe(gneg, fint(gcoef), gscale)
+			if err != nil {
+				t.Skip()
+				return
 			}
-		}
-	})
 
-	t.Run("error", func(t *testing.T) {
-		tests := []struct {
-			d, min, max string
-		}{
-			{"0", "1", "-1"},
-		}
-		for _, tt := range tests {
-			d := RequireFromString(tt.d)
-			min := RequireFromString(tt.min)
-			max := RequireFromString(tt.max)
-			_, err := d.Clamp(min, max)
-			if err == nil {
-				t.Errorf("%q.Clamp(%q, %q) did not fail", d, min, max)
+			got, err := d.addMulFint(e, g, scale, HalfEven)
+			if err != nil {
+				switch {
+				case errors.Is(err, ErrOverflow):
+					t.Skip() // Decimal overflow is an expected error in fast fused multiply-addition
+				case errors.Is(err, ErrScaleRange):
+					t.Skip() // Scale range is an expected error in fast fused multiply-addition
+				default:
+					t.Errorf("addMulFint(%q, %q, %q, %v) failed: %v", d, e, g, scale, err)
+				}
+				return
 			}
-		}
-	})
+
+			want, _, err := d.addMulBint(e, g, scale, HalfEven)
+			if err != nil {
+				t.Errorf("addMulBint(%q, %q, %q, %v) failed: %v", d, e, g, scale, err)
+				return
+			}
+
+			if got.CmpTotal(want) != 0 {
+				t.Errorf("addMulBint(%q, %q, %q, %v) = %q, whereas addMulFint(%q, %q, %q, %v) = %q", d, e, g, scale, want, d, e, g, scale, got)
+			}
+		},
+	)
 }
 
-func TestNullDecimal_Interfaces(t *testing.T) {
-	var n any = NullDecimal{}
-	_, ok := n.(driver.Valuer)
-	if !ok {
-		t.Errorf("%T does not implement driver.Valuer", n)
+func FuzzDecimal_Add_AddMul(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			for s := range MaxScale + 1 {
+				f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, s)
+			}
+		}
 	}
 
-	n = &NullDecimal{}
-	_, ok = n.(sql.Scanner)
-	if !ok {
-		t.Errorf("%T does not implement sql.Scanner", n)
-	}
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			got, err := d.AddExact(e, scale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			want, err := d.AddMulExact(e, One, scale)
+			if err != nil {
+				t.Errorf("AddMulExact(%q, %q, %v) failed: %v", d, e, scale, err)
+				return
+			}
+
+			if got.CmpTotal(want) != 0 {
+				t.Errorf("AddMulExact(%q, %q, %q, %v) = %q, whereas AddExact(%q, %q, %v) = %q", d, e, One, scale, want, d, e, scale, got)
+				return
+			}
+		},
+	)
 }
 
-func TestNullDecimal_Scan(t *testing.T) {
-	t.Run("[]byte", func(t *testing.T) {
-		tests := []string{"."}
-		for _, tt := range tests {
-			got := NullDecimal{}
-			err := got.Scan([]byte(tt))
-			if err == nil {
-				t.Errorf("Scan(%q) did not fail", tt)
+func FuzzDecimal_Mul_AddMul(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			for s := range MaxScale + 1 {
+				f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, s)
 			}
 		}
-	})
-}
+	}
 
-/******************************************************
-* Fuzzing
-******************************************************/
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
 
-var corpus = []struct {
-	neg   bool
-	scale int
-	coef  uint64
-}{
-	// zero
-	{false, 0, 0},
+			got, err := d.MulExact(e, scale)
+			if err != nil {
+				t.Skip()
+				return
+			}
 
-	// positive
-	{false, 0, 1},
-	{false, 0, 3},
-	{false, 0, 9999999999999999999},
-	{false, 19, 3},
-	{false, 19, 1},
-	{false, 19, 9999999999999999999},
+			want, err := Zero.AddMulExact(d, e, scale)
+			if err != nil {
+				t.Errorf("AddMulExact(%q, %q, %v) failed: %v", d, e, scale, err)
+				return
+			}
 
-	// negative
-	{true, 0, 1},
-	{true, 0, 3},
-	{true, 0, 9999999999999999999},
-	{true, 19, 1},
-	{true, 19, 3},
-	{true, 19, 9999999999999999999},
+			if got.CmpTotal(want) != 0 {
+				t.Errorf("AddMulExact(%q, %q, %q, %v) = %q, whereas MulExact(%q, %q, %v) = %q", Zero, d, e, scale, want, d, e, scale, got)
+			}
+		},
+	)
 }
 
-func FuzzParse(f *testing.F) {
-	for _, c := range corpus {
-		for s := range MaxScale + 1 {
-			d, err := newSafe(c.neg, fint(c.coef), c.scale)
-			if err != nil {
-				continue
+func FuzzDecimal_AddQuo(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			for _, g := range corpus {
+				for s := range MaxScale + 1 {
+					f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, g.neg, g.scale, g.coef, s)
+				}
 			}
-			f.Add(d.String(), s)
 		}
 	}
 
 	f.Fuzz(
-		func(t *testing.T, num string, scale int) {
-			got, err := parseFint(num, scale)
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, gneg bool, gscale int, gcoef uint64, scale int) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			g, err := newSafe(gneg, fint(gcoef), gscale)
 			if err != nil {
 				t.Skip()
 				return
 			}
 
-			want, err := parseBint(num, scale)
+			got, gotInexact, err := d.addQuoFint(e, g, scale, HalfEven)
 			if err != nil {
-				t.Errorf("parseBint(%q) failed: %v", num, err)
+				switch {
+				case errors.Is(err, ErrOverflow):
+					t.Skip() // Decimal overflow is an expected error in fast fused quotient-addition
+				case errors.Is(err, ErrDivisionByZero):
+					t.Skip() // Division by zero is an expected error in fast fused quotient-addition
+				case errors.Is(err, ErrInexactDivision):
+					t.Skip() // Inexact division is an expected error in fast fused quotient-addition
+				case errors.Is(err, ErrScaleRange):
+					t.Skip() // Scale range is an expected error in fast fused quotient-addition
+				default:
+					t.Errorf("addQuoFint(%q, %q, %q, %v) failed: %v", d, e, g, scale, err)
+				}
 				return
 			}
 
-			if got.CmpTotal(want) != 0 {
-				t.Errorf("parseBint(%q) = %q, whereas parseFint(%q) = %q", num, want, num, got)
+			want, wantInexact, err := d.addQuoBint(e, g, scale, HalfEven)
+			if err != nil {
+				t.Errorf("addQuoBint(%q, %q, %q, %v) failed: %v", d, e, g, scale, err)
+				return
+			}
+
+			if got.Cmp(want) != 0 {
+				t.Errorf("addQuoBint(%q, %q, %q, %v) = %q, whereas addQuoFint(%q, %q, %q, %v) = %q", d, e, g, scale, want, d, e, g, scale, got)
+			}
+			if gotInexact != wantInexact {
+				t.Errorf("addQuoBint(%q, %q, %q, %v) reported inexact = %v, whereas addQuoFint(%q, %q, %q, %v) reported inexact = %v", d, e, g, scale, wantInexact, d, e, g, scale, gotInexact)
 			}
 		},
 	)
 }
 
-func FuzzBCD(f *testing.F) {
-	for _, c := range corpus {
-		d, err := newSafe(c.neg, fint(c.coef), c.scale)
-		if err != nil {
-			continue
+func FuzzDecimal_Add_AddQuo(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			for s := range MaxScale + 1 {
+				f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, s)
+			}
 		}
-		f.Add(d.bcd())
 	}
 
 	f.Fuzz(
-		func(t *testing.T, bcd []byte) {
-			_, err := parseBCD(bcd)
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
 			if err != nil {
 				t.Skip()
+				return
 			}
-		},
-	)
-}
-
-func FuzzDecimal_String_Parse(f *testing.F) {
-	for _, d := range corpus {
-		f.Add(d.neg, d.scale, d.coef)
-	}
 
-	f.Fuzz(
-		func(t *testing.T, neg bool, scale int, coef uint64) {
-			want, err := newSafe(neg, fint(coef), scale)
+			got, err := d.AddExact(e, scale)
 			if err != nil {
 				t.Skip()
 				return
 			}
 
-			s := want.String()
-			got, err := NewFromString(s)
+			want, err := d.AddQuoExact(e, One, scale)
 			if err != nil {
-				t.Errorf("NewFromString(%q) failed: %v", s, err)
+				t.Errorf("AddQuoExact(%q, %q, %v) failed: %v", d, e, scale, err)
 				return
 			}
 
 			if got.CmpTotal(want) != 0 {
-				t.Errorf("NewFromString(%q) = %v, want %v", s, got, want)
+				t.Errorf("AddQuoExact(%q, %q, %q, %v) = %q, whereas AddExact(%q, %q, %v) = %q", d, e, One, scale, want, d, e, scale, got)
 				return
 			}
 		},
 	)
 }
 
-func FuzzDecimal_BCD_ParseBCD(f *testing.F) {
+func FuzzDecimal_Quo_AddQuo(f *testing.F) {
 	for _, d := range corpus {
-		f.Add(d.neg, d.scale, d.coef)
+		for _, e := range corpus {
+			for s := 0; s <= MaxScale; s++ {
+				f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, s)
+			}
+		}
 	}
 
 	f.Fuzz(
-		func(t *testing.T, neg bool, scale int, coef uint64) {
-			want, err := newSafe(neg, fint(coef), scale)
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
 			if err != nil {
 				t.Skip()
 				return
 			}
 
-			b := want.bcd()
-			got, err := parseBCD(b)
+			got, err := d.QuoExact(e, scale)
 			if err != nil {
-				t.Errorf("parseBCD(% x) failed: %v", b, err)
+				t.Skip()
 				return
 			}
 
-			if got.CmpTotal(want) != 0 {
-				t.Errorf("parseBCD(% x) = %v, want %v", b, got, want)
+			want, err := Zero.AddQuoExact(d, e, scale)
+			if err != nil {
+				t.Errorf("AddQuoExact(%q, %q, %v) failed: %v", d, e, scale, err)
 				return
 			}
+
+			if got.CmpTotal(want) != 0 {
+				t.Errorf("AddQuoExact(%q, %q, %q, %v) = %q, whereas QuoExact(%q, %q, %v) = %q", Zero, d, e, scale, want, d, e, scale, got)
+			}
 		},
 	)
 }
 
-func FuzzDecimal_Int64_NewFromInt64(f *testing.F) {
+func FuzzDecimal_Add(f *testing.F) {
 	for _, d := range corpus {
-		for s := range MaxScale + 1 {
-			f.Add(d.neg, d.scale, d.coef, s)
+		for _, e := range corpus {
+			for s := range MaxScale + 1 {
+				f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, s)
+			}
 		}
 	}
 
 	f.Fuzz(
-		func(t *testing.T, dneg bool, dscale int, dcoef uint64, scale int) {
-			want, err := newSafe(dneg, fint(dcoef), dscale)
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
 			if err != nil {
 				t.Skip()
 				return
 			}
-
-			w, f, ok := want.Int64(scale)
-			if !ok {
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
 				t.Skip()
 				return
 			}
-			got, err := NewFromInt64(w, f, scale)
+
+			got, err := d.addFint(e, scale, HalfEven)
 			if err != nil {
-				t.Errorf("NewFromInt64(%v, %v, %v) failed: %v", w, f, scale, err)
+				switch {
+				case errors.Is(err, ErrOverflow):
+					t.Skip() // Decimal overflow is an expected error in fast addition
+				case errors.Is(err, ErrScaleRange):
+					t.Skip() // Scale range is an expected error in fast addition
+				default:
+					t.Errorf("addFint(%q, %q, %v) failed: %v", d, e, scale, err)
+				}
 				return
 			}
 
-			want = want.Round(scale)
-			if got.Cmp(want) != 0 {
-				t.Errorf("NewFromInt64(%v, %v, %v) = %v, want %v", w, f, scale, got, want)
+			want, _, err := d.addBint(e, scale, HalfEven)
+			if err != nil {
+				t.Errorf("addBint(%q, %q, %v) failed: %v", d, e, scale, err)
 				return
 			}
+
+			if got.Cmp(want) != 0 {
+				t.Errorf("addBint(%q, %q, %v) = %q, whereas addFint(%q, %q, %v) = %q", d, e, scale, want, d, e, scale, got)
+			}
 		},
 	)
 }
 
-func FuzzDecimal_Float64_NewFromFloat64(f *testing.F) {
+func FuzzDecimal_Add_Sum(f *testing.F) {
 	for _, d := range corpus {
-		f.Add(d.neg, d.scale, d.coef)
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef)
+		}
 	}
 
 	f.Fuzz(
-		func(t *testing.T, dneg bool, dscale int, dcoef uint64) {
-			want, err := newSafe(dneg, fint(dcoef), dscale)
-			if err != nil || want.Prec() > 17 {
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
 				t.Skip()
 				return
 			}
-
-			f, ok := want.Float64()
-			if !ok {
-				t.Errorf("%q.Float64() failed", want)
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
 				return
 			}
-			got, err := NewFromFloat64(f)
+
+			got, err := d.Add(e)
 			if err != nil {
-				t.Logf("%q.Float64() = %v", want, f)
-				t.Errorf("NewFromFloat64(%v) failed: %v", f, err)
+				t.Skip()
 				return
 			}
 
-			if got.Cmp(want) != 0 {
-				t.Errorf("NewFromFloat64(%v) = %v, want %v", f, got, want)
+			want, err := Sum(d, e)
+			if err != nil {
+				t.Errorf("Sum(%q, %q) failed: %v", d, e, err)
 				return
 			}
+
+			if got.CmpTotal(want) != 0 {
+				t.Errorf("Sum(%q, %q) = %q, whereas Add(%q, %q) = %q", d, e, want, d, e, got)
+			}
 		},
 	)
 }
 
-func FuzzDecimal_Mul(f *testing.F) {
+func FuzzDecimal_Quo(f *testing.F) {
 	for _, d := range corpus {
 		for _, e := range corpus {
 			for s := range MaxScale + 1 {
@@ -4183,33 +6661,40 @@ func FuzzDecimal_Mul(f *testing.F) {
 				return
 			}
 
-			got, err := d.mulFint(e, scale)
+			got, gotInexact, err := d.quoFint(e, scale, HalfEven)
 			if err != nil {
 				switch {
-				case errors.Is(err, errDecimalOverflow):
-					t.Skip() // Decimal overflow is an expected error in fast multiplication
-				case errors.Is(err, errScaleRange):
-					t.Skip() // Scale range is an expected error in fast multiplication
+				case errors.Is(err, ErrOverflow):
+					t.Skip() // Decimal overflow is an expected error in fast division
+				case errors.Is(err, ErrDivisionByZero):
+					t.Skip() // Division by zero is an expected error in fast division
+				case errors.Is(err, ErrInexactDivision):
+					t.Skip() // Inexact division is an expected error in fast division
+				case errors.Is(err, ErrScaleRange):
+					t.Skip() // Scale range is an expected error in fast division
 				default:
-					t.Errorf("mulFint(%q, %q, %v) failed: %v", d, e, scale, err)
+					t.Errorf("quoFint(%q, %q, %v) failed: %v", d, e, scale, err)
 				}
 				return
 			}
 
-			want, err := d.mulBint(e, scale)
+			want, wantInexact, err := d.quoBint(e, scale, HalfEven)
 			if err != nil {
-				t.Errorf("mulBint(%q, %q, %v) failed: %v", d, e, scale, err)
+				t.Errorf("quoBint(%q, %q, %v) failed: %v", d, e, scale, err)
 				return
 			}
 
-			if got.CmpTotal(want) != 0 {
-				t.Errorf("mulBint(%q, %q, %v) = %q, whereas mulFint(%q, %q, %v) = %q", d, e, scale, want, d, e, scale, got)
+			if got.Cmp(want) != 0 {
+				t.Errorf("quoBint(%q, %q, %v) = %q, whereas quoFint(%q, %q, %v) = %q", d, e, scale, want, d, e, scale, got)
+			}
+			if gotInexact != wantInexact {
+				t.Errorf("quoBint(%q, %q, %v) reported inexact = %v, whereas quoFint(%q, %q, %v) reported inexact = %v", d, e, scale, wantInexact, d, e, scale, gotInexact)
 			}
 		},
 	)
 }
 
-func FuzzDecimal_Mul_Prod(f *testing.F) {
+func FuzzDecimal_QuoRem(f *testing.F) {
 	for _, d := range corpus {
 		for _, e := range corpus {
 			f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef)
@@ -4229,91 +6714,112 @@ func FuzzDecimal_Mul_Prod(f *testing.F) {
 				return
 			}
 
-			got, err := d.Mul(e)
+			gotQuo, gotRem, err := d.quoRemFint(e)
 			if err != nil {
-				t.Skip()
+				switch {
+				case errors.Is(err, ErrOverflow):
+					t.Skip() // Decimal overflow is an expected error in fast division
+				case errors.Is(err, ErrDivisionByZero):
+					t.Skip() // Division by zero is an expected error in fast division
+				default:
+					t.Errorf("quoRemFint(%q, %q) failed: %v", d, e, err)
+				}
 				return
 			}
+			if gotQuo.Scale() != 0 {
+				t.Errorf("quoRemFint(%q, %q) = (%q, _), expected integer quotient", d, e, gotQuo)
+			}
+			if gotRem.Scale() != max(d.Scale(), e.Scale()) {
+				t.Errorf("quoRemFint(%q, %q) = (_, %q), expected remainder with scale %d", d, e, gotRem, max(d.Scale(), e.Scale()))
+			}
+			if !gotRem.IsZero() && gotRem.Sign() != d.Sign() {
+				t.Errorf("quoRemFint(%q, %q) = (_, %q), expected remainder with the same sign as the dividend", d, e, gotRem)
+			}
 
-			want, err := Prod(d, e)
+			wantQuo, wantRem, err := d.quoRemBint(e)
 			if err != nil {
-				t.Errorf("Prod(%q, %q) failed: %v", d, e, err)
+				t.Errorf("quoRemBint(%q, %q) failed: %v", d, e, err)
 				return
 			}
 
-			if got.CmpTotal(want) != 0 {
-				t.Errorf("Prod(%q, %q) = %q, whereas Mul(%q, %q) = %q", d, e, want, d, e, got)
+			if gotQuo.CmpTotal(wantQuo) != 0 || gotRem.CmpTotal(wantRem) != 0 {
+				t.Errorf("quoRemBint(%q, %q) = (%q, %q), whereas quoRemFint(%q, %q) = (%q, %q)", d, e, wantQuo, wantRem, d, e, gotQuo, gotRem)
 			}
 		},
 	)
 }
 
-func FuzzDecimal_AddMul(f *testing.F) {
+func FuzzDecimal_Allocate(f *testing.F) {
 	for _, d := range corpus {
 		for _, e := range corpus {
-			for _, g := range corpus {
-				for s := range MaxScale + 1 {
-					f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, g.neg, g.scale, g.coef, s)
-				}
-			}
+			f.Add(d.neg, d.scale, d.coef, e.scale, e.coef, uint64(1), 2)
 		}
 	}
 
 	f.Fuzz(
-		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, gneg bool, gscale int, gcoef uint64, scale int) {
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, escale int, ecoef, fcoef uint64, scale int) {
 			d, err := newSafe(dneg, fint(dcoef), dscale)
 			if err != nil {
 				t.Skip()
 				return
 			}
-			e, err := newSafe(eneg, fint(ecoef), escale)
+			e, err := newSafe(false, fint(ecoef), escale)
 			if err != nil {
 				t.Skip()
 				return
 			}
-			g, err := newSafe(gneg, fint(gcoef), gscale)
+			f, err := newSafe(false, fint(fcoef), 0)
 			if err != nil {
 				t.Skip()
 				return
 			}
 
-			got, err := d.addMulFint(e, g, scale)
+			got, err := d.Allocate(scale, e, f, e)
 			if err != nil {
 				switch {
-				case errors.Is(err, errDecimalOverflow):
-					t.Skip() // Decimal overflow is an expected error in fast fused multiply-addition
-				case errors.Is(err, errScaleRange):
-					t.Skip() // Scale range is an expected error in fast fused multiply-addition
+				case errors.Is(err, ErrScaleRange),
+					errors.Is(err, ErrOverflow),
+					errors.Is(err, ErrInvalidOperation),
+					errors.Is(err, ErrDivisionByZero):
+					t.Skip() // Expected errors
 				default:
-					t.Errorf("addMulFint(%q, %q, %q, %v) failed: %v", d, e, g, scale, err)
+					t.Errorf("%q.Allocate(%v, %q, %q, %q) failed: %v", d, scale, e, f, e, err)
 				}
 				return
 			}
 
-			want, err := d.addMulBint(e, g, scale)
+			sum, err := Sum(got...)
 			if err != nil {
-				t.Errorf("addMulBint(%q, %q, %q, %v) failed: %v", d, e, g, scale, err)
+				t.Errorf("Sum(%v) failed: %v", got, err)
 				return
 			}
-
-			if got.CmpTotal(want) != 0 {
-				t.Errorf("addMulBint(%q, %q, %q, %v) = %q, whereas addMulFint(%q, %q, %q, %v) = %q", d, e, g, scale, want, d, e, g, scale, got)
+			if sum.Cmp(d) != 0 {
+				t.Errorf("Sum(%q.Allocate(%v, %q, %q, %q)) = %q, want %q", d, scale, e, f, e, sum, d)
+			}
+			for _, p := range got {
+				if p.Scale() != scale {
+					t.Errorf("%q.Allocate(%v, %q, %q, %q) = %v, expected parts with scale %v", d, scale, e, f, e, got, scale)
+				}
+				if !p.IsZero() && p.Sign() != d.Sign() {
+					t.Errorf("%q.Allocate(%v, %q, %q, %q) = %v, expected parts with the same sign as %q", d, scale, e, f, e, got, d)
+				}
+			}
+			if got[0].CmpAbs(got[2]) < 0 {
+				t.Errorf("%q.Allocate(%v, %q, %q, %q) = %v, expected ties to favor the first part", d, scale, e, f, e, got)
 			}
 		},
 	)
 }
 
-func FuzzDecimal_Add_AddMul(f *testing.F) {
+func FuzzDecimal_Cmp(f *testing.F) {
 	for _, d := range corpus {
 		for _, e := range corpus {
-			for s := range MaxScale + 1 {
-				f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, s)
-			}
+			f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef)
 		}
 	}
 
 	f.Fuzz(
-		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64) {
 			d, err := newSafe(dneg, fint(dcoef), dscale)
 			if err != nil {
 				t.Skip()
@@ -4325,506 +6831,465 @@ func FuzzDecimal_Add_AddMul(f *testing.F) {
 				return
 			}
 
-			got, err := d.AddExact(e, scale)
+			got, err := d.cmpFint(e)
+			if err != nil {
+				if errors.Is(err, ErrOverflow) {
+					t.Skip() // Decimal overflow is an expected error in fast comparison
+				} else {
+					t.Errorf("cmpFint(%q, %q) failed: %v", d, e, err)
+				}
+				return
+			}
+
+			want := d.cmpBint(e)
+
+			if got != want {
+				t.Errorf("cmpBint(%q, %q) = %v, whereas cmpFint(%q, %q) = %v", d, e, want, d, e, got)
+				return
+			}
+		},
+	)
+}
+
+func FuzzDecimal_Sqrt_PowInt(f *testing.F) {
+	for _, d := range corpus {
+		f.Add(d.neg, d.scale, d.coef)
+	}
+
+	f.Fuzz(
+		func(t *testing.T, neg bool, scale int, coef uint64) {
+			want, err := newSafe(neg, fint(coef), scale)
 			if err != nil {
 				t.Skip()
 				return
 			}
 
-			want, err := d.AddMulExact(e, One, scale)
+			d, err := want.Sqrt()
 			if err != nil {
-				t.Errorf("AddMulExact(%q, %q, %v) failed: %v", d, e, scale, err)
+				t.Skip()
+				return
+			}
+			got, err := d.PowInt(2)
+			if err != nil {
+				t.Skip()
 				return
 			}
 
-			if got.CmpTotal(want) != 0 {
-				t.Errorf("AddMulExact(%q, %q, %q, %v) = %q, whereas AddExact(%q, %q, %v) = %q", d, e, One, scale, want, d, e, scale, got)
+			if cmp, err := cmpULP(got, want, 3); err != nil {
+				t.Errorf("cmpULP(%q, %q) failed: %v", got, want, err)
+			} else if cmp != 0 {
+				t.Errorf("%q.Sqrt().PowInt(2) = %q, want %q", want, got, want)
 				return
 			}
 		},
 	)
 }
 
-func FuzzDecimal_Mul_AddMul(f *testing.F) {
+func FuzzDecimal_Root_PowInt(f *testing.F) {
 	for _, d := range corpus {
-		for _, e := range corpus {
-			for s := range MaxScale + 1 {
-				f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, s)
-			}
-		}
+		f.Add(d.neg, d.scale, d.coef, 3)
+		f.Add(d.neg, d.scale, d.coef, 5)
 	}
 
 	f.Fuzz(
-		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
-			d, err := newSafe(dneg, fint(dcoef), dscale)
+		func(t *testing.T, neg bool, scale int, coef uint64, n int) {
+			want, err := newSafe(neg, fint(coef), scale)
 			if err != nil {
 				t.Skip()
 				return
 			}
-			e, err := newSafe(eneg, fint(ecoef), escale)
-			if err != nil {
-				t.Skip()
+			if n < 1 || n > MaxPrec || want.Prec()*n > MaxPrec || want.Scale()*n > MaxScale {
+				t.Skip() // Power is not representable exactly
 				return
 			}
 
-			got, err := d.MulExact(e, scale)
+			d, err := want.PowInt(n)
 			if err != nil {
 				t.Skip()
 				return
 			}
-
-			want, err := Zero.AddMulExact(d, e, scale)
+			if n%2 == 0 {
+				want = want.Abs()
+			}
+			got, err := d.Root(n)
 			if err != nil {
-				t.Errorf("AddMulExact(%q, %q, %v) failed: %v", d, e, scale, err)
+				t.Errorf("%q.Root(%v) failed: %v", d, n, err)
 				return
 			}
 
-			if got.CmpTotal(want) != 0 {
-				t.Errorf("AddMulExact(%q, %q, %q, %v) = %q, whereas MulExact(%q, %q, %v) = %q", Zero, d, e, scale, want, d, e, scale, got)
+			if got.Cmp(want) != 0 {
+				t.Errorf("%q.Root(%v) = %q, want %q", d, n, got, want)
+				return
 			}
 		},
 	)
 }
 
-func FuzzDecimal_AddQuo(f *testing.F) {
+func FuzzDecimal_Root_Sqrt(f *testing.F) {
 	for _, d := range corpus {
-		for _, e := range corpus {
-			for _, g := range corpus {
-				for s := range MaxScale + 1 {
-					f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, g.neg, g.scale, g.coef, s)
-				}
-			}
-		}
+		f.Add(d.neg, d.scale, d.coef)
 	}
 
 	f.Fuzz(
-		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, gneg bool, gscale int, gcoef uint64, scale int) {
-			d, err := newSafe(dneg, fint(dcoef), dscale)
-			if err != nil {
-				t.Skip()
-				return
-			}
-			e, err := newSafe(eneg, fint(ecoef), escale)
+		func(t *testing.T, neg bool, scale int, coef uint64) {
+			d, err := newSafe(neg, fint(coef), scale)
 			if err != nil {
 				t.Skip()
 				return
 			}
-			g, err := newSafe(gneg, fint(gcoef), gscale)
+
+			want, err := d.Sqrt()
 			if err != nil {
 				t.Skip()
 				return
 			}
-
-			got, err := d.addQuoFint(e, g, scale)
+			got, err := d.Root(2)
 			if err != nil {
-				switch {
-				case errors.Is(err, errDecimalOverflow):
-					t.Skip() // Decimal overflow is an expected error in fast fused quotient-addition
-				case errors.Is(err, errDivisionByZero):
-					t.Skip() // Division by zero is an expected error in fast fused quotient-addition
-				case errors.Is(err, errInexactDivision):
-					t.Skip() // Inexact division is an expected error in fast fused quotient-addition
-				case errors.Is(err, errScaleRange):
-					t.Skip() // Scale range is an expected error in fast fused quotient-addition
-				default:
-					t.Errorf("addQuoFint(%q, %q, %q, %v) failed: %v", d, e, g, scale, err)
-				}
+				t.Errorf("%q.Root(2) failed: %v", d, err)
 				return
 			}
 
-			want, err := d.addQuoBint(e, g, scale)
-			if err != nil {
-				t.Errorf("addQuoBint(%q, %q, %q, %v) failed: %v", d, e, g, scale, err)
+			if got != want {
+				t.Errorf("%q.Root(2) = %q, want %q", d, got, want)
 				return
 			}
-
-			if got.Cmp(want) != 0 {
-				t.Errorf("addQuoBint(%q, %q, %q, %v) = %q, whereas addQuoFint(%q, %q, %q, %v) = %q", d, e, g, scale, want, d, e, g, scale, got)
-			}
 		},
 	)
 }
 
-func FuzzDecimal_Add_AddQuo(f *testing.F) {
+func FuzzDecimal_Log_Exp(f *testing.F) {
 	for _, d := range corpus {
-		for _, e := range corpus {
-			for s := range MaxScale + 1 {
-				f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, s)
-			}
-		}
+		f.Add(d.neg, d.scale, d.coef)
 	}
 
 	f.Fuzz(
-		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
-			d, err := newSafe(dneg, fint(dcoef), dscale)
+		func(t *testing.T, neg bool, scale int, coef uint64) {
+			want, err := newSafe(neg, fint(coef), scale)
 			if err != nil {
 				t.Skip()
 				return
 			}
-			e, err := newSafe(eneg, fint(ecoef), escale)
+
+			d, err := want.Log()
 			if err != nil {
 				t.Skip()
 				return
 			}
-
-			got, err := d.AddExact(e, scale)
+			got, err := d.Exp()
 			if err != nil {
 				t.Skip()
 				return
 			}
 
-			want, err := d.AddQuoExact(e, One, scale)
-			if err != nil {
-				t.Errorf("AddQuoExact(%q, %q, %v) failed: %v", d, e, scale, err)
+			if cmp, err := cmpULP(got, want, 70); err != nil {
+				t.Errorf("cmpULP(%q, %q) failed: %v", got, want, err)
 				return
-			}
-
-			if got.CmpTotal(want) != 0 {
-				t.Errorf("AddQuoExact(%q, %q, %q, %v) = %q, whereas AddExact(%q, %q, %v) = %q", d, e, One, scale, want, d, e, scale, got)
+			} else if cmp != 0 {
+				t.Errorf("%q.Log().Exp() = %q, want %q", want, got, want)
 				return
 			}
 		},
 	)
 }
 
-func FuzzDecimal_Quo_AddQuo(f *testing.F) {
+func FuzzDecimal_Log1p_Log(f *testing.F) {
 	for _, d := range corpus {
-		for _, e := range corpus {
-			for s := 0; s <= MaxScale; s++ {
-				f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, s)
-			}
-		}
+		f.Add(d.neg, d.scale, d.coef)
 	}
 
 	f.Fuzz(
-		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
-			d, err := newSafe(dneg, fint(dcoef), dscale)
+		func(t *testing.T, neg bool, scale int, coef uint64) {
+			d, err := newSafe(neg, fint(coef), scale)
 			if err != nil {
 				t.Skip()
 				return
 			}
-			e, err := newSafe(eneg, fint(ecoef), escale)
+
+			e, err := d.AddExact(One, d.Scale())
 			if err != nil {
-				t.Skip()
+				t.Skip() // One plus d is not representable exactly
 				return
 			}
-
-			got, err := d.QuoExact(e, scale)
+			want, err := e.Log()
 			if err != nil {
 				t.Skip()
 				return
 			}
-
-			want, err := Zero.AddQuoExact(d, e, scale)
+			got, err := d.Log1p()
 			if err != nil {
-				t.Errorf("AddQuoExact(%q, %q, %v) failed: %v", d, e, scale, err)
+				t.Errorf("%q.Log1p() failed: %v", d, err)
 				return
 			}
 
-			if got.CmpTotal(want) != 0 {
-				t.Errorf("AddQuoExact(%q, %q, %q, %v) = %q, whereas QuoExact(%q, %q, %v) = %q", Zero, d, e, scale, want, d, e, scale, got)
+			if got != want {
+				t.Errorf("%q.Log1p() = %q, want %q", d, got, want)
+				return
 			}
 		},
 	)
 }
 
-func FuzzDecimal_Add(f *testing.F) {
+func FuzzDecimal_Expm1_Exp(f *testing.F) {
 	for _, d := range corpus {
-		for _, e := range corpus {
-			for s := range MaxScale + 1 {
-				f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, s)
-			}
-		}
+		f.Add(d.neg, d.scale, d.coef)
 	}
 
 	f.Fuzz(
-		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
-			d, err := newSafe(dneg, fint(dcoef), dscale)
+		func(t *testing.T, neg bool, scale int, coef uint64) {
+			d, err := newSafe(neg, fint(coef), scale)
 			if err != nil {
 				t.Skip()
 				return
 			}
-			e, err := newSafe(eneg, fint(ecoef), escale)
+
+			e, err := d.Exp()
 			if err != nil {
 				t.Skip()
 				return
 			}
-
-			got, err := d.addFint(e, scale)
+			want, err := e.Sub(One)
 			if err != nil {
-				switch {
-				case errors.Is(err, errDecimalOverflow):
-					t.Skip() // Decimal overflow is an expected error in fast addition
-				case errors.Is(err, errScaleRange):
-					t.Skip() // Scale range is an expected error in fast addition
-				default:
-					t.Errorf("addFint(%q, %q, %v) failed: %v", d, e, scale, err)
-				}
+				t.Skip()
 				return
 			}
-
-			want, err := d.addBint(e, scale)
+			got, err := d.Expm1()
 			if err != nil {
-				t.Errorf("addBint(%q, %q, %v) failed: %v", d, e, scale, err)
+				t.Errorf("%q.Expm1() failed: %v", d, err)
 				return
 			}
 
-			if got.Cmp(want) != 0 {
-				t.Errorf("addBint(%q, %q, %v) = %q, whereas addFint(%q, %q, %v) = %q", d, e, scale, want, d, e, scale, got)
+			if cmp, err := cmpULP(got, want, 10); err != nil {
+				t.Errorf("cmpULP(%q, %q) failed: %v", got, want, err)
+				return
+			} else if cmp != 0 {
+				t.Errorf("%q.Expm1() = %q, want %q", d, got, want)
+				return
 			}
 		},
 	)
 }
 
-func FuzzDecimal_Add_Sum(f *testing.F) {
+func FuzzDecimal_LogBase_Log10(f *testing.F) {
 	for _, d := range corpus {
-		for _, e := range corpus {
-			f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef)
-		}
+		f.Add(d.neg, d.scale, d.coef)
 	}
 
 	f.Fuzz(
-		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64) {
-			d, err := newSafe(dneg, fint(dcoef), dscale)
-			if err != nil {
-				t.Skip()
-				return
-			}
-			e, err := newSafe(eneg, fint(ecoef), escale)
+		func(t *testing.T, neg bool, scale int, coef uint64) {
+			d, err := newSafe(neg, fint(coef), scale)
 			if err != nil {
 				t.Skip()
 				return
 			}
 
-			got, err := d.Add(e)
+			want, err := d.Log10()
 			if err != nil {
 				t.Skip()
 				return
 			}
-
-			want, err := Sum(d, e)
+			got, err := d.LogBase(Ten)
 			if err != nil {
-				t.Errorf("Sum(%q, %q) failed: %v", d, e, err)
+				t.Errorf("%q.LogBase(10) failed: %v", d, err)
 				return
 			}
 
-			if got.CmpTotal(want) != 0 {
-				t.Errorf("Sum(%q, %q) = %q, whereas Add(%q, %q) = %q", d, e, want, d, e, got)
+			if cmp, err := cmpULP(got, want, 1); err != nil {
+				t.Errorf("cmpULP(%q, %q) failed: %v", got, want, err)
+				return
+			} else if cmp != 0 {
+				t.Errorf("%q.LogBase(10) = %q, want %q", d, got, want)
+				return
 			}
 		},
 	)
 }
 
-func FuzzDecimal_Quo(f *testing.F) {
+func FuzzDecimal_Sin_Cos(f *testing.F) {
 	for _, d := range corpus {
-		for _, e := range corpus {
-			for s := range MaxScale + 1 {
-				f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef, s)
-			}
-		}
+		f.Add(d.neg, d.scale, d.coef)
 	}
 
 	f.Fuzz(
-		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64, scale int) {
-			d, err := newSafe(dneg, fint(dcoef), dscale)
+		func(t *testing.T, neg bool, scale int, coef uint64) {
+			d, err := newSafe(neg, fint(coef), scale)
 			if err != nil {
 				t.Skip()
 				return
 			}
-			e, err := newSafe(eneg, fint(ecoef), escale)
+
+			sin, err := d.Sin()
 			if err != nil {
-				t.Skip()
+				t.Errorf("%q.Sin() failed: %v", d, err)
 				return
 			}
-
-			got, err := d.quoFint(e, scale)
+			cos, err := d.Cos()
 			if err != nil {
-				switch {
-				case errors.Is(err, errDecimalOverflow):
-					t.Skip() // Decimal overflow is an expected error in fast division
-				case errors.Is(err, errDivisionByZero):
-					t.Skip() // Division by zero is an expected error in fast division
-				case errors.Is(err, errInexactDivision):
-					t.Skip() // Inexact division is an expected error in fast division
-				case errors.Is(err, errScaleRange):
-					t.Skip() // Scale range is an expected error in fast division
-				default:
-					t.Errorf("quoFint(%q, %q, %v) failed: %v", d, e, scale, err)
-				}
+				t.Errorf("%q.Cos() failed: %v", d, err)
 				return
 			}
-
-			want, err := d.quoBint(e, scale)
+			got, err := sin.Mul(sin)
 			if err != nil {
-				t.Errorf("quoBint(%q, %q, %v) failed: %v", d, e, scale, err)
+				t.Skip()
+				return
+			}
+			got, err = got.AddMul(cos, cos)
+			if err != nil {
+				t.Skip()
 				return
 			}
 
-			if got.Cmp(want) != 0 {
-				t.Errorf("quoBint(%q, %q, %v) = %q, whereas quoFint(%q, %q, %v) = %q", d, e, scale, want, d, e, scale, got)
+			if cmp, err := cmpULP(got, One, 3); err != nil {
+				t.Errorf("cmpULP(%q, %q) failed: %v", got, One, err)
+				return
+			} else if cmp != 0 {
+				t.Errorf("%q.Sin()^2 + %q.Cos()^2 = %q, want %q", d, d, got, One)
+				return
 			}
 		},
 	)
 }
 
-func FuzzDecimal_QuoRem(f *testing.F) {
+func FuzzDecimal_Asin_Acos(f *testing.F) {
 	for _, d := range corpus {
-		for _, e := range corpus {
-			f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef)
-		}
+		f.Add(d.neg, d.scale, d.coef)
 	}
 
+	want := MustNew(1_570_796_326_794_896_619, 18)
+
 	f.Fuzz(
-		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64) {
-			d, err := newSafe(dneg, fint(dcoef), dscale)
+		func(t *testing.T, neg bool, scale int, coef uint64) {
+			d, err := newSafe(neg, fint(coef), scale)
 			if err != nil {
 				t.Skip()
 				return
 			}
-			e, err := newSafe(eneg, fint(ecoef), escale)
+
+			asin, err := d.Asin()
 			if err != nil {
 				t.Skip()
 				return
 			}
-
-			gotQuo, gotRem, err := d.quoRemFint(e)
+			acos, err := d.Acos()
 			if err != nil {
-				switch {
-				case errors.Is(err, errDecimalOverflow):
-					t.Skip() // Decimal overflow is an expected error in fast division
-				case errors.Is(err, errDivisionByZero):
-					t.Skip() // Division by zero is an expected error in fast division
-				default:
-					t.Errorf("quoRemFint(%q, %q) failed: %v", d, e, err)
-				}
+				t.Errorf("%q.Acos() failed: %v", d, err)
 				return
 			}
-			if gotQuo.Scale() != 0 {
-				t.Errorf("quoRemFint(%q, %q) = (%q, _), expected integer quotient", d, e, gotQuo)
-			}
-			if gotRem.Scale() != max(d.Scale(), e.Scale()) {
-				t.Errorf("quoRemFint(%q, %q) = (_, %q), expected remainder with scale %d", d, e, gotRem, max(d.Scale(), e.Scale()))
-			}
-			if !gotRem.IsZero() && gotRem.Sign() != d.Sign() {
-				t.Errorf("quoRemFint(%q, %q) = (_, %q), expected remainder with the same sign as the dividend", d, e, gotRem)
-			}
-
-			wantQuo, wantRem, err := d.quoRemBint(e)
+			got, err := asin.Add(acos)
 			if err != nil {
-				t.Errorf("quoRemBint(%q, %q) failed: %v", d, e, err)
+				t.Errorf("%q.Add(%q) failed: %v", asin, acos, err)
 				return
 			}
 
-			if gotQuo.CmpTotal(wantQuo) != 0 || gotRem.CmpTotal(wantRem) != 0 {
-				t.Errorf("quoRemBint(%q, %q) = (%q, %q), whereas quoRemFint(%q, %q) = (%q, %q)", d, e, wantQuo, wantRem, d, e, gotQuo, gotRem)
+			if cmp, err := cmpULP(got, want, 3); err != nil {
+				t.Errorf("cmpULP(%q, %q) failed: %v", got, want, err)
+				return
+			} else if cmp != 0 {
+				t.Errorf("%q.Asin() + %q.Acos() = %q, want %q", d, d, got, want)
+				return
 			}
 		},
 	)
 }
 
-func FuzzDecimal_Cmp(f *testing.F) {
+func FuzzDecimal_Atan2_Atan(f *testing.F) {
 	for _, d := range corpus {
-		for _, e := range corpus {
-			f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef)
-		}
+		f.Add(d.neg, d.scale, d.coef)
 	}
 
 	f.Fuzz(
-		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64) {
-			d, err := newSafe(dneg, fint(dcoef), dscale)
+		func(t *testing.T, neg bool, scale int, coef uint64) {
+			d, err := newSafe(neg, fint(coef), scale)
 			if err != nil {
 				t.Skip()
 				return
 			}
-			e, err := newSafe(eneg, fint(ecoef), escale)
+
+			want, err := d.Atan()
 			if err != nil {
-				t.Skip()
+				t.Errorf("%q.Atan() failed: %v", d, err)
 				return
 			}
-
-			got, err := d.cmpFint(e)
+			got, err := d.Atan2(One)
 			if err != nil {
-				if errors.Is(err, errDecimalOverflow) {
-					t.Skip() // Decimal overflow is an expected error in fast comparison
-				} else {
-					t.Errorf("cmpFint(%q, %q) failed: %v", d, e, err)
-				}
+				t.Errorf("%q.Atan2(1) failed: %v", d, err)
 				return
 			}
 
-			want := d.cmpBint(e)
-
 			if got != want {
-				t.Errorf("cmpBint(%q, %q) = %v, whereas cmpFint(%q, %q) = %v", d, e, want, d, e, got)
+				t.Errorf("%q.Atan2(1) = %q, want %q", d, got, want)
 				return
 			}
 		},
 	)
 }
 
-func FuzzDecimal_Sqrt_PowInt(f *testing.F) {
+func FuzzDecimal_PowDec_PowInt(f *testing.F) {
 	for _, d := range corpus {
-		f.Add(d.neg, d.scale, d.coef)
+		f.Add(d.neg, d.scale, d.coef, 3)
+		f.Add(d.neg, d.scale, d.coef, -2)
 	}
 
 	f.Fuzz(
-		func(t *testing.T, neg bool, scale int, coef uint64) {
-			want, err := newSafe(neg, fint(coef), scale)
+		func(t *testing.T, neg bool, scale int, coef uint64, power int) {
+			d, err := newSafe(neg, fint(coef), scale)
 			if err != nil {
 				t.Skip()
 				return
 			}
-
-			d, err := want.Sqrt()
+			e, err := New(int64(power), 0)
 			if err != nil {
 				t.Skip()
 				return
 			}
-			got, err := d.PowInt(2)
+
+			want, err := d.PowInt(power)
 			if err != nil {
 				t.Skip()
 				return
 			}
+			got, err := d.PowDec(e)
+			if err != nil {
+				t.Errorf("%q.PowDec(%q) failed: %v", d, e, err)
+				return
+			}
 
-			if cmp, err := cmpULP(got, want, 3); err != nil {
-				t.Errorf("cmpULP(%q, %q) failed: %v", got, want, err)
-			} else if cmp != 0 {
-				t.Errorf("%q.Sqrt().PowInt(2) = %q, want %q", want, got, want)
+			if got != want {
+				t.Errorf("%q.PowDec(%q) = %q, want %q", d, e, got, want)
 				return
 			}
 		},
 	)
 }
 
-func FuzzDecimal_Log_Exp(f *testing.F) {
+func FuzzDecimal_PowDec_Sqrt(f *testing.F) {
 	for _, d := range corpus {
 		f.Add(d.neg, d.scale, d.coef)
 	}
 
 	f.Fuzz(
 		func(t *testing.T, neg bool, scale int, coef uint64) {
-			want, err := newSafe(neg, fint(coef), scale)
+			d, err := newSafe(neg, fint(coef), scale)
 			if err != nil {
 				t.Skip()
 				return
 			}
 
-			d, err := want.Log()
+			want, err := d.Sqrt()
 			if err != nil {
 				t.Skip()
 				return
 			}
-			got, err := d.Exp()
+			got, err := d.PowDec(MustNew(5, 1))
 			if err != nil {
-				t.Skip()
+				t.Errorf("%q.PowDec(0.5) failed: %v", d, err)
 				return
 			}
 
-			if cmp, err := cmpULP(got, want, 70); err != nil {
+			if cmp, err := cmpULP(got, want, 1); err != nil {
 				t.Errorf("cmpULP(%q, %q) failed: %v", got, want, err)
 				return
 			} else if cmp != 0 {
-				t.Errorf("%q.Log().Exp() = %q, want %q", want, got, want)
+				t.Errorf("%q.PowDec(0.5) = %q, want %q", d, got, want)
 				return
 			}
 		},
@@ -4874,7 +7339,7 @@ func FuzzDecimal_Sub_Cmp(f *testing.F) {
 
 			f, err := d.Sub(e)
 			if err != nil {
-				if errors.Is(err, errDecimalOverflow) {
+				if errors.Is(err, ErrOverflow) {
 					t.Skip() // Decimal overflow is an expected error in subtraction
 				} else {
 					t.Errorf("%q.Sub(%q) failed: %v", d, e, err)
@@ -4906,13 +7371,13 @@ func FuzzDecimal_New(f *testing.F) {
 
 	f.Fuzz(
 		func(t *testing.T, neg bool, scale int, coef uint64) {
-			got, err := newFromFint(neg, fint(coef), scale, 0)
+			got, err := newFromFint(neg, fint(coef), scale, 0, HalfEven)
 			if err != nil {
 				t.Skip()
 				return
 			}
 
-			want, err := newFromBint(neg, toBint(coef), scale, 0)
+			want, err := newFromBint(neg, toBint(coef), scale, 0, HalfEven)
 			if err != nil {
 				t.Errorf("newDecimalFromBint(%v, %v, %v, 0) failed: %v", neg, coef, scale, err)
 				return
@@ -0,0 +1,143 @@
+package decimalbson
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/govalues/decimal"
+)
+
+// BSON types supported by this package.
+const (
+	typeDouble     byte = 0x01
+	typeString     byte = 0x02
+	typeNull       byte = 0x0a
+	typeInt32      byte = 0x10
+	typeInt64      byte = 0x12
+	typeDecimal128 byte = 0x13
+)
+
+// Decimal is a decimal that is stored as a BSON Decimal128 value.
+type Decimal struct {
+	decimal.Decimal
+}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface.
+// The decimal is encoded as a BSON Decimal128 value,
+// see also function [decimal.EncodeDecimal128BID].
+func (d Decimal) MarshalBSONValue() (byte, []byte, error) {
+	return typeDecimal128, appendDecimal128(nil, d.Decimal), nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface.
+// BSON Decimal128, int32, int64, double, and string values are supported,
+// see also function [decimal.DecodeDecimal128BID].
+// In case of an error, the decimal is not changed.
+//
+// UnmarshalBSONValue returns an error if:
+//   - the value has another type, including null;
+//   - the value is malformed;
+//   - the value is an infinity or a NaN;
+//   - the integer part of the value has more than [decimal.MaxPrec] digits.
+func (d *Decimal) UnmarshalBSONValue(typ byte, data []byte) error {
+	if typ == typeNull {
+		return fmt.Errorf("converting BSON null to %T: null is not supported, use %T", d, NullDecimal{})
+	}
+	e, err := parseValue(typ, data)
+	if err != nil {
+		return err
+	}
+	d.Decimal = e
+	return nil
+}
+
+// NullDecimal is a decimal that is stored as a BSON Decimal128 value
+// or as a BSON null value if it is not valid.
+type NullDecimal struct {
+	decimal.NullDecimal
+}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface.
+// A null decimal is encoded as a BSON null value, while other decimals
+// are encoded in the same way as [Decimal.MarshalBSONValue] does.
+func (n NullDecimal) MarshalBSONValue() (byte, []byte, error) {
+	if !n.Valid {
+		return typeNull, nil, nil
+	}
+	return typeDecimal128, appendDecimal128(nil, n.Decimal), nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface.
+// A BSON null value is decoded as a null decimal, while other values
+// are decoded in the same way as [Decimal.UnmarshalBSONValue] does.
+// In case of an error, the decimal is not changed.
+func (n *NullDecimal) UnmarshalBSONValue(typ byte, data []byte) error {
+	if typ == typeNull {
+		n.Decimal = decimal.Decimal{}
+		n.Valid = false
+		return nil
+	}
+	e, err := parseValue(typ, data)
+	if err != nil {
+		return err
+	}
+	n.Decimal = e
+	n.Valid = true
+	return nil
+}
+
+// appendDecimal128 appends the BSON Decimal128 representation of decimal d,
+// which is the low half followed by the high half, both in little-endian order.
+func appendDecimal128(b []byte, d decimal.Decimal) []byte {
+	hi, lo := decimal.EncodeDecimal128BID(d)
+	b = binary.LittleEndian.AppendUint64(b, lo)
+	return binary.LittleEndian.AppendUint64(b, hi)
+}
+
+// parseValue converts a BSON value of the given type to a decimal.
+func parseValue(typ byte, data []byte) (decimal.Decimal, error) {
+	var d decimal.Decimal
+	var err error
+	switch typ {
+	case typeDecimal128:
+		if len(data) != 16 {
+			return decimal.Decimal{}, fmt.Errorf("converting BSON decimal128: %w: got %v bytes, want 16", decimal.ErrInvalidDecimal, len(data))
+		}
+		lo := binary.LittleEndian.Uint64(data[:8])
+		hi := binary.LittleEndian.Uint64(data[8:])
+		d, err = decimal.DecodeDecimal128BID(hi, lo)
+	case typeInt32:
+		if len(data) != 4 {
+			return decimal.Decimal{}, fmt.Errorf("converting BSON int32: %w: got %v bytes, want 4", decimal.ErrInvalidDecimal, len(data))
+		}
+		//nolint:gosec
+		d, err = decimal.New(int64(int32(binary.LittleEndian.Uint32(data))), 0)
+	case typeInt64:
+		if len(data) != 8 {
+			return decimal.Decimal{}, fmt.Errorf("converting BSON int64: %w: got %v bytes, want 8", decimal.ErrInvalidDecimal, len(data))
+		}
+		//nolint:gosec
+		d, err = decimal.New(int64(binary.LittleEndian.Uint64(data)), 0)
+	case typeDouble:
+		if len(data) != 8 {
+			return decimal.Decimal{}, fmt.Errorf("converting BSON double: %w: got %v bytes, want 8", decimal.ErrInvalidDecimal, len(data))
+		}
+		d, err = decimal.NewFromFloat64(math.Float64frombits(binary.LittleEndian.Uint64(data)))
+	case typeString:
+		// The length includes the terminating null byte
+		if len(data) < 5 || data[len(data)-1] != 0 ||
+			int(binary.LittleEndian.Uint32(data[:4])) != len(data)-4 ||
+			bytes.IndexByte(data[4:len(data)-1], 0) >= 0 {
+			return decimal.Decimal{}, fmt.Errorf("converting BSON string: %w: malformed string", decimal.ErrInvalidDecimal)
+		}
+		d, err = decimal.NewFromString(string(data[4 : len(data)-1]))
+	default:
+		return decimal.Decimal{}, fmt.Errorf("converting BSON type %#02x to decimal: type is not supported", typ)
+	}
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	return d, nil
+}
@@ -0,0 +1,249 @@
+package decimalbson
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+// valueMarshaler and valueUnmarshaler mirror the interfaces of the MongoDB Go driver v2.
+type valueMarshaler interface {
+	MarshalBSONValue() (typ byte, data []byte, err error)
+}
+
+type valueUnmarshaler interface {
+	UnmarshalBSONValue(typ byte, data []byte) error
+}
+
+func TestDecimal_Interfaces(t *testing.T) {
+	var d any = Decimal{}
+	_, ok := d.(valueMarshaler)
+	if !ok {
+		t.Errorf("%T does not implement bson.ValueMarshaler", d)
+	}
+	d = &Decimal{}
+	_, ok = d.(valueUnmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement bson.ValueUnmarshaler", d)
+	}
+
+	n := any(NullDecimal{})
+	_, ok = n.(valueMarshaler)
+	if !ok {
+		t.Errorf("%T does not implement bson.ValueMarshaler", n)
+	}
+	n = &NullDecimal{}
+	_, ok = n.(valueUnmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement bson.ValueUnmarshaler", n)
+	}
+}
+
+func TestDecimal_MarshalBSONValue(t *testing.T) {
+	tests := []struct {
+		d    string
+		want string
+	}{
+		{"0", "00000000000000000000000000004030"},
+		{"1", "01000000000000000000000000004030"},
+		{"-1", "010000000000000000000000000040b0"},
+		{"0.1", "0100000000000000000000000000" + "3e30"},
+		{"0.001234", "d2040000000000000000000000003430"},
+		{"123456789012", "141a99be1c0000000000000000004030"},
+		{"0.00123400000", "40ef5a07000000000000000000002a30"},
+		{"0.1234567890123456789", "1581e97df41022110000000000001a30"},
+		{"-1.00", "64000000000000000000000000003cb0"},
+		{"9999999999999999999", "ffffe7890423c78a0000000000004030"},
+		{"0.0000000000000000001", "01000000000000000000000000001a30"},
+	}
+	for _, tt := range tests {
+		d := Decimal{decimal.MustNewFromString(tt.d)}
+		typ, data, err := d.MarshalBSONValue()
+		if err != nil {
+			t.Errorf("%q.MarshalBSONValue() failed: %v", d, err)
+			continue
+		}
+		if typ != typeDecimal128 {
+			t.Errorf("%q.MarshalBSONValue() type = %#02x, want %#02x", d, typ, typeDecimal128)
+		}
+		got := hex.EncodeToString(data)
+		if got != tt.want {
+			t.Errorf("%q.MarshalBSONValue() = %v, want %v", d, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_UnmarshalBSONValue(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			typ  byte
+			data string
+			want string
+		}{
+			// Decimal128, the BSON corpus (decimal128-1.json)
+			{typeDecimal128, "00000000000000000000000000004030", "0"},
+			{typeDecimal128, "000000000000000000000000000040b0", "0"},
+			{typeDecimal128, "01000000000000000000000000004030", "1"},
+			{typeDecimal128, "010000000000000000000000000040b0", "-1"},
+			{typeDecimal128, "01000000000000000000000000003e30", "0.1"},
+			{typeDecimal128, "d2040000000000000000000000003430", "0.001234"},
+			{typeDecimal128, "40ef5a07000000000000000000002a30", "0.00123400000"},
+			{typeDecimal128, "f2af967ed05c82de3297ff6fde3cfc2f", "0.1234567890123456789"},
+			{typeDecimal128, "01000000000000000000000000004630", "1000"},
+			{typeDecimal128, "00000000000000000000000000000000", "0.0000000000000000000"},
+			{typeDecimal128, "0000000000000000000000000000fe5f", "0"},
+
+			// Decimal128, trailing zeros
+			{typeDecimal128, "64000000000000000000000000003cb0", "-1.00"},
+			{typeDecimal128, "01000000000000000000000000001a30", "0.0000000000000000001"},
+			{typeDecimal128, "ffffe7890423c78a0000000000004030", "9999999999999999999"},
+
+			// Other types
+			{typeInt32, "ffffffff", "-1"},
+			{typeInt32, "00000080", "-2147483648"},
+			{typeInt64, "ffffffffffffff7f", "9223372036854775807"},
+			{typeDouble, "000000000000f83f", "1.5"},
+			{typeString, "050000002d312e3000", "-1.0"},
+		}
+		for _, tt := range tests {
+			data, err := hex.DecodeString(tt.data)
+			if err != nil {
+				t.Fatalf("hex.DecodeString(%q) failed: %v", tt.data, err)
+			}
+			var got Decimal
+			err = got.UnmarshalBSONValue(tt.typ, data)
+			if err != nil {
+				t.Errorf("UnmarshalBSONValue(%#02x, %v) failed: %v", tt.typ, tt.data, err)
+				continue
+			}
+			want := decimal.MustNewFromString(tt.want)
+			if got.Decimal != want {
+				t.Errorf("UnmarshalBSONValue(%#02x, %v) = %q, want %q", tt.typ, tt.data, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			typ  byte
+			data string
+		}{
+			"nan 1":             {typeDecimal128, "0000000000000000000000000000007c"},
+			"nan 2":             {typeDecimal128, "0000000000000000000000000000007e"},
+			"infinity 1":        {typeDecimal128, "00000000000000000000000000000078"},
+			"infinity 2":        {typeDecimal128, "000000000000000000000000000000f8"},
+			"overflow 1":        {typeDecimal128, "01000000000000000000000000006830"},
+			"overflow 2":        {typeDecimal128, "ffffffff638e8d37c087adbe09ed4130"},
+			"length 1":          {typeDecimal128, ""},
+			"length 2":          {typeDecimal128, "0100000000000000000000000000403000"},
+			"length 3":          {typeInt32, "0100000000000000"},
+			"length 4":          {typeInt64, "01000000"},
+			"length 5":          {typeDouble, "01000000"},
+			"null":              {typeNull, ""},
+			"double nan":        {typeDouble, "000000000000f87f"},
+			"string 1":          {typeString, ""},
+			"string 2":          {typeString, "0200000031"},
+			"string 3":          {typeString, "030000003100"},
+			"string 4":          {typeString, "0300000031003100"},
+			"string 5":          {typeString, "020000007878"},
+			"unsupported type":  {0x08, "01"},
+			"unsupported array": {0x04, "0500000000"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				data, err := hex.DecodeString(tt.data)
+				if err != nil {
+					t.Fatalf("hex.DecodeString(%q) failed: %v", tt.data, err)
+				}
+				want := Decimal{decimal.MustNewFromString("1.23")}
+				got := want
+				err = got.UnmarshalBSONValue(tt.typ, data)
+				if err == nil {
+					t.Errorf("UnmarshalBSONValue(%#02x, %v) did not fail", tt.typ, tt.data)
+				}
+				if got != want {
+					t.Errorf("UnmarshalBSONValue(%#02x, %v) changed decimal to %q", tt.typ, tt.data, got)
+				}
+			})
+		}
+	})
+}
+
+func TestNullDecimal_BSONValue(t *testing.T) {
+	tests := []struct {
+		n    NullDecimal
+		typ  byte
+		data string
+	}{
+		{NullDecimal{}, typeNull, ""},
+		{NullDecimal{decimal.NullDecimal{Decimal: decimal.MustNewFromString("-1.00"), Valid: true}}, typeDecimal128, "64000000000000000000000000003cb0"},
+		{NullDecimal{decimal.NullDecimal{Decimal: decimal.Zero, Valid: true}}, typeDecimal128, "00000000000000000000000000004030"},
+	}
+	for _, tt := range tests {
+		typ, data, err := tt.n.MarshalBSONValue()
+		if err != nil {
+			t.Errorf("%+v.MarshalBSONValue() failed: %v", tt.n, err)
+			continue
+		}
+		if typ != tt.typ || hex.EncodeToString(data) != tt.data {
+			t.Errorf("%+v.MarshalBSONValue() = (%#02x, %x), want (%#02x, %v)", tt.n, typ, data, tt.typ, tt.data)
+		}
+		got := NullDecimal{decimal.NullDecimal{Decimal: decimal.One, Valid: !tt.n.Valid}}
+		err = got.UnmarshalBSONValue(typ, data)
+		if err != nil {
+			t.Errorf("UnmarshalBSONValue(%#02x, %x) failed: %v", typ, data, err)
+			continue
+		}
+		if got != tt.n {
+			t.Errorf("UnmarshalBSONValue(%#02x, %x) = %+v, want %+v", typ, data, got, tt.n)
+		}
+	}
+
+	t.Run("error", func(t *testing.T) {
+		want := NullDecimal{decimal.NullDecimal{Decimal: decimal.One, Valid: true}}
+		got := want
+		err := got.UnmarshalBSONValue(typeDecimal128, []byte{0x01})
+		if err == nil {
+			t.Errorf("UnmarshalBSONValue(%#02x, 01) did not fail", typeDecimal128)
+		}
+		if got != want {
+			t.Errorf("UnmarshalBSONValue(%#02x, 01) changed decimal to %+v", typeDecimal128, got)
+		}
+	})
+}
+
+func FuzzDecimal_BSONValue(f *testing.F) {
+	for _, s := range []string{"0", "1", "-1.00", "0.0000000000000000001", "-999999999.9999999999", "9223372036854775807"} {
+		d := decimal.MustNewFromString(s)
+		coef := int64(d.Coef())
+		if d.IsNeg() {
+			coef = -coef
+		}
+		f.Add(coef, d.Scale())
+	}
+
+	f.Fuzz(
+		func(t *testing.T, coef int64, scale int) {
+			d, err := decimal.New(coef, scale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			typ, data, err := Decimal{d}.MarshalBSONValue()
+			if err != nil {
+				t.Errorf("%q.MarshalBSONValue() failed: %v", d, err)
+				return
+			}
+			var got Decimal
+			err = got.UnmarshalBSONValue(typ, data)
+			if err != nil {
+				t.Errorf("UnmarshalBSONValue(%#02x, %x) failed: %v", typ, data, err)
+				return
+			}
+			if got.Decimal != d {
+				t.Errorf("UnmarshalBSONValue(MarshalBSONValue(%q)) = %q", d, got)
+			}
+		},
+	)
+}
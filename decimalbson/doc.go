@@ -0,0 +1,39 @@
+/*
+Package decimalbson stores [decimal.Decimal] in MongoDB as [BSON Decimal128]
+values, so that decimals can be compared, sorted, and aggregated by the server.
+
+The package has no dependencies outside the standard library.
+The [Decimal] and [NullDecimal] wrappers implement the MarshalBSONValue and
+UnmarshalBSONValue methods with the signatures expected by the ValueMarshaler
+and ValueUnmarshaler interfaces of the [MongoDB Go driver] v2, so they can be
+used as fields of documents without registering any codecs:
+
+	type Payment struct {
+	  Amount   decimalbson.Decimal     `bson:"amount"`
+	  Discount decimalbson.NullDecimal `bson:"discount"`
+	}
+
+# Representation
+
+BSON Decimal128 is the [IEEE 754] decimal128 format in the binary integer
+decimal (BID) encoding, so the conversion itself is done by
+[decimal.EncodeDecimal128BID] and [decimal.DecodeDecimal128BID].
+Their high and low 64-bit halves can also be passed directly to and from
+the Decimal128 type of the driver:
+
+	d128 := bson.NewDecimal128(decimal.EncodeDecimal128BID(d))
+	d, err := decimal.DecodeDecimal128BID(d128.GetBytes())
+
+Decimals are always encoded exactly, and the exponent is equal to minus the
+scale of the decimal, so trailing zeros are preserved.
+When decoding, values with more than [decimal.MaxPrec] significant digits are
+rounded, whereas infinities, NaNs, and values with an integer part that is
+too large result in errors.
+For convenience, BSON int32, int64, double, and string values are accepted
+as well.
+
+[BSON Decimal128]: https://github.com/mongodb/specifications/blob/master/source/bson-decimal128/decimal128.md
+[IEEE 754]: https://en.wikipedia.org/wiki/Decimal128_floating-point_format
+[MongoDB Go driver]: https://pkg.go.dev/go.mongodb.org/mongo-driver/v2/bson
+*/
+package decimalbson
@@ -0,0 +1,38 @@
+package decimalbson_test
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/decimal/decimalbson"
+)
+
+func ExampleDecimal_MarshalBSONValue() {
+	d := decimalbson.Decimal{Decimal: decimal.MustNewFromString("-1.00")}
+	typ, data, err := d.MarshalBSONValue()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%#02x %x\n", typ, data)
+	// Output: 0x13 64000000000000000000000000003cb0
+}
+
+func ExampleDecimal_UnmarshalBSONValue() {
+	data := []byte{0xd2, 0x04, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x34, 0x30}
+	var d decimalbson.Decimal
+	if err := d.UnmarshalBSONValue(0x13, data); err != nil {
+		panic(err)
+	}
+	fmt.Println(d)
+	// Output: 0.001234
+}
+
+func ExampleNullDecimal_MarshalBSONValue() {
+	var n decimalbson.NullDecimal
+	typ, data, err := n.MarshalBSONValue()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%#02x %x\n", typ, data)
+	// Output: 0x0a
+}
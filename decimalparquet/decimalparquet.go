@@ -0,0 +1,367 @@
+package decimalparquet
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+
+	"github.com/govalues/decimal"
+)
+
+const (
+	// MaxPrec is the maximum precision of the DECIMAL logical type supported
+	// by this package. It is equal to the precision of the Arrow Decimal128 type.
+	MaxPrec = 38
+	// MaxPrecInt32 is the maximum precision of a DECIMAL column stored as INT32.
+	MaxPrecInt32 = 9
+	// MaxPrecInt64 is the maximum precision of a DECIMAL column stored as INT64.
+	MaxPrecInt64 = 18
+)
+
+// pow10 is a cache of powers of 10, where pow10[x] = 10^x.
+var pow10 = func() [20]uint64 {
+	var p [20]uint64
+	p[0] = 1
+	for i := 1; i < len(p); i++ {
+		p[i] = p[i-1] * 10
+	}
+	return p
+}()
+
+// Type represents the DECIMAL(precision, scale) logical type, which is
+// a column of unscaled integers with a fixed number of digits after the
+// decimal point.
+// The zero value is not a valid type, use [NewType] to create one.
+type Type struct {
+	prec  int
+	scale int
+}
+
+// NewType returns the DECIMAL(prec, scale) logical type.
+//
+// NewType returns an error if:
+//   - the precision is not in the range [1, MaxPrec];
+//   - the scale is not in the range [0, prec].
+func NewType(prec, scale int) (Type, error) {
+	if prec < 1 || prec > MaxPrec {
+		return Type{}, fmt.Errorf("creating DECIMAL(%v,%v): %w: precision must be between 1 and %v", prec, scale, decimal.ErrPrecRange, MaxPrec)
+	}
+	if scale < 0 || scale > prec {
+		return Type{}, fmt.Errorf("creating DECIMAL(%v,%v): %w: scale must be between 0 and %v", prec, scale, decimal.ErrScaleRange, prec)
+	}
+	return Type{prec: prec, scale: scale}, nil
+}
+
+// MustNewType is like [NewType] but panics if the type cannot be created.
+// This function simplifies safe initialization of global variables holding types.
+func MustNewType(prec, scale int) Type {
+	t, err := NewType(prec, scale)
+	if err != nil {
+		panic(fmt.Sprintf("NewType(%v, %v) failed: %v", prec, scale, err))
+	}
+	return t
+}
+
+// Prec returns the maximum number of digits in the unscaled integer.
+func (t Type) Prec() int {
+	return t.prec
+}
+
+// Scale returns the number of digits after the decimal point.
+func (t Type) Scale() int {
+	return t.scale
+}
+
+// String implements the [fmt.Stringer] interface and returns the type
+// in the form "DECIMAL(p,s)".
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (t Type) String() string {
+	return fmt.Sprintf("DECIMAL(%v,%v)", t.prec, t.scale)
+}
+
+// FixedLen returns the minimum length of a FIXED_LEN_BYTE_ARRAY column
+// that can hold any value of the type.
+// For example, DECIMAL(9,2) requires 4 bytes, and DECIMAL(38,10) requires 16 bytes.
+func (t Type) FixedLen() int {
+	// The number of bits, including the sign bit, needed for 10^prec - 1
+	n := int(math.Ceil(float64(t.prec)*math.Log2(10))) + 1
+	return (n + 7) / 8
+}
+
+// Int32 returns the unscaled value of the decimal for a column stored as INT32.
+// The decimal is rounded to the scale of the type using
+// [rounding half to even] (banker's rounding), see also method [decimal.Decimal.Round].
+//
+// Int32 returns an error if:
+//   - the precision of the type is greater than [MaxPrecInt32];
+//   - the rounded decimal has more digits than the precision of the type.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (t Type) Int32(d decimal.Decimal) (int32, error) {
+	if t.prec > MaxPrecInt32 {
+		return 0, fmt.Errorf("converting %v to %v: precision is too large for INT32", d, t)
+	}
+	_, lo, err := t.unscaled(d)
+	if err != nil {
+		return 0, err
+	}
+	//nolint:gosec
+	return int32(lo), nil
+}
+
+// Int64 returns the unscaled value of the decimal for a column stored as INT64.
+// The decimal is rounded in the same way as [Type.Int32] does.
+//
+// Int64 returns an error if:
+//   - the precision of the type is greater than [MaxPrecInt64];
+//   - the rounded decimal has more digits than the precision of the type.
+func (t Type) Int64(d decimal.Decimal) (int64, error) {
+	if t.prec > MaxPrecInt64 {
+		return 0, fmt.Errorf("converting %v to %v: precision is too large for INT64", d, t)
+	}
+	_, lo, err := t.unscaled(d)
+	if err != nil {
+		return 0, err
+	}
+	//nolint:gosec
+	return int64(lo), nil
+}
+
+// Decimal128 returns the unscaled value of the decimal as the high and low
+// halves of an Arrow Decimal128 value, which can be passed to the
+// decimal128.New function of the Arrow Go library.
+// The decimal is rounded in the same way as [Type.Int32] does.
+//
+// Decimal128 returns an error if the rounded decimal has more digits than
+// the precision of the type.
+func (t Type) Decimal128(d decimal.Decimal) (hi int64, lo uint64, err error) {
+	uhi, lo, err := t.unscaled(d)
+	if err != nil {
+		return 0, 0, err
+	}
+	//nolint:gosec
+	return int64(uhi), lo, nil
+}
+
+// Bytes returns the unscaled value of the decimal as a big-endian two's
+// complement integer with the minimum number of bytes, as required for
+// a column stored as BYTE_ARRAY.
+// The decimal is rounded in the same way as [Type.Int32] does.
+//
+// Bytes returns an error if the rounded decimal has more digits than
+// the precision of the type.
+func (t Type) Bytes(d decimal.Decimal) ([]byte, error) {
+	hi, lo, err := t.unscaled(d)
+	if err != nil {
+		return nil, err
+	}
+	b := appendInt128(make([]byte, 0, 16), hi, lo)
+	return b[16-minLen(b):], nil
+}
+
+// FixedBytes returns the unscaled value of the decimal as a big-endian two's
+// complement integer of exactly size bytes, as required for a column stored as
+// FIXED_LEN_BYTE_ARRAY(size).
+// The decimal is rounded in the same way as [Type.Int32] does.
+//
+// FixedBytes returns an error if:
+//   - the rounded decimal has more digits than the precision of the type;
+//   - the unscaled value does not fit into size bytes.
+func (t Type) FixedBytes(d decimal.Decimal, size int) ([]byte, error) {
+	hi, lo, err := t.unscaled(d)
+	if err != nil {
+		return nil, err
+	}
+	b := appendInt128(make([]byte, 0, 16), hi, lo)
+	n := minLen(b)
+	if size < n {
+		return nil, fmt.Errorf("converting %v to %v: %w: unscaled value needs %v bytes, got %v", d, t, decimal.ErrOverflow, n, size)
+	}
+	if size <= 16 {
+		return b[16-size:], nil
+	}
+	// Sign extension
+	ext := make([]byte, size-16, size)
+	if int64(hi) < 0 {
+		for i := range ext {
+			ext[i] = 0xff
+		}
+	}
+	return append(ext, b...), nil
+}
+
+// FromInt32 converts an unscaled value of a column stored as INT32 to a decimal.
+//
+// FromInt32 returns an error if the value has more digits than
+// the precision of the type.
+func (t Type) FromInt32(v int32) (decimal.Decimal, error) {
+	return t.FromInt64(int64(v))
+}
+
+// FromInt64 converts an unscaled value of a column stored as INT64 to a decimal.
+//
+// FromInt64 returns an error if the value has more digits than
+// the precision of the type.
+func (t Type) FromInt64(v int64) (decimal.Decimal, error) {
+	var hi uint64
+	if v < 0 {
+		hi = math.MaxUint64
+	}
+	//nolint:gosec
+	return t.fromUnscaled(hi, uint64(v))
+}
+
+// FromDecimal128 converts the high and low halves of an Arrow Decimal128
+// value to a decimal, see also method [Type.Decimal128].
+// If the scale of the type is greater than [decimal.MaxScale] or the value
+// has more than [decimal.MaxPrec] digits, the result is rounded using
+// [rounding half to even] (banker's rounding).
+//
+// FromDecimal128 returns an error if:
+//   - the value has more digits than the precision of the type;
+//   - the integer part of the value has more than [decimal.MaxPrec] digits.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (t Type) FromDecimal128(hi int64, lo uint64) (decimal.Decimal, error) {
+	//nolint:gosec
+	return t.fromUnscaled(uint64(hi), lo)
+}
+
+// FromBytes converts a big-endian two's complement unscaled value of a column
+// stored as BYTE_ARRAY or FIXED_LEN_BYTE_ARRAY to a decimal.
+// The result is rounded in the same way as [Type.FromDecimal128] does.
+//
+// FromBytes returns an error if:
+//   - the byte slice is empty;
+//   - the value has more digits than the precision of the type;
+//   - the integer part of the value has more than [decimal.MaxPrec] digits.
+func (t Type) FromBytes(b []byte) (decimal.Decimal, error) {
+	if len(b) == 0 {
+		return decimal.Decimal{}, fmt.Errorf("converting %v: %w: empty unscaled value", t, decimal.ErrInvalidDecimal)
+	}
+	// Sign extension
+	var ext byte
+	if b[0]&0x80 != 0 {
+		ext = 0xff
+	}
+	for len(b) > 16 {
+		if b[0] != ext {
+			return decimal.Decimal{}, fmt.Errorf("converting %v: %w: unscaled value has more than %v digits", t, decimal.ErrOverflow, t.prec)
+		}
+		b = b[1:]
+	}
+	var buf [16]byte
+	for i := range 16 - len(b) {
+		buf[i] = ext
+	}
+	copy(buf[16-len(b):], b)
+	var hi, lo uint64
+	for i := range 8 {
+		hi = hi<<8 | uint64(buf[i])
+		lo = lo<<8 | uint64(buf[i+8])
+	}
+	return t.fromUnscaled(hi, lo)
+}
+
+// unscaled returns the decimal rounded to the scale of the type and
+// multiplied by 10^scale as a 128-bit two's complement integer.
+func (t Type) unscaled(d decimal.Decimal) (hi, lo uint64, err error) {
+	e := d.Round(t.scale)
+	shift := t.scale - e.Scale()
+	if !e.IsZero() && e.Prec()+shift > t.prec {
+		return 0, 0, fmt.Errorf("converting %v to %v: %w: the number of digits (%v) exceeds the precision", d, t, decimal.ErrOverflow, e.Prec()+shift)
+	}
+	lo = e.Coef()
+	for shift > 0 {
+		n := min(shift, len(pow10)-1)
+		hi, lo = mul128(hi, lo, pow10[n])
+		shift -= n
+	}
+	if e.IsNeg() {
+		hi, lo = neg128(hi, lo)
+	}
+	return hi, lo, nil
+}
+
+// fromUnscaled converts a 128-bit two's complement unscaled value to a decimal.
+func (t Type) fromUnscaled(hi, lo uint64) (decimal.Decimal, error) {
+	neg := int64(hi) < 0
+	if neg {
+		hi, lo = neg128(hi, lo)
+	}
+
+	// Precision validation
+	phi, plo := uint64(0), uint64(1)
+	for shift := t.prec; shift > 0; {
+		n := min(shift, len(pow10)-1)
+		phi, plo = mul128(phi, plo, pow10[n])
+		shift -= n
+	}
+	if hi > phi || hi == phi && lo >= plo {
+		return decimal.Decimal{}, fmt.Errorf("converting %v: %w: unscaled value has more than %v digits", t, decimal.ErrOverflow, t.prec)
+	}
+
+	// Special case: 64-bit coefficient
+	if hi == 0 && lo <= math.MaxInt64 && t.scale <= decimal.MaxScale {
+		//nolint:gosec
+		coef := int64(lo)
+		if neg {
+			coef = -coef
+		}
+		return decimal.New(coef, t.scale)
+	}
+
+	// General case
+	coef := new(big.Int).SetUint64(hi)
+	coef.Lsh(coef, 64)
+	coef.Or(coef, new(big.Int).SetUint64(lo))
+	if neg {
+		coef.Neg(coef)
+	}
+	b, err := decimal.NewBigDecimalFromBigInt(coef, t.scale)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("converting %v: %w", t, err)
+	}
+	d, err := b.Decimal()
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("converting %v: %w", t, err)
+	}
+	return d, nil
+}
+
+// mul128 returns the 128-bit product of (hi, lo) and y.
+// The caller must ensure that the product does not overflow.
+func mul128(hi, lo, y uint64) (uint64, uint64) {
+	h, l := bits.Mul64(lo, y)
+	return hi*y + h, l
+}
+
+// neg128 returns the 128-bit two's complement negation of (hi, lo).
+func neg128(hi, lo uint64) (uint64, uint64) {
+	lo, borrow := bits.Sub64(0, lo, 0)
+	hi, _ = bits.Sub64(0, hi, borrow)
+	return hi, lo
+}
+
+// appendInt128 appends the big-endian representation of (hi, lo).
+func appendInt128(b []byte, hi, lo uint64) []byte {
+	for i := 56; i >= 0; i -= 8 {
+		b = append(b, byte(hi>>i))
+	}
+	for i := 56; i >= 0; i -= 8 {
+		b = append(b, byte(lo>>i))
+	}
+	return b
+}
+
+// minLen returns the minimum number of trailing bytes of a big-endian two's
+// complement integer that represent the same value.
+func minLen(b []byte) int {
+	i := 0
+	for i < len(b)-1 && (b[i] == 0x00 && b[i+1]&0x80 == 0 || b[i] == 0xff && b[i+1]&0x80 != 0) {
+		i++
+	}
+	return len(b) - i
+}
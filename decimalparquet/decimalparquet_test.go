@@ -0,0 +1,468 @@
+package decimalparquet
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestNewType(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			prec, scale int
+			want        string
+		}{
+			{1, 0, "DECIMAL(1,0)"},
+			{9, 2, "DECIMAL(9,2)"},
+			{18, 18, "DECIMAL(18,18)"},
+			{38, 10, "DECIMAL(38,10)"},
+			{38, 38, "DECIMAL(38,38)"},
+		}
+		for _, tt := range tests {
+			got, err := NewType(tt.prec, tt.scale)
+			if err != nil {
+				t.Errorf("NewType(%v, %v) failed: %v", tt.prec, tt.scale, err)
+				continue
+			}
+			if got.Prec() != tt.prec || got.Scale() != tt.scale || got.String() != tt.want {
+				t.Errorf("NewType(%v, %v) = %v, want %v", tt.prec, tt.scale, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			prec, scale int
+			want        error
+		}{
+			"prec 1":  {0, 0, decimal.ErrPrecRange},
+			"prec 2":  {39, 0, decimal.ErrPrecRange},
+			"prec 3":  {-1, 0, decimal.ErrPrecRange},
+			"scale 1": {9, -1, decimal.ErrScaleRange},
+			"scale 2": {9, 10, decimal.ErrScaleRange},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := NewType(tt.prec, tt.scale)
+				if !errors.Is(err, tt.want) {
+					t.Errorf("NewType(%v, %v) = %v, want %v", tt.prec, tt.scale, err, tt.want)
+				}
+			})
+		}
+	})
+}
+
+func TestType_FixedLen(t *testing.T) {
+	for prec := 1; prec <= MaxPrec; prec++ {
+		// The smallest n, such that 10^prec - 1 <= 2^(8n-1) - 1
+		max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(prec)), nil)
+		want := (max.BitLen() + 8) / 8
+		got := MustNewType(prec, 0).FixedLen()
+		if got != want {
+			t.Errorf("DECIMAL(%v,0).FixedLen() = %v, want %v", prec, got, want)
+		}
+	}
+}
+
+func TestType_Int(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			prec, scale int
+			d           string
+			want        int64
+		}{
+			{9, 2, "0", 0},
+			{9, 2, "1.5", 150},
+			{9, 2, "-1.005", -100},
+			{9, 2, "-1.015", -102},
+			{9, 2, "1234567.89", 123456789},
+			{9, 2, "-9999999.994", -999999999},
+			{9, 0, "0.5", 0},
+			{9, 9, "0.123456789", 123456789},
+			{18, 4, "-12.5", -125000},
+			{18, 0, "999999999999999999", 999999999999999999},
+			{18, 18, "-0.999999999999999999", -999999999999999999},
+		}
+		for _, tt := range tests {
+			typ := MustNewType(tt.prec, tt.scale)
+			d := decimal.MustNewFromString(tt.d)
+			got, err := typ.Int64(d)
+			if err != nil {
+				t.Errorf("%v.Int64(%q) failed: %v", typ, d, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%v.Int64(%q) = %v, want %v", typ, d, got, tt.want)
+			}
+			if tt.prec <= MaxPrecInt32 {
+				got32, err := typ.Int32(d)
+				if err != nil {
+					t.Errorf("%v.Int32(%q) failed: %v", typ, d, err)
+					continue
+				}
+				if int64(got32) != tt.want {
+					t.Errorf("%v.Int32(%q) = %v, want %v", typ, d, got32, tt.want)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			prec, scale int
+			d           string
+		}{
+			"overflow 1":  {9, 2, "12345678.9"},
+			"overflow 2":  {9, 2, "9999999.995"},
+			"overflow 3":  {1, 0, "-10"},
+			"overflow 4":  {18, 18, "1"},
+			"precision 1": {19, 0, "1"},
+			"precision 2": {38, 10, "0"},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				typ := MustNewType(tt.prec, tt.scale)
+				d := decimal.MustNewFromString(tt.d)
+				_, err := typ.Int64(d)
+				if err == nil {
+					t.Errorf("%v.Int64(%q) did not fail", typ, d)
+				}
+				_, err = typ.Int32(d)
+				if err == nil {
+					t.Errorf("%v.Int32(%q) did not fail", typ, d)
+				}
+			})
+		}
+	})
+}
+
+func TestType_FromInt(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			prec, scale int
+			v           int64
+			want        string
+		}{
+			{9, 2, 0, "0.00"},
+			{9, 2, 150, "1.50"},
+			{9, 2, -999999999, "-9999999.99"},
+			{18, 18, 999999999999999999, "0.999999999999999999"},
+			{18, 0, -999999999999999999, "-999999999999999999"},
+			{38, 38, -1, "-0.0000000000000000000"},
+			{38, 20, 15, "0.0000000000000000002"},
+			{38, 20, 25, "0.0000000000000000002"},
+			{38, 0, math.MinInt64, "-9223372036854775808"},
+		}
+		for _, tt := range tests {
+			typ := MustNewType(tt.prec, tt.scale)
+			got, err := typ.FromInt64(tt.v)
+			if err != nil {
+				t.Errorf("%v.FromInt64(%v) failed: %v", typ, tt.v, err)
+				continue
+			}
+			want := decimal.MustNewFromString(tt.want)
+			if got != want {
+				t.Errorf("%v.FromInt64(%v) = %q, want %q", typ, tt.v, got, want)
+			}
+			if tt.v >= math.MinInt32 && tt.v <= math.MaxInt32 {
+				got, err = typ.FromInt32(int32(tt.v))
+				if err != nil {
+					t.Errorf("%v.FromInt32(%v) failed: %v", typ, tt.v, err)
+					continue
+				}
+				if got != want {
+					t.Errorf("%v.FromInt32(%v) = %q, want %q", typ, tt.v, got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			prec, scale int
+			v           int64
+		}{
+			"overflow 1": {9, 2, 1000000000},
+			"overflow 2": {9, 2, -1000000000},
+			"overflow 3": {1, 0, 10},
+			"overflow 4": {18, 0, math.MinInt64},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				typ := MustNewType(tt.prec, tt.scale)
+				_, err := typ.FromInt64(tt.v)
+				if !errors.Is(err, decimal.ErrOverflow) {
+					t.Errorf("%v.FromInt64(%v) = %v, want %v", typ, tt.v, err, decimal.ErrOverflow)
+				}
+			})
+		}
+	})
+}
+
+func TestType_Bytes(t *testing.T) {
+	tests := []struct {
+		prec, scale int
+		d           string
+		bytes       string
+		fixed       string
+		hi          int64
+		lo          uint64
+	}{
+		{9, 2, "0", "00", "00000000", 0, 0},
+		{9, 2, "1.27", "7f", "0000007f", 0, 127},
+		{9, 2, "1.28", "0080", "00000080", 0, 128},
+		{9, 2, "-1.28", "80", "ffffff80", -1, 18446744073709551488},
+		{9, 2, "-1.29", "ff7f", "ffffff7f", -1, 18446744073709551487},
+		{9, 2, "2.55", "00ff", "000000ff", 0, 255},
+		{9, 2, "-0.01", "ff", "ffffffff", -1, 18446744073709551615},
+		{9, 2, "1234567.89", "075bcd15", "075bcd15", 0, 123456789},
+		{38, 10, "-1", "fdabf41c00", "fffffffffffffffffffffffdabf41c00", -1, 18446744063709551616},
+		{38, 10, "9999999999999999999", "01431e0fae6d7217c84bf41c00", "00000001431e0fae6d7217c84bf41c00", 5421010862, 7886392046514347008},
+		{38, 19, "9999999999999999999", "4b3b4ca85a86c4797ec2ff3b76180000", "4b3b4ca85a86c4797ec2ff3b76180000", 5421010862427522169, 9134143625110224896},
+		{38, 19, "-9999999999999999999", "b4c4b357a5793b86813d00c489e80000", "b4c4b357a5793b86813d00c489e80000", -5421010862427522170, 9312600448599326720},
+	}
+	for _, tt := range tests {
+		typ := MustNewType(tt.prec, tt.scale)
+		d := decimal.MustNewFromString(tt.d)
+
+		// Bytes
+		b, err := typ.Bytes(d)
+		if err != nil {
+			t.Errorf("%v.Bytes(%q) failed: %v", typ, d, err)
+			continue
+		}
+		if got := hex.EncodeToString(b); got != tt.bytes {
+			t.Errorf("%v.Bytes(%q) = %v, want %v", typ, d, got, tt.bytes)
+		}
+		got, err := typ.FromBytes(b)
+		if err != nil {
+			t.Errorf("%v.FromBytes(%x) failed: %v", typ, b, err)
+			continue
+		}
+		if got.Cmp(d) != 0 {
+			t.Errorf("%v.FromBytes(%x) = %q, want %q", typ, b, got, d)
+		}
+
+		// FixedBytes
+		b, err = typ.FixedBytes(d, typ.FixedLen())
+		if err != nil {
+			t.Errorf("%v.FixedBytes(%q, %v) failed: %v", typ, d, typ.FixedLen(), err)
+			continue
+		}
+		if got := hex.EncodeToString(b); got != tt.fixed {
+			t.Errorf("%v.FixedBytes(%q, %v) = %v, want %v", typ, d, typ.FixedLen(), got, tt.fixed)
+		}
+		got, err = typ.FromBytes(b)
+		if err != nil {
+			t.Errorf("%v.FromBytes(%x) failed: %v", typ, b, err)
+			continue
+		}
+		if got.Cmp(d) != 0 {
+			t.Errorf("%v.FromBytes(%x) = %q, want %q", typ, b, got, d)
+		}
+
+		// Decimal128
+		hi, lo, err := typ.Decimal128(d)
+		if err != nil {
+			t.Errorf("%v.Decimal128(%q) failed: %v", typ, d, err)
+			continue
+		}
+		if hi != tt.hi || lo != tt.lo {
+			t.Errorf("%v.Decimal128(%q) = (%v, %v), want (%v, %v)", typ, d, hi, lo, tt.hi, tt.lo)
+		}
+		got, err = typ.FromDecimal128(hi, lo)
+		if err != nil {
+			t.Errorf("%v.FromDecimal128(%v, %v) failed: %v", typ, hi, lo, err)
+			continue
+		}
+		if got.Cmp(d) != 0 {
+			t.Errorf("%v.FromDecimal128(%v, %v) = %q, want %q", typ, hi, lo, got, d)
+		}
+	}
+}
+
+func TestType_FixedBytes(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			prec, scale int
+			d           string
+			size        int
+			want        string
+		}{
+			{9, 2, "1.28", 2, "0080"},
+			{9, 2, "-1.28", 1, "80"},
+			{9, 2, "-1.28", 17, "ffffffffffffffffffffffffffffffff80"},
+			{9, 2, "1.28", 18, "000000000000000000000000000000000080"},
+		}
+		for _, tt := range tests {
+			typ := MustNewType(tt.prec, tt.scale)
+			d := decimal.MustNewFromString(tt.d)
+			b, err := typ.FixedBytes(d, tt.size)
+			if err != nil {
+				t.Errorf("%v.FixedBytes(%q, %v) failed: %v", typ, d, tt.size, err)
+				continue
+			}
+			if got := hex.EncodeToString(b); got != tt.want {
+				t.Errorf("%v.FixedBytes(%q, %v) = %v, want %v", typ, d, tt.size, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			prec, scale int
+			d           string
+			size        int
+		}{
+			"size 1":     {9, 2, "1.28", 1},
+			"size 2":     {9, 2, "0", 0},
+			"size 3":     {9, 2, "0", -1},
+			"overflow 1": {9, 2, "12345678.9", 16},
+			"overflow 2": {38, 30, "123456789", 16},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				typ := MustNewType(tt.prec, tt.scale)
+				d := decimal.MustNewFromString(tt.d)
+				_, err := typ.FixedBytes(d, tt.size)
+				if !errors.Is(err, decimal.ErrOverflow) {
+					t.Errorf("%v.FixedBytes(%q, %v) = %v, want %v", typ, d, tt.size, err, decimal.ErrOverflow)
+				}
+			})
+		}
+	})
+}
+
+func TestType_FromBytes(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			prec, scale int
+			b           string
+			want        string
+		}{
+			{9, 2, "00", "0.00"},
+			{9, 2, "80", "-1.28"},
+			{9, 2, "ffffffff80", "-1.28"},
+			{9, 2, "000000000000000000000000000000000080", "1.28"},
+			{9, 2, "ffffffffffffffffffffffffffffffffff80", "-1.28"},
+			{38, 38, "4b3b4ca85a86c47a098a223fffffffff", "1.0000000000000000000"},
+			{38, 38, "b4c4b357a5793b85f675ddc000000001", "-1.0000000000000000000"},
+			{38, 20, "0f", "0.0000000000000000002"},
+			{38, 0, "7fffffffffffffff", "9223372036854775807"},
+			{38, 0, "008ac7230489e7ffff", "9999999999999999999"},
+			{38, 19, "4b3b4ca85a86c4797ec2ff3b76180000", "9999999999999999999"},
+		}
+		for _, tt := range tests {
+			typ := MustNewType(tt.prec, tt.scale)
+			b, err := hex.DecodeString(tt.b)
+			if err != nil {
+				t.Fatalf("hex.DecodeString(%q) failed: %v", tt.b, err)
+			}
+			got, err := typ.FromBytes(b)
+			if err != nil {
+				t.Errorf("%v.FromBytes(%v) failed: %v", typ, tt.b, err)
+				continue
+			}
+			want := decimal.MustNewFromString(tt.want)
+			if got != want {
+				t.Errorf("%v.FromBytes(%v) = %q, want %q", typ, tt.b, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			prec, scale int
+			b           string
+			want        error
+		}{
+			"empty":      {9, 2, "", decimal.ErrInvalidDecimal},
+			"overflow 1": {9, 2, "3b9aca00", decimal.ErrOverflow},
+			"overflow 2": {9, 2, "c4653600", decimal.ErrOverflow},
+			"overflow 3": {38, 0, "4b3b4ca85a86c47a098a224000000000", decimal.ErrOverflow},
+			"overflow 4": {38, 0, "0100000000000000000000000000000000", decimal.ErrOverflow},
+			"overflow 5": {38, 0, "fe00000000000000000000000000000000", decimal.ErrOverflow},
+			"overflow 6": {38, 0, "008ac7230489e80000", decimal.ErrOverflow},
+			"overflow 7": {38, 18, "4b3b4ca85a86c47a098a223fffffffff", decimal.ErrOverflow},
+			"overflow 8": {38, 19, "4b3b4ca85a86c47a098a223fffffffff", decimal.ErrOverflow},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				typ := MustNewType(tt.prec, tt.scale)
+				b, err := hex.DecodeString(tt.b)
+				if err != nil {
+					t.Fatalf("hex.DecodeString(%q) failed: %v", tt.b, err)
+				}
+				_, err = typ.FromBytes(b)
+				if !errors.Is(err, tt.want) {
+					t.Errorf("%v.FromBytes(%v) = %v, want %v", typ, tt.b, err, tt.want)
+				}
+			})
+		}
+	})
+}
+
+func FuzzType_Bytes(f *testing.F) {
+	for _, s := range []string{"0", "1", "-1.28", "0.0000000000000000001", "-999999999.9999999999", "9223372036854775807"} {
+		d := decimal.MustNewFromString(s)
+		coef := int64(d.Coef())
+		if d.IsNeg() {
+			coef = -coef
+		}
+		f.Add(coef, d.Scale())
+	}
+
+	typ := MustNewType(MaxPrec, decimal.MaxScale)
+
+	f.Fuzz(
+		func(t *testing.T, coef int64, scale int) {
+			d, err := decimal.New(coef, scale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			want := d.Pad(typ.Scale())
+
+			b, err := typ.Bytes(d)
+			if err != nil {
+				t.Errorf("%v.Bytes(%q) failed: %v", typ, d, err)
+				return
+			}
+			got, err := typ.FromBytes(b)
+			if err != nil {
+				t.Errorf("%v.FromBytes(%x) failed: %v", typ, b, err)
+				return
+			}
+			if got != want {
+				t.Errorf("%v.FromBytes(%v.Bytes(%q)) = %q, want %q", typ, typ, d, got, want)
+			}
+
+			hi, lo, err := typ.Decimal128(d)
+			if err != nil {
+				t.Errorf("%v.Decimal128(%q) failed: %v", typ, d, err)
+				return
+			}
+			got, err = typ.FromDecimal128(hi, lo)
+			if err != nil {
+				t.Errorf("%v.FromDecimal128(%v, %v) failed: %v", typ, hi, lo, err)
+				return
+			}
+			if got != want {
+				t.Errorf("%v.FromDecimal128(%v.Decimal128(%q)) = %q, want %q", typ, typ, d, got, want)
+			}
+
+			// Cross-check with math/big
+			x := new(big.Int).SetBytes(b)
+			if len(b) > 0 && b[0]&0x80 != 0 {
+				x.Sub(x, new(big.Int).Lsh(big.NewInt(1), uint(8*len(b))))
+			}
+			if x.Cmp(big.NewInt(0).Mul(big.NewInt(coef), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(typ.Scale()-scale)), nil))) != 0 {
+				t.Errorf("%v.Bytes(%q) = %x, want %v", typ, d, b, x)
+			}
+		},
+	)
+}
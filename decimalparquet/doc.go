@@ -0,0 +1,48 @@
+/*
+Package decimalparquet converts [decimal.Decimal] to and from the DECIMAL
+logical type of [Apache Parquet] and the Decimal128 type of [Apache Arrow].
+
+Both formats store a decimal column as unscaled integers, that is,
+decimals multiplied by 10^scale, where the precision and the scale
+are fixed for the whole column.
+A column is described by a [Type], which is created with [NewType]:
+
+	price := decimalparquet.MustNewType(9, 2) // DECIMAL(9,2)
+	v, err := price.Int32(d)                  // 12.34 -> 1234
+	d, err := price.FromInt32(v)              // 1234  -> 12.34
+
+The package has no dependencies outside the standard library.
+
+# Physical Types
+
+Parquet stores DECIMAL columns using one of the following physical types:
+
+  - INT32 for precision up to 9, see [Type.Int32] and [Type.FromInt32];
+  - INT64 for precision up to 18, see [Type.Int64] and [Type.FromInt64];
+  - FIXED_LEN_BYTE_ARRAY, see [Type.FixedBytes], [Type.FixedLen], and [Type.FromBytes];
+  - BYTE_ARRAY, see [Type.Bytes] and [Type.FromBytes].
+
+Byte arrays hold big-endian two's complement integers.
+Arrow Decimal128 values are 128-bit two's complement integers split into
+a signed high half and an unsigned low half, see [Type.Decimal128] and
+[Type.FromDecimal128].
+
+# Rounding and Errors
+
+When converting a decimal to an unscaled integer, the decimal is rounded
+to the scale of the column using [rounding half to even], and zeros are
+appended if the decimal has fewer digits after the decimal point.
+If the result has more digits than the precision of the column, an error
+wrapping [decimal.ErrOverflow] is returned instead of a truncated value.
+
+When converting an unscaled integer to a decimal, values with more digits
+than the precision of the column are rejected.
+Columns with scale greater than [decimal.MaxScale] or values with more than
+[decimal.MaxPrec] digits are rounded using rounding half to even, and
+values with an integer part that is too large result in errors.
+
+[Apache Parquet]: https://github.com/apache/parquet-format/blob/master/LogicalTypes.md#decimal
+[Apache Arrow]: https://arrow.apache.org/docs/format/Columnar.html
+[rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+*/
+package decimalparquet
@@ -0,0 +1,70 @@
+package decimalparquet_test
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/decimal/decimalparquet"
+)
+
+func ExampleNewType() {
+	t, err := decimalparquet.NewType(18, 4)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(t, t.FixedLen())
+	// Output: DECIMAL(18,4) 8
+}
+
+func ExampleType_Int32() {
+	t := decimalparquet.MustNewType(9, 2)
+	fmt.Println(t.Int32(decimal.MustNewFromString("12.345")))
+	fmt.Println(t.Int32(decimal.MustNewFromString("-1.5")))
+	fmt.Println(t.Int32(decimal.MustNewFromString("12345678.9")))
+	// Output:
+	// 1234 <nil>
+	// -150 <nil>
+	// 0 converting 12345678.9 to DECIMAL(9,2): decimal overflow: the number of digits (10) exceeds the precision
+}
+
+func ExampleType_FromInt64() {
+	t := decimalparquet.MustNewType(18, 4)
+	fmt.Println(t.FromInt64(-125000))
+	// Output: -12.5000 <nil>
+}
+
+func ExampleType_Bytes() {
+	t := decimalparquet.MustNewType(9, 2)
+	b, err := t.Bytes(decimal.MustNewFromString("-1.29"))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%x\n", b)
+	fmt.Println(t.FromBytes(b))
+	// Output:
+	// ff7f
+	// -1.29 <nil>
+}
+
+func ExampleType_FixedBytes() {
+	t := decimalparquet.MustNewType(9, 2)
+	b, err := t.FixedBytes(decimal.MustNewFromString("1.28"), t.FixedLen())
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%x\n", b)
+	// Output: 00000080
+}
+
+func ExampleType_Decimal128() {
+	t := decimalparquet.MustNewType(38, 10)
+	hi, lo, err := t.Decimal128(decimal.MustNewFromString("-1"))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(hi, lo)
+	fmt.Println(t.FromDecimal128(hi, lo))
+	// Output:
+	// -1 18446744063709551616
+	// -1.0000000000 <nil>
+}
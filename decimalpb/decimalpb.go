@@ -0,0 +1,211 @@
+package decimalpb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// ErrInvalidMessage is returned when a message cannot be decoded from
+// the wire format or violates the constraints of its definition.
+var ErrInvalidMessage = errors.New("invalid message")
+
+// Decimal mirrors the [google.type.Decimal] message.
+//
+// [google.type.Decimal]: https://github.com/googleapis/googleapis/blob/master/google/type/decimal.proto
+type Decimal struct {
+	// Value is the decimal value as a string, such as "-12.340".
+	Value string // field 1
+}
+
+// ToProtoDecimal converts a decimal to a message.
+// The trailing zeros of the decimal are preserved.
+// See also method [decimal.Decimal.String].
+func ToProtoDecimal(d decimal.Decimal) *Decimal {
+	return &Decimal{Value: d.String()}
+}
+
+// FromProtoDecimal converts a message to a (possibly rounded) decimal.
+// All forms permitted by the message definition are accepted, including
+// an explicit plus sign, exponent notation, and a missing integer or
+// fractional part, such as ".5" or "5.".
+// See also constructor [decimal.NewFromString].
+//
+// FromProtoDecimal returns an error if:
+//   - the message is nil;
+//   - the value is not a valid decimal;
+//   - the integer part of the value has more than [decimal.MaxPrec] digits.
+func FromProtoDecimal(p *Decimal) (decimal.Decimal, error) {
+	if p == nil {
+		return decimal.Decimal{}, fmt.Errorf("converting google.type.Decimal: %w: nil message", ErrInvalidMessage)
+	}
+	d, err := decimal.NewFromString(p.Value)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("converting google.type.Decimal: %w", err)
+	}
+	return d, nil
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// It decodes the message from the protobuf wire format, skipping unknown fields.
+//
+// [encoding.BinaryUnmarshaler]: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (p *Decimal) UnmarshalBinary(data []byte) error {
+	var v Decimal
+	for len(data) > 0 {
+		num, typ, n, err := consumeTag(data)
+		if err != nil {
+			return fmt.Errorf("decoding google.type.Decimal: %w", err)
+		}
+		data = data[n:]
+		switch {
+		case num == 1 && typ == wireLen:
+			v.Value, n, err = consumeString(data)
+		default:
+			n, err = consumeValue(data, typ)
+		}
+		if err != nil {
+			return fmt.Errorf("decoding google.type.Decimal: %w", err)
+		}
+		data = data[n:]
+	}
+	*p = v
+	return nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// It encodes the message in the protobuf wire format.
+//
+// [encoding.BinaryMarshaler]: https://pkg.go.dev/encoding#BinaryMarshaler
+func (p *Decimal) MarshalBinary() ([]byte, error) {
+	return appendString(nil, 1, p.Value), nil
+}
+
+// Money mirrors the [google.type.Money] message.
+//
+// [google.type.Money]: https://github.com/googleapis/googleapis/blob/master/google/type/money.proto
+type Money struct {
+	// CurrencyCode is the three-letter currency code defined in ISO 4217,
+	// such as "USD".
+	CurrencyCode string // field 1
+	// Units is the whole part of the amount.
+	Units int64 // field 2
+	// Nanos is the number of nano (10^-9) units of the amount.
+	// It must be within the range [-999,999,999, 999,999,999] and, if Units
+	// is not zero, it must have the same sign as Units or be zero.
+	Nanos int32 // field 3
+}
+
+// ToProtoMoney converts a decimal amount in the given currency to a message.
+// If the amount has more than 9 digits after the decimal point, it is rounded
+// using [rounding half to even] (banker's rounding).
+// See also method [decimal.Decimal.Int64].
+//
+// ToProtoMoney returns an error if:
+//   - the currency code does not consist of three upper-case letters;
+//   - the integer part of the amount does not fit into an int64 value.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func ToProtoMoney(currency string, d decimal.Decimal) (*Money, error) {
+	if !isCode(currency) {
+		return nil, fmt.Errorf("converting %v to google.type.Money: %w: invalid currency code %q", d, ErrInvalidMessage, currency)
+	}
+	units, nanos, ok := d.Int64(9)
+	if !ok {
+		return nil, fmt.Errorf("converting %v to google.type.Money: %w", d, decimal.ErrOverflow)
+	}
+	//nolint:gosec
+	return &Money{CurrencyCode: currency, Units: units, Nanos: int32(nanos)}, nil
+}
+
+// FromProtoMoney converts a message to a currency code and
+// a (possibly rounded) decimal amount.
+// The trailing zeros of the amount are removed.
+// See also constructor [decimal.NewFromInt64].
+//
+// FromProtoMoney returns an error if:
+//   - the message is nil;
+//   - the currency code does not consist of three upper-case letters;
+//   - the nanos are not within the range [-999,999,999, 999,999,999];
+//   - the units and the nanos have different signs.
+func FromProtoMoney(p *Money) (string, decimal.Decimal, error) {
+	if p == nil {
+		return "", decimal.Decimal{}, fmt.Errorf("converting google.type.Money: %w: nil message", ErrInvalidMessage)
+	}
+	if !isCode(p.CurrencyCode) {
+		return "", decimal.Decimal{}, fmt.Errorf("converting google.type.Money: %w: invalid currency code %q", ErrInvalidMessage, p.CurrencyCode)
+	}
+	if p.Nanos < -999_999_999 || p.Nanos > 999_999_999 {
+		return "", decimal.Decimal{}, fmt.Errorf("converting google.type.Money: %w: nanos %v out of range", ErrInvalidMessage, p.Nanos)
+	}
+	if p.Units > 0 && p.Nanos < 0 || p.Units < 0 && p.Nanos > 0 {
+		return "", decimal.Decimal{}, fmt.Errorf("converting google.type.Money: %w: units %v and nanos %v have different signs", ErrInvalidMessage, p.Units, p.Nanos)
+	}
+	d, err := decimal.NewFromInt64(p.Units, int64(p.Nanos), 9)
+	if err != nil {
+		return "", decimal.Decimal{}, fmt.Errorf("converting google.type.Money: %w", err)
+	}
+	return p.CurrencyCode, d, nil
+}
+
+// isCode returns true if s consists of 3 upper-case ASCII letters.
+func isCode(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for i := range len(s) {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface.
+// It decodes the message from the protobuf wire format, skipping unknown fields.
+// The message is not validated, use [FromProtoMoney] for that.
+//
+// [encoding.BinaryUnmarshaler]: https://pkg.go.dev/encoding#BinaryUnmarshaler
+func (p *Money) UnmarshalBinary(data []byte) error {
+	var v Money
+	for len(data) > 0 {
+		num, typ, n, err := consumeTag(data)
+		if err != nil {
+			return fmt.Errorf("decoding google.type.Money: %w", err)
+		}
+		data = data[n:]
+		var u uint64
+		switch {
+		case num == 1 && typ == wireLen:
+			v.CurrencyCode, n, err = consumeString(data)
+		case num == 2 && typ == wireVarint:
+			u, n, err = consumeVarint(data)
+			//nolint:gosec
+			v.Units = int64(u)
+		case num == 3 && typ == wireVarint:
+			u, n, err = consumeVarint(data)
+			//nolint:gosec
+			v.Nanos = int32(u)
+		default:
+			n, err = consumeValue(data, typ)
+		}
+		if err != nil {
+			return fmt.Errorf("decoding google.type.Money: %w", err)
+		}
+		data = data[n:]
+	}
+	*p = v
+	return nil
+}
+
+// MarshalBinary implements the [encoding.BinaryMarshaler] interface.
+// It encodes the message in the protobuf wire format.
+//
+// [encoding.BinaryMarshaler]: https://pkg.go.dev/encoding#BinaryMarshaler
+func (p *Money) MarshalBinary() ([]byte, error) {
+	b := appendString(nil, 1, p.CurrencyCode)
+	b = appendInt64(b, 2, p.Units)
+	b = appendInt64(b, 3, int64(p.Nanos))
+	return b, nil
+}
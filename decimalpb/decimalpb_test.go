@@ -0,0 +1,401 @@
+package decimalpb
+
+import (
+	"encoding"
+	"encoding/hex"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestInterfaces(t *testing.T) {
+	var p any
+
+	p = &Decimal{}
+	_, ok := p.(encoding.BinaryMarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.BinaryMarshaler", p)
+	}
+	_, ok = p.(encoding.BinaryUnmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.BinaryUnmarshaler", p)
+	}
+
+	p = &Money{}
+	_, ok = p.(encoding.BinaryMarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.BinaryMarshaler", p)
+	}
+	_, ok = p.(encoding.BinaryUnmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.BinaryUnmarshaler", p)
+	}
+}
+
+func TestToProtoDecimal(t *testing.T) {
+	tests := []struct {
+		d    string
+		want string
+	}{
+		{"0", "0"},
+		{"0.00", "0.00"},
+		{"-12.340", "-12.340"},
+		{"9999999999999999999", "9999999999999999999"},
+		{"0.0000000000000000001", "0.0000000000000000001"},
+	}
+	for _, tt := range tests {
+		d := decimal.MustNewFromString(tt.d)
+		got := ToProtoDecimal(d)
+		if got.Value != tt.want {
+			t.Errorf("ToProtoDecimal(%v) = %q, want %q", d, got.Value, tt.want)
+		}
+	}
+}
+
+func TestFromProtoDecimal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			value string
+			want  string
+		}{
+			{"0", "0"},
+			{"-0", "0"},
+			{"+1.50", "1.50"},
+			{".5", "0.5"},
+			{"5.", "5"},
+			{"00012.3400", "12.3400"},
+			{"1.5e3", "1500"},
+			{"-2.5E-1", "-0.25"},
+			{"1.23456789012345678901", "1.234567890123456789"},
+		}
+		for _, tt := range tests {
+			got, err := FromProtoDecimal(&Decimal{Value: tt.value})
+			if err != nil {
+				t.Errorf("FromProtoDecimal(%q) failed: %v", tt.value, err)
+				continue
+			}
+			want := decimal.MustNewFromString(tt.want)
+			if got != want {
+				t.Errorf("FromProtoDecimal(%q) = %q, want %q", tt.value, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			p       *Decimal
+			wantErr error
+		}{
+			"nil":      {nil, ErrInvalidMessage},
+			"empty":    {&Decimal{}, decimal.ErrInvalidDecimal},
+			"point":    {&Decimal{Value: "."}, decimal.ErrInvalidDecimal},
+			"nan":      {&Decimal{Value: "NaN"}, decimal.ErrInvalidDecimal},
+			"locale":   {&Decimal{Value: "1,5"}, decimal.ErrInvalidDecimal},
+			"overflow": {&Decimal{Value: "1e19"}, decimal.ErrOverflow},
+		}
+		for name, tt := range tests {
+			_, err := FromProtoDecimal(tt.p)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: FromProtoDecimal(%v) = %v, want %v", name, tt.p, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestToProtoMoney(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d     string
+			units int64
+			nanos int32
+		}{
+			{"0", 0, 0},
+			{"1.50", 1, 500_000_000},
+			{"-1.75", -1, -750_000_000},
+			{"-0.75", 0, -750_000_000},
+			{"0.000000001", 0, 1},
+			{"0.0000000005", 0, 0},
+			{"0.0000000015", 0, 2},
+			{"-0.9999999999", -1, 0},
+			{"9223372036854775807", math.MaxInt64, 0},
+			{"-9223372036854775808", math.MinInt64, 0},
+			{"9223372036.854775807", 9223372036, 854_775_807},
+		}
+		for _, tt := range tests {
+			d := decimal.MustNewFromString(tt.d)
+			got, err := ToProtoMoney("USD", d)
+			if err != nil {
+				t.Errorf("ToProtoMoney(USD, %v) failed: %v", d, err)
+				continue
+			}
+			want := Money{CurrencyCode: "USD", Units: tt.units, Nanos: tt.nanos}
+			if *got != want {
+				t.Errorf("ToProtoMoney(USD, %v) = %+v, want %+v", d, *got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			currency string
+			d        string
+			wantErr  error
+		}{
+			"currency 1": {"", "1", ErrInvalidMessage},
+			"currency 2": {"usd", "1", ErrInvalidMessage},
+			"currency 3": {"USDT", "1", ErrInvalidMessage},
+			"overflow 1": {"USD", "9223372036854775808", decimal.ErrOverflow},
+			"overflow 2": {"USD", "-9999999999999999999", decimal.ErrOverflow},
+		}
+		for name, tt := range tests {
+			d := decimal.MustNewFromString(tt.d)
+			_, err := ToProtoMoney(tt.currency, d)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: ToProtoMoney(%q, %v) = %v, want %v", name, tt.currency, d, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestFromProtoMoney(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			units int64
+			nanos int32
+			want  string
+		}{
+			{0, 0, "0"},
+			{1, 500_000_000, "1.5"},
+			{-1, -750_000_000, "-1.75"},
+			{0, -750_000_000, "-0.75"},
+			{0, 1, "0.000000001"},
+			{-5, 0, "-5"},
+			{0, 999_999_999, "0.999999999"},
+			{0, -999_999_999, "-0.999999999"},
+			{math.MaxInt64, 0, "9223372036854775807"},
+			{math.MinInt64, 0, "-9223372036854775808"},
+			{math.MaxInt64, 999_999_999, "9223372036854775808"},
+		}
+		for _, tt := range tests {
+			p := &Money{CurrencyCode: "EUR", Units: tt.units, Nanos: tt.nanos}
+			currency, got, err := FromProtoMoney(p)
+			if err != nil {
+				t.Errorf("FromProtoMoney(%+v) failed: %v", *p, err)
+				continue
+			}
+			want := decimal.MustNewFromString(tt.want)
+			if currency != "EUR" || got != want {
+				t.Errorf("FromProtoMoney(%+v) = %q, %q, want %q, %q", *p, currency, got, "EUR", want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			p *Money
+		}{
+			"nil":        {nil},
+			"currency 1": {&Money{Units: 1}},
+			"currency 2": {&Money{CurrencyCode: "eur", Units: 1}},
+			"currency 3": {&Money{CurrencyCode: "EU", Units: 1}},
+			"nanos 1":    {&Money{CurrencyCode: "EUR", Nanos: 1_000_000_000}},
+			"nanos 2":    {&Money{CurrencyCode: "EUR", Nanos: -1_000_000_000}},
+			"nanos 3":    {&Money{CurrencyCode: "EUR", Nanos: math.MaxInt32}},
+			"sign 1":     {&Money{CurrencyCode: "EUR", Units: 1, Nanos: -1}},
+			"sign 2":     {&Money{CurrencyCode: "EUR", Units: -1, Nanos: 1}},
+		}
+		for name, tt := range tests {
+			_, _, err := FromProtoMoney(tt.p)
+			if !errors.Is(err, ErrInvalidMessage) {
+				t.Errorf("%v: FromProtoMoney(%v) = %v, want %v", name, tt.p, err, ErrInvalidMessage)
+			}
+		}
+	})
+}
+
+func TestDecimal_Binary(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			p    Decimal
+			data string
+		}{
+			{Decimal{}, ""},
+			{Decimal{Value: "1.50"}, "0a04312e3530"},
+			{Decimal{Value: "-0.001"}, "0a062d302e303031"},
+		}
+		for _, tt := range tests {
+			data, err := tt.p.MarshalBinary()
+			if err != nil {
+				t.Errorf("%+v.MarshalBinary() failed: %v", tt.p, err)
+				continue
+			}
+			if got := hex.EncodeToString(data); got != tt.data {
+				t.Errorf("%+v.MarshalBinary() = %v, want %v", tt.p, got, tt.data)
+			}
+			got := Decimal{Value: "7"}
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Errorf("UnmarshalBinary(%v) failed: %v", tt.data, err)
+				continue
+			}
+			if got != tt.p {
+				t.Errorf("UnmarshalBinary(%v) = %+v, want %+v", tt.data, got, tt.p)
+			}
+		}
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		tests := []struct {
+			data string
+			want Decimal
+		}{
+			{"0a0131" + "1001", Decimal{Value: "1"}},
+			{"0a0131" + "1a0132", Decimal{Value: "1"}},
+			{"0a0131" + "2101020304050607080a0132", Decimal{Value: "2"}},
+			{"0a0131" + "2d01020304", Decimal{Value: "1"}},
+			{"0801" + "0a0131", Decimal{Value: "1"}},
+		}
+		for _, tt := range tests {
+			data, _ := hex.DecodeString(tt.data)
+			var got Decimal
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Errorf("UnmarshalBinary(%v) failed: %v", tt.data, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalBinary(%v) = %+v, want %+v", tt.data, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"tag 1":    "00",
+			"tag 2":    "80",
+			"string 1": "0a",
+			"string 2": "0a0531",
+			"string 3": "0a01ff",
+			"group 1":  "0b",
+			"fixed 1":  "0901",
+			"fixed 2":  "0d01",
+		}
+		for name, tt := range tests {
+			data, _ := hex.DecodeString(tt)
+			want := Decimal{Value: "7"}
+			got := want
+			err := got.UnmarshalBinary(data)
+			if !errors.Is(err, ErrInvalidMessage) {
+				t.Errorf("%v: UnmarshalBinary(%v) = %v, want %v", name, tt, err, ErrInvalidMessage)
+			}
+			if got != want {
+				t.Errorf("%v: UnmarshalBinary(%v) changed the message to %+v", name, tt, got)
+			}
+		}
+	})
+}
+
+func TestMoney_Binary(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			p    Money
+			data string
+		}{
+			{Money{}, ""},
+			{Money{CurrencyCode: "USD"}, "0a03555344"},
+			{Money{CurrencyCode: "USD", Units: 1, Nanos: 500_000_000}, "0a03555344" + "1001" + "1880cab5ee01"},
+			{Money{CurrencyCode: "USD", Units: -1, Nanos: -1}, "0a03555344" + "10ffffffffffffffffff01" + "18ffffffffffffffffff01"},
+			{Money{Units: math.MaxInt64}, "10ffffffffffffffff7f"},
+			{Money{Units: math.MinInt64}, "1080808080808080808001"},
+		}
+		for _, tt := range tests {
+			data, err := tt.p.MarshalBinary()
+			if err != nil {
+				t.Errorf("%+v.MarshalBinary() failed: %v", tt.p, err)
+				continue
+			}
+			if got := hex.EncodeToString(data); got != tt.data {
+				t.Errorf("%+v.MarshalBinary() = %v, want %v", tt.p, got, tt.data)
+			}
+			got := Money{CurrencyCode: "EUR", Units: 7, Nanos: 7}
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Errorf("UnmarshalBinary(%v) failed: %v", tt.data, err)
+				continue
+			}
+			if got != tt.p {
+				t.Errorf("UnmarshalBinary(%v) = %+v, want %+v", tt.data, got, tt.p)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"units 1": "10",
+			"units 2": "10ff",
+			"units 3": "10ffffffffffffffffff02",
+			"nanos 1": "18",
+			"nanos 2": "1880cab5ee",
+		}
+		for name, tt := range tests {
+			data, _ := hex.DecodeString(tt)
+			want := Money{CurrencyCode: "EUR", Units: 7, Nanos: 7}
+			got := want
+			err := got.UnmarshalBinary(data)
+			if !errors.Is(err, ErrInvalidMessage) {
+				t.Errorf("%v: UnmarshalBinary(%v) = %v, want %v", name, tt, err, ErrInvalidMessage)
+			}
+			if got != want {
+				t.Errorf("%v: UnmarshalBinary(%v) changed the message to %+v", name, tt, got)
+			}
+		}
+	})
+}
+
+func FuzzMoney_RoundTrip(f *testing.F) {
+	f.Add(int64(0), 0)
+	f.Add(int64(150), 2)
+	f.Add(int64(-175), 2)
+	f.Add(int64(-1), 9)
+	f.Add(int64(math.MaxInt64), 0)
+	f.Add(int64(math.MaxInt64), 10)
+	f.Add(int64(math.MinInt64), 19)
+
+	f.Fuzz(
+		func(t *testing.T, coef int64, scale int) {
+			d, err := decimal.New(coef, scale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			p, err := ToProtoMoney("USD", d)
+			if err != nil {
+				t.Errorf("ToProtoMoney(USD, %q) failed: %v", d, err)
+				return
+			}
+			data, err := p.MarshalBinary()
+			if err != nil {
+				t.Errorf("%+v.MarshalBinary() failed: %v", *p, err)
+				return
+			}
+			var q Money
+			if err := q.UnmarshalBinary(data); err != nil {
+				t.Errorf("UnmarshalBinary(%x) failed: %v", data, err)
+				return
+			}
+			if q != *p {
+				t.Errorf("UnmarshalBinary(%x) = %+v, want %+v", data, q, *p)
+				return
+			}
+			currency, got, err := FromProtoMoney(&q)
+			if err != nil {
+				t.Errorf("FromProtoMoney(%+v) failed: %v", q, err)
+				return
+			}
+			want := d.Round(9).Trim(0)
+			if currency != "USD" || got != want {
+				t.Errorf("FromProtoMoney(ToProtoMoney(%q)) = %q, %q, want %q, %q", d, currency, got, "USD", want)
+			}
+		},
+	)
+}
@@ -0,0 +1,34 @@
+/*
+Package decimalpb converts [decimal.Decimal] to and from the Protocol Buffers
+messages [google.type.Decimal] and [google.type.Money].
+
+The package has no dependencies outside the standard library.
+Instead of generated code, it provides hand-written [Decimal] and [Money]
+types with the same fields as the messages, and encodes them to and decodes
+them from the protobuf wire format using [Decimal.MarshalBinary] and
+[Decimal.UnmarshalBinary].
+Programs that already use generated code can copy the fields
+between the generated messages and these types.
+
+# Decimal
+
+[google.type.Decimal] represents a decimal as a string, so it preserves
+trailing zeros.
+[ToProtoDecimal] and [FromProtoDecimal] are thin wrappers around
+[decimal.Decimal.String] and [decimal.NewFromString].
+
+# Money
+
+[google.type.Money] represents an amount as a pair of integers:
+the whole units and the nano (10^-9) units.
+Both integers must have the same sign, and the nanos must be within
+the range [-999,999,999, 999,999,999].
+[FromProtoMoney] rejects messages that break these rules, whereas
+[ToProtoMoney] always produces valid messages, rounding amounts with more
+than 9 digits after the decimal point.
+This format does not preserve trailing zeros.
+
+[google.type.Decimal]: https://github.com/googleapis/googleapis/blob/master/google/type/decimal.proto
+[google.type.Money]: https://github.com/googleapis/googleapis/blob/master/google/type/money.proto
+*/
+package decimalpb
@@ -0,0 +1,64 @@
+package decimalpb_test
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/decimal/decimalpb"
+)
+
+func ExampleToProtoDecimal() {
+	d := decimal.MustNewFromString("-12.340")
+	p := decimalpb.ToProtoDecimal(d)
+	fmt.Printf("%+v\n", *p)
+	// Output: {Value:-12.340}
+}
+
+func ExampleFromProtoDecimal() {
+	d, err := decimalpb.FromProtoDecimal(&decimalpb.Decimal{Value: "1.5e-3"})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(d)
+	// Output: 0.0015
+}
+
+func ExampleToProtoMoney() {
+	d := decimal.MustNewFromString("-1.75")
+	p, err := decimalpb.ToProtoMoney("USD", d)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%+v\n", *p)
+	// Output: {CurrencyCode:USD Units:-1 Nanos:-750000000}
+}
+
+func ExampleFromProtoMoney() {
+	p := &decimalpb.Money{CurrencyCode: "EUR", Units: 12, Nanos: 340_000_000}
+	fmt.Println(decimalpb.FromProtoMoney(p))
+	p = &decimalpb.Money{CurrencyCode: "EUR", Units: 12, Nanos: -340_000_000}
+	fmt.Println(decimalpb.FromProtoMoney(p))
+	// Output:
+	// EUR 12.34 <nil>
+	//  0 converting google.type.Money: invalid message: units 12 and nanos -340000000 have different signs
+}
+
+func ExampleMoney_MarshalBinary() {
+	p, err := decimalpb.ToProtoMoney("USD", decimal.MustNewFromString("1.5"))
+	if err != nil {
+		panic(err)
+	}
+	data, err := p.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%x\n", data)
+	var q decimalpb.Money
+	if err := q.UnmarshalBinary(data); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%+v\n", q)
+	// Output:
+	// 0a0355534410011880cab5ee01
+	// {CurrencyCode:USD Units:1 Nanos:500000000}
+}
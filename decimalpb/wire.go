@@ -0,0 +1,135 @@
+package decimalpb
+
+import (
+	"fmt"
+	"math"
+	"unicode/utf8"
+)
+
+// wireType is the type of a field in the protobuf wire format.
+type wireType uint8
+
+const (
+	wireVarint wireType = 0
+	wireI64    wireType = 1
+	wireLen    wireType = 2
+	wireI32    wireType = 5
+)
+
+// appendTag appends the key of a field with the given number and wire type.
+func appendTag(b []byte, num int, typ wireType) []byte {
+	//nolint:gosec
+	return appendVarint(b, uint64(num)<<3|uint64(typ))
+}
+
+// appendVarint appends a base-128 varint.
+func appendVarint(b []byte, v uint64) []byte {
+	for v >= 0x80 {
+		b = append(b, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(b, byte(v))
+}
+
+// appendString appends a string field, omitting it if it is empty,
+// as proto3 does for default values.
+func appendString(b []byte, num int, s string) []byte {
+	if s == "" {
+		return b
+	}
+	b = appendTag(b, num, wireLen)
+	b = appendVarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+// appendInt64 appends an int64 or int32 field, omitting it if it is zero,
+// as proto3 does for default values.
+// Negative values are sign-extended to 64 bits, so they always take 10 bytes.
+func appendInt64(b []byte, num int, v int64) []byte {
+	if v == 0 {
+		return b
+	}
+	b = appendTag(b, num, wireVarint)
+	//nolint:gosec
+	return appendVarint(b, uint64(v))
+}
+
+// consumeVarint decodes a base-128 varint and returns it along with
+// the number of bytes read.
+func consumeVarint(b []byte) (uint64, int, error) {
+	var v uint64
+	for i := 0; i < len(b) && i < 10; i++ {
+		c := b[i]
+		if i == 9 && c > 1 {
+			return 0, 0, fmt.Errorf("%w: varint overflows 64 bits", ErrInvalidMessage)
+		}
+		v |= uint64(c&0x7f) << (7 * i)
+		if c < 0x80 {
+			return v, i + 1, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("%w: truncated varint", ErrInvalidMessage)
+}
+
+// consumeTag decodes the key of a field and returns the field number
+// and the wire type along with the number of bytes read.
+func consumeTag(b []byte) (int, wireType, int, error) {
+	v, n, err := consumeVarint(b)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	num := v >> 3
+	if num == 0 || num > math.MaxInt32 {
+		return 0, 0, 0, fmt.Errorf("%w: invalid field number %v", ErrInvalidMessage, num)
+	}
+	//nolint:gosec
+	return int(num), wireType(v & 7), n, nil
+}
+
+// consumeString decodes the payload of a length-delimited field as
+// a UTF-8 string and returns it along with the number of bytes read.
+func consumeString(b []byte) (string, int, error) {
+	v, n, err := consumeVarint(b)
+	if err != nil {
+		return "", 0, err
+	}
+	if v > uint64(len(b)-n) {
+		return "", 0, fmt.Errorf("%w: truncated string", ErrInvalidMessage)
+	}
+	s := string(b[n : n+int(v)])
+	if !utf8.ValidString(s) {
+		return "", 0, fmt.Errorf("%w: string is not valid UTF-8", ErrInvalidMessage)
+	}
+	return s, n + int(v), nil
+}
+
+// consumeValue skips the payload of a field with the given wire type
+// and returns the number of bytes read.
+// Groups are deprecated and not supported.
+func consumeValue(b []byte, typ wireType) (int, error) {
+	switch typ {
+	case wireVarint:
+		_, n, err := consumeVarint(b)
+		return n, err
+	case wireI64:
+		if len(b) < 8 {
+			return 0, fmt.Errorf("%w: truncated fixed64", ErrInvalidMessage)
+		}
+		return 8, nil
+	case wireLen:
+		v, n, err := consumeVarint(b)
+		if err != nil {
+			return 0, err
+		}
+		if v > uint64(len(b)-n) {
+			return 0, fmt.Errorf("%w: truncated bytes", ErrInvalidMessage)
+		}
+		return n + int(v), nil
+	case wireI32:
+		if len(b) < 4 {
+			return 0, fmt.Errorf("%w: truncated fixed32", ErrInvalidMessage)
+		}
+		return 4, nil
+	}
+	return 0, fmt.Errorf("%w: unsupported wire type %v", ErrInvalidMessage, typ)
+}
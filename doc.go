@@ -44,6 +44,23 @@ Here are the ranges for frequently used scales:
 	| Bitcoin      | 8     |            -99,999,999,999.99999999  |            99,999,999,999.99999999  |
 	| Ethereum     | 9     |             -9,999,999,999.999999999 |             9,999,999,999.999999999 |
 
+For amounts that do not fit into these ranges, such as token balances with
+18 digits after the decimal point, use [Decimal128].
+It has the same design, but its coefficient consists of two uint64 words and
+can hold up to 38 digits, with the scale ranging from 0 to 38.
+Decimal128 can be converted to and from Decimal using [Decimal128.Decimal]
+and [Decimal.Decimal128].
+
+For computations that need even more digits, such as validation of pricing
+models, use [BigDecimal].
+Its coefficient is a *big.Int of unlimited size, and its scale is a 32-bit
+integer, which can be negative.
+Addition, subtraction and multiplication of BigDecimal values are exact,
+whereas division, square root, exponential and logarithm accept the number of
+significant digits that the result must have.
+BigDecimal can be converted to and from Decimal using [BigDecimal.Decimal]
+and [Decimal.BigDecimal].
+
 [Subnormal numbers] are not supported to ensure peak performance.
 Consequently, decimals between -0.00000000000000000005 and 0.00000000000000000005
 inclusive, are rounded to 0.
@@ -77,7 +94,8 @@ The following rules determine the significance of digits during step 2:
     All digits in the integer part are significant, while digits in the
     fractional part are considered insignificant.
   - [Decimal.AddExact], [Decimal.SubExact], [Decimal.MulExact], [Decimal.QuoExact],
-    [Decimal.AddMulExact], [Decimal.AddQuoExact], [Decimal.SubMulExact], [Decimal.SubQuoExact]:
+    [Decimal.AddMulExact], [Decimal.AddQuoExact], [Decimal.SubMulExact], [Decimal.SubQuoExact],
+    and their counterparts with an explicit rounding mode, such as [Decimal.MulExactMode]:
     All digits in the integer part are significant. The significance of digits
     in the fractional part is determined by the scale argument, which is typically
     equal to the scale of the currency.
@@ -97,10 +115,8 @@ The following rules determine the significance of digits:
 
 # Mathematical Context
 
-Unlike many other decimal libraries, this package does not provide
-an explicit mathematical [context].
-Instead, the [context] is implicit and can be approximately equated to
-the following settings:
+The methods of [Decimal] use an implicit mathematical [context],
+which can be approximately equated to the following settings:
 
 	| Attribute               | Value                                           |
 	| ----------------------- | ----------------------------------------------- |
@@ -115,6 +131,17 @@ the following settings:
 The equality of Etiny and Emin implies that this package does not support
 subnormal numbers.
 
+An explicit [Context] can be used when a different precision or rounding mode
+is required, or when exceptional conditions have to be recorded or trapped.
+The zero value of [Context] corresponds to the implicit context above.
+Its methods, such as [Context.Add], [Context.Quo], or [Context.Exp],
+round results to [Context.Precision] digits using [Context.Rounding],
+and accumulate the raised conditions, such as [Inexact] or [Rounded],
+in [Context.Flags].
+If any of the raised conditions is in [Context.Traps], the method returns
+an error instead of a result.
+Conditions [DivisionByZero], [InvalidOperation], and [Overflow] are always trapped.
+
 # Rounding Methods
 
 For all operations the result is the one that would be obtained by computing
@@ -134,6 +161,17 @@ explicit rounding:
     [Decimal.Floor].
   - Rounding towards zero:
     [Decimal.Trunc].
+  - Rounding with an explicit [RoundingMode]:
+    [Decimal.RoundMode], [Decimal.QuantizeMode], [Decimal.RescaleMode].
+
+Some regulations require a rounding method other than half-to-even,
+for example, half-up rounding of tax amounts.
+For such cases the following methods accept an explicit [RoundingMode],
+which is used instead of half-to-even rounding whenever the exact result
+has to be rounded to 19 digits:
+[Decimal.AddExactMode], [Decimal.SubExactMode], [Decimal.MulExactMode],
+[Decimal.QuoExactMode], [Decimal.AddMulExactMode], [Decimal.SubMulExactMode],
+[Decimal.AddQuoExactMode], [Decimal.SubQuoExactMode].
 
 See the documentation for each method for more details.
 
@@ -163,13 +201,17 @@ Errors are not returned in the following cases:
     If the result is a decimal between -0.00000000000000000005 and
     0.00000000000000000005 inclusive, it will be rounded to 0.
 
+Errors returned by arithmetic operations and parsing are of type [*OpError].
+They wrap one of the exported sentinel errors, such as [ErrDivisionByZero],
+[ErrInvalidOperation], or [ErrOverflow], which can be checked with [errors.Is].
+The operation and its operands can be accessed with [errors.As].
+
 # Data Conversion
 
 A. JSON
 
 The package integrates seamlessly with standard [encoding/json] through
-the implementation of [encoding.TextMarshaller] and [encoding.TextUnmarshaler]
-interfaces.
+the implementation of [json.Marshaler] and [json.Unmarshaler] interfaces.
 Below is an example structure:
 
 	type Object struct {
@@ -177,8 +219,9 @@ Below is an example structure:
 	  // Other fields...
 	}
 
-This package marshals decimals as quoted strings, ensuring the preservation of
-the exact numerical value.
+By default, this package marshals decimals as quoted strings, ensuring the
+preservation of the exact numerical value, and unmarshals both quoted strings
+and numbers.
 Below is an example OpenAPI schema:
 
 	Decimal:
@@ -186,6 +229,14 @@ Below is an example OpenAPI schema:
 	  format: decimal
 	  pattern: '^(\-|\+)?((\d+(\.\d*)?)|(\.\d+))$'
 
+The encoding can be configured for the whole program with [SetJSONOptions]:
+decimals can be marshaled as numbers or with trailing zeros removed,
+and the input can be restricted to a single form without exponent notation
+and null values.
+Fields of type [StringDecimal] and [NumberDecimal] are always marshaled
+as strings and numbers, respectively, regardless of the options.
+Fields of type [NullDecimal] are marshaled as null when they are not valid.
+
 B. XML
 
 The package integrates with standard [encoding/xml] via the implementation of
@@ -207,6 +258,9 @@ using the following type:
 	  </xs:restriction>
 	</xs:simpleType>
 
+Fields of type [NullDecimal] can also be used as XML attributes,
+which are omitted when the decimals are not valid.
+
 C. Protocol Buffers
 
 Protocol Buffers provide two formats to represent decimals.
@@ -232,6 +286,11 @@ Below is an example of a proto definition:
 	  int32 nanos = 2;
 	}
 
+Package [github.com/govalues/decimal/decimalpb] implements both conversions
+for the [google.type.Decimal] and [google.type.Money] messages, including
+the validation of the signs and the range of nanos, without depending on
+the protobuf module.
+
 D. SQL
 
 The package integrates with the standard [database/sql] via the implementation
@@ -265,15 +324,64 @@ Below are the reasons for these preferences:
     To prevent automatic rescaling, consider using VARCHAR(22), which accurately
     preserves the scale of decimals.
 
+E. IEEE 754
+
+Databases, mainframes, and some market data feeds exchange decimals in the
+[IEEE 754] decimal64 and decimal128 interchange formats, using either
+the binary integer decimal (BID) or the densely packed decimal (DPD) encoding.
+Use [EncodeDecimal64BID], [EncodeDecimal64DPD], [EncodeDecimal128BID],
+and [EncodeDecimal128DPD] to convert decimals to these formats, and the
+corresponding Decode functions to convert them back.
+The exponent is equal to minus the scale, so trailing zeros are preserved,
+except when a decimal64 has to be rounded to 16 digits.
+Infinities and NaNs are not supported and result in errors.
+
+MongoDB stores decimals as [BSON Decimal128], which is the decimal128 format
+in the BID encoding.
+Package [github.com/govalues/decimal/decimalbson] wraps decimals so that they
+can be used as document fields with the MongoDB Go driver.
+
+F. Apache Parquet and Arrow
+
+Columnar formats store decimals as unscaled integers with a precision and
+a scale that are fixed for the whole column, such as DECIMAL(9,2) in
+[Apache Parquet] or Decimal128 in [Apache Arrow].
+Package [github.com/govalues/decimal/decimalparquet] converts decimals to and
+from the INT32, INT64, BYTE_ARRAY, and FIXED_LEN_BYTE_ARRAY representations
+of these columns, rounding to the scale of the column and returning an error
+if a value does not fit its precision.
+
+G. Apache Avro
+
+The [Avro decimal] logical type stores decimals as unscaled big-endian two's
+complement integers with the scale defined by the schema.
+Use [Decimal.AvroBytes] and [Decimal.AvroFixed] to convert decimals to the
+"bytes" and "fixed" underlying types, and [FromAvroBytes] and [FromAvroFixed]
+to convert them back.
+Decimals with more digits after the decimal point than the schema allows are
+rounded using [HalfEven], or using the rounding mode passed to
+[Decimal.AvroBytesMode] and [Decimal.AvroFixedMode].
+
+[errors.Is]: https://pkg.go.dev/errors#Is
+[errors.As]: https://pkg.go.dev/errors#As
 [Infinity]: https://en.wikipedia.org/wiki/Infinity#Computing
 [Subnormal numbers]: https://en.wikipedia.org/wiki/Subnormal_number
 [NaN]: https://en.wikipedia.org/wiki/NaN
 [ANSI X3.274-1996]: https://speleotrove.com/decimal/dax3274.html
 [big.Int]: https://pkg.go.dev/math/big#Int
 [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+[json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+[json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
 [negative zeros]: https://en.wikipedia.org/wiki/Signed_zero
 [context]: https://speleotrove.com/decimal/damodel.html
+[IEEE 754]: https://en.wikipedia.org/wiki/Decimal_floating_point#IEEE_754-2008_encoding
+[Avro decimal]: https://avro.apache.org/docs/current/specification/#decimal
+[Apache Parquet]: https://github.com/apache/parquet-format/blob/master/LogicalTypes.md#decimal
+[Apache Arrow]: https://arrow.apache.org/docs/format/Columnar.html
+[BSON Decimal128]: https://github.com/mongodb/specifications/blob/master/source/bson-decimal128/decimal128.md
 [numerical strings]: https://github.com/googleapis/googleapis/blob/master/google/type/decimal.proto
+[google.type.Decimal]: https://github.com/googleapis/googleapis/blob/master/google/type/decimal.proto
+[google.type.Money]: https://github.com/googleapis/googleapis/blob/master/google/type/money.proto
 [a pair of integers]: https://github.com/googleapis/googleapis/blob/master/google/type/money.proto
 */
 package decimal
@@ -3,9 +3,11 @@ package decimal_test
 import (
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 
 	"github.com/govalues/decimal"
 )
@@ -131,6 +133,240 @@ func ExampleProd() {
 	// Output: -1043.28 <nil>
 }
 
+func ExampleMean() {
+	d := decimal.RequireFromString("5.67")
+	e := decimal.RequireFromString("-8")
+	f := decimal.RequireFromString("23")
+	fmt.Println(decimal.Mean(d, e, f))
+	// Output: 6.89 <nil>
+}
+
+func ExampleWeightedMean() {
+	values := []decimal.Decimal{
+		decimal.RequireFromString("10"),
+		decimal.RequireFromString("20"),
+	}
+	weights := []decimal.Decimal{
+		decimal.RequireFromString("0.25"),
+		decimal.RequireFromString("0.75"),
+	}
+	fmt.Println(decimal.WeightedMean(values, weights))
+	// Output: 17.5 <nil>
+}
+
+func ExampleVariance() {
+	d := decimal.RequireFromString("1")
+	e := decimal.RequireFromString("2")
+	f := decimal.RequireFromString("3")
+	g := decimal.RequireFromString("4")
+	fmt.Println(decimal.Variance(d, e, f, g))
+	// Output: 1.666666666666666667 <nil>
+}
+
+func ExamplePopVariance() {
+	d := decimal.RequireFromString("1")
+	e := decimal.RequireFromString("2")
+	f := decimal.RequireFromString("3")
+	g := decimal.RequireFromString("4")
+	fmt.Println(decimal.PopVariance(d, e, f, g))
+	// Output: 1.25 <nil>
+}
+
+func ExampleStdDev() {
+	d := decimal.RequireFromString("1")
+	e := decimal.RequireFromString("2")
+	f := decimal.RequireFromString("3")
+	g := decimal.RequireFromString("4")
+	fmt.Println(decimal.StdDev(d, e, f, g))
+	// Output: 1.290994448735805628 <nil>
+}
+
+func ExamplePopStdDev() {
+	d := decimal.RequireFromString("1")
+	e := decimal.RequireFromString("2")
+	f := decimal.RequireFromString("3")
+	g := decimal.RequireFromString("4")
+	fmt.Println(decimal.PopStdDev(d, e, f, g))
+	// Output: 1.118033988749894848 <nil>
+}
+
+func ExampleMedian() {
+	d := decimal.RequireFromString("5.67")
+	e := decimal.RequireFromString("-8")
+	f := decimal.RequireFromString("23")
+	g := decimal.RequireFromString("10")
+	fmt.Println(decimal.Median(d, e, f))
+	fmt.Println(decimal.Median(d, e, f, g))
+	// Output:
+	// 5.67 <nil>
+	// 7.835 <nil>
+}
+
+func ExamplePercentile() {
+	d := []decimal.Decimal{
+		decimal.RequireFromString("15"),
+		decimal.RequireFromString("20"),
+		decimal.RequireFromString("35"),
+		decimal.RequireFromString("40"),
+		decimal.RequireFromString("50"),
+	}
+	p := decimal.RequireFromString("30")
+	fmt.Println(decimal.Percentile(p, decimal.PercentileLinear, d...))
+	fmt.Println(decimal.Percentile(p, decimal.PercentileLower, d...))
+	fmt.Println(decimal.Percentile(p, decimal.PercentileHigher, d...))
+	fmt.Println(decimal.Percentile(p, decimal.PercentileNearest, d...))
+	fmt.Println(decimal.Percentile(p, decimal.PercentileMidpoint, d...))
+	fmt.Println(decimal.Percentile(p, decimal.PercentileExclusive, d...))
+	// Output:
+	// 23 <nil>
+	// 20 <nil>
+	// 35 <nil>
+	// 20 <nil>
+	// 27.5 <nil>
+	// 19 <nil>
+}
+
+func ExamplePercentileMethod_String() {
+	fmt.Println(decimal.PercentileLinear)
+	fmt.Println(decimal.PercentileExclusive)
+	// Output:
+	// Linear
+	// Exclusive
+}
+
+func ExampleMode() {
+	d := decimal.RequireFromString("1.0")
+	e := decimal.RequireFromString("2")
+	f := decimal.RequireFromString("1.00")
+	fmt.Println(decimal.Mode(d, e, f))
+	// Output: 1.0 <nil>
+}
+
+func ExampleAccumulator() {
+	var a decimal.Accumulator
+	a.Add(decimal.RequireFromString("9999999999999999999"))
+	a.Add(decimal.RequireFromString("1"))
+	a.Sub(decimal.RequireFromString("5"))
+	fmt.Println(a.Result(0))
+	// Output: 9999999999999999995 <nil>
+}
+
+func ExampleAccumulator_Add() {
+	var a decimal.Accumulator
+	a.Add(decimal.RequireFromString("5.67"))
+	a.Add(decimal.RequireFromString("-8"))
+	a.Add(decimal.RequireFromString("23"))
+	fmt.Println(a.Result(2))
+	// Output: 20.67 <nil>
+}
+
+func ExampleAccumulator_Sub() {
+	var a decimal.Accumulator
+	a.Add(decimal.RequireFromString("5.67"))
+	a.Sub(decimal.RequireFromString("-8"))
+	a.Sub(decimal.RequireFromString("23"))
+	fmt.Println(a.Result(2))
+	// Output: -9.33 <nil>
+}
+
+func ExampleAccumulator_AddMul() {
+	var a decimal.Accumulator
+	a.AddMul(decimal.RequireFromString("2.50"), decimal.RequireFromString("3"))
+	a.AddMul(decimal.RequireFromString("0.99"), decimal.RequireFromString("12"))
+	fmt.Println(a.Result(2))
+	// Output: 19.38 <nil>
+}
+
+func ExampleAccumulator_Count() {
+	var a decimal.Accumulator
+	a.Add(decimal.RequireFromString("5.67"))
+	a.Sub(decimal.RequireFromString("-8"))
+	a.AddMul(decimal.RequireFromString("2.50"), decimal.RequireFromString("3"))
+	fmt.Println(a.Count())
+	// Output: 3
+}
+
+func ExampleAccumulator_Reset() {
+	var a decimal.Accumulator
+	a.Add(decimal.RequireFromString("5.67"))
+	a.Reset()
+	a.Add(decimal.RequireFromString("-8"))
+	fmt.Println(a.Count())
+	fmt.Println(a.Result(0))
+	// Output:
+	// 1
+	// -8 <nil>
+}
+
+func ExampleAccumulator_Result() {
+	var a decimal.Accumulator
+	a.Add(decimal.RequireFromString("1.005"))
+	fmt.Println(a.Result(0))
+	fmt.Println(a.Result(2))
+	fmt.Println(a.Result(4))
+	// Output:
+	// 1 <nil>
+	// 1.00 <nil>
+	// 1.0050 <nil>
+}
+
+func ExampleAccumulator_ResultMode() {
+	var a decimal.Accumulator
+	a.Add(decimal.RequireFromString("1.005"))
+	fmt.Println(a.ResultMode(2, decimal.Up))
+	fmt.Println(a.ResultMode(2, decimal.Down))
+	// Output:
+	// 1.01 <nil>
+	// 1.00 <nil>
+}
+
+func ExampleAtomicDecimal() {
+	var a decimal.AtomicDecimal
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = a.Add(decimal.RequireFromString("0.01"))
+		}()
+	}
+	wg.Wait()
+	fmt.Println(a.Load())
+	// Output: 0.10
+}
+
+func ExampleAtomicDecimal_CompareAndSwap() {
+	var a decimal.AtomicDecimal
+	a.Store(decimal.RequireFromString("1.5"))
+	fmt.Println(a.CompareAndSwap(decimal.RequireFromString("1.50"), decimal.RequireFromString("2")))
+	fmt.Println(a.CompareAndSwap(decimal.RequireFromString("1.5"), decimal.RequireFromString("2")))
+	fmt.Println(a.Load())
+	// Output:
+	// false
+	// true
+	// 2
+}
+
+func ExampleShardedSum() {
+	var s decimal.ShardedSum
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.Add(decimal.RequireFromString("9999999999999999999"))
+			s.Sub(decimal.RequireFromString("9999999999999999998"))
+			s.Add(decimal.RequireFromString("0.01"))
+		}()
+	}
+	wg.Wait()
+	fmt.Println(s.Load())
+	fmt.Println(s.Result(1))
+	// Output:
+	// 10.10 <nil>
+	// 10.1 <nil>
+}
+
 func ExampleMustNew() {
 	fmt.Println(decimal.MustNew(567, 0))
 	fmt.Println(decimal.MustNew(567, 1))
@@ -226,12 +462,12 @@ func ExampleDecimal_ULP() {
 	// 0.01
 }
 
-func ExampleParse() {
+func ExampleNewFromString() {
 	fmt.Println(decimal.NewFromString("5.67"))
 	// Output: 5.67 <nil>
 }
 
-func ExampleParseExact() {
+func ExampleNewFromStringExact() {
 	fmt.Println(decimal.NewFromStringExact("5.67", 0))
 	fmt.Println(decimal.NewFromStringExact("5.67", 1))
 	fmt.Println(decimal.NewFromStringExact("5.67", 2))
@@ -245,7 +481,7 @@ func ExampleParseExact() {
 	// 5.6700 <nil>
 }
 
-func ExampleMustParse() {
+func ExampleRequireFromString() {
 	fmt.Println(decimal.RequireFromString("-1.23"))
 	// Output: -1.23
 }
@@ -365,6 +601,52 @@ func ExampleDecimal_MarshalText_json() {
 	// {"number":"567000"} <nil>
 }
 
+func ExampleJSONOptions_Marshal() {
+	d := decimal.RequireFromString("5.670")
+	fmt.Println(decimal.JSONOptions{}.Marshal(d))
+	fmt.Println(decimal.JSONOptions{Trim: true}.Marshal(d))
+	fmt.Println(decimal.JSONOptions{Number: true}.Marshal(d))
+	// Output:
+	// [34 53 46 54 55 48 34] <nil>
+	// [34 53 46 54 55 34] <nil>
+	// [53 46 54 55 48] <nil>
+}
+
+func ExampleJSONOptions_Unmarshal() {
+	var d decimal.Decimal
+	strict := decimal.JSONOptions{Strict: true}
+	fmt.Println(strict.Unmarshal([]byte(`"5.67"`), &d), d)
+	fmt.Println(strict.Unmarshal([]byte(`5.67`), &d))
+	fmt.Println(strict.Unmarshal([]byte(`"5.67e-5"`), &d))
+	fmt.Println(strict.Unmarshal([]byte(`null`), &d))
+	// Output:
+	// <nil> 5.67
+	// parsing decimal: invalid decimal: JSON number is not allowed
+	// parsing decimal: invalid decimal: exponent notation is not allowed
+	// parsing decimal: invalid decimal: null is not allowed
+}
+
+func ExampleSetJSONOptions() {
+	decimal.SetJSONOptions(decimal.JSONOptions{Number: true, Trim: true})
+	defer decimal.SetJSONOptions(decimal.JSONOptions{})
+	fmt.Println(marshalJSON("5.670"))
+	// Output: {"number":5.67} <nil>
+}
+
+func ExampleStringDecimal() {
+	type Payment struct {
+		Amount decimal.StringDecimal `json:"amount"`
+		Rate   decimal.NumberDecimal `json:"rate"`
+	}
+	p := Payment{
+		Amount: decimal.StringDecimal{Decimal: decimal.RequireFromString("9999999999999999.99")},
+		Rate:   decimal.NumberDecimal{Decimal: decimal.RequireFromString("0.05")},
+	}
+	data, err := json.Marshal(p)
+	fmt.Println(string(data), err)
+	// Output: {"amount":"9999999999999999.99","rate":0.05} <nil>
+}
+
 type Entity struct {
 	Number decimal.Decimal `xml:"Number"`
 }
@@ -598,6 +880,32 @@ func ExampleDecimal_PowInt() {
 	// 4 <nil>
 }
 
+func ExampleDecimal_PowDec() {
+	d := decimal.RequireFromString("4")
+	e := decimal.RequireFromString("0.5")
+	f := decimal.RequireFromString("-1.5")
+	g := decimal.RequireFromString("1.05")
+	fmt.Println(d.PowDec(e))
+	fmt.Println(d.PowDec(f))
+	fmt.Println(g.PowDec(e))
+	// Output:
+	// 2 <nil>
+	// 0.125 <nil>
+	// 1.024695076595959838 <nil>
+}
+
+func ExampleDecimal_PowDecExact() {
+	d := decimal.RequireFromString("4")
+	e := decimal.RequireFromString("0.5")
+	fmt.Println(d.PowDecExact(e, 0))
+	fmt.Println(d.PowDecExact(e, 2))
+	fmt.Println(d.PowDecExact(e, 4))
+	// Output:
+	// 2 <nil>
+	// 2.00 <nil>
+	// 2.0000 <nil>
+}
+
 func ExampleDecimal_Sqrt() {
 	d := decimal.RequireFromString("1")
 	e := decimal.RequireFromString("2")
@@ -614,6 +922,32 @@ func ExampleDecimal_Sqrt() {
 	// 2 <nil>
 }
 
+func ExampleDecimal_Cbrt() {
+	d := decimal.RequireFromString("-8")
+	e := decimal.RequireFromString("2")
+	f := decimal.RequireFromString("1.331")
+	fmt.Println(d.Cbrt())
+	fmt.Println(e.Cbrt())
+	fmt.Println(f.Cbrt())
+	// Output:
+	// -2 <nil>
+	// 1.259921049894873165 <nil>
+	// 1.1 <nil>
+}
+
+func ExampleDecimal_Root() {
+	d := decimal.RequireFromString("16")
+	e := decimal.RequireFromString("2")
+	f := decimal.RequireFromString("-16")
+	fmt.Println(d.Root(4))
+	fmt.Println(e.Root(12))
+	fmt.Println(f.Root(4))
+	// Output:
+	// 2 <nil>
+	// 1.059463094359295265 <nil>
+	// 0 computing root(-16, 4): invalid operation
+}
+
 func ExampleDecimal_Exp() {
 	d := decimal.RequireFromString("-2.302585092994045684")
 	e := decimal.RequireFromString("0")
@@ -627,6 +961,19 @@ func ExampleDecimal_Exp() {
 	// 10 <nil>
 }
 
+func ExampleDecimal_Expm1() {
+	d := decimal.RequireFromString("-0.6931471805599453094")
+	e := decimal.RequireFromString("0.0001")
+	f := decimal.RequireFromString("0.6931471805599453094")
+	fmt.Println(d.Expm1())
+	fmt.Println(e.Expm1())
+	fmt.Println(f.Expm1())
+	// Output:
+	// -0.5 <nil>
+	// 0.0001000050001666708 <nil>
+	// 1 <nil>
+}
+
 func ExampleDecimal_Log() {
 	d := decimal.RequireFromString("1")
 	e := decimal.RequireFromString("2.718281828459045236")
@@ -640,6 +987,187 @@ func ExampleDecimal_Log() {
 	// 2.302585092994045684 <nil>
 }
 
+func ExampleDecimal_Log1p() {
+	d := decimal.RequireFromString("-0.5")
+	e := decimal.RequireFromString("0.0001")
+	f := decimal.RequireFromString("1.718281828459045235")
+	fmt.Println(d.Log1p())
+	fmt.Println(e.Log1p())
+	fmt.Println(f.Log1p())
+	// Output:
+	// -0.6931471805599453094 <nil>
+	// 0.0000999950003333083 <nil>
+	// 0.9999999999999999999 <nil>
+}
+
+func ExampleDecimal_Log2() {
+	d := decimal.RequireFromString("0.5")
+	e := decimal.RequireFromString("1024")
+	f := decimal.RequireFromString("10")
+	fmt.Println(d.Log2())
+	fmt.Println(e.Log2())
+	fmt.Println(f.Log2())
+	// Output:
+	// -1 <nil>
+	// 10 <nil>
+	// 3.321928094887362348 <nil>
+}
+
+func ExampleDecimal_Log10() {
+	d := decimal.RequireFromString("0.001")
+	e := decimal.RequireFromString("1000")
+	f := decimal.RequireFromString("2")
+	fmt.Println(d.Log10())
+	fmt.Println(e.Log10())
+	fmt.Println(f.Log10())
+	// Output:
+	// -3 <nil>
+	// 3 <nil>
+	// 0.3010299956639811952 <nil>
+}
+
+func ExampleDecimal_LogBase() {
+	d := decimal.RequireFromString("8")
+	e := decimal.RequireFromString("2")
+	f := decimal.RequireFromString("3")
+	fmt.Println(d.LogBase(e))
+	fmt.Println(e.LogBase(d))
+	fmt.Println(d.LogBase(f))
+	// Output:
+	// 3 <nil>
+	// 0.3333333333333333333 <nil>
+	// 1.892789260714372311 <nil>
+}
+
+func ExampleDecimal_Sin() {
+	d := decimal.RequireFromString("0.5235987755982988731")
+	e := decimal.RequireFromString("1.570796326794896619")
+	f := decimal.RequireFromString("3.141592653589793238")
+	fmt.Println(d.Sin())
+	fmt.Println(e.Sin())
+	fmt.Println(f.Sin())
+	// Output:
+	// 0.5 <nil>
+	// 1 <nil>
+	// 0.0000000000000000005 <nil>
+}
+
+func ExampleDecimal_Cos() {
+	d := decimal.RequireFromString("0")
+	e := decimal.RequireFromString("1")
+	f := decimal.RequireFromString("3.141592653589793238")
+	fmt.Println(d.Cos())
+	fmt.Println(e.Cos())
+	fmt.Println(f.Cos())
+	// Output:
+	// 1 <nil>
+	// 0.5403023058681397174 <nil>
+	// -1 <nil>
+}
+
+func ExampleDecimal_Tan() {
+	d := decimal.RequireFromString("0")
+	e := decimal.RequireFromString("0.7853981633974483096")
+	f := decimal.RequireFromString("1")
+	fmt.Println(d.Tan())
+	fmt.Println(e.Tan())
+	fmt.Println(f.Tan())
+	// Output:
+	// 0 <nil>
+	// 1 <nil>
+	// 1.557407724654902231 <nil>
+}
+
+func ExampleDecimal_Asin() {
+	d := decimal.RequireFromString("-1")
+	e := decimal.RequireFromString("0.5")
+	f := decimal.RequireFromString("2")
+	fmt.Println(d.Asin())
+	fmt.Println(e.Asin())
+	fmt.Println(f.Asin())
+	// Output:
+	// -1.570796326794896619 <nil>
+	// 0.5235987755982988731 <nil>
+	// 0 computing asin(2): invalid operation
+}
+
+func ExampleDecimal_Acos() {
+	d := decimal.RequireFromString("-1")
+	e := decimal.RequireFromString("0.5")
+	f := decimal.RequireFromString("1")
+	fmt.Println(d.Acos())
+	fmt.Println(e.Acos())
+	fmt.Println(f.Acos())
+	// Output:
+	// 3.141592653589793238 <nil>
+	// 1.047197551196597746 <nil>
+	// 0 <nil>
+}
+
+func ExampleDecimal_Atan() {
+	d := decimal.RequireFromString("-1")
+	e := decimal.RequireFromString("0")
+	f := decimal.RequireFromString("1")
+	fmt.Println(d.Atan())
+	fmt.Println(e.Atan())
+	fmt.Println(f.Atan())
+	// Output:
+	// -0.7853981633974483096 <nil>
+	// 0 <nil>
+	// 0.7853981633974483096 <nil>
+}
+
+func ExampleDecimal_Atan2() {
+	d := decimal.RequireFromString("1")
+	e := decimal.RequireFromString("-1")
+	fmt.Println(d.Atan2(d))
+	fmt.Println(d.Atan2(e))
+	fmt.Println(e.Atan2(e))
+	// Output:
+	// 0.7853981633974483096 <nil>
+	// 2.356194490192344929 <nil>
+	// -2.356194490192344929 <nil>
+}
+
+func ExampleDecimal_Sinh() {
+	d := decimal.RequireFromString("-1")
+	e := decimal.RequireFromString("0")
+	f := decimal.RequireFromString("1")
+	fmt.Println(d.Sinh())
+	fmt.Println(e.Sinh())
+	fmt.Println(f.Sinh())
+	// Output:
+	// -1.175201193643801457 <nil>
+	// 0 <nil>
+	// 1.175201193643801457 <nil>
+}
+
+func ExampleDecimal_Cosh() {
+	d := decimal.RequireFromString("-1")
+	e := decimal.RequireFromString("0")
+	f := decimal.RequireFromString("1")
+	fmt.Println(d.Cosh())
+	fmt.Println(e.Cosh())
+	fmt.Println(f.Cosh())
+	// Output:
+	// 1.543080634815243778 <nil>
+	// 1 <nil>
+	// 1.543080634815243778 <nil>
+}
+
+func ExampleDecimal_Tanh() {
+	d := decimal.RequireFromString("-1")
+	e := decimal.RequireFromString("0")
+	f := decimal.RequireFromString("50")
+	fmt.Println(d.Tanh())
+	fmt.Println(e.Tanh())
+	fmt.Println(f.Tanh())
+	// Output:
+	// -0.7615941559557648881 <nil>
+	// 0 <nil>
+	// 1 <nil>
+}
+
 func ExampleDecimal_Add() {
 	d := decimal.RequireFromString("5.67")
 	e := decimal.RequireFromString("8")
@@ -716,6 +1244,16 @@ func ExampleDecimal_QuoExact() {
 	// 2.8300 <nil>
 }
 
+func ExampleDecimal_QuoExactMode() {
+	d := decimal.RequireFromString("2")
+	e := decimal.RequireFromString("3")
+	fmt.Println(d.QuoExactMode(e, 2, decimal.HalfEven))
+	fmt.Println(d.QuoExactMode(e, 2, decimal.Down))
+	// Output:
+	// 0.6666666666666666667 <nil>
+	// 0.6666666666666666666 <nil>
+}
+
 func ExampleDecimal_QuoRem() {
 	d := decimal.RequireFromString("5.67")
 	e := decimal.RequireFromString("2")
@@ -723,6 +1261,19 @@ func ExampleDecimal_QuoRem() {
 	// Output: 2 1.67 <nil>
 }
 
+func ExampleDecimal_Allocate() {
+	d := decimal.RequireFromString("100")
+	r := decimal.RequireFromString("1")
+	fmt.Println(d.Allocate(2, r, r, r))
+	// Output: [33.34 33.33 33.33] <nil>
+}
+
+func ExampleDecimal_Split() {
+	d := decimal.RequireFromString("10")
+	fmt.Println(d.Split(4, 0))
+	// Output: [3 3 2 2] <nil>
+}
+
 func ExampleDecimal_Inv() {
 	d := decimal.RequireFromString("2")
 	fmt.Println(d.Inv())
@@ -953,6 +1504,27 @@ func ExampleDecimal_Round() {
 	// 5.678
 }
 
+func ExampleDecimal_RoundMode() {
+	d := decimal.RequireFromString("-2.345")
+	fmt.Println(d.RoundMode(2, decimal.HalfEven))
+	fmt.Println(d.RoundMode(2, decimal.HalfUp))
+	fmt.Println(d.RoundMode(2, decimal.HalfDown))
+	fmt.Println(d.RoundMode(2, decimal.Up))
+	fmt.Println(d.RoundMode(2, decimal.Down))
+	fmt.Println(d.RoundMode(2, decimal.Ceiling))
+	fmt.Println(d.RoundMode(2, decimal.Floor))
+	fmt.Println(d.RoundMode(2, decimal.ZeroFiveUp))
+	// Output:
+	// -2.34
+	// -2.35
+	// -2.34
+	// -2.35
+	// -2.34
+	// -2.34
+	// -2.35
+	// -2.34
+}
+
 func ExampleDecimal_Trunc() {
 	d := decimal.RequireFromString("5.678")
 	fmt.Println(d.Trunc(0))
@@ -1270,3 +1842,290 @@ func ExampleNullDecimal_Value() {
 	// 5.67 <nil>
 	// <nil> <nil>
 }
+
+func ExampleNewNullDecimal() {
+	d := decimal.RequireFromString("5.67")
+	n := decimal.NewNullDecimal(d)
+	m := decimal.NewNullDecimalFromPtr(nil)
+	fmt.Println(n)
+	fmt.Println(m)
+	// Output:
+	// {5.67 true}
+	// {0 false}
+}
+
+func ExampleNullDecimal_ValueOrZero() {
+	n := decimal.NewNullDecimal(decimal.RequireFromString("5.67"))
+	m := decimal.NullDecimal{}
+	fmt.Println(n.ValueOrZero())
+	fmt.Println(m.ValueOrZero())
+	// Output:
+	// 5.67
+	// 0
+}
+
+func ExampleNullDecimal_Ptr() {
+	n := decimal.NewNullDecimal(decimal.RequireFromString("5.67"))
+	m := decimal.NullDecimal{}
+	fmt.Println(*n.Ptr())
+	fmt.Println(m.Ptr())
+	// Output:
+	// 5.67
+	// <nil>
+}
+
+func ExampleNullDecimal_MarshalJSON() {
+	type Payment struct {
+		Amount   decimal.NullDecimal `json:"amount"`
+		Discount decimal.NullDecimal `json:"discount"`
+	}
+	p := Payment{Amount: decimal.NewNullDecimal(decimal.RequireFromString("5.67"))}
+	data, err := json.Marshal(p)
+	fmt.Println(string(data), err)
+	// Output: {"amount":"5.67","discount":null} <nil>
+}
+
+func ExampleNullDecimal_UnmarshalJSON() {
+	type Payment struct {
+		Amount   decimal.NullDecimal `json:"amount"`
+		Discount decimal.NullDecimal `json:"discount"`
+	}
+	var p Payment
+	err := json.Unmarshal([]byte(`{"amount":"5.67","discount":null}`), &p)
+	fmt.Println(p, err)
+	// Output: {{5.67 true} {0 false}} <nil>
+}
+
+func ExampleNullDecimal_MarshalXMLAttr() {
+	type Entity struct {
+		XMLName  xml.Name            `xml:"Entity"`
+		Amount   decimal.NullDecimal `xml:"amount,attr"`
+		Discount decimal.NullDecimal `xml:"discount,attr"`
+	}
+	e := Entity{Amount: decimal.NewNullDecimal(decimal.RequireFromString("5.67"))}
+	data, err := xml.Marshal(e)
+	fmt.Println(string(data), err)
+	// Output: <Entity amount="5.67"></Entity> <nil>
+}
+
+func ExampleEncodeDecimal64BID() {
+	d := decimal.RequireFromString("-1.50")
+	x := decimal.EncodeDecimal64BID(d)
+	fmt.Printf("%#016x\n", x)
+	fmt.Println(decimal.DecodeDecimal64BID(x))
+	// Output:
+	// 0xb180000000000096
+	// -1.50 <nil>
+}
+
+func ExampleEncodeDecimal64DPD() {
+	d := decimal.RequireFromString("-1.50")
+	x := decimal.EncodeDecimal64DPD(d)
+	fmt.Printf("%#016x\n", x)
+	fmt.Println(decimal.DecodeDecimal64DPD(x))
+	// Output:
+	// 0xa2300000000000d0
+	// -1.50 <nil>
+}
+
+func ExampleDecodeDecimal64BID() {
+	fmt.Println(decimal.DecodeDecimal64BID(0x31c0000000000001))
+	fmt.Println(decimal.DecodeDecimal64BID(0x2f60000000000019))
+	fmt.Println(decimal.DecodeDecimal64BID(0x7c00000000000000))
+	// Output:
+	// 1 <nil>
+	// 0.0000000000000000025 <nil>
+	// 0 converting decimal64: invalid decimal: NaN is not supported
+}
+
+func ExampleEncodeDecimal128BID() {
+	d := decimal.RequireFromString("-1.50")
+	hi, lo := decimal.EncodeDecimal128BID(d)
+	fmt.Printf("%#016x %#016x\n", hi, lo)
+	fmt.Println(decimal.DecodeDecimal128BID(hi, lo))
+	// Output:
+	// 0xb03c000000000000 0x0000000000000096
+	// -1.50 <nil>
+}
+
+func ExampleEncodeDecimal128DPD() {
+	d := decimal.RequireFromString("-1.50")
+	hi, lo := decimal.EncodeDecimal128DPD(d)
+	fmt.Printf("%#016x %#016x\n", hi, lo)
+	fmt.Println(decimal.DecodeDecimal128DPD(hi, lo))
+	// Output:
+	// 0xa207800000000000 0x00000000000000d0
+	// -1.50 <nil>
+}
+
+func ExampleDecimal_AvroBytes() {
+	d := decimal.RequireFromString("-1.5")
+	b, err := d.AvroBytes(4)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("% x\n", b)
+	fmt.Println(decimal.FromAvroBytes(b, 4))
+	// Output:
+	// c5 68
+	// -1.5000 <nil>
+}
+
+func ExampleDecimal_AvroBytesMode() {
+	d := decimal.RequireFromString("1.23459")
+	fmt.Println(d.AvroBytesMode(4, decimal.HalfEven))
+	fmt.Println(d.AvroBytesMode(4, decimal.Down))
+	// Output:
+	// [48 58] <nil>
+	// [48 57] <nil>
+}
+
+func ExampleDecimal_AvroFixed() {
+	d := decimal.RequireFromString("-1.5")
+	b, err := d.AvroFixed(4, 8)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("% x\n", b)
+	fmt.Println(decimal.FromAvroFixed(b, 4, 8))
+	// Output:
+	// ff ff ff ff ff ff c5 68
+	// -1.5000 <nil>
+}
+
+func ExampleFromAvroBytes() {
+	fmt.Println(decimal.FromAvroBytes([]byte{0x00, 0x80}, 2))
+	fmt.Println(decimal.FromAvroBytes([]byte{0x80}, 2))
+	fmt.Println(decimal.FromAvroBytes([]byte{}, 2))
+	// Output:
+	// 1.28 <nil>
+	// -1.28 <nil>
+	// 0 converting Avro decimal: invalid decimal: empty unscaled value
+}
+
+func ExampleContext() {
+	ctx := decimal.Context{Precision: 5, Rounding: decimal.HalfUp}
+	d := decimal.RequireFromString("2")
+	e := decimal.RequireFromString("3")
+	fmt.Println(ctx.Quo(d, e))
+	fmt.Println(ctx.Flags)
+	// Output:
+	// 0.66667 <nil>
+	// Inexact|Rounded
+}
+
+func ExampleContext_Quo() {
+	ctx := decimal.Context{Traps: decimal.Inexact}
+	d := decimal.RequireFromString("1")
+	e := decimal.RequireFromString("4")
+	f := decimal.RequireFromString("3")
+	fmt.Println(ctx.Quo(d, e))
+	_, err := ctx.Quo(d, f)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, decimal.Inexact))
+	// Output:
+	// 0.25 <nil>
+	// computing [1 / 3]: Inexact
+	// true
+}
+
+func ExampleContext_Exp() {
+	d := decimal.RequireFromString("1")
+	for _, mode := range []decimal.RoundingMode{decimal.HalfEven, decimal.Up, decimal.Down} {
+		ctx := decimal.Context{Precision: 10, Rounding: mode}
+		fmt.Println(ctx.Exp(d))
+	}
+	// Output:
+	// 2.718281828 <nil>
+	// 2.718281829 <nil>
+	// 2.718281828 <nil>
+}
+
+func ExampleOpError() {
+	d := decimal.RequireFromString("1")
+	e := decimal.RequireFromString("0")
+	_, err := d.Quo(e)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, decimal.ErrDivisionByZero))
+	var opErr *decimal.OpError
+	if errors.As(err, &opErr) {
+		fmt.Println(opErr.Op, opErr.Operands)
+	}
+	// Output:
+	// computing [1 / 0]: division by zero
+	// true
+	// quo [1 0]
+}
+
+func ExampleDecimal128() {
+	balance := decimal.MustNewDecimal128FromString("1234567.123456789012345678")
+	price := decimal.MustNewDecimal128FromString("0.5")
+	value, _ := balance.Mul(price)
+	fmt.Println(value)
+	fmt.Println(value.Decimal())
+	// Output:
+	// 617283.5617283945061728390
+	// 617283.5617283945062 <nil>
+}
+
+func ExampleDecimal128_Quo() {
+	d := decimal.MustNewDecimal128FromString("2")
+	e := decimal.MustNewDecimal128FromString("3")
+	fmt.Println(d.Quo(e))
+	fmt.Println(d.QuoExactMode(e, 0, decimal.Down))
+	// Output:
+	// 0.66666666666666666666666666666666666667 <nil>
+	// 0.66666666666666666666666666666666666666 <nil>
+}
+
+func ExampleDecimal_Decimal128() {
+	d := decimal.MustNewFromString("9999999999999999999")
+	e := d.Decimal128()
+	f, _ := e.Add(e)
+	fmt.Println(f)
+	fmt.Println(f.Decimal())
+	// Output:
+	// 19999999999999999998
+	// 0 converting 19999999999999999998: decimal overflow: the integer part of a decimal.Decimal can have at most 19 digits, but it has 20 digits
+}
+
+func ExampleBigDecimal() {
+	d := decimal.MustNewBigDecimalFromString("2")
+	fmt.Println(d.Sqrt(50))
+	fmt.Println(d.Log(30))
+	// Output:
+	// 1.4142135623730950488016887242096980785696718753769 <nil>
+	// 0.693147180559945309417232121458 <nil>
+}
+
+func ExampleBigDecimal_Quo() {
+	d := decimal.MustNewBigDecimalFromString("2")
+	e := decimal.MustNewBigDecimalFromString("3")
+	fmt.Println(d.Quo(e, 40))
+	fmt.Println(d.QuoMode(e, 5, decimal.Down))
+	// Output:
+	// 0.6666666666666666666666666666666666666667 <nil>
+	// 0.66666 <nil>
+}
+
+func ExampleBigDecimal_String() {
+	d := decimal.MustNewBigDecimalFromString("1.5e400")
+	e := decimal.MustNewBigDecimalFromString("0.00000001")
+	fmt.Println(d.String())
+	fmt.Println(e.String())
+	fmt.Printf("%v\n", e)
+	// Output:
+	// 1.5E+400
+	// 1E-8
+	// 0.00000001
+}
+
+func ExampleBigDecimal_Decimal() {
+	d := decimal.MustNewBigDecimalFromString("1.23456789012345678901234567890")
+	fmt.Println(d.Decimal())
+	e := decimal.MustNewBigDecimalFromString("1e20")
+	fmt.Println(e.Decimal())
+	// Output:
+	// 1.234567890123456789 <nil>
+	// 0 converting 1E+20: decimal overflow: the integer part of a decimal.Decimal can have at most 19 digits, but it has 21 digits
+}
@@ -0,0 +1,254 @@
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrOverflow is returned when the integer part of a result has more
+	// digits than a decimal can hold.
+	ErrOverflow = errors.New("decimal overflow")
+	// ErrInvalidDecimal is returned when a string or a byte slice cannot be
+	// parsed as a decimal.
+	ErrInvalidDecimal = errors.New("invalid decimal")
+	// ErrScaleRange is returned when a scale is negative or greater than [MaxScale].
+	ErrScaleRange = errors.New("scale out of range")
+	// ErrInvalidOperation is returned when an operation is not defined for
+	// its operands, for example, the square root of a negative decimal.
+	ErrInvalidOperation = errors.New("invalid operation")
+	// ErrInexactDivision is returned when a quotient cannot be computed exactly.
+	ErrInexactDivision = errors.New("inexact division")
+	// ErrDivisionByZero is returned when a decimal is divided by zero.
+	ErrDivisionByZero = errors.New("division by zero")
+	// ErrModeRange is returned when a rounding mode is not one of the defined
+	// rounding modes.
+	ErrModeRange = errors.New("rounding mode out of range")
+	// ErrPrecRange is returned when a precision is negative or greater than [MaxPrec].
+	ErrPrecRange = errors.New("precision out of range")
+)
+
+// OpError is the error type returned by arithmetic operations and parsing.
+// It describes the failed operation and wraps the cause, which in turn wraps
+// one of the exported sentinel errors, such as [ErrOverflow] or [ErrDivisionByZero].
+// Use [errors.Is] to check for a particular sentinel error and [errors.As]
+// to access the details of the operation.
+//
+// [errors.Is]: https://pkg.go.dev/errors#Is
+// [errors.As]: https://pkg.go.dev/errors#As
+type OpError struct {
+	// Op is the name of the operation, such as "add", "quo", or "parse".
+	Op string
+	// Operands are the operands of the operation.
+	// The integer power of "pow" is represented as a decimal.
+	Operands []Decimal
+	// BigOperands are the operands of an operation on [BigDecimal] values.
+	BigOperands []BigDecimal
+	// Decimal128Operands are the operands of an operation on [Decimal128] values.
+	Decimal128Operands []Decimal128
+	// Input is the string being parsed by "parse".
+	Input string
+	// Scale is the number of digits after the decimal point that
+	// were requested to be significant.
+	Scale int
+	// Err is the cause of the error.
+	Err error
+}
+
+// opFormat describes how an operation is formatted by [OpError.Error].
+type opFormat struct {
+	// format has a verb for every leading operand and for every group.
+	format string
+	// leading is the number of operands that are formatted individually.
+	leading int
+	// groups is the number of equal-sized slices into which the remaining
+	// operands are split, or zero if there can be no remaining operands.
+	groups int
+}
+
+// opFormats contains the operations that are not formatted as function
+// calls, such as "computing sqrt(-1)".
+var opFormats = map[string]opFormat{
+	"parse":        {"parsing decimal", 0, 0},
+	"accumulate":   {"computing accumulated total", 0, 0},
+	"inv":          {"inverting %v", 1, 0},
+	"round":        {"rounding %v", 1, 0},
+	"add":          {"computing [%v + %v]", 2, 0},
+	"sub":          {"computing [%v - %v]", 2, 0},
+	"subabs":       {"computing [abs(%v - %v)]", 2, 0},
+	"mul":          {"computing [%v * %v]", 2, 0},
+	"quo":          {"computing [%v / %v]", 2, 0},
+	"quorem":       {"computing [%[1]v div %[2]v] and [%[1]v mod %[2]v]", 2, 0},
+	"pow":          {"computing [%v^%v]", 2, 0},
+	"addmul":       {"computing [%v + %v * %v]", 3, 0},
+	"submul":       {"computing [%v - %v * %v]", 3, 0},
+	"addquo":       {"computing [%v + %v / %v]", 3, 0},
+	"subquo":       {"computing [%v - %v / %v]", 3, 0},
+	"split":        {"splitting %v into %v parts", 2, 0},
+	"convert":      {"converting %v", 1, 0},
+	"fromfloat":    {"converting float", 0, 0},
+	"encode64":     {"converting %v to decimal64", 1, 0},
+	"decode64":     {"converting decimal64", 0, 0},
+	"decode128":    {"converting decimal128", 0, 0},
+	"encodeavro":   {"converting %v to Avro decimal", 1, 0},
+	"decodeavro":   {"converting Avro decimal", 0, 0},
+	"allocate":     {"allocating %v by %v", 1, 1},
+	"percentile":   {"computing [percentile(%v, %v)]", 1, 1},
+	"weightedmean": {"computing [weightedmean(%v, %v)]", 0, 2},
+	"sum":          {"computing [sum(%v)]", 0, 1},
+	"prod":         {"computing [prod(%v)]", 0, 1},
+	"mean":         {"computing [mean(%v)]", 0, 1},
+	"median":       {"computing [median(%v)]", 0, 1},
+	"mode":         {"computing [mode(%v)]", 0, 1},
+	"variance":     {"computing [variance(%v)]", 0, 1},
+	"popvariance":  {"computing [popvariance(%v)]", 0, 1},
+	"stddev":       {"computing [stddev(%v)]", 0, 1},
+	"popstddev":    {"computing [popstddev(%v)]", 0, 1},
+}
+
+// args returns the arguments of the format, or false if the number
+// of operands does not match the operation.
+func (f opFormat) args(o []any) ([]any, bool) {
+	if len(o) < f.leading {
+		return nil, false
+	}
+	rest := len(o) - f.leading
+	switch {
+	case f.groups == 0 && rest != 0:
+		return nil, false
+	case f.groups != 0 && rest%f.groups != 0:
+		return nil, false
+	}
+	args := make([]any, 0, f.leading+f.groups)
+	args = append(args, o[:f.leading]...)
+	for i := range f.groups {
+		n := rest / f.groups
+		args = append(args, o[f.leading+i*n:f.leading+(i+1)*n])
+	}
+	return args, true
+}
+
+// Error implements the error interface.
+// Operations missing from opFormats are formatted as function calls.
+func (e *OpError) Error() string {
+	if e.Op == "" {
+		return e.Err.Error()
+	}
+	o := make([]any, 0, len(e.Operands)+len(e.BigOperands)+len(e.Decimal128Operands))
+	for _, d := range e.Operands {
+		o = append(o, d)
+	}
+	for _, d := range e.BigOperands {
+		o = append(o, d.String())
+	}
+	for _, d := range e.Decimal128Operands {
+		o = append(o, d)
+	}
+	var s string
+	if f, ok := opFormats[e.Op]; ok {
+		if args, ok := f.args(o); ok {
+			s = fmt.Sprintf(f.format, args...)
+		}
+	}
+	if s == "" {
+		operands := make([]string, len(o))
+		for i, d := range o {
+			operands[i] = fmt.Sprint(d)
+		}
+		s = fmt.Sprintf("computing %v(%v)", e.Op, strings.Join(operands, ", "))
+	}
+	return s + ": " + e.Err.Error()
+}
+
+// Unwrap returns the cause of the error.
+func (e *OpError) Unwrap() error {
+	return e.Err
+}
+
+// newOpError returns an *OpError describing the failed operation.
+// Errors created by overflowError do not describe an operation yet,
+// so they are completed instead of being wrapped.
+func newOpError(op string, scale int, err error, operands ...Decimal) error {
+	if e, ok := err.(*OpError); ok && e.Op == "" {
+		e.Op = op
+		e.Operands = operands
+		return e
+	}
+	return &OpError{Op: op, Operands: operands, Scale: scale, Err: err}
+}
+
+// newBigOpError is similar to newOpError, but it describes a failed
+// operation on [BigDecimal] values.
+func newBigOpError(op string, err error, operands ...BigDecimal) error {
+	if e, ok := err.(*OpError); ok && e.Op == "" {
+		e.Op = op
+		e.BigOperands = operands
+		return e
+	}
+	return &OpError{Op: op, BigOperands: operands, Err: err}
+}
+
+// newOpError128 is similar to newOpError, but it describes a failed
+// operation on [Decimal128] values.
+func newOpError128(op string, scale int, err error, operands ...Decimal128) error {
+	if e, ok := err.(*OpError); ok && e.Op == "" {
+		e.Op = op
+		e.Decimal128Operands = operands
+		return e
+	}
+	return &OpError{Op: op, Decimal128Operands: operands, Scale: scale, Err: err}
+}
+
+// newParseError is similar to newOpError, but it describes a failure
+// to parse the given string.
+func newParseError(input string, scale int, err error) error {
+	if e, ok := err.(*OpError); ok && e.Op == "" {
+		e.Op = "parse"
+		e.Input = input
+		return e
+	}
+	return &OpError{Op: "parse", Input: input, Scale: scale, Err: err}
+}
+
+// overflowError returns an error describing the overflow of a coefficient
+// with the given precision and scale, when at least wantScale digits after
+// the decimal point have to be preserved.
+func overflowError(gotPrec, gotScale, wantScale int) error {
+	err := overflowCause(Decimal{}, MaxPrec, gotPrec, gotScale, wantScale)
+	return &OpError{Scale: wantScale, Err: err}
+}
+
+// overflowError128 is similar to overflowError, but it describes the overflow
+// of a [Decimal128] coefficient.
+func overflowError128(gotPrec, gotScale, wantScale int) error {
+	err := overflowCause(Decimal128{}, MaxPrec128, gotPrec, gotScale, wantScale)
+	return &OpError{Scale: wantScale, Err: err}
+}
+
+// overflowCause returns an [ErrOverflow] error for a decimal of type typ,
+// which can hold at most maxPrec digits.
+func overflowCause(typ any, maxPrec, gotPrec, gotScale, wantScale int) error {
+	maxDigits := maxPrec - wantScale
+	gotDigits := gotPrec - gotScale
+	switch wantScale {
+	case 0:
+		return fmt.Errorf("%w: the integer part of a %T can have at most %v digits, but it has %v digits", ErrOverflow, typ, maxDigits, gotDigits)
+	default:
+		return fmt.Errorf("%w: with %v significant digits after the decimal point, the integer part of a %T can have at most %v digits, but it has %v digits", ErrOverflow, wantScale, typ, maxDigits, gotDigits)
+	}
+}
+
+// unknownOverflowError is similar to overflowError, but it is used when
+// the number of digits in the integer part cannot be determined.
+func unknownOverflowError(wantScale int) error {
+	maxDigits := MaxPrec - wantScale
+	var err error
+	switch wantScale {
+	case 0:
+		err = fmt.Errorf("%w: the integer part of a %T can have at most %v digits, but it has significantly more digits", ErrOverflow, Decimal{}, maxDigits)
+	default:
+		err = fmt.Errorf("%w: with %v significant digits after the decimal point, the integer part of a %T can have at most %v digits, but it has significantly more digits", ErrOverflow, wantScale, Decimal{}, maxDigits)
+	}
+	return &OpError{Scale: wantScale, Err: err}
+}
@@ -0,0 +1,483 @@
+package decimal
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestOpError(t *testing.T) {
+	d := RequireFromString("9999999999999999999")
+	z := MustNew(0, 0)
+	one := MustNew(1, 0)
+	neg := MustNew(-1, 0)
+
+	tests := []struct {
+		name         string
+		f            func() error
+		wantOp       string
+		wantOperands []Decimal
+		wantInput    string
+		wantScale    int
+		wantErr      error
+		wantMsg      string
+	}{
+		{
+			name:         "add",
+			f:            func() error { _, err := d.Add(one); return err },
+			wantOp:       "add",
+			wantOperands: []Decimal{d, one},
+			wantErr:      ErrOverflow,
+			wantMsg:      "computing [9999999999999999999 + 1]: decimal overflow: the integer part of a decimal.Decimal can have at most 19 digits, but it has 20 digits",
+		},
+		{
+			name:         "sub",
+			f:            func() error { _, err := d.Neg().Sub(one); return err },
+			wantOp:       "sub",
+			wantOperands: []Decimal{d.Neg(), one},
+			wantErr:      ErrOverflow,
+			wantMsg:      "computing [-9999999999999999999 - 1]: decimal overflow: the integer part of a decimal.Decimal can have at most 19 digits, but it has 20 digits",
+		},
+		{
+			name:         "add exact",
+			f:            func() error { _, err := one.AddExact(one, MaxScale+1); return err },
+			wantOp:       "add",
+			wantOperands: []Decimal{one, one},
+			wantScale:    MaxScale + 1,
+			wantErr:      ErrScaleRange,
+			wantMsg:      "computing [1 + 1]: scale out of range",
+		},
+		{
+			name:         "mul exact",
+			f:            func() error { _, err := d.MulExact(one, 1); return err },
+			wantOp:       "mul",
+			wantOperands: []Decimal{d, one},
+			wantScale:    1,
+			wantErr:      ErrOverflow,
+			wantMsg:      "computing [9999999999999999999 * 1]: decimal overflow: with 1 significant digits after the decimal point, the integer part of a decimal.Decimal can have at most 18 digits, but it has 19 digits",
+		},
+		{
+			name:         "quo",
+			f:            func() error { _, err := one.Quo(z); return err },
+			wantOp:       "quo",
+			wantOperands: []Decimal{one, z},
+			wantErr:      ErrDivisionByZero,
+			wantMsg:      "computing [1 / 0]: division by zero",
+		},
+		{
+			name:         "quorem",
+			f:            func() error { _, _, err := one.QuoRem(z); return err },
+			wantOp:       "quorem",
+			wantOperands: []Decimal{one, z},
+			wantErr:      ErrDivisionByZero,
+			wantMsg:      "computing [1 div 0] and [1 mod 0]: division by zero",
+		},
+		{
+			name:         "subquo",
+			f:            func() error { _, err := one.SubQuo(one, z); return err },
+			wantOp:       "subquo",
+			wantOperands: []Decimal{one, one, z},
+			wantErr:      ErrDivisionByZero,
+			wantMsg:      "computing [1 - 1 / 0]: division by zero",
+		},
+		{
+			name:         "pow",
+			f:            func() error { _, err := z.PowInt(-2); return err },
+			wantOp:       "pow",
+			wantOperands: []Decimal{z, MustNew(-2, 0)},
+			wantErr:      ErrInvalidOperation,
+			wantMsg:      "computing [0^-2]: invalid operation",
+		},
+		{
+			name:         "sqrt",
+			f:            func() error { _, err := neg.Sqrt(); return err },
+			wantOp:       "sqrt",
+			wantOperands: []Decimal{neg},
+			wantErr:      ErrInvalidOperation,
+			wantMsg:      "computing sqrt(-1): invalid operation",
+		},
+		{
+			name:         "root",
+			f:            func() error { _, err := neg.Root(4); return err },
+			wantOp:       "root",
+			wantOperands: []Decimal{neg, MustNew(4, 0)},
+			wantErr:      ErrInvalidOperation,
+			wantMsg:      "computing root(-1, 4): invalid operation",
+		},
+		{
+			name:         "exp",
+			f:            func() error { _, err := MustNew(50, 0).Exp(); return err },
+			wantOp:       "exp",
+			wantOperands: []Decimal{MustNew(50, 0)},
+			wantErr:      ErrOverflow,
+			wantMsg:      "computing exp(50): decimal overflow: the integer part of a decimal.Decimal can have at most 19 digits, but it has significantly more digits",
+		},
+		{
+			name:         "log",
+			f:            func() error { _, err := z.Log(); return err },
+			wantOp:       "log",
+			wantOperands: []Decimal{z},
+			wantErr:      ErrInvalidOperation,
+			wantMsg:      "computing log(0): invalid operation",
+		},
+		{
+			name:         "log10",
+			f:            func() error { _, err := neg.Log10(); return err },
+			wantOp:       "log10",
+			wantOperands: []Decimal{neg},
+			wantErr:      ErrInvalidOperation,
+			wantMsg:      "computing log10(-1): invalid operation",
+		},
+		{
+			name:         "logbase",
+			f:            func() error { _, err := MustNew(2, 0).LogBase(One); return err },
+			wantOp:       "logbase",
+			wantOperands: []Decimal{MustNew(2, 0), One},
+			wantErr:      ErrInvalidOperation,
+			wantMsg:      "computing logbase(2, 1): invalid operation",
+		},
+		{
+			name:         "expm1",
+			f:            func() error { _, err := MustNew(50, 0).Expm1(); return err },
+			wantOp:       "expm1",
+			wantOperands: []Decimal{MustNew(50, 0)},
+			wantErr:      ErrOverflow,
+			wantMsg:      "computing expm1(50): decimal overflow: the integer part of a decimal.Decimal can have at most 19 digits, but it has significantly more digits",
+		},
+		{
+			name:         "asin",
+			f:            func() error { _, err := MustNew(2, 0).Asin(); return err },
+			wantOp:       "asin",
+			wantOperands: []Decimal{MustNew(2, 0)},
+			wantErr:      ErrInvalidOperation,
+			wantMsg:      "computing asin(2): invalid operation",
+		},
+		{
+			name:         "sinh",
+			f:            func() error { _, err := MustNew(50, 0).Sinh(); return err },
+			wantOp:       "sinh",
+			wantOperands: []Decimal{MustNew(50, 0)},
+			wantErr:      ErrOverflow,
+			wantMsg:      "computing sinh(50): decimal overflow: the integer part of a decimal.Decimal can have at most 19 digits, but it has significantly more digits",
+		},
+		{
+			name:    "sum",
+			f:       func() error { _, err := Sum(); return err },
+			wantOp:  "sum",
+			wantErr: ErrInvalidOperation,
+			wantMsg: "computing [sum([])]: invalid operation: no arguments",
+		},
+		{
+			name:         "prod",
+			f:            func() error { _, err := Prod(d, d); return err },
+			wantOp:       "prod",
+			wantOperands: []Decimal{d, d},
+			wantErr:      ErrOverflow,
+			wantMsg:      "computing [prod([9999999999999999999 9999999999999999999])]: decimal overflow: the integer part of a decimal.Decimal can have at most 19 digits, but it has 38 digits",
+		},
+		{
+			name:    "mean",
+			f:       func() error { _, err := Mean(); return err },
+			wantOp:  "mean",
+			wantErr: ErrInvalidOperation,
+			wantMsg: "computing [mean([])]: invalid operation: no arguments",
+		},
+		{
+			name:         "variance",
+			f:            func() error { _, err := Variance(One); return err },
+			wantOp:       "variance",
+			wantOperands: []Decimal{One},
+			wantErr:      ErrInvalidOperation,
+			wantMsg:      "computing [variance([1])]: invalid operation: at least two arguments are required",
+		},
+		{
+			name:         "weightedmean",
+			f:            func() error { _, err := WeightedMean([]Decimal{One}, []Decimal{Zero}); return err },
+			wantOp:       "weightedmean",
+			wantOperands: []Decimal{One, Zero},
+			wantErr:      ErrDivisionByZero,
+			wantMsg:      "computing [weightedmean([1], [0])]: division by zero",
+		},
+		{
+			name:         "percentile",
+			f:            func() error { _, err := Percentile(Ten, PercentileExclusive, One); return err },
+			wantOp:       "percentile",
+			wantOperands: []Decimal{Ten, One},
+			wantErr:      ErrInvalidOperation,
+			wantMsg:      "computing [percentile(10, [1])]: invalid operation: percentile 10 is too small for n = 1",
+		},
+		{
+			name: "accumulate",
+			f: func() error {
+				var a Accumulator
+				a.Add(d)
+				a.Add(d)
+				_, err := a.Result(0)
+				return err
+			},
+			wantOp:  "accumulate",
+			wantErr: ErrOverflow,
+			wantMsg: "computing accumulated total: decimal overflow: the integer part of a decimal.Decimal can have at most 19 digits, but it has 20 digits",
+		},
+		{
+			name:      "parse",
+			f:         func() error { _, err := NewFromStringExact("1.2x", 0); return err },
+			wantOp:    "parse",
+			wantInput: "1.2x",
+			wantErr:   ErrInvalidDecimal,
+			wantMsg:   "parsing decimal: invalid decimal: unexpected character 'x'",
+		},
+		{
+			name:      "parse exact",
+			f:         func() error { _, err := NewFromStringExact("123456789012345678", 2); return err },
+			wantOp:    "parse",
+			wantInput: "123456789012345678",
+			wantScale: 2,
+			wantErr:   ErrOverflow,
+			wantMsg:   "parsing decimal: decimal overflow: with 2 significant digits after the decimal point, the integer part of a decimal.Decimal can have at most 17 digits, but it has 18 digits",
+		},
+	}
+	for _, tt := range tests {
+		err := tt.f()
+		if err == nil {
+			t.Errorf("%v: did not fail", tt.name)
+			continue
+		}
+		var opErr *OpError
+		if !errors.As(err, &opErr) {
+			t.Errorf("%v: error %T is not an *OpError", tt.name, err)
+			continue
+		}
+		if opErr.Op != tt.wantOp {
+			t.Errorf("%v: Op = %q, want %q", tt.name, opErr.Op, tt.wantOp)
+		}
+		if !slices.Equal(opErr.Operands, tt.wantOperands) {
+			t.Errorf("%v: Operands = %v, want %v", tt.name, opErr.Operands, tt.wantOperands)
+		}
+		if opErr.Input != tt.wantInput {
+			t.Errorf("%v: Input = %q, want %q", tt.name, opErr.Input, tt.wantInput)
+		}
+		if opErr.Scale != tt.wantScale {
+			t.Errorf("%v: Scale = %v, want %v", tt.name, opErr.Scale, tt.wantScale)
+		}
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%v: errors.Is(%v, %v) = false, want true", tt.name, err, tt.wantErr)
+		}
+		if err.Error() != tt.wantMsg {
+			t.Errorf("%v: Error() = %q, want %q", tt.name, err.Error(), tt.wantMsg)
+		}
+	}
+}
+
+func TestOpError_nested(t *testing.T) {
+	_, err := MustNew(0, 0).Inv()
+	var opErr *OpError
+	if !errors.As(err, &opErr) || opErr.Op != "inv" {
+		t.Fatalf("Inv() returned %v, want *OpError with Op \"inv\"", err)
+	}
+	if !errors.As(opErr.Err, &opErr) || opErr.Op != "quo" {
+		t.Errorf("Inv() cause is %v, want *OpError with Op \"quo\"", opErr.Err)
+	}
+	if !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("errors.Is(%v, ErrDivisionByZero) = false, want true", err)
+	}
+	want := "inverting 0: computing [1 / 0]: division by zero"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestOpError_Error(t *testing.T) {
+	tests := []struct {
+		err  *OpError
+		want string
+	}{
+		{&OpError{Op: "cbrt", Operands: []Decimal{Ten}, Err: ErrOverflow}, "computing cbrt(10): decimal overflow"},
+		{&OpError{Op: "hypot", Operands: []Decimal{One, Ten}, Err: ErrOverflow}, "computing hypot(1, 10): decimal overflow"},
+		{&OpError{Op: "add", Operands: []Decimal{One}, Err: ErrOverflow}, "computing add(1): decimal overflow"},
+		{&OpError{Op: "weightedmean", Operands: []Decimal{One, Ten, One}, Err: ErrOverflow}, "computing weightedmean(1, 10, 1): decimal overflow"},
+		{&OpError{Op: "sum", Operands: []Decimal{One, Ten}, Err: ErrOverflow}, "computing [sum([1 10])]: decimal overflow"},
+		{&OpError{Op: "allocate", Operands: []Decimal{Ten}, Err: ErrOverflow}, "allocating 10 by []: decimal overflow"},
+		{&OpError{Err: ErrOverflow}, "decimal overflow"},
+	}
+	for _, tt := range tests {
+		got := tt.err.Error()
+		if got != tt.want {
+			t.Errorf("%#v.Error() = %q, want %q", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestOverflowError128(t *testing.T) {
+	err := overflowError128(40, 1, 2)
+	var opErr *OpError
+	if !errors.As(err, &opErr) {
+		t.Fatalf("overflowError128(40, 1, 2) returned %T, want *OpError", err)
+	}
+	if opErr.Scale != 2 {
+		t.Errorf("Scale = %v, want 2", opErr.Scale)
+	}
+	if !errors.Is(err, ErrOverflow) {
+		t.Errorf("errors.Is(%v, ErrOverflow) = false, want true", err)
+	}
+	want := "decimal overflow: with 2 significant digits after the decimal point, the integer part of a decimal.Decimal128 can have at most 36 digits, but it has 39 digits"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestOpError_bigDecimal(t *testing.T) {
+	one := MustNewBigDecimal(1, 0)
+	zero := MustNewBigDecimal(0, 0)
+	large := MustNewBigDecimal(1, -20)
+
+	tests := []struct {
+		name         string
+		f            func() error
+		wantOp       string
+		wantOperands []BigDecimal
+		wantInput    string
+		wantErr      error
+		wantMsg      string
+	}{
+		{
+			name:         "quo",
+			f:            func() error { _, err := one.Quo(zero, 10); return err },
+			wantOp:       "quo",
+			wantOperands: []BigDecimal{one, zero},
+			wantErr:      ErrDivisionByZero,
+			wantMsg:      "computing [1 / 0]: division by zero",
+		},
+		{
+			name:         "sqrt",
+			f:            func() error { _, err := one.Neg().Sqrt(10); return err },
+			wantOp:       "sqrt",
+			wantOperands: []BigDecimal{one.Neg()},
+			wantErr:      ErrInvalidOperation,
+			wantMsg:      "computing sqrt(-1): invalid operation",
+		},
+		{
+			name:         "convert",
+			f:            func() error { _, err := large.Decimal(); return err },
+			wantOp:       "convert",
+			wantOperands: []BigDecimal{large},
+			wantErr:      ErrOverflow,
+			wantMsg:      "converting 1E+20: decimal overflow: the integer part of a decimal.Decimal can have at most 19 digits, but it has 21 digits",
+		},
+		{
+			name:      "parse",
+			f:         func() error { _, err := NewBigDecimalFromString("1.2x"); return err },
+			wantOp:    "parse",
+			wantInput: "1.2x",
+			wantErr:   ErrInvalidDecimal,
+			wantMsg:   "parsing decimal: invalid decimal: unexpected character 'x'",
+		},
+		{
+			name:    "fromfloat",
+			f:       func() error { _, err := NewBigDecimalFromFloat64(math.NaN()); return err },
+			wantOp:  "fromfloat",
+			wantErr: ErrInvalidOperation,
+			wantMsg: "converting float: invalid operation: special value NaN",
+		},
+	}
+	for _, tt := range tests {
+		err := tt.f()
+		if err == nil {
+			t.Errorf("%v: did not fail", tt.name)
+			continue
+		}
+		var opErr *OpError
+		if !errors.As(err, &opErr) {
+			t.Errorf("%v: error %T is not an *OpError", tt.name, err)
+			continue
+		}
+		if opErr.Op != tt.wantOp {
+			t.Errorf("%v: Op = %q, want %q", tt.name, opErr.Op, tt.wantOp)
+		}
+		if !slices.EqualFunc(opErr.BigOperands, tt.wantOperands, func(d, e BigDecimal) bool { return d.Cmp(e) == 0 }) {
+			t.Errorf("%v: BigOperands = %v, want %v", tt.name, opErr.BigOperands, tt.wantOperands)
+		}
+		if opErr.Input != tt.wantInput {
+			t.Errorf("%v: Input = %q, want %q", tt.name, opErr.Input, tt.wantInput)
+		}
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%v: errors.Is(%v, %v) = false, want true", tt.name, err, tt.wantErr)
+		}
+		if err.Error() != tt.wantMsg {
+			t.Errorf("%v: Error() = %q, want %q", tt.name, err.Error(), tt.wantMsg)
+		}
+	}
+}
+
+func TestOpError_decimal128(t *testing.T) {
+	one := MustNewDecimal128(1, 0)
+	zero := MustNewDecimal128(0, 0)
+	large := MustNewDecimal128FromString("99999999999999999999999999999999999999")
+
+	tests := []struct {
+		name         string
+		f            func() error
+		wantOp       string
+		wantOperands []Decimal128
+		wantErr      error
+		wantMsg      string
+	}{
+		{
+			name:         "add",
+			f:            func() error { _, err := large.Add(one); return err },
+			wantOp:       "add",
+			wantOperands: []Decimal128{large, one},
+			wantErr:      ErrOverflow,
+			wantMsg:      "computing [99999999999999999999999999999999999999 + 1]: decimal overflow: the integer part of a decimal.Decimal128 can have at most 38 digits, but it has 39 digits",
+		},
+		{
+			name:         "sub",
+			f:            func() error { _, err := large.Neg().Sub(one); return err },
+			wantOp:       "sub",
+			wantOperands: []Decimal128{large.Neg(), one},
+			wantErr:      ErrOverflow,
+			wantMsg:      "computing [-99999999999999999999999999999999999999 - 1]: decimal overflow: the integer part of a decimal.Decimal128 can have at most 38 digits, but it has 39 digits",
+		},
+		{
+			name:         "mul",
+			f:            func() error { _, err := large.MulExact(one, 40); return err },
+			wantOp:       "mul",
+			wantOperands: []Decimal128{large, one},
+			wantErr:      ErrScaleRange,
+			wantMsg:      "computing [99999999999999999999999999999999999999 * 1]: scale out of range",
+		},
+		{
+			name:         "quo",
+			f:            func() error { _, err := one.Quo(zero); return err },
+			wantOp:       "quo",
+			wantOperands: []Decimal128{one, zero},
+			wantErr:      ErrDivisionByZero,
+			wantMsg:      "computing [1 / 0]: division by zero",
+		},
+	}
+	for _, tt := range tests {
+		err := tt.f()
+		if err == nil {
+			t.Errorf("%v: did not fail", tt.name)
+			continue
+		}
+		var opErr *OpError
+		if !errors.As(err, &opErr) {
+			t.Errorf("%v: error %T is not an *OpError", tt.name, err)
+			continue
+		}
+		if opErr.Op != tt.wantOp {
+			t.Errorf("%v: Op = %q, want %q", tt.name, opErr.Op, tt.wantOp)
+		}
+		if !slices.EqualFunc(opErr.Decimal128Operands, tt.wantOperands, func(d, e Decimal128) bool { return d.Cmp(e) == 0 }) {
+			t.Errorf("%v: Decimal128Operands = %v, want %v", tt.name, opErr.Decimal128Operands, tt.wantOperands)
+		}
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%v: errors.Is(%v, %v) = false, want true", tt.name, err, tt.wantErr)
+		}
+		if err.Error() != tt.wantMsg {
+			t.Errorf("%v: Error() = %q, want %q", tt.name, err.Error(), tt.wantMsg)
+		}
+	}
+}
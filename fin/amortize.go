@@ -0,0 +1,155 @@
+package fin
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+)
+
+// Structure specifies how the principal of a loan is repaid.
+type Structure int
+
+const (
+	// Annuity means that every payment is the same, except possibly the last one,
+	// and consists of interest and a growing share of principal.
+	Annuity Structure = iota
+	// EqualPrincipal means that every payment repays the same share of principal,
+	// so that payments decrease together with interest.
+	EqualPrincipal
+	// InterestOnly means that only interest is paid every period, and the whole
+	// principal is repaid with the last payment (balloon payment).
+	InterestOnly
+)
+
+// String implements the [fmt.Stringer] interface.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (s Structure) String() string {
+	switch s {
+	case Annuity:
+		return "Annuity"
+	case EqualPrincipal:
+		return "EqualPrincipal"
+	case InterestOnly:
+		return "InterestOnly"
+	default:
+		return fmt.Sprintf("Structure(%d)", int(s))
+	}
+}
+
+// Period is a row of an amortization schedule.
+// Payment is always equal to Interest plus Principal.
+type Period struct {
+	Number    int             // Number is the 1-based number of the period.
+	Payment   decimal.Decimal // Payment is the total amount paid at the end of the period.
+	Interest  decimal.Decimal // Interest is the part of the payment that covers interest.
+	Principal decimal.Decimal // Principal is the part of the payment that repays principal.
+	Balance   decimal.Decimal // Balance is the principal that remains after the payment.
+}
+
+// Amortize returns the amortization schedule of a loan with the given principal,
+// interest rate per period, and number of periods.
+// Payments are due at the end of each period.
+// Every amount in the schedule is rounded to the given scale using
+// [rounding half to even], and the principal part of the last payment is
+// adjusted so that the balance ends at exactly 0.
+// Amounts in the schedule have the same sign as the principal.
+//
+// For an [Annuity], the payment is computed as by [PMT] and rounded to the given scale.
+// For [EqualPrincipal], the principal is split into equal parts using [decimal.Decimal.Split],
+// so the parts sum exactly to the principal.
+//
+// Amortize returns an error if:
+//   - the structure is not valid;
+//   - the number of periods is not positive;
+//   - the scale is negative or greater than [decimal.MaxScale];
+//   - the principal has more digits after the decimal point than the given scale;
+//   - the integer part of an intermediate result has more than [decimal.MaxPrec] digits.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func Amortize(principal, rate decimal.Decimal, nper, scale int, s Structure) ([]Period, error) {
+	var err error
+	switch {
+	case s < Annuity || s > InterestOnly:
+		err = fmt.Errorf("%w: unknown structure %v", decimal.ErrInvalidOperation, s)
+	case nper <= 0:
+		err = fmt.Errorf("%w: number of periods must be positive", decimal.ErrInvalidOperation)
+	case scale < decimal.MinScale || scale > decimal.MaxScale:
+		err = decimal.ErrScaleRange
+	case principal.MinScale() > scale:
+		err = fmt.Errorf("%w: %v has more than %v digits after the decimal point", decimal.ErrInvalidOperation, principal, scale)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("computing Amortize(%v, %v, %v, %v, %v): %w", principal, rate, nper, scale, s, err)
+	}
+
+	// Principal parts of the payments
+	var c calc
+	var pmt decimal.Decimal
+	var parts []decimal.Decimal
+	switch s {
+	case Annuity:
+		n, err := decimal.New(int64(nper), 0)
+		if err != nil {
+			return nil, fmt.Errorf("computing Amortize(%v, %v, %v, %v, %v): %w", principal, rate, nper, scale, s, err)
+		}
+		pmt, err = PMT(rate, n, principal, decimal.Zero, End)
+		if err != nil {
+			return nil, fmt.Errorf("computing Amortize(%v, %v, %v, %v, %v): %w", principal, rate, nper, scale, s, err)
+		}
+		pmt = pmt.Neg().Round(scale)
+	case EqualPrincipal:
+		parts, err = principal.Split(nper, scale)
+		if err != nil {
+			return nil, fmt.Errorf("computing Amortize(%v, %v, %v, %v, %v): %w", principal, rate, nper, scale, s, err)
+		}
+	}
+
+	periods := make([]Period, nper)
+	balance := principal.Pad(scale)
+	for i := range periods {
+		p := Period{Number: i + 1}
+		p.Interest = c.interest(balance, rate, scale)
+		switch {
+		case i == nper-1:
+			p.Principal = balance
+		case s == Annuity:
+			p.Principal = c.Sub(pmt, p.Interest)
+		case s == EqualPrincipal:
+			p.Principal = parts[i]
+		case s == InterestOnly:
+			p.Principal = decimal.Zero.Pad(scale)
+		}
+		p.Payment = c.Add(p.Interest, p.Principal)
+		balance = c.Sub(balance, p.Principal)
+		p.Balance = balance
+		periods[i] = p
+	}
+	if c.Err != nil {
+		return nil, fmt.Errorf("computing Amortize(%v, %v, %v, %v, %v): %w", principal, rate, nper, scale, s, c.Err)
+	}
+	return periods, nil
+}
+
+// interest returns balance × rate rounded to the given scale using
+// rounding half to even.
+// The product is computed with at least scale digits after the decimal point,
+// but it is rounded to [decimal.MaxPrec] digits, so it is computed using
+// [decimal.ZeroFiveUp] first, since rounding such a product to the scale
+// gives the same result as rounding the exact product once.
+func (c *calc) interest(balance, rate decimal.Decimal, scale int) decimal.Decimal {
+	if c.Err != nil {
+		return decimal.Decimal{}
+	}
+	var f decimal.Decimal
+	f, c.Err = balance.MulExactMode(rate, scale, decimal.ZeroFiveUp)
+	if c.Err != nil {
+		return decimal.Decimal{}
+	}
+	if f.Scale() > scale {
+		return f.Round(scale)
+	}
+	// The product has already been rounded to the scale, if at all.
+	f, c.Err = balance.MulExact(rate, scale)
+	return f
+}
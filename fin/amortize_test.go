@@ -0,0 +1,183 @@
+package fin
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestStructure_String(t *testing.T) {
+	tests := []struct {
+		s    Structure
+		want string
+	}{
+		{Annuity, "Annuity"},
+		{EqualPrincipal, "EqualPrincipal"},
+		{InterestOnly, "InterestOnly"},
+		{Structure(3), "Structure(3)"},
+	}
+	for _, tt := range tests {
+		got := tt.s.String()
+		if got != tt.want {
+			t.Errorf("Structure(%d).String() = %q, want %q", int(tt.s), got, tt.want)
+		}
+	}
+}
+
+func TestAmortize(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			principal, rate string
+			nper, scale     int
+			s               Structure
+			want            [][4]string // payment, interest, principal, balance
+		}{
+			{
+				"10000", "0.01", 6, 2, Annuity,
+				[][4]string{
+					{"1725.48", "100.00", "1625.48", "8374.52"},
+					{"1725.48", "83.75", "1641.73", "6732.79"},
+					{"1725.48", "67.33", "1658.15", "5074.64"},
+					{"1725.48", "50.75", "1674.73", "3399.91"},
+					{"1725.48", "34.00", "1691.48", "1708.43"},
+					{"1725.51", "17.08", "1708.43", "0.00"},
+				},
+			},
+			{
+				"10000", "0.01", 6, 2, EqualPrincipal,
+				[][4]string{
+					{"1766.67", "100.00", "1666.67", "8333.33"},
+					{"1750.00", "83.33", "1666.67", "6666.66"},
+					{"1733.34", "66.67", "1666.67", "4999.99"},
+					{"1716.67", "50.00", "1666.67", "3333.32"},
+					{"1699.99", "33.33", "1666.66", "1666.66"},
+					{"1683.33", "16.67", "1666.66", "0.00"},
+				},
+			},
+			{
+				"10000", "0.01", 3, 2, InterestOnly,
+				[][4]string{
+					{"100.00", "100.00", "0.00", "10000.00"},
+					{"100.00", "100.00", "0.00", "10000.00"},
+					{"10100.00", "100.00", "10000.00", "0.00"},
+				},
+			},
+			{
+				"1000", "0", 3, 2, Annuity,
+				[][4]string{
+					{"333.33", "0.00", "333.33", "666.67"},
+					{"333.33", "0.00", "333.33", "333.34"},
+					{"333.34", "0.00", "333.34", "0.00"},
+				},
+			},
+			{
+				"-1000", "0.05", 2, 0, Annuity,
+				[][4]string{
+					{"-538", "-50", "-488", "-512"},
+					{"-538", "-26", "-512", "0"},
+				},
+			},
+			{
+				"500", "0.1", 1, 2, EqualPrincipal,
+				[][4]string{
+					{"550.00", "50.00", "500.00", "0.00"},
+				},
+			},
+			{
+				"1.41", "0.0886524822695035461", 2, 2, InterestOnly,
+				[][4]string{
+					{"0.13", "0.13", "0.00", "1.41"},
+					{"1.54", "0.13", "1.41", "0.00"},
+				},
+			},
+		}
+		for _, tt := range tests {
+			principal := decimal.MustNewFromString(tt.principal)
+			rate := decimal.MustNewFromString(tt.rate)
+			got, err := Amortize(principal, rate, tt.nper, tt.scale, tt.s)
+			if err != nil {
+				t.Errorf("Amortize(%v, %v, %v, %v, %v) failed: %v", principal, rate, tt.nper, tt.scale, tt.s, err)
+				continue
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("Amortize(%v, %v, %v, %v, %v) returned %v periods, want %v", principal, rate, tt.nper, tt.scale, tt.s, len(got), len(tt.want))
+				continue
+			}
+			for i, p := range got {
+				want := Period{
+					Number:    i + 1,
+					Payment:   decimal.MustNewFromString(tt.want[i][0]),
+					Interest:  decimal.MustNewFromString(tt.want[i][1]),
+					Principal: decimal.MustNewFromString(tt.want[i][2]),
+					Balance:   decimal.MustNewFromString(tt.want[i][3]),
+				}
+				if p != want {
+					t.Errorf("Amortize(%v, %v, %v, %v, %v)[%v] = %v, want %v", principal, rate, tt.nper, tt.scale, tt.s, i, p, want)
+				}
+			}
+		}
+	})
+
+	t.Run("invariants", func(t *testing.T) {
+		principals := []string{"0", "1", "0.01", "250000", "1234567.89", "-5000"}
+		rates := []string{"0", "0.0025", "0.004166666666666667", "0.1", "-0.001"}
+		for _, s := range []Structure{Annuity, EqualPrincipal, InterestOnly} {
+			for _, ps := range principals {
+				for _, rs := range rates {
+					for _, nper := range []int{1, 7, 360} {
+						principal := decimal.MustNewFromString(ps)
+						rate := decimal.MustNewFromString(rs)
+						got, err := Amortize(principal, rate, nper, 2, s)
+						if err != nil {
+							t.Errorf("Amortize(%v, %v, %v, 2, %v) failed: %v", principal, rate, nper, s, err)
+							continue
+						}
+						total := decimal.Zero
+						for _, p := range got {
+							sum, err := p.Interest.Add(p.Principal)
+							if err != nil || sum != p.Payment {
+								t.Errorf("Amortize(%v, %v, %v, 2, %v)[%v] = %v, payment is not equal to interest plus principal", principal, rate, nper, s, p.Number, p)
+							}
+							if p.Payment.Scale() != 2 || p.Balance.Scale() != 2 {
+								t.Errorf("Amortize(%v, %v, %v, 2, %v)[%v] = %v, want scale 2", principal, rate, nper, s, p.Number, p)
+							}
+							total = total.MustAdd(p.Principal)
+						}
+						if total.Cmp(principal) != 0 {
+							t.Errorf("Amortize(%v, %v, %v, 2, %v) repays %v, want %v", principal, rate, nper, s, total, principal)
+						}
+						if !got[len(got)-1].Balance.IsZero() {
+							t.Errorf("Amortize(%v, %v, %v, 2, %v) ends with balance %v, want 0", principal, rate, nper, s, got[len(got)-1].Balance)
+						}
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			principal, rate string
+			nper, scale     int
+			s               Structure
+			wantErr         error
+		}{
+			"structure": {"1000", "0.01", 12, 2, Structure(3), decimal.ErrInvalidOperation},
+			"nper 1":    {"1000", "0.01", 0, 2, Annuity, decimal.ErrInvalidOperation},
+			"nper 2":    {"1000", "0.01", -1, 2, EqualPrincipal, decimal.ErrInvalidOperation},
+			"scale 1":   {"1000", "0.01", 12, -1, Annuity, decimal.ErrScaleRange},
+			"scale 2":   {"1000", "0.01", 12, decimal.MaxScale + 1, Annuity, decimal.ErrScaleRange},
+			"inexact":   {"1000.005", "0.01", 12, 2, InterestOnly, decimal.ErrInvalidOperation},
+			"overflow":  {"999999999999999999", "10", 2, 0, InterestOnly, decimal.ErrOverflow},
+		}
+		for name, tt := range tests {
+			principal := decimal.MustNewFromString(tt.principal)
+			rate := decimal.MustNewFromString(tt.rate)
+			_, err := Amortize(principal, rate, tt.nper, tt.scale, tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: Amortize(%v, %v, %v, %v, %v) = %v, want %v", name, principal, rate, tt.nper, tt.scale, tt.s, err, tt.wantErr)
+			}
+		}
+	})
+}
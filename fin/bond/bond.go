@@ -0,0 +1,408 @@
+package bond
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/decimal/daycount"
+	"github.com/govalues/decimal/fin/internal/numeric"
+)
+
+// ErrNoConvergence is returned by [Bond.Yield] when the yield cannot be found.
+// It is the same error as [fin.ErrNoConvergence].
+//
+// [fin.ErrNoConvergence]: https://pkg.go.dev/github.com/govalues/decimal/fin#ErrNoConvergence
+var ErrNoConvergence = numeric.ErrNoConvergence
+
+var (
+	hundred = decimal.MustNew(100, 0)
+	bp      = decimal.MustNew(1, 4) // one basis point
+)
+
+// Frequency is the number of coupon payments per year.
+type Frequency int
+
+const (
+	Annual     Frequency = 1  // Annual means one coupon payment per year.
+	SemiAnnual Frequency = 2  // SemiAnnual means two coupon payments per year.
+	Quarterly  Frequency = 4  // Quarterly means four coupon payments per year.
+	Monthly    Frequency = 12 // Monthly means twelve coupon payments per year.
+)
+
+// String implements the [fmt.Stringer] interface.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (f Frequency) String() string {
+	switch f {
+	case Annual:
+		return "Annual"
+	case SemiAnnual:
+		return "SemiAnnual"
+	case Quarterly:
+		return "Quarterly"
+	case Monthly:
+		return "Monthly"
+	default:
+		return fmt.Sprintf("Frequency(%d)", int(f))
+	}
+}
+
+// valid returns true if coupon dates can be spaced a whole number
+// of months apart.
+func (f Frequency) valid() bool {
+	return f > 0 && 12%f == 0
+}
+
+// Bond represents a fixed-rate bond that pays regular coupons and
+// is redeemed at maturity.
+// Prices and accrued interest are quoted per 100 of face value.
+type Bond struct {
+	// Maturity is the date on which the bond is redeemed.
+	// Coupon dates are obtained by stepping back from the maturity date
+	// in whole coupon periods.
+	// If the maturity date is the last day of a month, so are all coupon dates.
+	Maturity time.Time
+	// Coupon is the annual coupon rate, for example, 0.05 for 5%.
+	Coupon decimal.Decimal
+	// Frequency is the number of coupon payments per year.
+	// It must divide 12.
+	Frequency Frequency
+	// Redemption is the amount paid at maturity per 100 of face value.
+	// The zero value means 100.
+	Redemption decimal.Decimal
+	// Convention is the day-count convention that determines which fraction
+	// of the current coupon period has elapsed.
+	// The zero value means ACT/ACT ICMA for the current coupon period.
+	Convention daycount.Convention
+}
+
+// Accrued returns the interest accrued from the previous coupon date
+// to the settlement date, per 100 of face value.
+//
+// Accrued returns an error if:
+//   - the frequency is not valid;
+//   - the settlement date is not before the maturity date;
+//   - the integer part of an intermediate result has more than [decimal.MaxPrec] digits.
+func (b Bond) Accrued(settlement time.Time) (decimal.Decimal, error) {
+	s, err := b.schedule(settlement)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing Accrued(%v): %w", formatDate(settlement), err)
+	}
+	return s.accrued, nil
+}
+
+// DirtyPrice returns the price of the bond including accrued interest,
+// per 100 of face value, for the given settlement date and annual yield.
+// The yield is compounded with the coupon frequency, and remaining cash flows
+// are discounted by whole and fractional coupon periods, which is the
+// street convention.
+//
+// DirtyPrice returns an error if:
+//   - the frequency is not valid;
+//   - the settlement date is not before the maturity date;
+//   - the yield per coupon period is -1 or less;
+//   - the integer part of an intermediate result has more than [decimal.MaxPrec] digits.
+func (b Bond) DirtyPrice(settlement time.Time, yield decimal.Decimal) (decimal.Decimal, error) {
+	m, err := b.measures(settlement, yield)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing DirtyPrice(%v, %v): %w", formatDate(settlement), yield, err)
+	}
+	return m.price, nil
+}
+
+// CleanPrice returns the price of the bond excluding accrued interest,
+// per 100 of face value, for the given settlement date and annual yield.
+// See [Bond.DirtyPrice] for details.
+func (b Bond) CleanPrice(settlement time.Time, yield decimal.Decimal) (decimal.Decimal, error) {
+	m, err := b.measures(settlement, yield)
+	var p decimal.Decimal
+	if err == nil {
+		p, err = m.price.Sub(m.accrued)
+	}
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing CleanPrice(%v, %v): %w", formatDate(settlement), yield, err)
+	}
+	return p, nil
+}
+
+// Yield returns the annual yield to maturity of the bond for the given
+// settlement date and clean price per 100 of face value.
+// It is the yield at which [Bond.CleanPrice] equals the given price, and
+// it is found using Newton's method with a fallback to the bisection method.
+//
+// Yield returns an error if:
+//   - the frequency is not valid;
+//   - the settlement date is not before the maturity date;
+//   - the solver does not converge, see [ErrNoConvergence].
+func (b Bond) Yield(settlement time.Time, price decimal.Decimal) (decimal.Decimal, error) {
+	s, err := b.schedule(settlement)
+	var dirty decimal.Decimal
+	if err == nil {
+		dirty, err = price.Add(s.accrued)
+	}
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing Yield(%v, %v): %w", formatDate(settlement), price, err)
+	}
+	f := func(rate decimal.Decimal) (y, dy decimal.Decimal, err error) {
+		// y  = P(r) - dirty
+		// dy = -Σ tₖ × pvₖ / (1 + r)
+		m, err := s.measures(rate)
+		if err != nil {
+			return decimal.Decimal{}, decimal.Decimal{}, err
+		}
+		var c numeric.Calc
+		y = c.Sub(m.price, dirty)
+		dy = c.Quo(m.weighted, c.Add(decimal.One, rate)).Neg()
+		return y, dy, c.Err
+	}
+	rate, err := numeric.Solve(f)
+	var yield decimal.Decimal
+	if err == nil {
+		yield, err = rate.Mul(s.freq)
+	}
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing Yield(%v, %v): %w", formatDate(settlement), price, err)
+	}
+	return yield, nil
+}
+
+// MacaulayDuration returns the Macaulay duration of the bond in years,
+// that is, the average time to its cash flows weighted by their present values.
+// See [Bond.DirtyPrice] for details on how cash flows are discounted.
+func (b Bond) MacaulayDuration(settlement time.Time, yield decimal.Decimal) (decimal.Decimal, error) {
+	m, err := b.measures(settlement, yield)
+	var d decimal.Decimal
+	if err == nil {
+		d, err = m.macaulay()
+	}
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing MacaulayDuration(%v, %v): %w", formatDate(settlement), yield, err)
+	}
+	return d, nil
+}
+
+// ModifiedDuration returns the modified duration of the bond in years,
+// that is, the relative decrease in its dirty price per unit increase in the yield.
+// See [Bond.DirtyPrice] for details on how cash flows are discounted.
+func (b Bond) ModifiedDuration(settlement time.Time, yield decimal.Decimal) (decimal.Decimal, error) {
+	m, err := b.measures(settlement, yield)
+	var d decimal.Decimal
+	if err == nil {
+		d, err = m.modified()
+	}
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing ModifiedDuration(%v, %v): %w", formatDate(settlement), yield, err)
+	}
+	return d, nil
+}
+
+// Convexity returns the convexity of the bond in years squared, that is,
+// the second derivative of its dirty price with respect to the yield
+// divided by the dirty price.
+// See [Bond.DirtyPrice] for details on how cash flows are discounted.
+func (b Bond) Convexity(settlement time.Time, yield decimal.Decimal) (decimal.Decimal, error) {
+	m, err := b.measures(settlement, yield)
+	var cx decimal.Decimal
+	if err == nil {
+		cx, err = m.convexity()
+	}
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing Convexity(%v, %v): %w", formatDate(settlement), yield, err)
+	}
+	return cx, nil
+}
+
+// DV01 returns the dollar value of one basis point, that is, the decrease
+// in the dirty price per 100 of face value when the yield rises by 0.0001,
+// as estimated by the modified duration.
+// See [Bond.DirtyPrice] for details on how cash flows are discounted.
+func (b Bond) DV01(settlement time.Time, yield decimal.Decimal) (decimal.Decimal, error) {
+	m, err := b.measures(settlement, yield)
+	var dv decimal.Decimal
+	if err == nil {
+		dv, err = m.dv01()
+	}
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing DV01(%v, %v): %w", formatDate(settlement), yield, err)
+	}
+	return dv, nil
+}
+
+// schedule describes the cash flows of a bond that remain after settlement.
+type schedule struct {
+	n       int             // number of remaining coupons
+	elapsed decimal.Decimal // fraction of the current coupon period that has elapsed
+	coupon  decimal.Decimal // coupon per period per 100 of face value
+	redeem  decimal.Decimal // redemption per 100 of face value
+	accrued decimal.Decimal // accrued interest per 100 of face value
+	freq    decimal.Decimal // number of coupon periods per year
+}
+
+// schedule returns the cash flows of the bond that remain after settlement.
+func (b Bond) schedule(settlement time.Time) (schedule, error) {
+	if !b.Frequency.valid() {
+		return schedule{}, fmt.Errorf("%w: unknown frequency %v", decimal.ErrInvalidOperation, b.Frequency)
+	}
+	if !dateOf(settlement).Before(dateOf(b.Maturity)) {
+		return schedule{}, fmt.Errorf("%w: settlement date %v is not before maturity date %v", decimal.ErrInvalidOperation, formatDate(settlement), formatDate(b.Maturity))
+	}
+
+	// Coupon dates around settlement
+	months := 12 / int(b.Frequency)
+	n := 1
+	next := dateOf(b.Maturity)
+	prev := addMonths(b.Maturity, -months)
+	for prev.After(dateOf(settlement)) {
+		n++
+		next = prev
+		prev = addMonths(b.Maturity, -n*months)
+	}
+
+	conv := b.Convention
+	if conv == (daycount.Convention{}) {
+		conv = daycount.ActActICMA(prev, next, int(b.Frequency))
+	}
+	a, err := conv.YearFrac(prev, settlement)
+	if err != nil {
+		return schedule{}, err
+	}
+	e, err := conv.YearFrac(prev, next)
+	if err != nil {
+		return schedule{}, err
+	}
+
+	var c numeric.Calc
+	s := schedule{n: n}
+	s.freq = decimal.MustNew(int64(b.Frequency), 0)
+	s.elapsed = c.Quo(a, e)
+	s.coupon = c.Quo(c.Mul(b.Coupon, hundred), s.freq)
+	s.accrued = c.Mul(s.coupon, s.elapsed)
+	s.redeem = b.Redemption
+	if s.redeem.IsZero() {
+		s.redeem = hundred
+	}
+	return s, c.Err
+}
+
+// measures holds the present values of the remaining cash flows
+// of a bond at a particular rate per coupon period.
+type measures struct {
+	schedule
+	rate     decimal.Decimal // rate per coupon period
+	price    decimal.Decimal // P = Σ pvₖ
+	weighted decimal.Decimal // Σ tₖ × pvₖ
+	squared  decimal.Decimal // Σ tₖ × (tₖ + 1) × pvₖ
+}
+
+// measures returns the present values of the remaining cash flows of the bond
+// at the given annual yield.
+func (b Bond) measures(settlement time.Time, yield decimal.Decimal) (measures, error) {
+	s, err := b.schedule(settlement)
+	if err != nil {
+		return measures{}, err
+	}
+	rate, err := yield.Quo(s.freq)
+	if err != nil {
+		return measures{}, err
+	}
+	return s.measures(rate)
+}
+
+// measures returns the present values of the remaining cash flows
+// at the given rate per coupon period:
+//
+//	pvₖ = cₖ × (1 + r)^(-tₖ), tₖ = k + 1 - elapsed
+//
+// where k = 0, 1, ..., n - 1, and cₖ is the coupon plus,
+// for the last cash flow, the redemption.
+func (s schedule) measures(rate decimal.Decimal) (measures, error) {
+	var c numeric.Calc
+	base := c.Add(decimal.One, rate)
+	if c.Err == nil && !base.IsPos() {
+		return measures{}, fmt.Errorf("%w: yield per coupon period must be greater than -1", decimal.ErrInvalidOperation)
+	}
+	m := measures{schedule: s, rate: rate}
+	v := c.Quo(decimal.One, base)
+	t := c.Sub(decimal.One, s.elapsed)
+	df := c.Pow(v, t)
+	for k := range s.n {
+		cf := s.coupon
+		if k == s.n-1 {
+			cf = c.Add(cf, s.redeem)
+		}
+		pv := c.Mul(cf, df)
+		tpv := c.Mul(t, pv)
+		m.price = c.Add(m.price, pv)
+		m.weighted = c.Add(m.weighted, tpv)
+		m.squared = c.AddMul(m.squared, tpv, c.Add(t, decimal.One))
+		t = c.Add(t, decimal.One)
+		df = c.Mul(df, v)
+	}
+	return m, c.Err
+}
+
+// macaulay returns the Macaulay duration:
+//
+//	D = Σ tₖ × pvₖ / (P × f)
+func (m measures) macaulay() (decimal.Decimal, error) {
+	var c numeric.Calc
+	d := c.Quo(m.weighted, c.Mul(m.price, m.freq))
+	return d, c.Err
+}
+
+// modified returns the modified duration:
+//
+//	D* = Σ tₖ × pvₖ / (P × f × (1 + r))
+func (m measures) modified() (decimal.Decimal, error) {
+	var c numeric.Calc
+	d := c.Quo(m.weighted, c.Mul(c.Mul(m.price, m.freq), c.Add(decimal.One, m.rate)))
+	return d, c.Err
+}
+
+// convexity returns the convexity:
+//
+//	C = Σ tₖ × (tₖ + 1) × pvₖ / (P × f² × (1 + r)²)
+func (m measures) convexity() (decimal.Decimal, error) {
+	var c numeric.Calc
+	g := c.Add(decimal.One, m.rate)
+	d := c.Mul(c.Mul(m.price, c.Mul(m.freq, m.freq)), c.Mul(g, g))
+	cx := c.Quo(m.squared, d)
+	return cx, c.Err
+}
+
+// dv01 returns the dollar value of one basis point:
+//
+//	DV01 = D* × P × 0.0001
+func (m measures) dv01() (decimal.Decimal, error) {
+	d, err := m.modified()
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	var c numeric.Calc
+	dv := c.Mul(c.Mul(d, m.price), bp)
+	return dv, c.Err
+}
+
+// addMonths returns the date that is the given number of months after
+// the maturity date, keeping its day of the month where possible.
+// If the maturity date is the last day of a month, so is the result.
+func addMonths(maturity time.Time, months int) time.Time {
+	y, m, d := maturity.Date()
+	first := time.Date(y, m+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+	if d > last || maturity.AddDate(0, 0, 1).Day() == 1 {
+		d = last
+	}
+	return time.Date(first.Year(), first.Month(), d, 0, 0, 0, 0, time.UTC)
+}
+
+// dateOf returns the calendar date of t at midnight UTC.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// formatDate returns a date in the ISO 8601 format for error messages.
+func formatDate(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
@@ -0,0 +1,374 @@
+package bond
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/decimal/daycount"
+)
+
+func date(s string) time.Time {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// Bonds from the spreadsheet documentation and a few additional bonds.
+var (
+	price = Bond{ // PRICE example
+		Maturity:   date("2017-11-15"),
+		Coupon:     decimal.MustNewFromString("0.0575"),
+		Frequency:  SemiAnnual,
+		Convention: daycount.Thirty360US,
+	}
+	yield = Bond{ // YIELD example
+		Maturity:   date("2016-11-15"),
+		Coupon:     decimal.MustNewFromString("0.0575"),
+		Frequency:  SemiAnnual,
+		Convention: daycount.Thirty360US,
+	}
+	duration = Bond{ // DURATION example
+		Maturity:  date("2048-01-01"),
+		Coupon:    decimal.MustNewFromString("0.08"),
+		Frequency: SemiAnnual,
+	}
+	mduration = Bond{ // MDURATION example
+		Maturity:  date("2016-01-01"),
+		Coupon:    decimal.MustNewFromString("0.08"),
+		Frequency: SemiAnnual,
+	}
+	treasury = Bond{
+		Maturity:  date("2034-05-15"),
+		Coupon:    decimal.MustNewFromString("0.04375"),
+		Frequency: SemiAnnual,
+	}
+	endOfMonth = Bond{
+		Maturity:  date("2030-08-31"),
+		Coupon:    decimal.MustNewFromString("0.03"),
+		Frequency: SemiAnnual,
+	}
+	zero = Bond{
+		Maturity:  date("2030-06-30"),
+		Frequency: Annual,
+	}
+	premium = Bond{
+		Maturity:   date("2026-03-01"),
+		Coupon:     decimal.MustNewFromString("0.06"),
+		Frequency:  Quarterly,
+		Redemption: decimal.MustNew(105, 0),
+		Convention: daycount.ThirtyE360,
+	}
+)
+
+func TestFrequency_String(t *testing.T) {
+	tests := []struct {
+		f    Frequency
+		want string
+	}{
+		{Annual, "Annual"},
+		{SemiAnnual, "SemiAnnual"},
+		{Quarterly, "Quarterly"},
+		{Monthly, "Monthly"},
+		{Frequency(3), "Frequency(3)"},
+	}
+	for _, tt := range tests {
+		got := tt.f.String()
+		if got != tt.want {
+			t.Errorf("Frequency(%d).String() = %q, want %q", int(tt.f), got, tt.want)
+		}
+	}
+}
+
+func TestBond_Accrued(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b          Bond
+			settlement string
+			want       string
+		}{
+			{price, "2008-02-15", "1.4375"},
+			{treasury, "2024-07-31", "0.9154211956521739131"},
+			{treasury, "2024-05-15", "0"},
+			{treasury, "2024-11-14", "2.175611413043478261"},
+			{endOfMonth, "2024-03-15", "0.1222826086956521739"},
+			{zero, "2024-12-31", "0"},
+			{premium, "2024-04-16", "0.75"},
+		}
+		for _, tt := range tests {
+			settlement := date(tt.settlement)
+			got, err := tt.b.Accrued(settlement)
+			if err != nil {
+				t.Errorf("%+v.Accrued(%v) failed: %v", tt.b, tt.settlement, err)
+				continue
+			}
+			want := decimal.MustNewFromString(tt.want)
+			if got.Cmp(want) != 0 {
+				t.Errorf("%+v.Accrued(%v) = %v, want %v", tt.b, tt.settlement, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			b          Bond
+			settlement string
+			wantErr    error
+		}{
+			"frequency 1": {Bond{Maturity: date("2030-01-01"), Frequency: 0}, "2024-01-01", decimal.ErrInvalidOperation},
+			"frequency 2": {Bond{Maturity: date("2030-01-01"), Frequency: 5}, "2024-01-01", decimal.ErrInvalidOperation},
+			"frequency 3": {Bond{Maturity: date("2030-01-01"), Frequency: -2}, "2024-01-01", decimal.ErrInvalidOperation},
+			"maturity 1":  {treasury, "2034-05-15", decimal.ErrInvalidOperation},
+			"maturity 2":  {treasury, "2035-01-01", decimal.ErrInvalidOperation},
+		}
+		for name, tt := range tests {
+			_, err := tt.b.Accrued(date(tt.settlement))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: %+v.Accrued(%v) = %v, want %v", name, tt.b, tt.settlement, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestBond_CleanPrice(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b                    Bond
+			settlement, yld      string
+			wantClean, wantDirty string
+		}{
+			{price, "2008-02-15", "0.065", "94.6343616213", "96.0718616213"},
+			{mduration, "2008-01-01", "0.09", "94.3829924754", "94.3829924754"},
+			{treasury, "2024-07-31", "0.0415", "101.7897940257", "102.7052152213"},
+			{endOfMonth, "2024-03-15", "0.04", "94.3559278647", "94.4782104734"},
+			{zero, "2024-06-30", "0.05", "74.6215396637", "74.6215396637"},
+			{zero, "2024-06-30", "0", "100.0000000000", "100.0000000000"},
+		}
+		for _, tt := range tests {
+			settlement := date(tt.settlement)
+			yld := decimal.MustNewFromString(tt.yld)
+			gotClean, err := tt.b.CleanPrice(settlement, yld)
+			if err != nil {
+				t.Errorf("%+v.CleanPrice(%v, %v) failed: %v", tt.b, tt.settlement, yld, err)
+				continue
+			}
+			gotDirty, err := tt.b.DirtyPrice(settlement, yld)
+			if err != nil {
+				t.Errorf("%+v.DirtyPrice(%v, %v) failed: %v", tt.b, tt.settlement, yld, err)
+				continue
+			}
+			wantClean := decimal.MustNewFromString(tt.wantClean)
+			if gotClean.Round(wantClean.Scale()).Cmp(wantClean) != 0 {
+				t.Errorf("%+v.CleanPrice(%v, %v) = %v, want %v", tt.b, tt.settlement, yld, gotClean, wantClean)
+			}
+			wantDirty := decimal.MustNewFromString(tt.wantDirty)
+			if gotDirty.Round(wantDirty.Scale()).Cmp(wantDirty) != 0 {
+				t.Errorf("%+v.DirtyPrice(%v, %v) = %v, want %v", tt.b, tt.settlement, yld, gotDirty, wantDirty)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			b               Bond
+			settlement, yld string
+			wantErr         error
+		}{
+			"frequency": {Bond{Maturity: date("2030-01-01")}, "2024-01-01", "0.05", decimal.ErrInvalidOperation},
+			"maturity":  {treasury, "2034-05-15", "0.05", decimal.ErrInvalidOperation},
+			"yield 1":   {treasury, "2024-07-31", "-2", decimal.ErrInvalidOperation},
+			"yield 2":   {treasury, "2024-07-31", "-3", decimal.ErrInvalidOperation},
+			"overflow":  {treasury, "2024-07-31", "-1.9999999999999", decimal.ErrOverflow},
+		}
+		for name, tt := range tests {
+			yld := decimal.MustNewFromString(tt.yld)
+			_, err := tt.b.CleanPrice(date(tt.settlement), yld)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: %+v.CleanPrice(%v, %v) = %v, want %v", name, tt.b, tt.settlement, yld, err, tt.wantErr)
+			}
+			_, err = tt.b.DirtyPrice(date(tt.settlement), yld)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: %+v.DirtyPrice(%v, %v) = %v, want %v", name, tt.b, tt.settlement, yld, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestBond_Yield(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b                 Bond
+			settlement, price string
+			want              string
+		}{
+			{yield, "2008-02-15", "95.04287", "0.06500001"},
+			{treasury, "2024-07-31", "101.7897940256858270", "0.0415000000"},
+			{zero, "2024-06-30", "74.62153966366276493", "0.0500000000"},
+			{premium, "2024-04-16", "104", "0.0625496890"},
+			{mduration, "2015-10-01", "101", "0.0390234706"},
+		}
+		for _, tt := range tests {
+			settlement := date(tt.settlement)
+			price := decimal.MustNewFromString(tt.price)
+			got, err := tt.b.Yield(settlement, price)
+			if err != nil {
+				t.Errorf("%+v.Yield(%v, %v) failed: %v", tt.b, tt.settlement, price, err)
+				continue
+			}
+			want := decimal.MustNewFromString(tt.want)
+			if got.Round(want.Scale()).Cmp(want) != 0 {
+				t.Errorf("%+v.Yield(%v, %v) = %v, want %v", tt.b, tt.settlement, price, got, want)
+			}
+		}
+	})
+
+	t.Run("roundtrip", func(t *testing.T) {
+		bonds := []Bond{price, treasury, endOfMonth, zero, premium}
+		settlements := []string{"2024-01-31", "2025-02-28", "2025-12-15"}
+		yields := []string{"-0.005", "0", "0.0001", "0.035", "0.12", "0.5"}
+		for _, b := range bonds {
+			for _, s := range settlements {
+				settlement := date(s)
+				if !settlement.Before(b.Maturity) {
+					continue
+				}
+				for _, y := range yields {
+					want := decimal.MustNewFromString(y)
+					price, err := b.CleanPrice(settlement, want)
+					if err != nil {
+						t.Errorf("%+v.CleanPrice(%v, %v) failed: %v", b, s, want, err)
+						continue
+					}
+					got, err := b.Yield(settlement, price)
+					if err != nil {
+						t.Errorf("%+v.Yield(%v, %v) failed: %v", b, s, price, err)
+						continue
+					}
+					if got.Round(9).Cmp(want) != 0 {
+						t.Errorf("%+v.Yield(%v, %v) = %v, want %v", b, s, price, got, want)
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			b                 Bond
+			settlement, price string
+			wantErr           error
+		}{
+			"frequency": {Bond{Maturity: date("2030-01-01")}, "2024-01-01", "100", decimal.ErrInvalidOperation},
+			"maturity":  {treasury, "2034-05-15", "100", decimal.ErrInvalidOperation},
+			"no root 1": {treasury, "2024-07-31", "-1", ErrNoConvergence},
+			"no root 2": {zero, "2024-07-31", "0", ErrNoConvergence},
+		}
+		for name, tt := range tests {
+			price := decimal.MustNewFromString(tt.price)
+			_, err := tt.b.Yield(date(tt.settlement), price)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: %+v.Yield(%v, %v) = %v, want %v", name, tt.b, tt.settlement, price, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestBond_Risk(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			b                                  Bond
+			settlement, yld                    string
+			wantMac, wantMod, wantConv, wantDV string
+		}{
+			{duration, "2018-07-01", "0.09", "10.9191452816", "10.4489428532", "187.5852757054", "0.0937443976"},
+			{mduration, "2008-01-01", "0.09", "5.9937749555", "5.7356698139", "41.9576028358", "0.0541349681"},
+			{treasury, "2024-07-31", "0.0415", "8.0154908907", "7.8525504685", "73.9164482986", "0.0806497886"},
+			{zero, "2024-06-30", "0.05", "6.0000000000", "5.7142857143", "38.0952380952", "0.0426408798"},
+		}
+		for _, tt := range tests {
+			settlement := date(tt.settlement)
+			yld := decimal.MustNewFromString(tt.yld)
+			for _, m := range []struct {
+				name string
+				f    func(time.Time, decimal.Decimal) (decimal.Decimal, error)
+				want string
+			}{
+				{"MacaulayDuration", tt.b.MacaulayDuration, tt.wantMac},
+				{"ModifiedDuration", tt.b.ModifiedDuration, tt.wantMod},
+				{"Convexity", tt.b.Convexity, tt.wantConv},
+				{"DV01", tt.b.DV01, tt.wantDV},
+			} {
+				got, err := m.f(settlement, yld)
+				if err != nil {
+					t.Errorf("%+v.%v(%v, %v) failed: %v", tt.b, m.name, tt.settlement, yld, err)
+					continue
+				}
+				want := decimal.MustNewFromString(m.want)
+				if got.Round(want.Scale()).Cmp(want) != 0 {
+					t.Errorf("%+v.%v(%v, %v) = %v, want %v", tt.b, m.name, tt.settlement, yld, got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			b               Bond
+			settlement, yld string
+			wantErr         error
+		}{
+			"frequency": {Bond{Maturity: date("2030-01-01")}, "2024-01-01", "0.05", decimal.ErrInvalidOperation},
+			"maturity":  {treasury, "2034-05-15", "0.05", decimal.ErrInvalidOperation},
+			"yield":     {treasury, "2024-07-31", "-2", decimal.ErrInvalidOperation},
+			"overflow":  {treasury, "2024-07-31", "-1.9999999999999", decimal.ErrOverflow},
+		}
+		for name, tt := range tests {
+			settlement := date(tt.settlement)
+			yld := decimal.MustNewFromString(tt.yld)
+			for _, m := range []struct {
+				name string
+				f    func(time.Time, decimal.Decimal) (decimal.Decimal, error)
+			}{
+				{"MacaulayDuration", tt.b.MacaulayDuration},
+				{"ModifiedDuration", tt.b.ModifiedDuration},
+				{"Convexity", tt.b.Convexity},
+				{"DV01", tt.b.DV01},
+			} {
+				_, err := m.f(settlement, yld)
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("%v: %+v.%v(%v, %v) = %v, want %v", name, tt.b, m.name, tt.settlement, yld, err, tt.wantErr)
+				}
+			}
+		}
+	})
+}
+
+func TestAddMonths(t *testing.T) {
+	tests := []struct {
+		maturity string
+		months   int
+		want     string
+	}{
+		{"2030-08-31", -6, "2030-02-28"},
+		{"2030-08-31", -12, "2029-08-31"},
+		{"2030-08-31", -78, "2024-02-29"},
+		{"2030-02-28", -6, "2029-08-31"},
+		{"2031-02-28", -12, "2030-02-28"},
+		{"2032-02-29", -12, "2031-02-28"},
+		{"2030-08-30", -6, "2030-02-28"},
+		{"2030-08-30", -12, "2029-08-30"},
+		{"2030-05-15", -3, "2030-02-15"},
+		{"2030-01-15", -1, "2029-12-15"},
+		{"2030-01-15", 1, "2030-02-15"},
+	}
+	for _, tt := range tests {
+		got := addMonths(date(tt.maturity), tt.months)
+		want := date(tt.want)
+		if !got.Equal(want) {
+			t.Errorf("addMonths(%v, %v) = %v, want %v", tt.maturity, tt.months, formatDate(got), tt.want)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+/*
+Package bond implements pricing and risk measures of fixed-rate bonds
+for [decimal.Decimal].
+
+# Conventions
+
+[Bond] describes a bond by its maturity date, annual coupon rate,
+coupon frequency, redemption value, and day-count convention.
+Prices and accrued interest are quoted per 100 of face value,
+and yields are annual rates compounded with the coupon frequency.
+
+Coupon dates are obtained by stepping back from the maturity date in whole
+coupon periods.
+The day-count convention determines the fraction of the current coupon
+period that has elapsed on the settlement date, which is used both for
+accrued interest and for discounting the remaining cash flows by
+a fractional number of periods.
+By default, ACT/ACT ICMA is used, as for most government bonds.
+
+For bonds with more than one remaining coupon, the results agree with
+the PRICE, YIELD, DURATION, and MDURATION functions in spreadsheets.
+
+# Yield
+
+[Bond.Yield] has no closed-form solution and is computed iteratively
+using Newton's method, with a fallback to the bisection method.
+If neither method finds the yield, an [ErrNoConvergence] error is returned.
+
+# Precision
+
+All methods use [decimal.Decimal] arithmetic, so every intermediate result
+is correctly rounded to 19 significant digits.
+Results are not rounded to any particular scale; use [decimal.Decimal.Round]
+to round them for quoting.
+*/
+package bond
@@ -0,0 +1,82 @@
+package bond_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/decimal/fin/bond"
+)
+
+func ExampleBond_CleanPrice() {
+	b := bond.Bond{
+		Maturity:  time.Date(2034, time.May, 15, 0, 0, 0, 0, time.UTC),
+		Coupon:    decimal.MustNew(4375, 5),
+		Frequency: bond.SemiAnnual,
+	}
+	settlement := time.Date(2024, time.July, 31, 0, 0, 0, 0, time.UTC)
+	yield := decimal.MustNew(415, 4)
+	clean, err := b.CleanPrice(settlement, yield)
+	if err != nil {
+		panic(err)
+	}
+	accrued, err := b.Accrued(settlement)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(clean.Round(6))
+	fmt.Println(accrued.Round(6))
+	// Output:
+	// 101.789794
+	// 0.915421
+}
+
+func ExampleBond_Yield() {
+	b := bond.Bond{
+		Maturity:  time.Date(2034, time.May, 15, 0, 0, 0, 0, time.UTC),
+		Coupon:    decimal.MustNew(4375, 5),
+		Frequency: bond.SemiAnnual,
+	}
+	settlement := time.Date(2024, time.July, 31, 0, 0, 0, 0, time.UTC)
+	yield, err := b.Yield(settlement, decimal.MustNew(10178, 2))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(yield.Round(6))
+	// Output: 0.041512
+}
+
+func ExampleBond_ModifiedDuration() {
+	b := bond.Bond{
+		Maturity:  time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Coupon:    decimal.MustNew(8, 2),
+		Frequency: bond.SemiAnnual,
+	}
+	settlement := time.Date(2008, time.January, 1, 0, 0, 0, 0, time.UTC)
+	yield := decimal.MustNew(9, 2)
+	mac, err := b.MacaulayDuration(settlement, yield)
+	if err != nil {
+		panic(err)
+	}
+	mod, err := b.ModifiedDuration(settlement, yield)
+	if err != nil {
+		panic(err)
+	}
+	conv, err := b.Convexity(settlement, yield)
+	if err != nil {
+		panic(err)
+	}
+	dv01, err := b.DV01(settlement, yield)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(mac.Round(5))
+	fmt.Println(mod.Round(5))
+	fmt.Println(conv.Round(5))
+	fmt.Println(dv01.Round(5))
+	// Output:
+	// 5.99377
+	// 5.73567
+	// 41.95760
+	// 0.05413
+}
@@ -0,0 +1,208 @@
+package fin
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/decimal/fin/internal/numeric"
+)
+
+// daysInYear is the number of days in a year used by [XNPV] and [XIRR].
+var daysInYear = decimal.MustNew(365, 0)
+
+// NPV returns the net present value of cash flows that occur at the end
+// of regular periods, discounted at the given rate per period.
+// Like in spreadsheets, the first cash flow is discounted by one period.
+// It is equivalent to the NPV function in spreadsheets.
+//
+// NPV returns an error if:
+//   - no cash flows are provided;
+//   - the rate is -1;
+//   - the integer part of an intermediate result has more than [decimal.MaxPrec] digits.
+func NPV(rate decimal.Decimal, values ...decimal.Decimal) (decimal.Decimal, error) {
+	if len(values) == 0 {
+		return decimal.Decimal{}, fmt.Errorf("computing NPV(%v, %v): %w: no cash flows", rate, values, decimal.ErrInvalidOperation)
+	}
+	var c calc
+	d := c.Quo(decimal.One, c.Add(decimal.One, rate))
+	p, _ := c.horner(d, values)
+	npv := c.Mul(p, d)
+	if c.Err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing NPV(%v, %v): %w", rate, values, c.Err)
+	}
+	return npv, nil
+}
+
+// IRR returns the internal rate of return of cash flows that occur at
+// regular periods, that is, the rate at which the net present value
+// of the cash flows is 0.
+// Unlike [NPV], the first cash flow is not discounted.
+// It is equivalent to the IRR function in spreadsheets.
+// See the package documentation for details on how the rate is found.
+//
+// IRR returns an error if:
+//   - the cash flows do not contain at least one positive and one negative value;
+//   - the solver does not converge, see [ErrNoConvergence].
+func IRR(values ...decimal.Decimal) (decimal.Decimal, error) {
+	if !hasSignChange(values) {
+		return decimal.Decimal{}, fmt.Errorf("computing IRR(%v): %w: cash flows must contain at least one positive and one negative value", values, decimal.ErrInvalidOperation)
+	}
+	f := func(rate decimal.Decimal) (y, dy decimal.Decimal, err error) {
+		// y  = Σ vᵢ × dⁱ, where d = 1 / (1 + r)
+		// dy = Σ i × vᵢ × dⁱ⁻¹ × (-d²)
+		var c calc
+		d := c.Quo(decimal.One, c.Add(decimal.One, rate))
+		y, dp := c.horner(d, values)
+		dy = c.Mul(dp, c.Mul(d, d)).Neg()
+		return y, dy, c.Err
+	}
+	irr, err := numeric.Solve(f)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing IRR(%v): %w", values, err)
+	}
+	return irr, nil
+}
+
+// XNPV returns the net present value of cash flows that occur on the given
+// dates, discounted at the given annual rate.
+// Cash flows are discounted to the first date, which must not be later
+// than any other date.
+// It is equivalent to the XNPV function in spreadsheets.
+//
+// XNPV returns an error if:
+//   - no cash flows are provided;
+//   - the numbers of cash flows and dates are different;
+//   - any date precedes the first date;
+//   - the rate is -1 or less;
+//   - the integer part of an intermediate result has more than [decimal.MaxPrec] digits.
+func XNPV(rate decimal.Decimal, values []decimal.Decimal, dates []time.Time) (decimal.Decimal, error) {
+	times, err := yearFracs(values, dates)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing XNPV(%v, %v, %v): %w", rate, values, formatDates(dates), err)
+	}
+	npv, _, err := xnpv(rate, values, times)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing XNPV(%v, %v, %v): %w", rate, values, formatDates(dates), err)
+	}
+	return npv, nil
+}
+
+// XIRR returns the internal rate of return of cash flows that occur on
+// the given dates, that is, the annual rate at which the net present value
+// of the cash flows is 0.
+// It is equivalent to the XIRR function in spreadsheets.
+// See the package documentation for details on how the rate is found.
+//
+// XIRR returns an error if:
+//   - the numbers of cash flows and dates are different;
+//   - any date precedes the first date;
+//   - the cash flows do not contain at least one positive and one negative value;
+//   - the solver does not converge, see [ErrNoConvergence].
+func XIRR(values []decimal.Decimal, dates []time.Time) (decimal.Decimal, error) {
+	times, err := yearFracs(values, dates)
+	if err == nil && !hasSignChange(values) {
+		err = fmt.Errorf("%w: cash flows must contain at least one positive and one negative value", decimal.ErrInvalidOperation)
+	}
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing XIRR(%v, %v): %w", values, formatDates(dates), err)
+	}
+	f := func(rate decimal.Decimal) (y, dy decimal.Decimal, err error) {
+		return xnpv(rate, values, times)
+	}
+	irr, err := numeric.Solve(f)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing XIRR(%v, %v): %w", values, formatDates(dates), err)
+	}
+	return irr, nil
+}
+
+// xnpv returns the net present value of cash flows at the given times in years,
+// and its derivative with respect to the rate:
+//
+//	y  = Σ vᵢ × (1 + r)^(-tᵢ)
+//	dy = Σ -tᵢ × vᵢ × (1 + r)^(-tᵢ) / (1 + r)
+func xnpv(rate decimal.Decimal, values, times []decimal.Decimal) (y, dy decimal.Decimal, err error) {
+	var c calc
+	base := c.Add(decimal.One, rate)
+	if c.Err == nil && !base.IsPos() {
+		return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("%w: rate must be greater than -1", decimal.ErrInvalidOperation)
+	}
+	logBase := c.Log1p(rate)
+	for i, v := range values {
+		pv := c.Mul(v, c.Exp(c.Mul(times[i], logBase).Neg()))
+		y = c.Add(y, pv)
+		dy = c.Sub(dy, c.Mul(times[i], pv))
+	}
+	dy = c.Quo(dy, base)
+	return y, dy, c.Err
+}
+
+// horner evaluates the polynomial p(x) = Σ vᵢ × xⁱ and its derivative p'(x)
+// using Horner's method.
+func (c *calc) horner(x decimal.Decimal, values []decimal.Decimal) (p, dp decimal.Decimal) {
+	for i := len(values) - 1; i >= 0; i-- {
+		dp = c.AddMul(p, dp, x)
+		p = c.AddMul(values[i], p, x)
+	}
+	return p, dp
+}
+
+// yearFracs returns the times in years from the first date to every date.
+func yearFracs(values []decimal.Decimal, dates []time.Time) ([]decimal.Decimal, error) {
+	switch {
+	case len(values) == 0:
+		return nil, fmt.Errorf("%w: no cash flows", decimal.ErrInvalidOperation)
+	case len(values) != len(dates):
+		return nil, fmt.Errorf("%w: %v cash flows, but %v dates", decimal.ErrInvalidOperation, len(values), len(dates))
+	}
+	times := make([]decimal.Decimal, len(dates))
+	for i, d := range dates {
+		days := daysBetween(dates[0], d)
+		if days < 0 {
+			return nil, fmt.Errorf("%w: date %v precedes the first date %v", decimal.ErrInvalidOperation, formatDate(d), formatDate(dates[0]))
+		}
+		n, err := decimal.New(int64(days), 0)
+		if err != nil {
+			return nil, err
+		}
+		times[i], err = n.Quo(daysInYear)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return times, nil
+}
+
+// daysBetween returns the number of calendar days from date a to date b.
+// The time of day and the location of the dates are ignored.
+func daysBetween(a, b time.Time) int {
+	ua := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	ub := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(ub.Sub(ua) / (24 * time.Hour))
+}
+
+// hasSignChange returns true if values contain at least one positive
+// and one negative value.
+func hasSignChange(values []decimal.Decimal) bool {
+	pos, neg := false, false
+	for _, v := range values {
+		pos = pos || v.IsPos()
+		neg = neg || v.IsNeg()
+	}
+	return pos && neg
+}
+
+// formatDate returns a date in the ISO 8601 format for error messages.
+func formatDate(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
+
+// formatDates returns dates in the ISO 8601 format for error messages.
+func formatDates(dates []time.Time) []string {
+	s := make([]string, len(dates))
+	for i, d := range dates {
+		s[i] = formatDate(d)
+	}
+	return s
+}
@@ -0,0 +1,278 @@
+package fin
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/govalues/decimal"
+)
+
+func decimals(s ...string) []decimal.Decimal {
+	d := make([]decimal.Decimal, len(s))
+	for i := range s {
+		d[i] = decimal.MustNewFromString(s[i])
+	}
+	return d
+}
+
+func dates(s ...string) []time.Time {
+	t := make([]time.Time, len(s))
+	for i := range s {
+		var err error
+		t[i], err = time.Parse(time.DateOnly, s[i])
+		if err != nil {
+			panic(err)
+		}
+	}
+	return t
+}
+
+func TestNPV(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			rate   string
+			values []string
+			want   string
+		}{
+			{"0.1", []string{"-10000", "3000", "4200", "6800"}, "1188.4434123352"},
+			{"0.08", []string{"8000", "9200", "10000", "12000", "14500"}, "41922.0615549324"},
+			{"0", []string{"-100", "50", "60"}, "10.0000000000"},
+			{"0.05", []string{"105"}, "100.0000000000"},
+		}
+		for _, tt := range tests {
+			rate := decimal.MustNewFromString(tt.rate)
+			values := decimals(tt.values...)
+			want := decimal.MustNewFromString(tt.want)
+			got, err := NPV(rate, values...)
+			if err != nil {
+				t.Errorf("NPV(%v, %v) failed: %v", rate, values, err)
+				continue
+			}
+			if got.Round(want.Scale()).Cmp(want) != 0 {
+				t.Errorf("NPV(%v, %v) = %v, want %v", rate, values, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			rate    string
+			values  []string
+			wantErr error
+		}{
+			"empty": {"0.1", nil, decimal.ErrInvalidOperation},
+			"rate":  {"-1", []string{"100"}, decimal.ErrDivisionByZero},
+		}
+		for name, tt := range tests {
+			rate := decimal.MustNewFromString(tt.rate)
+			values := decimals(tt.values...)
+			_, err := NPV(rate, values...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: NPV(%v, %v) = %v, want %v", name, rate, values, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestIRR(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			values []string
+			want   string
+		}{
+			{[]string{"-70000", "12000", "15000", "18000", "21000"}, "-0.021244848273"},
+			{[]string{"-70000", "12000", "15000", "18000", "21000", "26000"}, "0.086630948037"},
+			{[]string{"-70000", "12000", "15000"}, "-0.443506941335"},
+			{[]string{"-100", "110"}, "0.100000000000"},
+			{[]string{"100", "-110"}, "0.100000000000"},
+			{[]string{"-100", "0", "0", "0", "1000000"}, "9.000000000000"},
+			{[]string{"-1000", "100", "100", "100"}, "-0.424417443832"},
+		}
+		for _, tt := range tests {
+			values := decimals(tt.values...)
+			want := decimal.MustNewFromString(tt.want)
+			got, err := IRR(values...)
+			if err != nil {
+				t.Errorf("IRR(%v) failed: %v", values, err)
+				continue
+			}
+			if got.Round(want.Scale()).Cmp(want) != 0 {
+				t.Errorf("IRR(%v) = %v, want %v", values, got, want)
+			}
+			npv, err := NPV(got, values[1:]...)
+			if err != nil {
+				t.Errorf("NPV(%v, %v) failed: %v", got, values[1:], err)
+				continue
+			}
+			npv, err = npv.Add(values[0])
+			if err != nil {
+				t.Errorf("%v.Add(%v) failed: %v", npv, values[0], err)
+				continue
+			}
+			if npv.Round(6).Sign() != 0 {
+				t.Errorf("NPV(IRR(%v)) = %v, want 0", values, npv)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			values  []string
+			wantErr error
+		}{
+			"empty":     {nil, decimal.ErrInvalidOperation},
+			"positive":  {[]string{"100", "200"}, decimal.ErrInvalidOperation},
+			"negative":  {[]string{"-100", "-200"}, decimal.ErrInvalidOperation},
+			"zeros":     {[]string{"0", "0"}, decimal.ErrInvalidOperation},
+			"no root 1": {[]string{"1", "-1", "1"}, ErrNoConvergence},
+			"no root 2": {[]string{"100", "-1", "100"}, ErrNoConvergence},
+		}
+		for name, tt := range tests {
+			values := decimals(tt.values...)
+			_, err := IRR(values...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: IRR(%v) = %v, want %v", name, values, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestXNPV(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			rate   string
+			values []string
+			dates  []string
+			want   string
+		}{
+			{
+				"0.09",
+				[]string{"-10000", "2750", "4250", "3250", "2750"},
+				[]string{"2008-01-01", "2008-03-01", "2008-10-30", "2009-02-15", "2009-04-01"},
+				"2086.6476020315",
+			},
+			{
+				"0.1",
+				[]string{"-1000", "1100"},
+				[]string{"2021-01-01", "2022-01-01"},
+				"0.0000000000",
+			},
+			{
+				"0.1",
+				[]string{"-1000", "1100"},
+				[]string{"2020-01-01", "2021-01-01"},
+				"-0.2610896904",
+			},
+			{
+				"0",
+				[]string{"-1000", "600", "600"},
+				[]string{"2020-01-01", "2020-06-30", "2020-06-01"},
+				"200.0000000000",
+			},
+		}
+		for _, tt := range tests {
+			rate := decimal.MustNewFromString(tt.rate)
+			values := decimals(tt.values...)
+			dates := dates(tt.dates...)
+			want := decimal.MustNewFromString(tt.want)
+			got, err := XNPV(rate, values, dates)
+			if err != nil {
+				t.Errorf("XNPV(%v, %v, %v) failed: %v", rate, values, tt.dates, err)
+				continue
+			}
+			if got.Round(want.Scale()).Cmp(want) != 0 {
+				t.Errorf("XNPV(%v, %v, %v) = %v, want %v", rate, values, tt.dates, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			rate    string
+			values  []string
+			dates   []string
+			wantErr error
+		}{
+			"empty":  {"0.1", nil, nil, decimal.ErrInvalidOperation},
+			"length": {"0.1", []string{"-1000", "1100"}, []string{"2020-01-01"}, decimal.ErrInvalidOperation},
+			"order":  {"0.1", []string{"-1000", "1100"}, []string{"2020-01-01", "2019-12-31"}, decimal.ErrInvalidOperation},
+			"rate":   {"-1", []string{"-1000", "1100"}, []string{"2020-01-01", "2021-01-01"}, decimal.ErrInvalidOperation},
+		}
+		for name, tt := range tests {
+			rate := decimal.MustNewFromString(tt.rate)
+			values := decimals(tt.values...)
+			dates := dates(tt.dates...)
+			_, err := XNPV(rate, values, dates)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: XNPV(%v, %v, %v) = %v, want %v", name, rate, values, tt.dates, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestXIRR(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			values []string
+			dates  []string
+			want   string
+		}{
+			{
+				[]string{"-10000", "2750", "4250", "3250", "2750"},
+				[]string{"2008-01-01", "2008-03-01", "2008-10-30", "2009-02-15", "2009-04-01"},
+				"0.373362533519",
+			},
+			{
+				[]string{"-1000", "1100"},
+				[]string{"2021-01-01", "2022-01-01"},
+				"0.100000000000",
+			},
+			{
+				[]string{"-1000", "-500", "2000"},
+				[]string{"2020-01-15", "2020-07-01", "2023-03-31"},
+				"0.098676246903",
+			},
+			{
+				[]string{"-1000", "900"},
+				[]string{"2021-01-01", "2021-07-02"},
+				"-0.190468776484",
+			},
+		}
+		for _, tt := range tests {
+			values := decimals(tt.values...)
+			dates := dates(tt.dates...)
+			want := decimal.MustNewFromString(tt.want)
+			got, err := XIRR(values, dates)
+			if err != nil {
+				t.Errorf("XIRR(%v, %v) failed: %v", values, tt.dates, err)
+				continue
+			}
+			if got.Round(want.Scale()).Cmp(want) != 0 {
+				t.Errorf("XIRR(%v, %v) = %v, want %v", values, tt.dates, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			values  []string
+			dates   []string
+			wantErr error
+		}{
+			"empty":    {nil, nil, decimal.ErrInvalidOperation},
+			"length":   {[]string{"-1000", "1100"}, []string{"2020-01-01"}, decimal.ErrInvalidOperation},
+			"order":    {[]string{"-1000", "1100"}, []string{"2020-01-01", "2019-12-31"}, decimal.ErrInvalidOperation},
+			"positive": {[]string{"1000", "1100"}, []string{"2020-01-01", "2021-01-01"}, decimal.ErrInvalidOperation},
+			"no root":  {[]string{"1000", "-1", "1000"}, []string{"2020-01-01", "2021-01-01", "2022-01-01"}, ErrNoConvergence},
+		}
+		for name, tt := range tests {
+			values := decimals(tt.values...)
+			dates := dates(tt.dates...)
+			_, err := XIRR(values, dates)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: XIRR(%v, %v) = %v, want %v", name, values, tt.dates, err, tt.wantErr)
+			}
+		}
+	})
+}
@@ -0,0 +1,44 @@
+/*
+Package fin implements spreadsheet-compatible financial functions
+for [decimal.Decimal].
+
+# Time Value of Money
+
+[PMT], [PV], [FV], [NPER], and [RATE] solve the annuity equation
+
+	pv × (1 + rate)ⁿ + pmt × (1 + rate × when) × ((1 + rate)ⁿ - 1) / rate + fv = 0
+
+for one of its variables, where n is the number of periods and when is 0
+for payments at the end of each period and 1 for payments at the beginning.
+Like in spreadsheets, cash paid out is represented by negative numbers and
+cash received is represented by positive numbers.
+
+# Cash Flows
+
+[NPV] and [IRR] work with cash flows that occur at regular intervals,
+while [XNPV] and [XIRR] work with cash flows that occur on arbitrary dates.
+Time between dates is measured in days, and a year is assumed to have 365 days.
+
+# Amortization
+
+[Amortize] builds the repayment schedule of a loan period by period.
+Unlike other functions of the package, it rounds every amount to the requested
+scale, so that the schedule can be booked as is, and it adjusts the last
+payment so that the loan is repaid exactly.
+
+# Solvers
+
+[RATE], [IRR], and [XIRR] have no closed-form solution and are computed
+iteratively using Newton's method.
+If Newton's method does not converge, the root is searched for again using
+the bisection method.
+If neither method finds a root, an [ErrNoConvergence] error is returned.
+
+# Precision
+
+All functions use [decimal.Decimal] arithmetic, so every intermediate result
+is correctly rounded to 19 significant digits.
+Except for [Amortize], results are not rounded to any particular scale; use [decimal.Decimal.Round]
+to round them, for example, to the scale of the currency.
+*/
+package fin
@@ -0,0 +1,119 @@
+package fin_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/decimal/fin"
+)
+
+func ExamplePMT() {
+	// Monthly payment on a 10,000 loan at 8% annual interest over 10 months
+	rate := decimal.MustNew(8, 2).MustQuo(decimal.MustNew(12, 0))
+	nper := decimal.MustNew(10, 0)
+	pv := decimal.MustNew(10000, 0)
+	pmt, err := fin.PMT(rate, nper, pv, decimal.Zero, fin.End)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(pmt.Round(2))
+	// Output: -1037.03
+}
+
+func ExampleFV() {
+	// Savings after 12 monthly deposits of 1,000 at 12% annual interest
+	rate := decimal.MustNew(1, 2)
+	nper := decimal.MustNew(12, 0)
+	pmt := decimal.MustNew(-1000, 0)
+	fv, err := fin.FV(rate, nper, pmt, decimal.Zero, fin.End)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(fv.Round(2))
+	// Output: 12682.50
+}
+
+func ExampleRATE() {
+	// Monthly rate of a 4-year loan of 8,000 with monthly payments of 200
+	nper := decimal.MustNew(48, 0)
+	pmt := decimal.MustNew(-200, 0)
+	pv := decimal.MustNew(8000, 0)
+	rate, err := fin.RATE(nper, pmt, pv, decimal.Zero, fin.End)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(rate.Round(6))
+	// Output: 0.007701
+}
+
+func ExampleNPV() {
+	rate := decimal.MustNew(1, 1)
+	npv, err := fin.NPV(rate,
+		decimal.MustNew(-10000, 0),
+		decimal.MustNew(3000, 0),
+		decimal.MustNew(4200, 0),
+		decimal.MustNew(6800, 0),
+	)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(npv.Round(2))
+	// Output: 1188.44
+}
+
+func ExampleIRR() {
+	irr, err := fin.IRR(
+		decimal.MustNew(-70000, 0),
+		decimal.MustNew(12000, 0),
+		decimal.MustNew(15000, 0),
+		decimal.MustNew(18000, 0),
+		decimal.MustNew(21000, 0),
+		decimal.MustNew(26000, 0),
+	)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(irr.Round(4))
+	// Output: 0.0866
+}
+
+func ExampleXIRR() {
+	values := []decimal.Decimal{
+		decimal.MustNew(-10000, 0),
+		decimal.MustNew(2750, 0),
+		decimal.MustNew(4250, 0),
+		decimal.MustNew(3250, 0),
+		decimal.MustNew(2750, 0),
+	}
+	dates := []time.Time{
+		time.Date(2008, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2008, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2008, 10, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2009, 2, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2009, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+	xirr, err := fin.XIRR(values, dates)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(xirr.Round(6))
+	// Output: 0.373363
+}
+
+func ExampleAmortize() {
+	principal := decimal.MustNew(10000, 0)
+	rate := decimal.MustNew(1, 2)
+	schedule, err := fin.Amortize(principal, rate, 4, 2, fin.Annuity)
+	if err != nil {
+		panic(err)
+	}
+	for _, p := range schedule {
+		fmt.Printf("%v %7f %6f %7f %7f\n", p.Number, p.Payment, p.Interest, p.Principal, p.Balance)
+	}
+	// Output:
+	// 1 2562.81 100.00 2462.81 7537.19
+	// 2 2562.81  75.37 2487.44 5049.75
+	// 3 2562.81  50.50 2512.31 2537.44
+	// 4 2562.81  25.37 2537.44    0.00
+}
@@ -0,0 +1,251 @@
+// Package numeric implements the numerical methods shared by the fin package
+// and its subpackages.
+package numeric
+
+import (
+	"errors"
+
+	"github.com/govalues/decimal"
+)
+
+// ErrNoConvergence is returned when an iterative solver fails to find a root.
+var ErrNoConvergence = errors.New("no convergence")
+
+const maxIter = 200 // maximum number of iterations of each solver
+
+var (
+	guess     = decimal.MustNew(1, 1)  // starting point of Newton's method
+	tolerance = decimal.MustNew(1, 15) // relative accuracy of a root
+	half      = decimal.MustNew(5, 1)
+
+	// grid is a list of rates that are used to find a bracket for bisection.
+	grid = []decimal.Decimal{
+		decimal.MustNew(-999, 3),
+		decimal.MustNew(-99, 2),
+		decimal.MustNew(-9, 1),
+		decimal.MustNew(-5, 1),
+		decimal.MustNew(-1, 1),
+		decimal.MustNew(-1, 2),
+		decimal.Zero,
+		decimal.MustNew(1, 2),
+		decimal.MustNew(5, 2),
+		decimal.MustNew(1, 1),
+		decimal.MustNew(2, 1),
+		decimal.MustNew(5, 1),
+		decimal.MustNew(1, 0),
+		decimal.MustNew(10, 0),
+		decimal.MustNew(100, 0),
+		decimal.MustNew(1000, 0),
+	}
+)
+
+// Func evaluates a function and its derivative at the given rate.
+type Func func(rate decimal.Decimal) (y, dy decimal.Decimal, err error)
+
+// Solve returns a rate greater than -1 at which f is zero.
+// It starts with Newton's method and falls back to bisection.
+func Solve(f Func) (decimal.Decimal, error) {
+	if x, ok := newton(f, guess); ok {
+		return x, nil
+	}
+	return bisect(f)
+}
+
+// newton finds a root of f using Newton's method.
+// It reports false if the method left the domain of rates greater than -1
+// or did not converge.
+func newton(f Func, x decimal.Decimal) (decimal.Decimal, bool) {
+	for range maxIter {
+		y, dy, err := f(x)
+		if err != nil {
+			return decimal.Decimal{}, false
+		}
+		if y.IsZero() {
+			return x, true
+		}
+		if dy.IsZero() {
+			return decimal.Decimal{}, false
+		}
+		step, err := y.Quo(dy)
+		if err != nil {
+			return decimal.Decimal{}, false
+		}
+		x, err = x.Sub(step)
+		if err != nil || x.Cmp(decimal.NegOne) <= 0 {
+			return decimal.Decimal{}, false
+		}
+		if converged(step, x) {
+			return x, true
+		}
+	}
+	return decimal.Decimal{}, false
+}
+
+// bisect finds a root of f using the bisection method.
+// The initial bracket is the first pair of adjacent grid points
+// at which f is defined and has opposite signs.
+func bisect(f Func) (decimal.Decimal, error) {
+	var lo, hi decimal.Decimal
+	var ylo decimal.Decimal
+	found := false
+	prev := -1
+	for i, x := range grid {
+		y, _, err := f(x)
+		if err != nil {
+			// The function may change its sign where it is not defined,
+			// so a bracket must not span such a point.
+			prev = -1
+			continue
+		}
+		if y.IsZero() {
+			return x, nil
+		}
+		if prev >= 0 && y.Sign() != ylo.Sign() {
+			lo, hi = grid[prev], x
+			found = true
+			break
+		}
+		prev, ylo = i, y
+	}
+	if !found {
+		return decimal.Decimal{}, ErrNoConvergence
+	}
+
+	for range maxIter {
+		width, err := hi.Sub(lo)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		mid, err := lo.AddMul(width, half)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		if converged(width, mid) {
+			return mid, nil
+		}
+		y, _, err := f(mid)
+		if err != nil {
+			return decimal.Decimal{}, err
+		}
+		if y.IsZero() {
+			return mid, nil
+		}
+		if y.Sign() == ylo.Sign() {
+			lo, ylo = mid, y
+		} else {
+			hi = mid
+		}
+	}
+	return decimal.Decimal{}, ErrNoConvergence
+}
+
+// converged returns true if step is negligible relative to x.
+func converged(step, x decimal.Decimal) bool {
+	lim := tolerance
+	if x.Abs().Cmp(decimal.One) > 0 {
+		var err error
+		lim, err = lim.Mul(x.Abs())
+		if err != nil {
+			return false
+		}
+	}
+	return step.Abs().Cmp(lim) <= 0
+}
+
+// Calc evaluates a sequence of operations and remembers the first error,
+// so that formulas can be written without checking errors after every step.
+// Once an error has occurred, all subsequent operations return zero.
+type Calc struct {
+	Err error
+}
+
+// Add returns d + e.
+func (c *Calc) Add(d, e decimal.Decimal) decimal.Decimal {
+	if c.Err != nil {
+		return decimal.Decimal{}
+	}
+	var f decimal.Decimal
+	f, c.Err = d.Add(e)
+	return f
+}
+
+// Sub returns d - e.
+func (c *Calc) Sub(d, e decimal.Decimal) decimal.Decimal {
+	if c.Err != nil {
+		return decimal.Decimal{}
+	}
+	var f decimal.Decimal
+	f, c.Err = d.Sub(e)
+	return f
+}
+
+// Mul returns d × e.
+func (c *Calc) Mul(d, e decimal.Decimal) decimal.Decimal {
+	if c.Err != nil {
+		return decimal.Decimal{}
+	}
+	var f decimal.Decimal
+	f, c.Err = d.Mul(e)
+	return f
+}
+
+// Quo returns d / e.
+func (c *Calc) Quo(d, e decimal.Decimal) decimal.Decimal {
+	if c.Err != nil {
+		return decimal.Decimal{}
+	}
+	var f decimal.Decimal
+	f, c.Err = d.Quo(e)
+	return f
+}
+
+// AddMul returns d + e × f.
+func (c *Calc) AddMul(d, e, f decimal.Decimal) decimal.Decimal {
+	if c.Err != nil {
+		return decimal.Decimal{}
+	}
+	var g decimal.Decimal
+	g, c.Err = d.AddMul(e, f)
+	return g
+}
+
+// Exp returns e raised to the power d.
+func (c *Calc) Exp(d decimal.Decimal) decimal.Decimal {
+	if c.Err != nil {
+		return decimal.Decimal{}
+	}
+	var f decimal.Decimal
+	f, c.Err = d.Exp()
+	return f
+}
+
+// Log returns the natural logarithm of d.
+func (c *Calc) Log(d decimal.Decimal) decimal.Decimal {
+	if c.Err != nil {
+		return decimal.Decimal{}
+	}
+	var f decimal.Decimal
+	f, c.Err = d.Log()
+	return f
+}
+
+// Log1p returns the natural logarithm of 1 + d.
+func (c *Calc) Log1p(d decimal.Decimal) decimal.Decimal {
+	if c.Err != nil {
+		return decimal.Decimal{}
+	}
+	var f decimal.Decimal
+	f, c.Err = d.Log1p()
+	return f
+}
+
+// Pow returns d raised to the power e.
+// See [decimal.Decimal.PowDec] for details.
+func (c *Calc) Pow(d, e decimal.Decimal) decimal.Decimal {
+	if c.Err != nil {
+		return decimal.Decimal{}
+	}
+	var f decimal.Decimal
+	f, c.Err = d.PowDec(e)
+	return f
+}
@@ -0,0 +1,29 @@
+package numeric
+
+import (
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestBisect(t *testing.T) {
+	t.Run("undefined", func(t *testing.T) {
+		// f(x) = 1 / (x - 0.2) - 2 is not defined at the grid point 0.2,
+		// where it changes its sign, so the bracket must not span it.
+		pole := decimal.MustNew(2, 1)
+		two := decimal.MustNew(2, 0)
+		f := func(x decimal.Decimal) (decimal.Decimal, decimal.Decimal, error) {
+			var c Calc
+			y := c.Sub(c.Quo(decimal.One, c.Sub(x, pole)), two)
+			return y, decimal.Zero, c.Err
+		}
+		got, err := bisect(f)
+		if err != nil {
+			t.Fatalf("bisect(f) failed: %v", err)
+		}
+		want := decimal.MustNew(7, 1)
+		if diff, _ := got.SubAbs(want); diff.Cmp(tolerance) > 0 {
+			t.Errorf("bisect(f) = %v, want %v", got, want)
+		}
+	})
+}
@@ -0,0 +1,17 @@
+package fin
+
+import (
+	"github.com/govalues/decimal"
+	"github.com/govalues/decimal/fin/internal/numeric"
+)
+
+// ErrNoConvergence is returned when an iterative solver fails to find a root.
+var ErrNoConvergence = numeric.ErrNoConvergence
+
+var half = decimal.MustNew(5, 1)
+
+// calc extends [numeric.Calc] with the factors of the annuity equation
+// and other formulas of the package.
+type calc struct {
+	numeric.Calc
+}
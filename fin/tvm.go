@@ -0,0 +1,245 @@
+package fin
+
+import (
+	"fmt"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/decimal/fin/internal/numeric"
+)
+
+// Timing specifies when payments are due within a period.
+type Timing int
+
+const (
+	End   Timing = iota // End means payments are due at the end of each period.
+	Begin               // Begin means payments are due at the beginning of each period.
+)
+
+// String implements the [fmt.Stringer] interface.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (w Timing) String() string {
+	switch w {
+	case End:
+		return "End"
+	case Begin:
+		return "Begin"
+	default:
+		return fmt.Sprintf("Timing(%d)", int(w))
+	}
+}
+
+// decimal returns 0 for [End] and 1 for [Begin].
+func (w Timing) decimal() (decimal.Decimal, error) {
+	switch w {
+	case End:
+		return decimal.Zero, nil
+	case Begin:
+		return decimal.One, nil
+	default:
+		return decimal.Decimal{}, fmt.Errorf("%w: unknown timing %v", decimal.ErrInvalidOperation, w)
+	}
+}
+
+// FV returns the future value of an investment with periodic constant payments
+// and a constant interest rate.
+// It is equivalent to the FV function in spreadsheets.
+//
+// FV returns an error if:
+//   - the timing is not valid;
+//   - the integer part of an intermediate result has more than [decimal.MaxPrec] digits.
+func FV(rate, nper, pmt, pv decimal.Decimal, when Timing) (decimal.Decimal, error) {
+	w, err := when.decimal()
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing FV(%v, %v, %v, %v, %v): %w", rate, nper, pmt, pv, when, err)
+	}
+	var c calc
+	var fv decimal.Decimal
+	if rate.IsZero() {
+		// fv = -(pv + pmt × n)
+		fv = c.AddMul(pv, pmt, nper)
+	} else {
+		// fv = -(pv × g + pmt × k × a)
+		g, k, a := c.annuity(rate, nper, w)
+		fv = c.AddMul(c.Mul(pv, g), c.Mul(pmt, k), a)
+	}
+	if c.Err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing FV(%v, %v, %v, %v, %v): %w", rate, nper, pmt, pv, when, c.Err)
+	}
+	return fv.Neg(), nil
+}
+
+// PV returns the present value of an investment with periodic constant payments
+// and a constant interest rate.
+// It is equivalent to the PV function in spreadsheets.
+//
+// PV returns an error if:
+//   - the timing is not valid;
+//   - the rate is -1;
+//   - the integer part of an intermediate result has more than [decimal.MaxPrec] digits.
+func PV(rate, nper, pmt, fv decimal.Decimal, when Timing) (decimal.Decimal, error) {
+	w, err := when.decimal()
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing PV(%v, %v, %v, %v, %v): %w", rate, nper, pmt, fv, when, err)
+	}
+	var c calc
+	var pv decimal.Decimal
+	switch {
+	case rate.IsZero():
+		// pv = -(fv + pmt × n)
+		pv = c.AddMul(fv, pmt, nper)
+	case rate.IsPos():
+		// pv = -(fv × h + pmt × k × b)
+		h, k, b := c.discount(rate, nper, w)
+		pv = c.AddMul(c.Mul(fv, h), c.Mul(pmt, k), b)
+	default:
+		// pv = -(fv + pmt × k × a) / g
+		g, k, a := c.annuity(rate, nper, w)
+		pv = c.Quo(c.AddMul(fv, c.Mul(pmt, k), a), g)
+	}
+	if c.Err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing PV(%v, %v, %v, %v, %v): %w", rate, nper, pmt, fv, when, c.Err)
+	}
+	return pv.Neg(), nil
+}
+
+// PMT returns the periodic payment for a loan or an investment with
+// constant payments and a constant interest rate.
+// It is equivalent to the PMT function in spreadsheets.
+//
+// PMT returns an error if:
+//   - the timing is not valid;
+//   - the number of periods is 0;
+//   - the integer part of an intermediate result has more than [decimal.MaxPrec] digits.
+func PMT(rate, nper, pv, fv decimal.Decimal, when Timing) (decimal.Decimal, error) {
+	w, err := when.decimal()
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing PMT(%v, %v, %v, %v, %v): %w", rate, nper, pv, fv, when, err)
+	}
+	var c calc
+	var pmt decimal.Decimal
+	switch {
+	case rate.IsZero():
+		// pmt = -(pv + fv) / n
+		pmt = c.Quo(c.Add(pv, fv), nper)
+	case rate.IsPos():
+		// pmt = -(pv + fv × h) / (k × b)
+		h, k, b := c.discount(rate, nper, w)
+		pmt = c.Quo(c.AddMul(pv, fv, h), c.Mul(k, b))
+	default:
+		// pmt = -(pv × g + fv) / (k × a)
+		g, k, a := c.annuity(rate, nper, w)
+		pmt = c.Quo(c.AddMul(fv, pv, g), c.Mul(k, a))
+	}
+	if c.Err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing PMT(%v, %v, %v, %v, %v): %w", rate, nper, pv, fv, when, c.Err)
+	}
+	return pmt.Neg(), nil
+}
+
+// NPER returns the number of periods for a loan or an investment with
+// constant payments and a constant interest rate.
+// The result is not rounded to a whole number of periods.
+// It is equivalent to the NPER function in spreadsheets.
+//
+// NPER returns an error if:
+//   - the timing is not valid;
+//   - the payment and the interest rate are both 0;
+//   - the loan or the investment can never be paid off;
+//   - the integer part of an intermediate result has more than [decimal.MaxPrec] digits.
+func NPER(rate, pmt, pv, fv decimal.Decimal, when Timing) (decimal.Decimal, error) {
+	w, err := when.decimal()
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing NPER(%v, %v, %v, %v, %v): %w", rate, pmt, pv, fv, when, err)
+	}
+	var c calc
+	var nper decimal.Decimal
+	if rate.IsZero() {
+		// n = -(pv + fv) / pmt
+		nper = c.Quo(c.Add(pv, fv), pmt).Neg()
+	} else {
+		// n = ln((pmt × k - fv × r) / (pmt × k + pv × r)) / ln(1 + r)
+		pk := c.Mul(pmt, c.AddMul(decimal.One, rate, w))
+		num := c.Sub(pk, c.Mul(fv, rate))
+		den := c.AddMul(pk, pv, rate)
+		nper = c.Quo(c.Log(c.Quo(num, den)), c.Log1p(rate))
+	}
+	if c.Err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing NPER(%v, %v, %v, %v, %v): %w", rate, pmt, pv, fv, when, c.Err)
+	}
+	return nper, nil
+}
+
+// RATE returns the interest rate per period of a loan or an investment with
+// constant payments.
+// It is equivalent to the RATE function in spreadsheets.
+// See the package documentation for details on how the rate is found.
+//
+// RATE returns an error if:
+//   - the timing is not valid;
+//   - the number of periods is 0;
+//   - the solver does not converge, see [ErrNoConvergence].
+func RATE(nper, pmt, pv, fv decimal.Decimal, when Timing) (decimal.Decimal, error) {
+	w, err := when.decimal()
+	if err == nil && nper.IsZero() {
+		err = fmt.Errorf("%w: number of periods is 0", decimal.ErrInvalidOperation)
+	}
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing RATE(%v, %v, %v, %v, %v): %w", nper, pmt, pv, fv, when, err)
+	}
+	f := func(rate decimal.Decimal) (y, dy decimal.Decimal, err error) {
+		return rateFunc(rate, nper, pmt, pv, fv, w)
+	}
+	rate, err := numeric.Solve(f)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("computing RATE(%v, %v, %v, %v, %v): %w", nper, pmt, pv, fv, when, err)
+	}
+	return rate, nil
+}
+
+// rateFunc evaluates the annuity equation and its derivative with respect to the rate:
+//
+//	y  = pv × g + pmt × k × a + fv
+//	dy = pv × g' + pmt × (w × a + k × a')
+//
+// where g = (1 + r)ⁿ, k = 1 + r × w, a = (g - 1) / r.
+func rateFunc(rate, nper, pmt, pv, fv, w decimal.Decimal) (y, dy decimal.Decimal, err error) {
+	var c calc
+	if rate.IsZero() {
+		// Limits as the rate approaches 0:
+		// a = n, a' = n × (n - 1) / 2, g' = n
+		a := nper
+		da := c.Mul(c.Mul(nper, c.Sub(nper, decimal.One)), half)
+		y = c.Add(c.AddMul(fv, pmt, a), pv)
+		dy = c.AddMul(c.Mul(pv, nper), pmt, c.AddMul(da, w, a))
+		return y, dy, c.Err
+	}
+	base := c.Add(decimal.One, rate)
+	g, k, a := c.annuity(rate, nper, w)
+	dg := c.Quo(c.Mul(nper, g), base)
+	// a' = (g' × r - (g - 1)) / r²
+	da := c.Quo(c.Sub(dg, a), rate)
+	y = c.AddMul(c.AddMul(fv, pv, g), c.Mul(pmt, k), a)
+	dy = c.AddMul(c.Mul(pv, dg), pmt, c.AddMul(c.Mul(k, da), w, a))
+	return y, dy, c.Err
+}
+
+// annuity returns the growth factor g = (1 + r)ⁿ, the timing factor k = 1 + r × w,
+// and the annuity factor a = (g - 1) / r, where r is not 0.
+func (c *calc) annuity(rate, nper, w decimal.Decimal) (g, k, a decimal.Decimal) {
+	g = c.Pow(c.Add(decimal.One, rate), nper)
+	k = c.AddMul(decimal.One, rate, w)
+	a = c.Quo(c.Sub(g, decimal.One), rate)
+	return g, k, a
+}
+
+// discount returns the discount factor h = (1 + r)⁻ⁿ, the timing factor k = 1 + r × w,
+// and the present value annuity factor b = (1 - h) / r, where r is not 0.
+// For positive rates, these factors do not overflow even when the growth factor
+// returned by [calc.annuity] does.
+func (c *calc) discount(rate, nper, w decimal.Decimal) (h, k, b decimal.Decimal) {
+	h = c.Pow(c.Add(decimal.One, rate), nper.Neg())
+	k = c.AddMul(decimal.One, rate, w)
+	b = c.Quo(c.Sub(decimal.One, h), rate)
+	return h, k, b
+}
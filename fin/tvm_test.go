@@ -0,0 +1,294 @@
+package fin
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+// Expected values in the tests below are taken from the examples in the spreadsheet
+// documentation and extended to more digits using high-precision arithmetic.
+
+func TestTiming_String(t *testing.T) {
+	tests := []struct {
+		when Timing
+		want string
+	}{
+		{End, "End"},
+		{Begin, "Begin"},
+		{Timing(2), "Timing(2)"},
+	}
+	for _, tt := range tests {
+		got := tt.when.String()
+		if got != tt.want {
+			t.Errorf("Timing(%d).String() = %q, want %q", int(tt.when), got, tt.want)
+		}
+	}
+}
+
+func TestFV(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			rate, nper, pmt, pv string
+			when                Timing
+			want                string
+		}{
+			{"0.005", "10", "-200", "-500", Begin, "2581.4033740602"},
+			{"0.01", "12", "-1000", "0", End, "12682.5030131970"},
+			{"0.0091666666666666667", "35", "-2000", "0", Begin, "82846.2463718995"},
+			{"0.005", "12", "-100", "-1000", Begin, "2301.4018303409"},
+			{"0", "12", "-100", "-1000", End, "2200.0000000000"},
+			{"0.05", "2.5", "0", "-100", End, "112.9726321947"},
+		}
+		for _, tt := range tests {
+			rate := decimal.MustNewFromString(tt.rate)
+			nper := decimal.MustNewFromString(tt.nper)
+			pmt := decimal.MustNewFromString(tt.pmt)
+			pv := decimal.MustNewFromString(tt.pv)
+			want := decimal.MustNewFromString(tt.want)
+			got, err := FV(rate, nper, pmt, pv, tt.when)
+			if err != nil {
+				t.Errorf("FV(%v, %v, %v, %v, %v) failed: %v", rate, nper, pmt, pv, tt.when, err)
+				continue
+			}
+			if got.Round(want.Scale()).Cmp(want) != 0 {
+				t.Errorf("FV(%v, %v, %v, %v, %v) = %v, want %v", rate, nper, pmt, pv, tt.when, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			rate, nper, pmt, pv string
+			when                Timing
+			wantErr             error
+		}{
+			"timing":   {"0.01", "12", "-100", "0", Timing(2), decimal.ErrInvalidOperation},
+			"overflow": {"1", "100", "-100", "0", End, decimal.ErrOverflow},
+			"negative": {"-2", "0.5", "-100", "0", End, decimal.ErrInvalidOperation},
+		}
+		for name, tt := range tests {
+			rate := decimal.MustNewFromString(tt.rate)
+			nper := decimal.MustNewFromString(tt.nper)
+			pmt := decimal.MustNewFromString(tt.pmt)
+			pv := decimal.MustNewFromString(tt.pv)
+			_, err := FV(rate, nper, pmt, pv, tt.when)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: FV(%v, %v, %v, %v, %v) = %v, want %v", name, rate, nper, pmt, pv, tt.when, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestPV(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			rate, nper, pmt, fv string
+			when                Timing
+			want                string
+		}{
+			{"0.0066666666666666667", "240", "500", "0", End, "-59777.1458511880"},
+			{"0.005", "10", "-200", "2581.4033740602", Begin, "-500.0000000000"},
+			{"0.1", "5", "0", "1000", End, "-620.9213230592"},
+			{"0", "12", "-100", "0", End, "1200.0000000000"},
+		}
+		for _, tt := range tests {
+			rate := decimal.MustNewFromString(tt.rate)
+			nper := decimal.MustNewFromString(tt.nper)
+			pmt := decimal.MustNewFromString(tt.pmt)
+			fv := decimal.MustNewFromString(tt.fv)
+			want := decimal.MustNewFromString(tt.want)
+			got, err := PV(rate, nper, pmt, fv, tt.when)
+			if err != nil {
+				t.Errorf("PV(%v, %v, %v, %v, %v) failed: %v", rate, nper, pmt, fv, tt.when, err)
+				continue
+			}
+			if got.Round(want.Scale()).Cmp(want) != 0 {
+				t.Errorf("PV(%v, %v, %v, %v, %v) = %v, want %v", rate, nper, pmt, fv, tt.when, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			rate, nper, pmt, fv string
+			when                Timing
+			wantErr             error
+		}{
+			"timing": {"0.01", "12", "-100", "0", Timing(-1), decimal.ErrInvalidOperation},
+			"rate":   {"-1", "12", "-100", "0", End, decimal.ErrDivisionByZero},
+		}
+		for name, tt := range tests {
+			rate := decimal.MustNewFromString(tt.rate)
+			nper := decimal.MustNewFromString(tt.nper)
+			pmt := decimal.MustNewFromString(tt.pmt)
+			fv := decimal.MustNewFromString(tt.fv)
+			_, err := PV(rate, nper, pmt, fv, tt.when)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: PV(%v, %v, %v, %v, %v) = %v, want %v", name, rate, nper, pmt, fv, tt.when, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestPMT(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			rate, nper, pv, fv string
+			when               Timing
+			want               string
+		}{
+			{"0.0066666666666666667", "10", "10000", "0", End, "-1037.0320893592"},
+			{"0.0066666666666666667", "10", "10000", "0", Begin, "-1030.1643271780"},
+			{"0.005", "216", "0", "50000", End, "-129.0811608680"},
+			{"0.0035", "360", "250000", "0", End, "-1222.5429342838"},
+			{"0.1", "360", "250000", "0", End, "-25000.0000000000"},
+			{"0", "10", "1000", "0", End, "-100.0000000000"},
+		}
+		for _, tt := range tests {
+			rate := decimal.MustNewFromString(tt.rate)
+			nper := decimal.MustNewFromString(tt.nper)
+			pv := decimal.MustNewFromString(tt.pv)
+			fv := decimal.MustNewFromString(tt.fv)
+			want := decimal.MustNewFromString(tt.want)
+			got, err := PMT(rate, nper, pv, fv, tt.when)
+			if err != nil {
+				t.Errorf("PMT(%v, %v, %v, %v, %v) failed: %v", rate, nper, pv, fv, tt.when, err)
+				continue
+			}
+			if got.Round(want.Scale()).Cmp(want) != 0 {
+				t.Errorf("PMT(%v, %v, %v, %v, %v) = %v, want %v", rate, nper, pv, fv, tt.when, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			rate, nper, pv, fv string
+			when               Timing
+			wantErr            error
+		}{
+			"timing": {"0.01", "12", "1000", "0", Timing(2), decimal.ErrInvalidOperation},
+			"nper 1": {"0.01", "0", "1000", "0", End, decimal.ErrDivisionByZero},
+			"nper 2": {"0", "0", "1000", "0", End, decimal.ErrDivisionByZero},
+		}
+		for name, tt := range tests {
+			rate := decimal.MustNewFromString(tt.rate)
+			nper := decimal.MustNewFromString(tt.nper)
+			pv := decimal.MustNewFromString(tt.pv)
+			fv := decimal.MustNewFromString(tt.fv)
+			_, err := PMT(rate, nper, pv, fv, tt.when)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: PMT(%v, %v, %v, %v, %v) = %v, want %v", name, rate, nper, pv, fv, tt.when, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestNPER(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			rate, pmt, pv, fv string
+			when              Timing
+			want              string
+		}{
+			{"0.01", "-100", "-1000", "10000", Begin, "59.6738656743"},
+			{"0.01", "-100", "-1000", "10000", End, "60.0821228538"},
+			{"0.01", "-100", "-1000", "0", End, "-9.5785940398"},
+			{"0", "-100", "1000", "0", End, "10.0000000000"},
+		}
+		for _, tt := range tests {
+			rate := decimal.MustNewFromString(tt.rate)
+			pmt := decimal.MustNewFromString(tt.pmt)
+			pv := decimal.MustNewFromString(tt.pv)
+			fv := decimal.MustNewFromString(tt.fv)
+			want := decimal.MustNewFromString(tt.want)
+			got, err := NPER(rate, pmt, pv, fv, tt.when)
+			if err != nil {
+				t.Errorf("NPER(%v, %v, %v, %v, %v) failed: %v", rate, pmt, pv, fv, tt.when, err)
+				continue
+			}
+			if got.Round(want.Scale()).Cmp(want) != 0 {
+				t.Errorf("NPER(%v, %v, %v, %v, %v) = %v, want %v", rate, pmt, pv, fv, tt.when, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			rate, pmt, pv, fv string
+			when              Timing
+			wantErr           error
+		}{
+			"timing":   {"0.01", "-100", "1000", "0", Timing(2), decimal.ErrInvalidOperation},
+			"payment":  {"0", "0", "1000", "0", End, decimal.ErrDivisionByZero},
+			"never":    {"0.01", "-1", "1000", "0", End, decimal.ErrInvalidOperation},
+			"interest": {"0.01", "-10", "1000", "0", End, decimal.ErrDivisionByZero},
+		}
+		for name, tt := range tests {
+			rate := decimal.MustNewFromString(tt.rate)
+			pmt := decimal.MustNewFromString(tt.pmt)
+			pv := decimal.MustNewFromString(tt.pv)
+			fv := decimal.MustNewFromString(tt.fv)
+			_, err := NPER(rate, pmt, pv, fv, tt.when)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: NPER(%v, %v, %v, %v, %v) = %v, want %v", name, rate, pmt, pv, fv, tt.when, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestRATE(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			nper, pmt, pv, fv string
+			when              Timing
+			want              string
+		}{
+			{"48", "-200", "8000", "0", End, "0.007701472488"},
+			{"48", "-200", "8000", "0", Begin, "0.008052981924"},
+			{"10", "0", "-1000", "2000", End, "0.071773462536"},
+			{"12", "-100", "1200", "0", End, "0.000000000000"},
+			{"360", "-1122.6716555316", "250000", "0", End, "0.002917024568"},
+			{"5", "-100", "-1000", "2000", End, "0.072890677213"},
+		}
+		for _, tt := range tests {
+			nper := decimal.MustNewFromString(tt.nper)
+			pmt := decimal.MustNewFromString(tt.pmt)
+			pv := decimal.MustNewFromString(tt.pv)
+			fv := decimal.MustNewFromString(tt.fv)
+			want := decimal.MustNewFromString(tt.want)
+			got, err := RATE(nper, pmt, pv, fv, tt.when)
+			if err != nil {
+				t.Errorf("RATE(%v, %v, %v, %v, %v) failed: %v", nper, pmt, pv, fv, tt.when, err)
+				continue
+			}
+			if got.Round(want.Scale()).Cmp(want) != 0 {
+				t.Errorf("RATE(%v, %v, %v, %v, %v) = %v, want %v", nper, pmt, pv, fv, tt.when, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			nper, pmt, pv, fv string
+			when              Timing
+			wantErr           error
+		}{
+			"timing":    {"48", "-200", "8000", "0", Timing(2), decimal.ErrInvalidOperation},
+			"nper":      {"0", "-200", "8000", "0", End, decimal.ErrInvalidOperation},
+			"no root 1": {"48", "200", "8000", "0", End, ErrNoConvergence},
+		}
+		for name, tt := range tests {
+			nper := decimal.MustNewFromString(tt.nper)
+			pmt := decimal.MustNewFromString(tt.pmt)
+			pv := decimal.MustNewFromString(tt.pv)
+			fv := decimal.MustNewFromString(tt.fv)
+			_, err := RATE(nper, pmt, pv, fv, tt.when)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: RATE(%v, %v, %v, %v, %v) = %v, want %v", name, nper, pmt, pv, fv, tt.when, err, tt.wantErr)
+			}
+		}
+	})
+}
@@ -0,0 +1,426 @@
+package decimal
+
+import "fmt"
+
+// IEEE 754-2008 interchange formats.
+const (
+	prec64    = 16   // number of digits in the coefficient of a decimal64
+	bias64    = 398  // exponent bias of a decimal64
+	prec128   = 34   // number of digits in the coefficient of a decimal128
+	bias128   = 6176 // exponent bias of a decimal128
+	maxCoef64 = fint(9_999_999_999_999_999)
+)
+
+// EncodeDecimal64BID converts a decimal to an [IEEE 754] decimal64
+// in the binary integer decimal (BID) encoding.
+// The exponent of the result is equal to minus the scale of the decimal,
+// so trailing zeros are preserved.
+// If the coefficient has more than 16 digits, it is rounded
+// using [rounding half to even] (banker's rounding), and the exponent
+// is increased accordingly.
+// Use [EncodeDecimal64BIDExact] to detect such rounding.
+// Rounding can carry into a 20th digit, so the result does not always
+// convert back to a decimal: for example, 9999999999999999999 is encoded
+// as 1000000000000000 × 10^4, which [DecodeDecimal64BID] rejects with
+// an error wrapping [ErrOverflow].
+// See also function [DecodeDecimal64BID].
+//
+// [IEEE 754]: https://en.wikipedia.org/wiki/Decimal64_floating-point_format
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func EncodeDecimal64BID(d Decimal) uint64 {
+	neg, coef, exp := d.ieee64()
+	//nolint:gosec
+	b := uint64(exp + bias64)
+	var x uint64
+	if coef < 1<<53 {
+		x = b<<53 | uint64(coef)
+	} else {
+		x = 0b11<<61 | b<<51 | uint64(coef)&(1<<51-1)
+	}
+	if neg {
+		x |= 1 << 63
+	}
+	return x
+}
+
+// EncodeDecimal64BIDExact is similar to [EncodeDecimal64BID], but it returns
+// an error wrapping [ErrOverflow] if the coefficient has to be rounded,
+// that is, if it has more than 16 significant digits.
+// Trailing zeros can still be removed from the coefficient, since
+// this does not change the value of the decimal.
+func EncodeDecimal64BIDExact(d Decimal) (uint64, error) {
+	if !d.isDecimal64() {
+		return 0, newOpError("encode64", 0, fmt.Errorf("%w: coefficient has more than %v significant digits", ErrOverflow, prec64), d)
+	}
+	return EncodeDecimal64BID(d), nil
+}
+
+// DecodeDecimal64BID converts an [IEEE 754] decimal64 in the binary integer
+// decimal (BID) encoding to a (possibly rounded) decimal.
+// The scale of the result is equal to minus the exponent, so trailing zeros
+// are preserved.
+// If the exponent is positive, the scale of the result is 0.
+// If the exponent is less than -[MaxScale], the result is rounded
+// to [MaxScale] digits after the decimal point using
+// [rounding half to even] (banker's rounding).
+// Non-canonical coefficients are decoded as zero, as required by the standard.
+// See also function [EncodeDecimal64BID].
+//
+// DecodeDecimal64BID returns an error if:
+//   - the value is an infinity or a NaN;
+//   - the integer part of the result has more than [MaxPrec] digits.
+//
+// [IEEE 754]: https://en.wikipedia.org/wiki/Decimal64_floating-point_format
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func DecodeDecimal64BID(x uint64) (Decimal, error) {
+	neg := x>>63 != 0
+	var coef fint
+	var b uint64
+	switch {
+	case x>>59&0b1111 == 0b1111:
+		return Decimal{}, specialError("decode64", x>>58&1 != 0)
+	case x>>61&0b11 == 0b11:
+		b = x >> 51 & 0x3ff
+		coef = fint(1<<53 | x&(1<<51-1))
+		if coef > maxCoef64 {
+			coef = 0 // non-canonical
+		}
+	default:
+		b = x >> 53 & 0x3ff
+		coef = fint(x & (1<<53 - 1))
+	}
+	//nolint:gosec
+	return newFromIEEE64(neg, coef, int(b)-bias64)
+}
+
+// EncodeDecimal64DPD is similar to [EncodeDecimal64BID], but it uses
+// the densely packed decimal (DPD) encoding.
+// Use [EncodeDecimal64DPDExact] to detect rounding.
+// See also function [DecodeDecimal64DPD].
+func EncodeDecimal64DPD(d Decimal) uint64 {
+	neg, coef, exp := d.ieee64()
+	//nolint:gosec
+	b := uint64(exp + bias64)
+
+	// Coefficient continuation
+	var x uint64
+	for i := range prec64 / 3 {
+		x |= uint64(declet(coef%1000)) << (10 * i)
+		coef /= 1000
+	}
+
+	// Combination and exponent continuation
+	x |= uint64(combination(b>>8, coef))<<58 | (b&0xff)<<50
+	if neg {
+		x |= 1 << 63
+	}
+	return x
+}
+
+// EncodeDecimal64DPDExact is similar to [EncodeDecimal64BIDExact], but it uses
+// the densely packed decimal (DPD) encoding.
+func EncodeDecimal64DPDExact(d Decimal) (uint64, error) {
+	if !d.isDecimal64() {
+		return 0, newOpError("encode64", 0, fmt.Errorf("%w: coefficient has more than %v significant digits", ErrOverflow, prec64), d)
+	}
+	return EncodeDecimal64DPD(d), nil
+}
+
+// DecodeDecimal64DPD is similar to [DecodeDecimal64BID], but it uses
+// the densely packed decimal (DPD) encoding.
+// Non-canonical declets are decoded as required by the standard.
+// See also function [EncodeDecimal64DPD].
+func DecodeDecimal64DPD(x uint64) (Decimal, error) {
+	neg := x>>63 != 0
+	top, msd, special := splitCombination(x >> 58 & 0b11111)
+	if special {
+		return Decimal{}, specialError("decode64", x>>58&1 != 0)
+	}
+	b := top<<8 | x>>50&0xff
+
+	// Coefficient
+	coef := fint(msd)
+	for i := prec64/3 - 1; i >= 0; i-- {
+		coef = coef*1000 + undeclet(x>>(10*i)&0x3ff)
+	}
+
+	//nolint:gosec
+	return newFromIEEE64(neg, coef, int(b)-bias64)
+}
+
+// EncodeDecimal128BID converts a decimal to an [IEEE 754] decimal128
+// in the binary integer decimal (BID) encoding.
+// The result is returned as the high and low 64-bit halves.
+// The exponent of the result is equal to minus the scale of the decimal,
+// so the conversion is always exact and trailing zeros are preserved.
+// See also function [DecodeDecimal128BID].
+//
+// [IEEE 754]: https://en.wikipedia.org/wiki/Decimal128_floating-point_format
+func EncodeDecimal128BID(d Decimal) (hi, lo uint64) {
+	//nolint:gosec
+	b := uint64(bias128 - d.Scale())
+	hi = b << 49
+	lo = uint64(d.coef)
+	if d.IsNeg() {
+		hi |= 1 << 63
+	}
+	return hi, lo
+}
+
+// DecodeDecimal128BID converts an [IEEE 754] decimal128 in the binary integer
+// decimal (BID) encoding, given as the high and low 64-bit halves,
+// to a (possibly rounded) decimal.
+// The scale of the result is equal to minus the exponent, so trailing zeros
+// are preserved.
+// If the exponent is positive, the scale of the result is 0.
+// If the coefficient has more than [MaxPrec] digits or the exponent is less
+// than -[MaxScale], the result is rounded using [rounding half to even]
+// (banker's rounding).
+// Non-canonical coefficients are decoded as zero, as required by the standard.
+// See also function [EncodeDecimal128BID].
+//
+// DecodeDecimal128BID returns an error if:
+//   - the value is an infinity or a NaN;
+//   - the integer part of the result has more than [MaxPrec] digits.
+//
+// [IEEE 754]: https://en.wikipedia.org/wiki/Decimal128_floating-point_format
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func DecodeDecimal128BID(hi, lo uint64) (Decimal, error) {
+	neg := hi>>63 != 0
+	var coef dint
+	var b uint64
+	switch {
+	case hi>>59&0b1111 == 0b1111:
+		return Decimal{}, specialError("decode128", hi>>58&1 != 0)
+	case hi>>61&0b11 == 0b11:
+		b = hi >> 47 & 0x3fff
+		// The coefficient is always non-canonical, since 2^113 > 10^34 - 1
+	default:
+		b = hi >> 49 & 0x3fff
+		coef = dint{hi: hi & (1<<49 - 1), lo: lo}
+		if coef.cmp(dpow10[prec128]) >= 0 {
+			coef = dint{} // non-canonical
+		}
+	}
+
+	bcoef := getBint()
+	defer putBint(bcoef)
+	bcoef.setDint(coef)
+
+	//nolint:gosec
+	return newFromIEEE("decode128", neg, bcoef, int(b)-bias128)
+}
+
+// EncodeDecimal128DPD is similar to [EncodeDecimal128BID], but it uses
+// the densely packed decimal (DPD) encoding.
+// See also function [DecodeDecimal128DPD].
+func EncodeDecimal128DPD(d Decimal) (hi, lo uint64) {
+	//nolint:gosec
+	b := uint64(bias128 - d.Scale())
+
+	// Coefficient continuation.
+	// Decimals have at most 19 digits, so the most significant digit
+	// and the upper declets are always zero.
+	coef := d.coef
+	for i := 0; coef != 0; i++ {
+		hi, lo = or128(hi, lo, uint64(declet(coef%1000)), 10*i)
+		coef /= 1000
+	}
+
+	// Combination and exponent continuation
+	hi |= uint64(combination(b>>12, 0))<<58 | (b&0xfff)<<46
+	if d.IsNeg() {
+		hi |= 1 << 63
+	}
+	return hi, lo
+}
+
+// DecodeDecimal128DPD is similar to [DecodeDecimal128BID], but it uses
+// the densely packed decimal (DPD) encoding.
+// Non-canonical declets are decoded as required by the standard.
+// See also function [EncodeDecimal128DPD].
+func DecodeDecimal128DPD(hi, lo uint64) (Decimal, error) {
+	neg := hi>>63 != 0
+	top, msd, special := splitCombination(hi >> 58 & 0b11111)
+	if special {
+		return Decimal{}, specialError("decode128", hi>>58&1 != 0)
+	}
+	b := top<<12 | hi>>46&0xfff
+
+	// Coefficient
+	coef := getBint()
+	defer putBint(coef)
+	coef.setFint(fint(msd))
+	for i := prec128/3 - 1; i >= 0; i-- {
+		coef.fsa(coef, 3, undeclet(rsh128(hi, lo, 10*i)&0x3ff))
+	}
+
+	//nolint:gosec
+	return newFromIEEE("decode128", neg, coef, int(b)-bias128)
+}
+
+// ieee64 returns the sign, the coefficient, and the exponent of a decimal,
+// with the coefficient rounded to fit into a decimal64.
+func (d Decimal) ieee64() (neg bool, coef fint, exp int) {
+	neg, coef, exp = d.IsNeg(), d.coef, -d.Scale()
+	if coef > maxCoef64 {
+		shift := coef.prec() - prec64
+		coef = coef.rshMode(shift, HalfEven, neg)
+		exp += shift
+		// Handling the rare case when rshMode rounded
+		// a 16-digit coefficient to a 17-digit coefficient.
+		if coef > maxCoef64 {
+			coef /= 10
+			exp++
+		}
+	}
+	return neg, coef, exp
+}
+
+// isDecimal64 returns true if the decimal can be converted to a decimal64
+// without rounding, that is, if rounding its coefficient to 16 digits
+// would discard only trailing zeros.
+func (d Decimal) isDecimal64() bool {
+	return d.coef <= maxCoef64 || d.coef.ntz() >= d.coef.prec()-prec64
+}
+
+// newFromIEEE64 is similar to newFromIEEE, but it takes a decimal64 coefficient.
+func newFromIEEE64(neg bool, coef fint, exp int) (Decimal, error) {
+	bcoef := getBint()
+	defer putBint(bcoef)
+	bcoef.setFint(coef)
+	return newFromIEEE("decode64", neg, bcoef, exp)
+}
+
+// newFromIEEE creates a new decimal equal to (-1)^neg * coef * 10^exp.
+// If the coefficient has to be rounded, rounding half to even is used.
+// Op describes the conversion in errors.
+func newFromIEEE(op string, neg bool, coef *bint, exp int) (Decimal, error) {
+	if coef.sign() == 0 {
+		return newSafe(false, 0, min(max(-exp, 0), MaxScale))
+	}
+	prec := coef.prec()
+	switch {
+	case exp > 0:
+		if prec+exp > MaxPrec {
+			return Decimal{}, newOpError(op, 0, overflowError(prec+exp, 0, 0))
+		}
+		coef.lsh(coef, exp)
+		exp = 0
+	case -exp > MaxScale+prec:
+		// The decimal is less than 10^-(MaxScale+1), so it rounds to zero
+		return newSafe(false, 0, MaxScale)
+	}
+	d, err := newFromBint(neg, coef, -exp, 0, HalfEven)
+	if err != nil {
+		return Decimal{}, newOpError(op, 0, err)
+	}
+	return d, nil
+}
+
+// specialError returns an error describing the conversion of an infinity
+// or a NaN.
+func specialError(op string, nan bool) error {
+	if nan {
+		return newOpError(op, 0, fmt.Errorf("%w: NaN is not supported", ErrInvalidDecimal))
+	}
+	return newOpError(op, 0, fmt.Errorf("%w: infinity is not supported", ErrInvalidDecimal))
+}
+
+// combination returns the 5-bit combination field of the densely packed
+// decimal encoding from the 2 most significant bits of the biased exponent
+// and the most significant digit of the coefficient.
+//
+//nolint:gosec
+func combination(top uint64, msd fint) uint8 {
+	if msd < 8 {
+		return uint8(top<<3 | uint64(msd))
+	}
+	return uint8(0b11000 | top<<1 | uint64(msd-8))
+}
+
+// splitCombination returns the 2 most significant bits of the biased exponent
+// and the most significant digit of the coefficient from the 5-bit combination
+// field of the densely packed decimal encoding.
+// It reports whether the field denotes an infinity or a NaN instead.
+func splitCombination(g uint64) (top, msd uint64, special bool) {
+	switch {
+	case g>>1 == 0b1111:
+		return 0, 0, true
+	case g>>3 == 0b11:
+		return g >> 1 & 0b11, 8 + g&1, false
+	default:
+		return g >> 3, g & 0b111, false
+	}
+}
+
+// declet returns the 10-bit densely packed decimal encoding
+// of a 3-digit number.
+//
+//nolint:gosec
+func declet(n fint) uint16 {
+	a, b, c := uint16(n/100), uint16(n/10%10), uint16(n%10)
+	switch {
+	case a < 8 && b < 8 && c < 8:
+		return a<<7 | b<<4 | c
+	case a < 8 && b < 8:
+		return a<<7 | b<<4 | 0b1000 | c&1
+	case a < 8 && c < 8:
+		return a<<7 | (c>>1)<<5 | (b&1)<<4 | 0b1010 | c&1
+	case a < 8:
+		return a<<7 | 0b10<<5 | (b&1)<<4 | 0b1110 | c&1
+	case b < 8 && c < 8:
+		return (c>>1)<<8 | (a&1)<<7 | b<<4 | 0b1100 | c&1
+	case b < 8:
+		return (b>>1)<<8 | (a&1)<<7 | 0b01<<5 | (b&1)<<4 | 0b1110 | c&1
+	case c < 8:
+		return (c>>1)<<8 | (a&1)<<7 | 0b00<<5 | (b&1)<<4 | 0b1110 | c&1
+	default:
+		return (a&1)<<7 | 0b11<<5 | (b&1)<<4 | 0b1110 | c&1
+	}
+}
+
+// undeclet returns the 3-digit number encoded by a 10-bit declet.
+// Non-canonical declets are decoded in the same way as their canonical
+// counterparts.
+func undeclet(x uint64) fint {
+	p, r, u, y := x>>8&0b11, x>>7&1, x>>4&1, x&1
+	var a, b, c uint64
+	switch {
+	case x&0b1000 == 0:
+		a, b, c = x>>7&0b111, x>>4&0b111, x&0b111
+	case x&0b1110 == 0b1000:
+		a, b, c = x>>7&0b111, x>>4&0b111, 8+y
+	case x&0b1110 == 0b1010:
+		a, b, c = x>>7&0b111, 8+u, x>>5&0b11<<1|y
+	case x&0b1110 == 0b1100:
+		a, b, c = 8+r, x>>4&0b111, p<<1|y
+	case x&0b1100000 == 0b0000000:
+		a, b, c = 8+r, 8+u, p<<1|y
+	case x&0b1100000 == 0b0100000:
+		a, b, c = 8+r, p<<1|u, 8+y
+	case x&0b1100000 == 0b1000000:
+		a, b, c = x>>7&0b111, 8+u, 8+y
+	default:
+		a, b, c = 8+r, 8+u, 8+y
+	}
+	return fint(a*100 + b*10 + c)
+}
+
+// or128 sets the bits of value v at the given offset in a 128-bit integer
+// made of two 64-bit words.
+func or128(hi, lo, v uint64, shift int) (uint64, uint64) {
+	if shift < 64 {
+		return hi | v>>(64-shift), lo | v<<shift
+	}
+	return hi | v<<(shift-64), lo
+}
+
+// rsh128 returns the lower 64 bits of a 128-bit integer made of two 64-bit words
+// shifted to the right by the given number of bits.
+func rsh128(hi, lo uint64, shift int) uint64 {
+	if shift < 64 {
+		return lo>>shift | hi<<(64-shift)
+	}
+	return hi >> (shift - 64)
+}
@@ -0,0 +1,454 @@
+package decimal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDeclet(t *testing.T) {
+	t.Run("known", func(t *testing.T) {
+		tests := []struct {
+			n    fint
+			want uint16
+		}{
+			{0, 0x000},
+			{9, 0x009},
+			{10, 0x010},
+			{99, 0x05f},
+			{100, 0x080},
+			{123, 0x0a3},
+			{888, 0x06e},
+			{999, 0x0ff},
+		}
+		for _, tt := range tests {
+			got := declet(tt.n)
+			if got != tt.want {
+				t.Errorf("declet(%v) = %#03x, want %#03x", tt.n, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("canonical", func(t *testing.T) {
+		seen := make(map[uint16]bool)
+		for n := range fint(1000) {
+			x := declet(n)
+			if x > 0x3ff {
+				t.Errorf("declet(%v) = %#03x, want 10 bits", n, x)
+			}
+			if seen[x] {
+				t.Errorf("declet(%v) = %#03x, which is already used", n, x)
+			}
+			seen[x] = true
+			if got := undeclet(uint64(x)); got != n {
+				t.Errorf("undeclet(declet(%v)) = %v", n, got)
+			}
+		}
+	})
+
+	t.Run("non-canonical", func(t *testing.T) {
+		tests := []struct {
+			x    uint64
+			want fint
+		}{
+			{0x16e, 888},
+			{0x26e, 888},
+			{0x36e, 888},
+			{0x1ff, 999},
+			{0x2ff, 999},
+			{0x3ff, 999},
+			{0x36f, 889},
+			{0x37e, 898},
+		}
+		for _, tt := range tests {
+			got := undeclet(tt.x)
+			if got != tt.want {
+				t.Errorf("undeclet(%#03x) = %v, want %v", tt.x, got, tt.want)
+			}
+		}
+		for x := range uint64(1024) {
+			if got := undeclet(x); got > 999 {
+				t.Errorf("undeclet(%#03x) = %v, want at most 999", x, got)
+			}
+		}
+	})
+}
+
+func TestDecimal64Interchange(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d        string
+			bid, dpd uint64
+		}{
+			{"0", 0x31c0000000000000, 0x2238000000000000},
+			{"0.00", 0x3180000000000000, 0x2230000000000000},
+			{"1", 0x31c0000000000001, 0x2238000000000001},
+			{"-1", 0xb1c0000000000001, 0xa238000000000001},
+			{"1.0", 0x31a000000000000a, 0x2234000000000010},
+			{"0.9999999999999999", 0x6bf386f26fc0ffff, 0x6df8ff3fcff3fcff},
+			{"9999999999999999", 0x6c7386f26fc0ffff, 0x6e38ff3fcff3fcff},
+			{"0.0000000000000000001", 0x2f60000000000001, 0x21ec000000000001},
+			{"-0.0000000000000000015", 0xaf6000000000000f, 0xa1ec000000000015},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			if got := EncodeDecimal64BID(d); got != tt.bid {
+				t.Errorf("EncodeDecimal64BID(%q) = %#016x, want %#016x", d, got, tt.bid)
+			}
+			if got := EncodeDecimal64DPD(d); got != tt.dpd {
+				t.Errorf("EncodeDecimal64DPD(%q) = %#016x, want %#016x", d, got, tt.dpd)
+			}
+			got, err := DecodeDecimal64BID(tt.bid)
+			if err != nil {
+				t.Errorf("DecodeDecimal64BID(%#016x) failed: %v", tt.bid, err)
+			} else if got != d {
+				t.Errorf("DecodeDecimal64BID(%#016x) = %q, want %q", tt.bid, got, d)
+			}
+			got, err = DecodeDecimal64DPD(tt.dpd)
+			if err != nil {
+				t.Errorf("DecodeDecimal64DPD(%#016x) failed: %v", tt.dpd, err)
+			} else if got != d {
+				t.Errorf("DecodeDecimal64DPD(%#016x) = %q, want %q", tt.dpd, got, d)
+			}
+		}
+	})
+
+	t.Run("rounding", func(t *testing.T) {
+		tests := []struct {
+			d, want string
+		}{
+			{"1234567890.123456789", "1234567890.123457"},
+			{"-1234567890.123456789", "-1234567890.123457"},
+			{"12345678901234565", "12345678901234560"},
+			{"12345678901234575", "12345678901234580"},
+			{"9999999999999999.5", "10000000000000000"},
+			{"0.9999999999999999999", "1.000000000000000"},
+			{"9999999999999999999", "10000000000000000000"},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			got, err := DecodeDecimal64BID(EncodeDecimal64BID(d))
+			if tt.want == "10000000000000000000" {
+				if !errors.Is(err, ErrOverflow) {
+					t.Errorf("DecodeDecimal64BID(EncodeDecimal64BID(%q)) = %v, want %v", d, err, ErrOverflow)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("DecodeDecimal64BID(EncodeDecimal64BID(%q)) failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("DecodeDecimal64BID(EncodeDecimal64BID(%q)) = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("exact", func(t *testing.T) {
+		tests := []struct {
+			d       string
+			wantErr error
+		}{
+			{"1234567890.123456", nil},
+			{"12345678901234560", nil},
+			{"1234567890123456000", nil},
+			{"-0.1234567890123456000", nil},
+			{"12345678901234565", ErrOverflow},
+			{"1234567890.123456789", ErrOverflow},
+			{"9999999999999999999", ErrOverflow},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			bid, err := EncodeDecimal64BIDExact(d)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("EncodeDecimal64BIDExact(%q) = %v, want %v", d, err, tt.wantErr)
+			} else if err == nil && bid != EncodeDecimal64BID(d) {
+				t.Errorf("EncodeDecimal64BIDExact(%q) = %#016x, want %#016x", d, bid, EncodeDecimal64BID(d))
+			}
+			dpd, err := EncodeDecimal64DPDExact(d)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("EncodeDecimal64DPDExact(%q) = %v, want %v", d, err, tt.wantErr)
+			} else if err == nil && dpd != EncodeDecimal64DPD(d) {
+				t.Errorf("EncodeDecimal64DPDExact(%q) = %#016x, want %#016x", d, dpd, EncodeDecimal64DPD(d))
+			}
+		}
+	})
+
+	t.Run("decode", func(t *testing.T) {
+		tests := []struct {
+			bid, dpd uint64
+			want     string
+		}{
+			// Positive exponents
+			{0x31e0000000000001, 0x223c000000000001, "10"},
+			{0x3400000000000001, 0x2280000000000001, "1000000000000000000"},
+			{0x6c8b86f26fc0ffff, 0x6e44ff3fcff3fcff, "9999999999999999000"},
+			{0x5fe0000000000000, 0x43fc000000000000, "0"},
+
+			// Negative exponents
+			{0x2f40000000000005, 0x21e8000000000005, "0.0000000000000000000"},
+			{0x2f4000000000000f, 0x21e8000000000015, "0.0000000000000000002"},
+			{0xaf4000000000000f, 0xa1e8000000000015, "-0.0000000000000000002"},
+			{0x2f20000000000000, 0x21e4000000000000, "0.0000000000000000000"},
+			{0x0000000000000001, 0x0000000000000001, "0.0000000000000000000"},
+			{0x0000000000000000, 0x0000000000000000, "0.0000000000000000000"},
+
+			// Negative zero
+			{0xb1c0000000000000, 0xa238000000000000, "0"},
+
+			// Non-canonical
+			{0x6c7fffffffffffff, 0x223800000000036e, "0"},
+		}
+		for _, tt := range tests {
+			want := RequireFromString(tt.want)
+			got, err := DecodeDecimal64BID(tt.bid)
+			if err != nil {
+				t.Errorf("DecodeDecimal64BID(%#016x) failed: %v", tt.bid, err)
+			} else if got != want {
+				t.Errorf("DecodeDecimal64BID(%#016x) = %q, want %q", tt.bid, got, want)
+			}
+			if tt.dpd == 0x223800000000036e {
+				want = RequireFromString("888")
+			}
+			got, err = DecodeDecimal64DPD(tt.dpd)
+			if err != nil {
+				t.Errorf("DecodeDecimal64DPD(%#016x) failed: %v", tt.dpd, err)
+			} else if got != want {
+				t.Errorf("DecodeDecimal64DPD(%#016x) = %q, want %q", tt.dpd, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			bid, dpd uint64
+			wantErr  error
+		}{
+			"inf 1":      {0x7800000000000000, 0x7800000000000000, ErrInvalidDecimal},
+			"inf 2":      {0xf800000000000000, 0xf800000000000000, ErrInvalidDecimal},
+			"inf 3":      {0x78000000000000ff, 0x7a00000000000001, ErrInvalidDecimal},
+			"nan 1":      {0x7c00000000000000, 0x7c00000000000000, ErrInvalidDecimal},
+			"nan 2":      {0xfe00000000000001, 0xfe00000000000001, ErrInvalidDecimal},
+			"overflow 1": {0x3420000000000001, 0x2284000000000001, ErrOverflow},
+			"overflow 2": {0x6c9386f26fc0ffff, 0x6e48ff3fcff3fcff, ErrOverflow},
+			"overflow 3": {0x5fe0000000000001, 0x43fc000000000001, ErrOverflow},
+			"overflow 4": {EncodeDecimal64BID(RequireFromString("9999999999999999999")), EncodeDecimal64DPD(RequireFromString("9999999999999999999")), ErrOverflow},
+		}
+		for name, tt := range tests {
+			_, err := DecodeDecimal64BID(tt.bid)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: DecodeDecimal64BID(%#016x) = %v, want %v", name, tt.bid, err, tt.wantErr)
+			}
+			var opErr *OpError
+			if !errors.As(err, &opErr) || opErr.Op != "decode64" {
+				t.Errorf("%v: DecodeDecimal64BID(%#016x) = %#v, want *OpError", name, tt.bid, err)
+			}
+			_, err = DecodeDecimal64DPD(tt.dpd)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: DecodeDecimal64DPD(%#016x) = %v, want %v", name, tt.dpd, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+// u128 is a 128-bit interchange value made of two 64-bit words.
+type u128 struct {
+	hi, lo uint64
+}
+
+func TestDecimal128Interchange(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d        string
+			bid, dpd u128
+		}{
+			{"0", u128{0x3040000000000000, 0}, u128{0x2208000000000000, 0}},
+			{"0.00", u128{0x303c000000000000, 0}, u128{0x2207800000000000, 0}},
+			{"1", u128{0x3040000000000000, 1}, u128{0x2208000000000000, 1}},
+			{"-1", u128{0xb040000000000000, 1}, u128{0xa208000000000000, 1}},
+			{"1.0", u128{0x303e000000000000, 0xa}, u128{0x2207c00000000000, 0x10}},
+			{"9999999999999999999", u128{0x3040000000000000, 0x8ac7230489e7ffff}, u128{0x2208000000000000, 0x93fcff3fcff3fcff}},
+			{"-0.0000000000000000001", u128{0xb01a000000000000, 1}, u128{0xa203400000000000, 1}},
+			{"1234567890.123456789", u128{0x302e000000000000, 0x112210f47de98115}, u128{0x2205c00000000000, 0x14d2e7078a395bcf}},
+		}
+		for _, tt := range tests {
+			d := RequireFromString(tt.d)
+			var got u128
+			got.hi, got.lo = EncodeDecimal128BID(d)
+			if got != tt.bid {
+				t.Errorf("EncodeDecimal128BID(%q) = %#016x, want %#016x", d, got, tt.bid)
+			}
+			got.hi, got.lo = EncodeDecimal128DPD(d)
+			if got != tt.dpd {
+				t.Errorf("EncodeDecimal128DPD(%q) = %#016x, want %#016x", d, got, tt.dpd)
+			}
+			e, err := DecodeDecimal128BID(tt.bid.hi, tt.bid.lo)
+			if err != nil {
+				t.Errorf("DecodeDecimal128BID(%#016x) failed: %v", tt.bid, err)
+			} else if e != d {
+				t.Errorf("DecodeDecimal128BID(%#016x) = %q, want %q", tt.bid, e, d)
+			}
+			e, err = DecodeDecimal128DPD(tt.dpd.hi, tt.dpd.lo)
+			if err != nil {
+				t.Errorf("DecodeDecimal128DPD(%#016x) failed: %v", tt.dpd, err)
+			} else if e != d {
+				t.Errorf("DecodeDecimal128DPD(%#016x) = %q, want %q", tt.dpd, e, d)
+			}
+		}
+	})
+
+	t.Run("decode", func(t *testing.T) {
+		tests := []struct {
+			bid, dpd u128
+			want     string
+		}{
+			// Positive exponents
+			{u128{0x3064000000000000, 1}, u128{0x220c800000000000, 1}, "1000000000000000000"},
+			{u128{0x5ffe000000000000, 0}, u128{0x43ffc00000000000, 0}, "0"},
+
+			// Rounding
+			{u128{0x30043cde6fff9732, 0xde825cd07e96aff2}, u128{0x2600934b9c1e28e5, 0x6f3c127177823534}, "1234.567890123456789"},
+			{u128{0x2fffed09bead87c0, 0x378d8e63ffffffff}, u128{0x6dffcff3fcff3fcf, 0xf3fcff3fcff3fcff}, "10.00000000000000000"},
+			{u128{0x3018000000000000, 0x5}, u128{0x2203000000000000, 0x5}, "0.0000000000000000000"},
+			{u128{0x3018000000000000, 0x19}, u128{0x2203000000000000, 0x25}, "0.0000000000000000002"},
+			{u128{0x3018000000000000, 0x23}, u128{0x2203000000000000, 0x35}, "0.0000000000000000004"},
+			{u128{0, 1}, u128{0, 1}, "0.0000000000000000000"},
+
+			// Non-canonical
+			{u128{0x3041ed09bead87c0, 0x378d8e6400000000}, u128{0x2208000000000000, 0x36e}, "0"},
+			{u128{0x6000000000000000, 1}, u128{0x2208000000000000, 0x36e}, "0.0000000000000000000"},
+		}
+		for _, tt := range tests {
+			want := RequireFromString(tt.want)
+			got, err := DecodeDecimal128BID(tt.bid.hi, tt.bid.lo)
+			if err != nil {
+				t.Errorf("DecodeDecimal128BID(%#016x) failed: %v", tt.bid, err)
+			} else if got != want {
+				t.Errorf("DecodeDecimal128BID(%#016x) = %q, want %q", tt.bid, got, want)
+			}
+			if tt.dpd.lo == 0x36e {
+				want = RequireFromString("888")
+			}
+			got, err = DecodeDecimal128DPD(tt.dpd.hi, tt.dpd.lo)
+			if err != nil {
+				t.Errorf("DecodeDecimal128DPD(%#016x) failed: %v", tt.dpd, err)
+			} else if got != want {
+				t.Errorf("DecodeDecimal128DPD(%#016x) = %q, want %q", tt.dpd, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			bid, dpd u128
+			wantErr  error
+		}{
+			"inf 1":      {u128{0x7800000000000000, 0}, u128{0x7800000000000000, 0}, ErrInvalidDecimal},
+			"inf 2":      {u128{0xf800000000000000, 0}, u128{0xf800000000000000, 0}, ErrInvalidDecimal},
+			"nan 1":      {u128{0x7c00000000000000, 0}, u128{0x7c00000000000000, 0}, ErrInvalidDecimal},
+			"nan 2":      {u128{0xfe00000000000000, 1}, u128{0xfe00000000000000, 1}, ErrInvalidDecimal},
+			"overflow 1": {u128{0x3066000000000000, 1}, u128{0x220cc00000000000, 1}, ErrOverflow},
+			"overflow 2": {u128{0xdffe000000000000, 1}, u128{0xc3ffc00000000000, 1}, ErrOverflow},
+		}
+		for name, tt := range tests {
+			_, err := DecodeDecimal128BID(tt.bid.hi, tt.bid.lo)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: DecodeDecimal128BID(%#016x) = %v, want %v", name, tt.bid, err, tt.wantErr)
+			}
+			_, err = DecodeDecimal128DPD(tt.dpd.hi, tt.dpd.lo)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: DecodeDecimal128DPD(%#016x) = %v, want %v", name, tt.dpd, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func FuzzDecimal64_BID_DPD(f *testing.F) {
+	for _, d := range corpus {
+		f.Add(d.neg, d.scale, d.coef)
+	}
+
+	f.Fuzz(
+		func(t *testing.T, neg bool, scale int, coef uint64) {
+			d, err := newSafe(neg, fint(coef), scale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			got, err := DecodeDecimal64BID(EncodeDecimal64BID(d))
+			if err != nil {
+				if d.Prec() > prec64 && errors.Is(err, ErrOverflow) {
+					t.Skip() // 9999999999999999999 rounds to 10^19
+					return
+				}
+				t.Errorf("DecodeDecimal64BID(EncodeDecimal64BID(%q)) failed: %v", d, err)
+				return
+			}
+			want, err := DecodeDecimal64DPD(EncodeDecimal64DPD(d))
+			if err != nil {
+				t.Errorf("DecodeDecimal64DPD(EncodeDecimal64DPD(%q)) failed: %v", d, err)
+				return
+			}
+			if got != want {
+				t.Errorf("BID and DPD encodings of %q differ: %q != %q", d, got, want)
+				return
+			}
+
+			if d.Prec() <= prec64 {
+				if got != d {
+					t.Errorf("DecodeDecimal64BID(EncodeDecimal64BID(%q)) = %q", d, got)
+				}
+				return
+			}
+			// Rounding to 16 significant digits
+			shift := d.Prec() - prec64
+			wcoef := getBint()
+			defer putBint(wcoef)
+			wcoef.setFint(d.coef.rshHalfEven(shift))
+			wcoef.lsh(wcoef, shift)
+			want, err = newFromBint(d.IsNeg(), wcoef, d.Scale(), 0, HalfEven)
+			if err != nil {
+				t.Errorf("newFromBint(%v) failed: %v", wcoef, err)
+				return
+			}
+			if got.Cmp(want) != 0 {
+				t.Errorf("DecodeDecimal64BID(EncodeDecimal64BID(%q)) = %q, want %q", d, got, want)
+			}
+		},
+	)
+}
+
+func FuzzDecimal128_BID_DPD(f *testing.F) {
+	for _, d := range corpus {
+		f.Add(d.neg, d.scale, d.coef)
+	}
+
+	f.Fuzz(
+		func(t *testing.T, neg bool, scale int, coef uint64) {
+			d, err := newSafe(neg, fint(coef), scale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			got, err := DecodeDecimal128BID(EncodeDecimal128BID(d))
+			if err != nil {
+				t.Errorf("DecodeDecimal128BID(EncodeDecimal128BID(%q)) failed: %v", d, err)
+				return
+			}
+			if got != d {
+				t.Errorf("DecodeDecimal128BID(EncodeDecimal128BID(%q)) = %q", d, got)
+			}
+
+			got, err = DecodeDecimal128DPD(EncodeDecimal128DPD(d))
+			if err != nil {
+				t.Errorf("DecodeDecimal128DPD(EncodeDecimal128DPD(%q)) failed: %v", d, err)
+				return
+			}
+			if got != d {
+				t.Errorf("DecodeDecimal128DPD(EncodeDecimal128DPD(%q)) = %q", d, got)
+			}
+		},
+	)
+}
@@ -2,7 +2,10 @@ package decimal
 
 import (
 	"fmt"
+	"math"
 	"math/big"
+	"math/bits"
+	"strconv"
 	"sync"
 )
 
@@ -183,6 +186,122 @@ func (x fint) rshDown(shift int) fint {
 	return x / y
 }
 
+// rshHalfUp (Right Shift) calculates round(x / 10^shift) and rounds result
+// using "half away from zero" rule.
+func (x fint) rshHalfUp(shift int) fint {
+	// Special cases
+	switch {
+	case x == 0:
+		return 0
+	case shift <= 0:
+		return x
+	case shift >= len(pow10):
+		return 0
+	}
+	// General case
+	y := pow10[shift]
+	z := x / y
+	r := x - z*y // r = x % y
+	y = y >> 1   // y = y / 2, which is safe as y is a multiple of 10
+	if y <= r {  // half-away-from-zero
+		z++
+	}
+	return z
+}
+
+// rshHalfDown (Right Shift) calculates round(x / 10^shift) and rounds result
+// using "half towards zero" rule.
+func (x fint) rshHalfDown(shift int) fint {
+	// Special cases
+	switch {
+	case x == 0:
+		return 0
+	case shift <= 0:
+		return x
+	case shift >= len(pow10):
+		return 0
+	}
+	// General case
+	y := pow10[shift]
+	z := x / y
+	r := x - z*y // r = x % y
+	y = y >> 1   // y = y / 2, which is safe as y is a multiple of 10
+	if y < r {   // half-towards-zero
+		z++
+	}
+	return z
+}
+
+// rsh05Up (Right Shift) calculates ⌊x / 10^shift⌋ and rounds result away
+// from zero only if the last digit of the result is 0 or 5.
+func (x fint) rsh05Up(shift int) fint {
+	// Special cases
+	switch {
+	case x == 0:
+		return 0
+	case shift <= 0:
+		return x
+	case shift >= len(pow10):
+		return 1
+	}
+	// General case
+	y := pow10[shift]
+	z := x / y
+	r := x - z*y // r = x % y
+	if r > 0 && z%5 == 0 {
+		z++
+	}
+	return z
+}
+
+// rshMode (Right Shift) calculates round(x / 10^shift) and rounds result
+// using the given rounding mode.
+// Argument neg indicates whether x is the coefficient of a negative number,
+// which is required by the directed rounding modes.
+func (x fint) rshMode(shift int, mode RoundingMode, neg bool) fint {
+	switch mode {
+	case HalfUp:
+		return x.rshHalfUp(shift)
+	case HalfDown:
+		return x.rshHalfDown(shift)
+	case Up:
+		return x.rshUp(shift)
+	case Down:
+		return x.rshDown(shift)
+	case Ceiling:
+		if neg {
+			return x.rshDown(shift)
+		}
+		return x.rshUp(shift)
+	case Floor:
+		if neg {
+			return x.rshUp(shift)
+		}
+		return x.rshDown(shift)
+	case ZeroFiveUp:
+		return x.rsh05Up(shift)
+	}
+	return x.rshHalfEven(shift)
+}
+
+// rshModeInexact is similar to rshMode, but it also reports whether
+// any of the discarded digits is not zero.
+func (x fint) rshModeInexact(shift int, mode RoundingMode, neg bool) (fint, bool) {
+	z := x.rshMode(shift, mode, neg)
+	// Special cases
+	switch {
+	case x == 0 || shift <= 0:
+		return z, false
+	case shift >= len(pow10):
+		return z, true
+	}
+	// General case
+	// The rounded quotient differs from x / 10^shift by less than one,
+	// so z * 10^shift is equal to x, even if the multiplication wraps around,
+	// only if all discarded digits are zeros.
+	return z, z*pow10[shift] != x
+}
+
 // prec returns length of x in decimal digits.
 // prec assumes that 0 has no digits.
 func (x fint) prec() int {
@@ -229,6 +348,372 @@ func (x fint) hasPrec(prec int) bool {
 	return x >= pow10[prec-1]
 }
 
+// dint (Double INTeger) is a 128-bit unsigned integer made of two uint64 words.
+type dint struct {
+	hi, lo uint64
+}
+
+// maxDint is a maximum value of dint, which is equal to 10^38 - 1.
+var maxDint = dint{hi: 0x4b3b4ca85a86c47a, lo: 0x098a223fffffffff}
+
+// dpow10 is a cache of powers of 10, where dpow10[x] = 10^x.
+var dpow10 = func() [39]dint {
+	var p [39]dint
+	p[0] = dint{lo: 1}
+	for i := 1; i < len(p); i++ {
+		hi, lo := bits.Mul64(p[i-1].lo, 10)
+		p[i] = dint{hi: p[i-1].hi*10 + hi, lo: lo}
+	}
+	return p
+}()
+
+func (x dint) isZero() bool {
+	return x.hi == 0 && x.lo == 0
+}
+
+func (x dint) isOdd() bool {
+	return x.lo&1 != 0
+}
+
+func (x dint) cmp(y dint) int {
+	switch {
+	case x.hi < y.hi:
+		return -1
+	case x.hi > y.hi:
+		return 1
+	case x.lo < y.lo:
+		return -1
+	case x.lo > y.lo:
+		return 1
+	}
+	return 0
+}
+
+// add calculates x + y and checks overflow.
+func (x dint) add(y dint) (z dint, ok bool) {
+	var carry uint64
+	z.lo, carry = bits.Add64(x.lo, y.lo, 0)
+	z.hi, carry = bits.Add64(x.hi, y.hi, carry)
+	if carry != 0 || z.cmp(maxDint) > 0 {
+		return dint{}, false
+	}
+	return z, true
+}
+
+// subAbs calculates |x - y|.
+func (x dint) subAbs(y dint) (z dint) {
+	if x.cmp(y) < 0 {
+		x, y = y, x
+	}
+	var borrow uint64
+	z.lo, borrow = bits.Sub64(x.lo, y.lo, 0)
+	z.hi, _ = bits.Sub64(x.hi, y.hi, borrow)
+	return z
+}
+
+// mul calculates x * y and checks overflow.
+func (x dint) mul(y dint) (z dint, ok bool) {
+	if x.hi != 0 && y.hi != 0 {
+		return dint{}, false
+	}
+	if x.hi != 0 {
+		x, y = y, x
+	}
+	// Compute z = x.lo * y
+	hi, lo := bits.Mul64(x.lo, y.lo)
+	carry, cross := bits.Mul64(x.lo, y.hi)
+	if carry != 0 {
+		return dint{}, false
+	}
+	z.lo = lo
+	z.hi, carry = bits.Add64(hi, cross, 0)
+	if carry != 0 || z.cmp(maxDint) > 0 {
+		return dint{}, false
+	}
+	return z, true
+}
+
+// quoRem64 calculates q = ⌊x / y⌋, r = x - y * q.
+// quoRem64 assumes that y is not 0.
+func (x dint) quoRem64(y uint64) (q dint, r uint64) {
+	q.hi, r = x.hi/y, x.hi%y
+	q.lo, r = bits.Div64(r, x.lo, y)
+	return q, r
+}
+
+// quo calculates x / y and checks division by zero and inexact division.
+// quo does not support divisors that do not fit into uint64.
+func (x dint) quo(y dint) (z dint, ok bool) {
+	if y.hi != 0 || y.lo == 0 {
+		return dint{}, false
+	}
+	z, r := x.quoRem64(y.lo)
+	if r != 0 {
+		return dint{}, false
+	}
+	return z, true
+}
+
+// lsh (Left Shift) calculates x * 10^shift and checks overflow.
+func (x dint) lsh(shift int) (z dint, ok bool) {
+	// Special cases
+	switch {
+	case shift <= 0:
+		return x, true
+	case shift >= len(dpow10):
+		return dint{}, false
+	}
+	// General case
+	return x.mul(dpow10[shift])
+}
+
+// fsa (Fused Shift and Addition) calculates x * 10^shift + b and checks overflow.
+func (x dint) fsa(shift int, b byte) (z dint, ok bool) {
+	z, ok = x.lsh(shift)
+	if !ok {
+		return dint{}, false
+	}
+	return z.add(dint{lo: uint64(b)})
+}
+
+// hlf calculates x / 2.
+func (x dint) hlf() dint {
+	return dint{hi: x.hi >> 1, lo: x.lo>>1 | x.hi<<63}
+}
+
+// quoRemPow10 calculates q = ⌊x / 10^shift⌋, r = x - 10^shift * q.
+// quoRemPow10 assumes that 0 < shift < len(dpow10).
+func (x dint) quoRemPow10(shift int) (q, r dint) {
+	if shift < len(pow10) {
+		q, r.lo = x.quoRem64(uint64(pow10[shift]))
+		return q, r
+	}
+	// 10^shift does not fit into uint64, so the division is
+	// performed in two steps.
+	y := uint64(pow10[len(pow10)-1])
+	q, lo := x.quoRem64(y)
+	q, hi := q.quoRem64(uint64(pow10[shift-len(pow10)+1]))
+	r, _ = dint{lo: hi}.mul(dint{lo: y}) // Cannot overflow as r < 10^shift
+	r, _ = r.add(dint{lo: lo})
+	return q, r
+}
+
+// rshHalfEven (Right Shift) calculates round(x / 10^shift) and rounds result
+// using "half to even" rule.
+func (x dint) rshHalfEven(shift int) dint {
+	// Special cases
+	switch {
+	case x.isZero():
+		return dint{}
+	case shift <= 0:
+		return x
+	case shift >= len(dpow10):
+		return dint{}
+	}
+	// General case
+	z, r := x.quoRemPow10(shift)
+	y := dpow10[shift].hlf() // y = 10^shift / 2
+	c := y.cmp(r)
+	if c < 0 || (c == 0 && z.isOdd()) { // half-to-even
+		z, _ = z.add(dint{lo: 1}) // Cannot overflow as z is at most maxDint / 10
+	}
+	return z
+}
+
+// rshUp (Right Shift) calculates ⌈x / 10^shift⌉ and rounds result away from zero.
+func (x dint) rshUp(shift int) dint {
+	// Special cases
+	switch {
+	case x.isZero():
+		return dint{}
+	case shift <= 0:
+		return x
+	case shift >= len(dpow10):
+		return dint{lo: 1}
+	}
+	// General case
+	z, r := x.quoRemPow10(shift)
+	if !r.isZero() {
+		z, _ = z.add(dint{lo: 1})
+	}
+	return z
+}
+
+// rshDown (Right Shift) calculates ⌊x / 10^shift⌋ and rounds result towards zero.
+func (x dint) rshDown(shift int) dint {
+	// Special cases
+	switch {
+	case x.isZero():
+		return dint{}
+	case shift <= 0:
+		return x
+	case shift >= len(dpow10):
+		return dint{}
+	}
+	// General case
+	z, _ := x.quoRemPow10(shift)
+	return z
+}
+
+// rshHalfUp (Right Shift) calculates round(x / 10^shift) and rounds result
+// using "half away from zero" rule.
+func (x dint) rshHalfUp(shift int) dint {
+	// Special cases
+	switch {
+	case x.isZero():
+		return dint{}
+	case shift <= 0:
+		return x
+	case shift >= len(dpow10):
+		return dint{}
+	}
+	// General case
+	z, r := x.quoRemPow10(shift)
+	y := dpow10[shift].hlf() // y = 10^shift / 2
+	if y.cmp(r) <= 0 {       // half-away-from-zero
+		z, _ = z.add(dint{lo: 1})
+	}
+	return z
+}
+
+// rshHalfDown (Right Shift) calculates round(x / 10^shift) and rounds result
+// using "half towards zero" rule.
+func (x dint) rshHalfDown(shift int) dint {
+	// Special cases
+	switch {
+	case x.isZero():
+		return dint{}
+	case shift <= 0:
+		return x
+	case shift >= len(dpow10):
+		return dint{}
+	}
+	// General case
+	z, r := x.quoRemPow10(shift)
+	y := dpow10[shift].hlf() // y = 10^shift / 2
+	if y.cmp(r) < 0 {        // half-towards-zero
+		z, _ = z.add(dint{lo: 1})
+	}
+	return z
+}
+
+// rsh05Up (Right Shift) calculates ⌊x / 10^shift⌋ and rounds result away
+// from zero only if the last digit of the result is 0 or 5.
+func (x dint) rsh05Up(shift int) dint {
+	// Special cases
+	switch {
+	case x.isZero():
+		return dint{}
+	case shift <= 0:
+		return x
+	case shift >= len(dpow10):
+		return dint{lo: 1}
+	}
+	// General case
+	z, r := x.quoRemPow10(shift)
+	if !r.isZero() && (z.hi%5+z.lo%5)%5 == 0 { // 2^64 is 1 modulo 5
+		z, _ = z.add(dint{lo: 1})
+	}
+	return z
+}
+
+// rshMode (Right Shift) calculates round(x / 10^shift) and rounds result
+// using the given rounding mode.
+// Argument neg indicates whether x is the coefficient of a negative number,
+// which is required by the directed rounding modes.
+func (x dint) rshMode(shift int, mode RoundingMode, neg bool) dint {
+	switch mode {
+	case HalfUp:
+		return x.rshHalfUp(shift)
+	case HalfDown:
+		return x.rshHalfDown(shift)
+	case Up:
+		return x.rshUp(shift)
+	case Down:
+		return x.rshDown(shift)
+	case Ceiling:
+		if neg {
+			return x.rshDown(shift)
+		}
+		return x.rshUp(shift)
+	case Floor:
+		if neg {
+			return x.rshUp(shift)
+		}
+		return x.rshDown(shift)
+	case ZeroFiveUp:
+		return x.rsh05Up(shift)
+	}
+	return x.rshHalfEven(shift)
+}
+
+// prec returns length of x in decimal digits.
+// prec assumes that 0 has no digits.
+func (x dint) prec() int {
+	left, right := 0, len(dpow10)
+	for left < right {
+		mid := (left + right) / 2
+		if x.cmp(dpow10[mid]) < 0 {
+			right = mid
+		} else {
+			left = mid + 1
+		}
+	}
+	return left
+}
+
+// ntz returns number of trailing zeros in x.
+// ntz assumes that 0 has no trailing zeros.
+func (x dint) ntz() int {
+	if x.isZero() {
+		return 0
+	}
+	var n int
+	for {
+		q, r := x.quoRem64(10)
+		if r != 0 {
+			return n
+		}
+		x = q
+		n++
+	}
+}
+
+// hasPrec returns true if x has given number of digits or more.
+// hasPrec assumes that 0 has no digits.
+func (x dint) hasPrec(prec int) bool {
+	// Special cases
+	switch {
+	case prec < 1:
+		return true
+	case prec > len(dpow10):
+		return false
+	}
+	// General case
+	return x.cmp(dpow10[prec-1]) >= 0
+}
+
+// appendDigits appends the decimal digits of x to b.
+// appendDigits assumes that 0 has no digits.
+func (x dint) appendDigits(b []byte) []byte {
+	const width = 19 // number of digits in the lower part
+	if x.isZero() {
+		return b
+	}
+	q, r := x.quoRem64(uint64(pow10[width]))
+	if q.isZero() {
+		return strconv.AppendUint(b, r, 10)
+	}
+	b = strconv.AppendUint(b, q.lo, 10) // q < 10^19 fits into uint64
+	var buf [width]byte
+	digits := strconv.AppendUint(buf[:0], r, 10)
+	for range width - len(digits) {
+		b = append(b, '0')
+	}
+	b = append(b, digits...)
+	return b
+}
+
 // bint (Big INTeger) is a wrapper around big.Int.
 type bint big.Int
 
@@ -498,6 +983,20 @@ var bnlog10 = [...]*bint{
 	mustParseBint("11282666955670823851688158127953384617245"),
 }
 
+// bnlog2 is the natural logarithm of 2, where bnlog2 = round(log(2) * 10^38).
+var bnlog2 = mustParseBint("69314718055994530941723212145817656808")
+
+// bpiScale is the number of digits after the decimal point in bpi.
+// The extra digits keep the argument reduction of trigonometric functions
+// accurate for decimals with 19 digits in the integer part.
+const bpiScale = 100
+
+// bpi is π with 100 digits after the decimal point, where bpi = round(π * 10^100).
+var bpi = mustParseBint("31415926535897932384626433832795028841971693993751058209749445923078164062862089986280348253421170680")
+
+// bpi4 is π with 4 * MaxScale digits after the decimal point, where bpi4 = ⌊π * 10^76⌋.
+var bpi4 = mustParseBint("31415926535897932384626433832795028841971693993751058209749445923078164062862")
+
 // mustParseBint converts a string to *big.Int, panicking on error.
 // Use only for package variable initialization and test code!
 func mustParseBint(s string) *bint {
@@ -535,6 +1034,39 @@ func (z *bint) setFint(x fint) {
 	(*big.Int)(z).SetUint64(uint64(x))
 }
 
+// setDecimal sets z to the signed coefficient of decimal d aligned to the given scale.
+// If the scale is less than the scale of d, the result is unpredictable.
+func (z *bint) setDecimal(d Decimal, scale int) {
+	z.setFint(d.coef)
+	z.lsh(z, scale-d.Scale())
+	if d.IsNeg() {
+		z.neg(z)
+	}
+}
+
+// sumDecimal sets z to the signed sum of the coefficients of decimals
+// aligned to the given scale.
+// If the scale is less than the scale of any decimal, the result is unpredictable.
+func (z *bint) sumDecimal(scale int, d ...Decimal) {
+	b := getBint()
+	defer putBint(b)
+	z.setFint(0)
+	for _, f := range d {
+		b.setDecimal(f, scale)
+		z.add(z, b)
+	}
+}
+
+func (z *bint) isInt64() bool {
+	return (*big.Int)(z).IsInt64()
+}
+
+// int64 converts *big.Int to int64.
+// If z cannot be represented as int64, the result is undefined.
+func (z *bint) int64() int64 {
+	return (*big.Int)(z).Int64()
+}
+
 // fint converts *big.Int to uint64.
 // If z cannot be represented as uint64, the result is undefined.
 func (z *bint) fint() fint {
@@ -542,11 +1074,37 @@ func (z *bint) fint() fint {
 	return fint(f)
 }
 
+func (z *bint) setDint(x dint) {
+	(*big.Int)(z).SetUint64(x.hi)
+	(*big.Int)(z).Lsh((*big.Int)(z), 64)
+	(*big.Int)(z).Or((*big.Int)(z), new(big.Int).SetUint64(x.lo))
+}
+
+// dint converts *big.Int to dint.
+// If z cannot be represented as dint, the result is undefined.
+func (z *bint) dint() dint {
+	var x, y big.Int
+	x.Rsh((*big.Int)(z), 64)
+	y.SetUint64(math.MaxUint64)
+	y.And((*big.Int)(z), &y)
+	return dint{hi: x.Uint64(), lo: y.Uint64()}
+}
+
 // add calculates z = x + y.
 func (z *bint) add(x, y *bint) {
 	(*big.Int)(z).Add((*big.Int)(x), (*big.Int)(y))
 }
 
+// neg calculates z = -x.
+func (z *bint) neg(x *bint) {
+	(*big.Int)(z).Neg((*big.Int)(x))
+}
+
+// abs calculates z = |x|.
+func (z *bint) abs(x *bint) {
+	(*big.Int)(z).Abs((*big.Int)(x))
+}
+
 // inc calcualtes z = x + 1.
 func (z *bint) inc(x *bint) {
 	y := bpow10[0]
@@ -596,12 +1154,27 @@ func (z *bint) mul(x, y *bint) {
 	(*big.Int)(z).Mul((*big.Int)(x), (*big.Int)(y))
 }
 
+// mulInt64 calculates z = x * y and returns z.
+func (z *bint) mulInt64(x *bint, y int64) *bint {
+	b := getBint()
+	defer putBint(b)
+	b.setInt64(y)
+	z.mul(x, b)
+	return z
+}
+
 // exp calculates z = x^y.
 // If y is negative, the result is unpredictable.
 func (z *bint) exp(x, y *bint) {
 	(*big.Int)(z).Exp((*big.Int)(x), (*big.Int)(y), nil)
 }
 
+// sqrt calculates z = ⌊√x⌋.
+// If x is negative, the method panics.
+func (z *bint) sqrt(x *bint) {
+	(*big.Int)(z).Sqrt((*big.Int)(x))
+}
+
 // pow10 calculates z = 10^power.
 // If power is negative, the result is unpredictable.
 func (z *bint) pow10(power int) {
@@ -631,6 +1204,29 @@ func (z *bint) isOdd() bool {
 	return (*big.Int)(z).Bit(0) != 0
 }
 
+// sticky returns 1 if remainder r is not zero and 0 otherwise.
+// It is used as an extra least significant digit of a truncated quotient
+// to ensure that the quotient is rounded correctly in all rounding modes.
+func sticky(r *bint) fint {
+	if r.sign() != 0 {
+		return 1
+	}
+	return 0
+}
+
+// isMul5 reports whether z is a multiple of 5, that is, whether the last
+// digit of z is 0 or 5.
+// If z is negative, the result is unpredictable.
+func (z *bint) isMul5() bool {
+	// Both 2^32 and 2^64 are 1 modulo 5,
+	// so z % 5 is equal to the sum of its words modulo 5.
+	var m big.Word
+	for _, w := range (*big.Int)(z).Bits() {
+		m += w % 5
+	}
+	return m%5 == 0
+}
+
 // lsh (Left Shift) calculates z = x * 10^shift.
 func (z *bint) lsh(x *bint, shift int) {
 	var y *bint
@@ -646,10 +1242,12 @@ func (z *bint) lsh(x *bint, shift int) {
 
 // fsa (Fused Shift and Addition) calculates z = x * 10^shift + f.
 func (z *bint) fsa(x *bint, shift int, f fint) {
+	// Shifting x into a separate variable prevents copying x,
+	// if z and x are the same variable.
 	y := getBint()
 	defer putBint(y)
-	y.setFint(f)
-	z.lsh(x, shift)
+	y.lsh(x, shift)
+	z.setFint(f)
 	z.add(z, y)
 }
 
@@ -677,17 +1275,18 @@ func (z *bint) rshDown(x *bint, shift int) {
 	z.quo(x, y)
 }
 
-// rshHalfEven (Right Shift) calculates z = round(x / 10^shift) and
-// rounds result using "half to even" rule.
-func (z *bint) rshHalfEven(x *bint, shift int) {
+// rshDownInexact (Right Shift) calculates z = ⌊x / 10^shift⌋, rounds
+// result towards zero, and reports whether any of the discarded digits
+// is not zero.
+func (z *bint) rshDownInexact(x *bint, shift int) bool {
 	// Special cases
 	switch {
 	case x.sign() == 0:
 		z.setFint(0)
-		return
+		return false
 	case shift <= 0:
 		z.setBint(x)
-		return
+		return false
 	}
 	// General case
 	var y, r *bint
@@ -701,6 +1300,35 @@ func (z *bint) rshHalfEven(x *bint, shift int) {
 		y.pow10(shift)
 	}
 	z.quoRem(x, y, r)
+	return r.sign() != 0
+}
+
+// rshHalfEven (Right Shift) calculates z = round(x / 10^shift),
+// rounds result using "half to even" rule, and reports whether
+// any of the discarded digits is not zero.
+func (z *bint) rshHalfEven(x *bint, shift int) bool {
+	// Special cases
+	switch {
+	case x.sign() == 0:
+		z.setFint(0)
+		return false
+	case shift <= 0:
+		z.setBint(x)
+		return false
+	}
+	// General case
+	var y, r *bint
+	r = getBint()
+	defer putBint(r)
+	if shift < len(bpow10) {
+		y = bpow10[shift]
+	} else {
+		y = getBint()
+		defer putBint(y)
+		y.pow10(shift)
+	}
+	z.quoRem(x, y, r)
+	inexact := r.sign() != 0
 	r.dbl(r) // r = r * 2
 	switch y.cmp(r) {
 	case -1:
@@ -711,6 +1339,170 @@ func (z *bint) rshHalfEven(x *bint, shift int) {
 			z.inc(z) // z = z + 1
 		}
 	}
+	return inexact
+}
+
+// rshUp (Right Shift) calculates z = ⌈x / 10^shift⌉, rounds
+// result away from zero, and reports whether any of the discarded
+// digits is not zero.
+func (z *bint) rshUp(x *bint, shift int) bool {
+	// Special cases
+	switch {
+	case x.sign() == 0:
+		z.setFint(0)
+		return false
+	case shift <= 0:
+		z.setBint(x)
+		return false
+	}
+	// General case
+	var y, r *bint
+	r = getBint()
+	defer putBint(r)
+	if shift < len(bpow10) {
+		y = bpow10[shift]
+	} else {
+		y = getBint()
+		defer putBint(y)
+		y.pow10(shift)
+	}
+	z.quoRem(x, y, r)
+	if r.sign() == 0 {
+		return false
+	}
+	z.inc(z) // z = z + 1
+	return true
+}
+
+// rshHalfUp (Right Shift) calculates z = round(x / 10^shift),
+// rounds result using "half away from zero" rule, and reports whether
+// any of the discarded digits is not zero.
+func (z *bint) rshHalfUp(x *bint, shift int) bool {
+	// Special cases
+	switch {
+	case x.sign() == 0:
+		z.setFint(0)
+		return false
+	case shift <= 0:
+		z.setBint(x)
+		return false
+	}
+	// General case
+	var y, r *bint
+	r = getBint()
+	defer putBint(r)
+	if shift < len(bpow10) {
+		y = bpow10[shift]
+	} else {
+		y = getBint()
+		defer putBint(y)
+		y.pow10(shift)
+	}
+	z.quoRem(x, y, r)
+	inexact := r.sign() != 0
+	r.dbl(r) // r = r * 2
+	if y.cmp(r) <= 0 {
+		z.inc(z) // z = z + 1
+	}
+	return inexact
+}
+
+// rshHalfDown (Right Shift) calculates z = round(x / 10^shift),
+// rounds result using "half towards zero" rule, and reports whether
+// any of the discarded digits is not zero.
+func (z *bint) rshHalfDown(x *bint, shift int) bool {
+	// Special cases
+	switch {
+	case x.sign() == 0:
+		z.setFint(0)
+		return false
+	case shift <= 0:
+		z.setBint(x)
+		return false
+	}
+	// General case
+	var y, r *bint
+	r = getBint()
+	defer putBint(r)
+	if shift < len(bpow10) {
+		y = bpow10[shift]
+	} else {
+		y = getBint()
+		defer putBint(y)
+		y.pow10(shift)
+	}
+	z.quoRem(x, y, r)
+	inexact := r.sign() != 0
+	r.dbl(r) // r = r * 2
+	if y.cmp(r) < 0 {
+		z.inc(z) // z = z + 1
+	}
+	return inexact
+}
+
+// rsh05Up (Right Shift) calculates z = ⌊x / 10^shift⌋, rounds
+// result away from zero only if the last digit of the result is 0 or 5,
+// and reports whether any of the discarded digits is not zero.
+func (z *bint) rsh05Up(x *bint, shift int) bool {
+	// Special cases
+	switch {
+	case x.sign() == 0:
+		z.setFint(0)
+		return false
+	case shift <= 0:
+		z.setBint(x)
+		return false
+	}
+	// General case
+	var y, r *bint
+	r = getBint()
+	defer putBint(r)
+	if shift < len(bpow10) {
+		y = bpow10[shift]
+	} else {
+		y = getBint()
+		defer putBint(y)
+		y.pow10(shift)
+	}
+	z.quoRem(x, y, r)
+	if r.sign() == 0 {
+		return false
+	}
+	if z.isMul5() {
+		z.inc(z) // z = z + 1
+	}
+	return true
+}
+
+// rshMode (Right Shift) calculates z = round(x / 10^shift),
+// rounds result using the given rounding mode, and reports whether
+// any of the discarded digits is not zero.
+// Argument neg indicates whether x is the coefficient of a negative number,
+// which is required by the directed rounding modes.
+func (z *bint) rshMode(x *bint, shift int, mode RoundingMode, neg bool) bool {
+	switch mode {
+	case HalfUp:
+		return z.rshHalfUp(x, shift)
+	case HalfDown:
+		return z.rshHalfDown(x, shift)
+	case Up:
+		return z.rshUp(x, shift)
+	case Down:
+		return z.rshDownInexact(x, shift)
+	case Ceiling:
+		if neg {
+			return z.rshDownInexact(x, shift)
+		}
+		return z.rshUp(x, shift)
+	case Floor:
+		if neg {
+			return z.rshUp(x, shift)
+		}
+		return z.rshDownInexact(x, shift)
+	case ZeroFiveUp:
+		return z.rsh05Up(x, shift)
+	}
+	return z.rshHalfEven(x, shift)
 }
 
 // prec returns length of z in decimal digits.
@@ -737,6 +1529,31 @@ func (z *bint) prec() int {
 	return left
 }
 
+// ntz returns the number of trailing zeros in z.
+// ntz assumes that 0 has no trailing zeros.
+// If z is negative, the result is unpredictable.
+func (z *bint) ntz() int {
+	if z.sign() == 0 {
+		return 0
+	}
+	x := getBint()
+	defer putBint(x)
+	q := getBint()
+	defer putBint(q)
+	r := getBint()
+	defer putBint(r)
+	x.setBint(z)
+	var n int
+	for {
+		q.quoRem(x, bpow10[1], r)
+		if r.sign() != 0 {
+			return n
+		}
+		x.setBint(q)
+		n++
+	}
+}
+
 // hasPrec checks if z has a given number of digits or more.
 // hasPrec assumes that 0 has no digits.
 // If z is negative, the result is unpredictable.
@@ -755,6 +1572,39 @@ func (z *bint) hasPrec(prec int) bool {
 	return z.cmp(bpow10[prec-1]) >= 0
 }
 
+// twos returns the big-endian two's complement representation of z
+// with the minimum number of bytes, sign-extended to at least size bytes.
+func (z *bint) twos(size int) []byte {
+	x := getBint()
+	defer putBint(x)
+	neg := z.sign() < 0
+	if neg {
+		(*big.Int)(x).Not((*big.Int)(z)) // -z - 1
+	} else {
+		x.setBint(z)
+	}
+	n := max((*big.Int)(x).BitLen()/8+1, size)
+	b := (*big.Int)(x).FillBytes(make([]byte, n))
+	if neg {
+		for i := range b {
+			b[i] = ^b[i]
+		}
+	}
+	return b
+}
+
+// setTwos sets z to the integer represented by the big-endian two's complement
+// byte slice b. If b is empty, z is set to zero.
+func (z *bint) setTwos(b []byte) {
+	(*big.Int)(z).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		y := getBint()
+		defer putBint(y)
+		(*big.Int)(y).Lsh(big.NewInt(1), uint(8*len(b))) //nolint:gosec
+		z.sub(z, y)
+	}
+}
+
 // bpool is a cache of reusable *big.Int instances.
 var bpool = sync.Pool{
 	New: func() any {
@@ -1,7 +1,9 @@
 package decimal
 
 import (
+	"fmt"
 	"math"
+	"strings"
 	"testing"
 )
 
@@ -350,6 +352,86 @@ func TestFint_rshDown(t *testing.T) {
 	}
 }
 
+func TestFint_rshMode(t *testing.T) {
+	cases := []struct {
+		x     fint
+		shift int
+		mode  RoundingMode
+		neg   bool
+		want  fint
+	}{
+		// Negative shift
+		{1, -1, HalfUp, false, 1},
+		{1, -1, ZeroFiveUp, false, 1},
+
+		// Half away from zero
+		{15, 1, HalfUp, false, 2},
+		{25, 1, HalfUp, false, 3},
+		{14, 1, HalfUp, false, 1},
+		{25, 1, HalfUp, true, 3},
+		{5_000_000_000_000_000_000, 19, HalfUp, false, 1},
+		{4_999_999_999_999_999_999, 19, HalfUp, false, 0},
+		{maxFint, 20, HalfUp, false, 0},
+
+		// Half towards zero
+		{15, 1, HalfDown, false, 1},
+		{25, 1, HalfDown, false, 2},
+		{16, 1, HalfDown, false, 2},
+		{25, 1, HalfDown, true, 2},
+		{5_000_000_000_000_000_000, 19, HalfDown, false, 0},
+		{5_000_000_000_000_000_001, 19, HalfDown, false, 1},
+		{maxFint, 20, HalfDown, false, 0},
+
+		// Half to even
+		{15, 1, HalfEven, false, 2},
+		{25, 1, HalfEven, false, 2},
+		{25, 1, HalfEven, true, 2},
+
+		// Away from zero
+		{11, 1, Up, false, 2},
+		{11, 1, Up, true, 2},
+		{10, 1, Up, false, 1},
+		{maxFint, 20, Up, false, 1},
+
+		// Towards zero
+		{19, 1, Down, false, 1},
+		{19, 1, Down, true, 1},
+		{maxFint, 20, Down, false, 0},
+
+		// Towards positive infinity
+		{11, 1, Ceiling, false, 2},
+		{11, 1, Ceiling, true, 1},
+		{1, 20, Ceiling, false, 1},
+		{1, 20, Ceiling, true, 0},
+
+		// Towards negative infinity
+		{11, 1, Floor, false, 1},
+		{11, 1, Floor, true, 2},
+		{1, 20, Floor, false, 0},
+		{1, 20, Floor, true, 1},
+
+		// Zero or five away from zero
+		{11, 1, ZeroFiveUp, false, 1},
+		{19, 1, ZeroFiveUp, false, 1},
+		{51, 1, ZeroFiveUp, false, 6},
+		{59, 1, ZeroFiveUp, true, 6},
+		{101, 1, ZeroFiveUp, false, 11},
+		{100, 1, ZeroFiveUp, false, 10},
+		{1, 1, ZeroFiveUp, false, 1},
+		{1, 20, ZeroFiveUp, false, 1},
+
+		// Invalid mode
+		{25, 1, RoundingMode(-1), false, 2},
+		{35, 1, RoundingMode(100), false, 4},
+	}
+	for _, tt := range cases {
+		got := tt.x.rshMode(tt.shift, tt.mode, tt.neg)
+		if got != tt.want {
+			t.Errorf("%v.rshMode(%v, %v, %v) = %v, want %v", tt.x, tt.shift, tt.mode, tt.neg, got, tt.want)
+		}
+	}
+}
+
 func TestFint_prec(t *testing.T) {
 	cases := []struct {
 		x    fint
@@ -527,6 +609,266 @@ func TestFint_hasPrec(t *testing.T) {
 	}
 }
 
+func TestDint_add(t *testing.T) {
+	cases := []struct {
+		x, y, wantCoef dint
+		wantOk         bool
+	}{
+		{dint{}, dint{}, dint{}, true},
+		{dint{lo: math.MaxUint64}, dint{lo: 1}, dint{hi: 1}, true},
+		{dint{hi: 1, lo: math.MaxUint64}, dint{lo: 1}, dint{hi: 2}, true},
+		{maxDint, dint{}, maxDint, true},
+		{maxDint, dint{lo: 1}, dint{}, false},
+		{dint{hi: math.MaxUint64}, dint{hi: 1}, dint{}, false},
+	}
+	for _, tt := range cases {
+		x, y := tt.x, tt.y
+		gotCoef, gotOk := x.add(y)
+		if gotCoef != tt.wantCoef || gotOk != tt.wantOk {
+			t.Errorf("%v.add(%v) = %v, %v, want %v, %v", x, y, gotCoef, gotOk, tt.wantCoef, tt.wantOk)
+		}
+	}
+}
+
+func TestDint_subAbs(t *testing.T) {
+	cases := []struct {
+		x, y, want dint
+	}{
+		{dint{}, dint{}, dint{}},
+		{dint{hi: 1}, dint{lo: 1}, dint{lo: math.MaxUint64}},
+		{dint{lo: 1}, dint{hi: 1}, dint{lo: math.MaxUint64}},
+		{maxDint, maxDint, dint{}},
+	}
+	for _, tt := range cases {
+		got := tt.x.subAbs(tt.y)
+		if got != tt.want {
+			t.Errorf("%v.subAbs(%v) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func TestDint_mul(t *testing.T) {
+	cases := []struct {
+		x, y, wantCoef dint
+		wantOk         bool
+	}{
+		{dint{}, dint{}, dint{}, true},
+		{dint{lo: 10}, dint{lo: 10}, dint{lo: 100}, true},
+		{dint{lo: 1 << 32}, dint{lo: 1 << 32}, dint{hi: 1}, true},
+		{dint{hi: 1}, dint{lo: 10}, dint{hi: 10}, true},
+		{dint{lo: 10}, dint{hi: 1}, dint{hi: 10}, true},
+		{dint{hi: 1}, dint{hi: 1}, dint{}, false},
+		{maxDint, dint{lo: 1}, maxDint, true},
+		{maxDint, dint{lo: 2}, dint{}, false},
+		{dpow10[19], dpow10[19], dpow10[38], false},
+		{dpow10[19], dpow10[18], dpow10[37], true},
+	}
+	for _, tt := range cases {
+		x, y := tt.x, tt.y
+		gotCoef, gotOk := x.mul(y)
+		if gotCoef != tt.wantCoef && gotOk || gotOk != tt.wantOk {
+			t.Errorf("%v.mul(%v) = %v, %v, want %v, %v", x, y, gotCoef, gotOk, tt.wantCoef, tt.wantOk)
+		}
+	}
+}
+
+func TestDint_quo(t *testing.T) {
+	cases := []struct {
+		x, y, wantCoef dint
+		wantOk         bool
+	}{
+		{dint{lo: 1}, dint{}, dint{}, false},
+		{dint{lo: 1}, dint{lo: 1}, dint{lo: 1}, true},
+		{dint{lo: 20}, dint{lo: 4}, dint{lo: 5}, true},
+		{dint{lo: 20}, dint{lo: 3}, dint{}, false},
+		{dint{hi: 2}, dint{lo: 2}, dint{hi: 1}, true},
+		{dint{hi: 2}, dint{hi: 1}, dint{}, false},
+		{dpow10[38], dpow10[19], dpow10[19], true},
+	}
+	for _, tt := range cases {
+		x, y := tt.x, tt.y
+		gotCoef, gotOk := x.quo(y)
+		if gotCoef != tt.wantCoef || gotOk != tt.wantOk {
+			t.Errorf("%v.quo(%v) = %v, %v, want %v, %v", x, y, gotCoef, gotOk, tt.wantCoef, tt.wantOk)
+		}
+	}
+}
+
+func TestDint_lsh(t *testing.T) {
+	cases := []struct {
+		x        dint
+		shift    int
+		wantCoef dint
+		wantOk   bool
+	}{
+		{dint{}, 0, dint{}, true},
+		{dint{lo: 1}, -1, dint{lo: 1}, true},
+		{dint{lo: 1}, 1, dint{lo: 10}, true},
+		{dint{lo: 1}, 37, dpow10[37], true},
+		{dint{lo: 1}, 38, dint{}, false},
+		{dint{lo: 1}, 39, dint{}, false},
+		{dint{lo: 10}, 38, dint{}, false},
+		{dint{lo: maxFint}, 19, dint{hi: 0x4b3b4ca85a86c479, lo: 0x7ec2ff3b76180000}, true},
+	}
+	for _, tt := range cases {
+		gotCoef, gotOk := tt.x.lsh(tt.shift)
+		if gotCoef != tt.wantCoef || gotOk != tt.wantOk {
+			t.Errorf("%v.lsh(%v) = %v, %v, want %v, %v", tt.x, tt.shift, gotCoef, gotOk, tt.wantCoef, tt.wantOk)
+		}
+	}
+}
+
+func TestDint_rshMode(t *testing.T) {
+	t.Run("fint", func(t *testing.T) {
+		cases := []struct {
+			x     fint
+			shift int
+		}{
+			{0, 1},
+			{1, -1},
+			{1, 1},
+			{5, 1},
+			{15, 1},
+			{25, 1},
+			{51, 1},
+			{59, 1},
+			{100, 1},
+			{101, 1},
+			{5_000_000_000_000_000_000, 19},
+			{5_000_000_000_000_000_001, 19},
+			{4_999_999_999_999_999_999, 19},
+			{maxFint, 19},
+			{maxFint, 20},
+			{maxFint, 40},
+		}
+		for _, tt := range cases {
+			for _, mode := range []RoundingMode{HalfEven, HalfUp, HalfDown, Up, Down, Ceiling, Floor, ZeroFiveUp} {
+				for _, neg := range []bool{false, true} {
+					x := dint{lo: uint64(tt.x)}
+					got := x.rshMode(tt.shift, mode, neg)
+					want := dint{lo: uint64(tt.x.rshMode(tt.shift, mode, neg))}
+					if got != want {
+						t.Errorf("%v.rshMode(%v, %v, %v) = %v, want %v", x, tt.shift, mode, neg, got, want)
+					}
+				}
+			}
+		}
+	})
+
+	t.Run("bint", func(t *testing.T) {
+		cases := []struct {
+			x     string
+			shift int
+		}{
+			{"25000000000000000000000000000000000000", 37},
+			{"15000000000000000000000000000000000000", 37},
+			{"15000000000000000000000000000000000001", 37},
+			{"14999999999999999999999999999999999999", 37},
+			{"25000000000000000000000000000000000000", 38},
+			{"50000000000000000000000000000000000000", 38},
+			{"50000000000000000000000000000000000001", 38},
+			{"12345678901234567890500000000000000000", 20},
+			{"12345678901234567890500000000000000001", 20},
+			{"12345678901234567891500000000000000000", 20},
+			{"12345678901234567890499999999999999999", 20},
+			{"10000000000000000000000000000000000000", 19},
+			{"10000000000000000000000000000000000001", 19},
+			{"99999999999999999999999999999999999999", 1},
+			{"99999999999999999999999999999999999999", 38},
+			{"184467440737095516161", 1},
+			{"184467440737095516151", 1},
+		}
+		for _, tt := range cases {
+			for _, mode := range []RoundingMode{HalfEven, HalfUp, HalfDown, Up, Down, Ceiling, Floor, ZeroFiveUp} {
+				for _, neg := range []bool{false, true} {
+					b := mustParseBint(tt.x)
+					x := b.dint()
+					got := x.rshMode(tt.shift, mode, neg)
+					b.rshMode(b, tt.shift, mode, neg)
+					want := b.dint()
+					if got != want {
+						t.Errorf("%v.rshMode(%v, %v, %v) = %v, want %v", tt.x, tt.shift, mode, neg, got, want)
+					}
+				}
+			}
+		}
+	})
+}
+
+func TestDint_prec(t *testing.T) {
+	cases := []struct {
+		x    dint
+		want int
+	}{
+		{dint{}, 0},
+		{dint{lo: 1}, 1},
+		{dint{lo: 9}, 1},
+		{dint{lo: 10}, 2},
+		{dint{lo: maxFint}, 19},
+		{dint{lo: math.MaxUint64}, 20},
+		{dint{hi: 1}, 20},
+		{dpow10[37], 38},
+		{maxDint, 38},
+	}
+	for _, tt := range cases {
+		got := tt.x.prec()
+		if got != tt.want {
+			t.Errorf("%v.prec() = %v, want %v", tt.x, got, tt.want)
+		}
+		if !tt.x.hasPrec(tt.want) || tt.x.hasPrec(tt.want+1) {
+			t.Errorf("%v.hasPrec(%v) is inconsistent with %v.prec()", tt.x, tt.want, tt.x)
+		}
+	}
+}
+
+func TestDint_ntz(t *testing.T) {
+	cases := []struct {
+		x    dint
+		want int
+	}{
+		{dint{}, 0},
+		{dint{lo: 1}, 0},
+		{dint{lo: 10}, 1},
+		{dpow10[20], 20},
+		{dpow10[38], 38},
+		{maxDint, 0},
+	}
+	for _, tt := range cases {
+		got := tt.x.ntz()
+		if got != tt.want {
+			t.Errorf("%v.ntz() = %v, want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestDint_appendDigits(t *testing.T) {
+	cases := []string{
+		"1",
+		"9999999999999999999",
+		"10000000000000000000",
+		"10000000000000000001",
+		"18446744073709551616",
+		"12345678901234567890123456789012345678",
+		"99999999999999999999999999999999999999",
+	}
+	for _, tt := range cases {
+		x := mustParseBint(tt).dint()
+		got := string(x.appendDigits(nil))
+		if got != tt {
+			t.Errorf("%v.appendDigits() = %q, want %q", x, got, tt)
+		}
+		b := getBint()
+		b.setDint(x)
+		if b.string() != tt {
+			t.Errorf("setDint(%v) = %v, want %v", x, b.string(), tt)
+		}
+		putBint(b)
+	}
+	if got := string(dint{}.appendDigits(nil)); got != "" {
+		t.Errorf("dint{}.appendDigits() = %q, want %q", got, "")
+	}
+}
+
 func TestBint_rshDown(t *testing.T) {
 	cases := []struct {
 		z     string
@@ -611,6 +953,35 @@ func TestBint_rshDown(t *testing.T) {
 	}
 }
 
+func TestBint_rshDownInexact(t *testing.T) {
+	cases := []struct {
+		z           string
+		shift       int
+		want        string
+		wantInexact bool
+	}{
+		{"0", 0, "0", false},
+		{"0", 5, "0", false},
+		{"1", 0, "1", false},
+		{"1", 1, "0", true},
+		{"20", 1, "2", false},
+		{"21", 1, "2", true},
+		{"10000000000000000000", 19, "1", false},
+		{"10000000000000000001", 19, "1", true},
+		{"100000000000000000000000000000000000000000000000000", 50, "1", false},
+		{"100000000000000000000000000000000000000000000000001", 50, "1", true},
+		{"9999999999999999999", 100, "0", true},
+	}
+	for _, tt := range cases {
+		got := mustParseBint(tt.z)
+		gotInexact := got.rshDownInexact(got, tt.shift)
+		want := mustParseBint(tt.want)
+		if got.cmp(want) != 0 || gotInexact != tt.wantInexact {
+			t.Errorf("%v.rshDownInexact(%v) = [%v %v], want [%v %v]", tt.z, tt.shift, got, gotInexact, want, tt.wantInexact)
+		}
+	}
+}
+
 func TestBint_rshHalfEven(t *testing.T) {
 	cases := []struct {
 		z     string
@@ -695,6 +1066,58 @@ func TestBint_rshHalfEven(t *testing.T) {
 	}
 }
 
+func TestBint_rshMode(t *testing.T) {
+	modes := []RoundingMode{HalfEven, HalfUp, HalfDown, Up, Down, Ceiling, Floor, ZeroFiveUp}
+	values := []fint{
+		0, 1, 2, 5, 8, 10, 11, 15, 19, 25, 50, 51, 55, 59, 100, 101, 150, 999,
+		5_000_000_000_000_000_000,
+		5_000_000_000_000_000_001,
+		maxFint,
+		10_000_000_000_000_000_000,
+		15_000_000_000_000_000_000,
+		math.MaxUint64,
+	}
+	for _, mode := range modes {
+		for _, neg := range []bool{false, true} {
+			for _, x := range values {
+				for shift := range 22 {
+					want := x.rshMode(shift, mode, neg)
+					got := new(bint)
+					got.setFint(x)
+					got.rshMode(got, shift, mode, neg)
+					if got.fint() != want {
+						t.Errorf("%v.rshMode(%v, %v, %v) = %v, want %v", x, shift, mode, neg, got.string(), want)
+					}
+				}
+			}
+		}
+	}
+
+	t.Run("large shift", func(t *testing.T) {
+		cases := []struct {
+			z     string
+			shift int
+			mode  RoundingMode
+			want  string
+		}{
+			{"1", 100, Up, "1"},
+			{"1", 100, HalfUp, "0"},
+			{"1", 100, ZeroFiveUp, "1"},
+			{"5" + strings.Repeat("0", 100), 101, HalfUp, "1"},
+			{"5" + strings.Repeat("0", 100), 101, HalfDown, "0"},
+			{"5" + strings.Repeat("0", 99) + "1", 101, HalfDown, "1"},
+		}
+		for _, tt := range cases {
+			got := mustParseBint(tt.z)
+			got.rshMode(got, tt.shift, tt.mode, false)
+			want := mustParseBint(tt.want)
+			if got.cmp(want) != 0 {
+				t.Errorf("%v.rshMode(%v, %v) = %v, want %v", tt.z, tt.shift, tt.mode, got.string(), want.string())
+			}
+		}
+	})
+}
+
 func TestBint_lsh(t *testing.T) {
 	cases := []struct {
 		z     string
@@ -973,3 +1396,69 @@ func TestBint_hasPrec(t *testing.T) {
 		}
 	}
 }
+
+func TestBint_twos(t *testing.T) {
+	cases := []struct {
+		z    int64
+		size int
+		want string
+	}{
+		{0, 0, "00"},
+		{1, 0, "01"},
+		{127, 0, "7f"},
+		{128, 0, "0080"},
+		{255, 0, "00ff"},
+		{32768, 0, "008000"},
+		{-1, 0, "ff"},
+		{-128, 0, "80"},
+		{-129, 0, "ff7f"},
+		{-32768, 0, "8000"},
+		{-32769, 0, "ff7fff"},
+		{math.MaxInt64, 0, "7fffffffffffffff"},
+		{math.MinInt64, 0, "8000000000000000"},
+		{0, 4, "00000000"},
+		{128, 4, "00000080"},
+		{-1, 4, "ffffffff"},
+		{-129, 4, "ffffff7f"},
+		{-32769, 2, "ff7fff"},
+	}
+	for _, tt := range cases {
+		z := getBint()
+		z.setInt64(tt.z)
+		got := fmt.Sprintf("%x", z.twos(tt.size))
+		putBint(z)
+		if got != tt.want {
+			t.Errorf("%v.twos(%v) = %v, want %v", tt.z, tt.size, got, tt.want)
+		}
+	}
+}
+
+func TestBint_setTwos(t *testing.T) {
+	cases := []struct {
+		b    []byte
+		want int64
+	}{
+		{nil, 0},
+		{[]byte{0x00}, 0},
+		{[]byte{0x7f}, 127},
+		{[]byte{0x00, 0x80}, 128},
+		{[]byte{0x00, 0x00, 0x80}, 128},
+		{[]byte{0xff}, -1},
+		{[]byte{0xff, 0xff}, -1},
+		{[]byte{0x80}, -128},
+		{[]byte{0xff, 0x7f}, -129},
+		{[]byte{0x7f, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, math.MaxInt64},
+		{[]byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, math.MinInt64},
+	}
+	for _, tt := range cases {
+		got := getBint()
+		got.setTwos(tt.b)
+		want := getBint()
+		want.setInt64(tt.want)
+		if got.cmp(want) != 0 {
+			t.Errorf("setTwos(% x) = %v, want %v", tt.b, got, want)
+		}
+		putBint(got)
+		putBint(want)
+	}
+}
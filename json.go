@@ -0,0 +1,167 @@
+package decimal
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+)
+
+// JSONOptions determines how decimals are encoded to and decoded from JSON.
+//
+// The zero value is the default: decimals are encoded as JSON strings
+// with trailing zeros preserved, and JSON strings, JSON numbers, exponent
+// notation, and the JSON null value are all accepted when decoding.
+type JSONOptions struct {
+	// Number encodes decimals as JSON numbers instead of JSON strings.
+	// Note that many JSON decoders, including the ones in JavaScript,
+	// decode JSON numbers as float64 values, which cannot hold 19 digits.
+	Number bool
+	// Trim removes trailing zeros from the fractional part of decimals
+	// when encoding, see also method [Decimal.Trim].
+	Trim bool
+	// Strict rejects the following input when decoding:
+	//   - JSON numbers if Number is false, or JSON strings if Number is true;
+	//   - decimals in exponent notation, such as "1.23e5";
+	//   - the JSON null value.
+	Strict bool
+}
+
+// jsonOptions holds the package-level options.
+// A nil pointer means the zero options.
+var jsonOptions atomic.Pointer[JSONOptions]
+
+// SetJSONOptions sets the package-level options, which are used by
+// [Decimal.MarshalJSON] and [Decimal.UnmarshalJSON].
+// It is safe for concurrent use, but it is intended to be called once
+// during program initialization, since changing the options affects
+// all decimals in the program.
+func SetJSONOptions(opts JSONOptions) {
+	jsonOptions.Store(&opts)
+}
+
+// CurrentJSONOptions returns the package-level options set by [SetJSONOptions].
+func CurrentJSONOptions() JSONOptions {
+	if p := jsonOptions.Load(); p != nil {
+		return *p
+	}
+	return JSONOptions{}
+}
+
+// Marshal returns the JSON encoding of decimal d.
+func (o JSONOptions) Marshal(d Decimal) ([]byte, error) {
+	if o.Trim {
+		d = d.Trim(0)
+	}
+	s := d.String()
+	if o.Number {
+		return []byte(s), nil
+	}
+	return []byte(strconv.Quote(s)), nil
+}
+
+// Unmarshal decodes JSON-encoded data and stores the result in the decimal
+// pointed to by d.
+// In non-strict mode, the JSON null value is a no-op, as is customary
+// for the [json.Unmarshaler] interface.
+//
+// Unmarshal returns an error if:
+//   - the data is not a valid decimal;
+//   - the options are strict and the data is not in the expected form.
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (o JSONOptions) Unmarshal(data []byte, d *Decimal) error {
+	if string(data) == "null" {
+		if o.Strict {
+			return newParseError(string(data), 0, fmt.Errorf("%w: null is not allowed", ErrInvalidDecimal))
+		}
+		return nil
+	}
+	quoted := len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"'
+	if o.Strict {
+		switch {
+		case quoted && o.Number:
+			return newParseError(string(data), 0, fmt.Errorf("%w: JSON string is not allowed", ErrInvalidDecimal))
+		case !quoted && !o.Number:
+			return newParseError(string(data), 0, fmt.Errorf("%w: JSON number is not allowed", ErrInvalidDecimal))
+		case bytes.ContainsAny(data, "eE"):
+			return newParseError(string(data), 0, fmt.Errorf("%w: exponent notation is not allowed", ErrInvalidDecimal))
+		}
+	}
+	if quoted {
+		data = data[1 : len(data)-1]
+	}
+	e, err := NewFromString(string(data))
+	if err != nil {
+		return err
+	}
+	*d = e
+	return nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The decimal is decoded according to the package-level options,
+// see [SetJSONOptions].
+// By default, both JSON strings and JSON numbers are accepted, and,
+// as is customary for the [json.Unmarshaler] interface,
+// the JSON null value is a no-op.
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	return CurrentJSONOptions().Unmarshal(data, d)
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// The decimal is encoded according to the package-level options,
+// see [SetJSONOptions].
+// By default, the decimal is encoded as a JSON string, since JSON numbers
+// are usually decoded as float64 values, which cannot hold 19 digits.
+// See also method [Decimal.String].
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return CurrentJSONOptions().Marshal(d)
+}
+
+// StringDecimal is a decimal that is always encoded as a JSON string,
+// regardless of the Number field of the package-level options.
+// The other package-level options still apply, see [SetJSONOptions].
+// It is useful for API fields that are consumed by JavaScript clients.
+type StringDecimal struct {
+	Decimal
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+func (d *StringDecimal) UnmarshalJSON(data []byte) error {
+	o := CurrentJSONOptions()
+	o.Number = false
+	return o.Unmarshal(data, &d.Decimal)
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+func (d StringDecimal) MarshalJSON() ([]byte, error) {
+	o := CurrentJSONOptions()
+	o.Number = false
+	return o.Marshal(d.Decimal)
+}
+
+// NumberDecimal is a decimal that is always encoded as a JSON number,
+// regardless of the Number field of the package-level options.
+// The other package-level options still apply, see [SetJSONOptions].
+type NumberDecimal struct {
+	Decimal
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+func (d *NumberDecimal) UnmarshalJSON(data []byte) error {
+	o := CurrentJSONOptions()
+	o.Number = true
+	return o.Unmarshal(data, &d.Decimal)
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+func (d NumberDecimal) MarshalJSON() ([]byte, error) {
+	o := CurrentJSONOptions()
+	o.Number = true
+	return o.Marshal(d.Decimal)
+}
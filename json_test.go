@@ -0,0 +1,168 @@
+package decimal
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestJSONOptions_Marshal(t *testing.T) {
+	tests := []struct {
+		d    string
+		opts JSONOptions
+		want string
+	}{
+		{"1.50", JSONOptions{}, `"1.50"`},
+		{"-1.50", JSONOptions{}, `"-1.50"`},
+		{"0.000", JSONOptions{}, `"0.000"`},
+		{"1.50", JSONOptions{Trim: true}, `"1.5"`},
+		{"0.000", JSONOptions{Trim: true}, `"0"`},
+		{"1.50", JSONOptions{Number: true}, `1.50`},
+		{"-1.50", JSONOptions{Number: true, Trim: true}, `-1.5`},
+		{"9999999999999999999", JSONOptions{Number: true}, `9999999999999999999`},
+		{"0.0000000000000000001", JSONOptions{Number: true}, `0.0000000000000000001`},
+	}
+	for _, tt := range tests {
+		d := RequireFromString(tt.d)
+		got, err := tt.opts.Marshal(d)
+		if err != nil {
+			t.Errorf("%+v.Marshal(%q) failed: %v", tt.opts, d, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%+v.Marshal(%q) = %s, want %s", tt.opts, d, got, tt.want)
+		}
+	}
+}
+
+func TestJSONOptions_Unmarshal(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			data string
+			opts JSONOptions
+			want string
+		}{
+			// Lenient
+			{`"1.50"`, JSONOptions{}, "1.50"},
+			{`1.50`, JSONOptions{}, "1.50"},
+			{`1e-3`, JSONOptions{}, "0.001"},
+			{`"1E3"`, JSONOptions{}, "1000"},
+			{`null`, JSONOptions{}, "7"},
+			{`"1.50"`, JSONOptions{Number: true}, "1.50"},
+			{`1.50`, JSONOptions{Number: true}, "1.50"},
+			{`null`, JSONOptions{Number: true}, "7"},
+
+			// Strict
+			{`"1.50"`, JSONOptions{Strict: true}, "1.50"},
+			{`"-0.001"`, JSONOptions{Strict: true}, "-0.001"},
+			{`1.50`, JSONOptions{Number: true, Strict: true}, "1.50"},
+			{`-0.001`, JSONOptions{Number: true, Strict: true}, "-0.001"},
+		}
+		for _, tt := range tests {
+			got := MustNew(7, 0)
+			if err := tt.opts.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Errorf("%+v.Unmarshal(%s) failed: %v", tt.opts, tt.data, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("%+v.Unmarshal(%s) = %q, want %q", tt.opts, tt.data, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			data string
+			opts JSONOptions
+		}{
+			"empty 1":    {``, JSONOptions{}},
+			"empty 2":    {`""`, JSONOptions{}},
+			"quotes 1":   {`"1.5`, JSONOptions{}},
+			"quotes 2":   {`"1.5"`, JSONOptions{Number: true, Strict: true}},
+			"number 1":   {`1.5`, JSONOptions{Strict: true}},
+			"exponent 1": {`"1e3"`, JSONOptions{Strict: true}},
+			"exponent 2": {`1E3`, JSONOptions{Number: true, Strict: true}},
+			"null 1":     {`null`, JSONOptions{Strict: true}},
+			"null 2":     {`null`, JSONOptions{Number: true, Strict: true}},
+			"type 1":     {`true`, JSONOptions{}},
+			"type 2":     {`{}`, JSONOptions{}},
+			"type 3":     {`[1]`, JSONOptions{}},
+			"overflow 1": {`"99999999999999999999"`, JSONOptions{}},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				want := MustNew(7, 0)
+				got := want
+				err := tt.opts.Unmarshal([]byte(tt.data), &got)
+				if err == nil {
+					t.Errorf("%+v.Unmarshal(%s) did not fail", tt.opts, tt.data)
+				}
+				if got != want {
+					t.Errorf("%+v.Unmarshal(%s) changed the decimal to %q", tt.opts, tt.data, got)
+				}
+			})
+		}
+	})
+}
+
+func TestSetJSONOptions(t *testing.T) {
+	t.Cleanup(func() { SetJSONOptions(JSONOptions{}) })
+
+	if got := CurrentJSONOptions(); got != (JSONOptions{}) {
+		t.Errorf("CurrentJSONOptions() = %+v, want %+v", got, JSONOptions{})
+	}
+
+	type object struct {
+		D Decimal       `json:"d"`
+		S StringDecimal `json:"s"`
+		N NumberDecimal `json:"n"`
+	}
+	d := RequireFromString("1.50")
+	v := object{D: d, S: StringDecimal{d}, N: NumberDecimal{d}}
+
+	tests := []struct {
+		opts JSONOptions
+		want string
+	}{
+		{JSONOptions{}, `{"d":"1.50","s":"1.50","n":1.50}`},
+		{JSONOptions{Trim: true}, `{"d":"1.5","s":"1.5","n":1.5}`},
+		{JSONOptions{Number: true}, `{"d":1.50,"s":"1.50","n":1.50}`},
+		{JSONOptions{Number: true, Trim: true, Strict: true}, `{"d":1.5,"s":"1.5","n":1.5}`},
+	}
+	for _, tt := range tests {
+		SetJSONOptions(tt.opts)
+		if got := CurrentJSONOptions(); got != tt.opts {
+			t.Errorf("CurrentJSONOptions() = %+v, want %+v", got, tt.opts)
+		}
+
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Errorf("json.Marshal(%v) with %+v failed: %v", v, tt.opts, err)
+			continue
+		}
+		if string(data) != tt.want {
+			t.Errorf("json.Marshal(%v) with %+v = %s, want %s", v, tt.opts, data, tt.want)
+		}
+
+		var got object
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Errorf("json.Unmarshal(%s) with %+v failed: %v", data, tt.opts, err)
+			continue
+		}
+		if got.D.Cmp(d) != 0 || got.S.Cmp(d) != 0 || got.N.Cmp(d) != 0 {
+			t.Errorf("json.Unmarshal(%s) with %+v = %v, want %v", data, tt.opts, got, v)
+		}
+	}
+
+	// Strict options reject the form of the other wrapper
+	SetJSONOptions(JSONOptions{Strict: true})
+	var s StringDecimal
+	if err := json.Unmarshal([]byte(`1.5`), &s); !errors.Is(err, ErrInvalidDecimal) {
+		t.Errorf("json.Unmarshal(1.5, StringDecimal) = %v, want %v", err, ErrInvalidDecimal)
+	}
+	var n NumberDecimal
+	if err := json.Unmarshal([]byte(`"1.5"`), &n); !errors.Is(err, ErrInvalidDecimal) {
+		t.Errorf("json.Unmarshal(\"1.5\", NumberDecimal) = %v, want %v", err, ErrInvalidDecimal)
+	}
+}
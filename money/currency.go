@@ -0,0 +1,277 @@
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/govalues/decimal"
+)
+
+var (
+	// ErrUnknownCurrency is returned when a currency is not registered.
+	ErrUnknownCurrency = errors.New("unknown currency")
+	// ErrCurrencyMismatch is returned when an operation is applied to amounts
+	// in different currencies.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+)
+
+// Currency represents a currency as described by [ISO 4217].
+// Its zero value is not a valid currency.
+// Currency is designed to be safe for concurrent use by multiple goroutines.
+//
+// [ISO 4217]: https://en.wikipedia.org/wiki/ISO_4217
+type Currency struct {
+	code  string          // alphabetic code, such as "USD"
+	num   int             // numeric code, such as 840
+	scale int             // number of digits in the minor unit
+	cash  decimal.Decimal // smallest coin or banknote in circulation
+}
+
+var (
+	registryMu sync.RWMutex
+	byCode     = map[string]Currency{}
+	byNum      = map[int]Currency{}
+)
+
+// Predefined currencies.
+var (
+	AED = mustRegister("AED", 784, 2, "0.01")  // UAE Dirham
+	ARS = mustRegister("ARS", 32, 2, "0.01")   // Argentine Peso
+	AUD = mustRegister("AUD", 36, 2, "0.05")   // Australian Dollar
+	BGN = mustRegister("BGN", 975, 2, "0.01")  // Bulgarian Lev
+	BHD = mustRegister("BHD", 48, 3, "0.005")  // Bahraini Dinar
+	BRL = mustRegister("BRL", 986, 2, "0.05")  // Brazilian Real
+	CAD = mustRegister("CAD", 124, 2, "0.05")  // Canadian Dollar
+	CHF = mustRegister("CHF", 756, 2, "0.05")  // Swiss Franc
+	CLP = mustRegister("CLP", 152, 0, "1")     // Chilean Peso
+	CNY = mustRegister("CNY", 156, 2, "0.01")  // Yuan Renminbi
+	COP = mustRegister("COP", 170, 2, "0.01")  // Colombian Peso
+	CZK = mustRegister("CZK", 203, 2, "1")     // Czech Koruna
+	DKK = mustRegister("DKK", 208, 2, "0.50")  // Danish Krone
+	EGP = mustRegister("EGP", 818, 2, "0.01")  // Egyptian Pound
+	EUR = mustRegister("EUR", 978, 2, "0.01")  // Euro
+	GBP = mustRegister("GBP", 826, 2, "0.01")  // Pound Sterling
+	HKD = mustRegister("HKD", 344, 2, "0.10")  // Hong Kong Dollar
+	HUF = mustRegister("HUF", 348, 2, "5")     // Forint
+	IDR = mustRegister("IDR", 360, 2, "0.01")  // Rupiah
+	ILS = mustRegister("ILS", 376, 2, "0.10")  // New Israeli Sheqel
+	INR = mustRegister("INR", 356, 2, "0.01")  // Indian Rupee
+	ISK = mustRegister("ISK", 352, 0, "1")     // Iceland Krona
+	JOD = mustRegister("JOD", 400, 3, "0.005") // Jordanian Dinar
+	JPY = mustRegister("JPY", 392, 0, "1")     // Yen
+	KRW = mustRegister("KRW", 410, 0, "1")     // Won
+	KWD = mustRegister("KWD", 414, 3, "0.005") // Kuwaiti Dinar
+	MXN = mustRegister("MXN", 484, 2, "0.05")  // Mexican Peso
+	MYR = mustRegister("MYR", 458, 2, "0.05")  // Malaysian Ringgit
+	NOK = mustRegister("NOK", 578, 2, "1")     // Norwegian Krone
+	NZD = mustRegister("NZD", 554, 2, "0.10")  // New Zealand Dollar
+	OMR = mustRegister("OMR", 512, 3, "0.005") // Rial Omani
+	PHP = mustRegister("PHP", 608, 2, "0.01")  // Philippine Peso
+	PKR = mustRegister("PKR", 586, 2, "0.01")  // Pakistan Rupee
+	PLN = mustRegister("PLN", 985, 2, "0.01")  // Zloty
+	QAR = mustRegister("QAR", 634, 2, "0.01")  // Qatari Rial
+	RON = mustRegister("RON", 946, 2, "0.01")  // Romanian Leu
+	RUB = mustRegister("RUB", 643, 2, "0.01")  // Russian Ruble
+	SAR = mustRegister("SAR", 682, 2, "0.01")  // Saudi Riyal
+	SEK = mustRegister("SEK", 752, 2, "1")     // Swedish Krona
+	SGD = mustRegister("SGD", 702, 2, "0.05")  // Singapore Dollar
+	THB = mustRegister("THB", 764, 2, "0.25")  // Baht
+	TND = mustRegister("TND", 788, 3, "0.010") // Tunisian Dinar
+	TRY = mustRegister("TRY", 949, 2, "0.01")  // Turkish Lira
+	TWD = mustRegister("TWD", 901, 2, "1")     // New Taiwan Dollar
+	UAH = mustRegister("UAH", 980, 2, "0.10")  // Hryvnia
+	USD = mustRegister("USD", 840, 2, "0.01")  // US Dollar
+	VND = mustRegister("VND", 704, 0, "1")     // Dong
+	ZAR = mustRegister("ZAR", 710, 2, "0.10")  // Rand
+)
+
+// mustRegister is like [RegisterCurrency] but panics if the currency
+// cannot be registered.
+// It is used to initialize predefined currencies.
+func mustRegister(code string, num, scale int, cash string) Currency {
+	c, err := RegisterCurrency(code, num, scale, decimal.MustNewFromString(cash))
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RegisterCurrency adds a currency to the registry and returns it.
+// The code must consist of 3 upper-case ASCII letters.
+// The numeric code must be between 1 and 999, or 0 if the currency
+// does not have one.
+// The scale is the number of digits in the minor unit, and the cash
+// rounding increment is the smallest coin or banknote in circulation.
+//
+// RegisterCurrency returns an error if:
+//   - the code or the numeric code is invalid or already registered;
+//   - the scale is negative or greater than [decimal.MaxScale];
+//   - the cash rounding increment is not positive or is not a multiple
+//     of the minor unit.
+func RegisterCurrency(code string, num, scale int, cash decimal.Decimal) (Currency, error) {
+	// Validation
+	switch {
+	case !isCode(code):
+		return Currency{}, fmt.Errorf("registering currency %q: invalid code", code)
+	case num < 0 || num > 999:
+		return Currency{}, fmt.Errorf("registering currency %q: invalid numeric code %v", code, num)
+	case scale < 0 || scale > decimal.MaxScale:
+		return Currency{}, fmt.Errorf("registering currency %q: %w", code, decimal.ErrScaleRange)
+	case !cash.IsPos() || cash.MinScale() > scale:
+		return Currency{}, fmt.Errorf("registering currency %q: invalid cash rounding increment %v", code, cash)
+	}
+
+	c := Currency{code: code, num: num, scale: scale, cash: cash.Trim(0)}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := byCode[code]; ok {
+		return Currency{}, fmt.Errorf("registering currency %q: code is already registered", code)
+	}
+	if _, ok := byNum[num]; ok && num != 0 {
+		return Currency{}, fmt.Errorf("registering currency %q: numeric code %v is already registered", code, num)
+	}
+	byCode[code] = c
+	if num != 0 {
+		byNum[num] = c
+	}
+	return c, nil
+}
+
+// isCode returns true if s consists of 3 upper-case ASCII letters.
+func isCode(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for i := range len(s) {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseCurrency returns a registered currency with the given alphabetic code.
+// The code is case-sensitive and must be upper-case, for example "USD".
+//
+// ParseCurrency returns an [ErrUnknownCurrency] error if the currency is
+// not registered.
+func ParseCurrency(code string) (Currency, error) {
+	registryMu.RLock()
+	c, ok := byCode[code]
+	registryMu.RUnlock()
+	if !ok {
+		return Currency{}, fmt.Errorf("parsing currency %q: %w", code, ErrUnknownCurrency)
+	}
+	return c, nil
+}
+
+// MustParseCurrency is like [ParseCurrency] but panics if the currency
+// is not registered.
+// It simplifies safe initialization of global variables holding currencies.
+func MustParseCurrency(code string) Currency {
+	c, err := ParseCurrency(code)
+	if err != nil {
+		panic(fmt.Sprintf("ParseCurrency(%q) failed: %v", code, err))
+	}
+	return c
+}
+
+// CurrencyByNum returns a registered currency with the given numeric code,
+// for example 840 for the US Dollar.
+//
+// CurrencyByNum returns an [ErrUnknownCurrency] error if the currency is
+// not registered.
+func CurrencyByNum(num int) (Currency, error) {
+	registryMu.RLock()
+	c, ok := byNum[num]
+	registryMu.RUnlock()
+	if !ok || num == 0 {
+		return Currency{}, fmt.Errorf("looking up currency %03d: %w", num, ErrUnknownCurrency)
+	}
+	return c, nil
+}
+
+// Code returns the alphabetic code of the currency, for example "USD".
+func (c Currency) Code() string {
+	return c.code
+}
+
+// Num returns the numeric code of the currency, for example 840.
+// Currencies without a numeric code return 0.
+func (c Currency) Num() int {
+	return c.num
+}
+
+// Scale returns the number of digits after the decimal point in the
+// minor unit of the currency, for example 2 for the US Dollar.
+func (c Currency) Scale() int {
+	return c.scale
+}
+
+// CashIncrement returns the smallest coin or banknote of the currency,
+// for example 0.05 for the Swiss Franc.
+// See also method [Money.RoundToCash].
+func (c Currency) CashIncrement() decimal.Decimal {
+	return c.cash
+}
+
+// IsZero returns true if c is the zero value, which is not a valid currency.
+func (c Currency) IsZero() bool {
+	return c.code == ""
+}
+
+// String implements the [fmt.Stringer] interface and returns
+// the alphabetic code of the currency.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (c Currency) String() string {
+	return c.code
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// See also constructor [ParseCurrency].
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (c *Currency) UnmarshalText(text []byte) error {
+	var err error
+	*c, err = ParseCurrency(string(text))
+	return err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// See also method [Currency.String].
+//
+// [encoding.TextMarshaler]: https://pkg.go.dev/encoding#TextMarshaler
+func (c Currency) MarshalText() ([]byte, error) {
+	return []byte(c.code), nil
+}
+
+// Scan implements the [sql.Scanner] interface.
+// See also constructor [ParseCurrency].
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+func (c *Currency) Scan(value any) error {
+	var err error
+	switch value := value.(type) {
+	case string:
+		*c, err = ParseCurrency(value)
+	case []byte:
+		*c, err = ParseCurrency(string(value))
+	case nil:
+		err = fmt.Errorf("converting to %T: nil is not supported", c)
+	default:
+		err = fmt.Errorf("converting from %T to %T: type %T is not supported", value, c, value)
+	}
+	return err
+}
+
+// Value implements the [driver.Valuer] interface.
+// See also method [Currency.String].
+//
+// [driver.Valuer]: https://pkg.go.dev/database/sql/driver#Valuer
+func (c Currency) Value() (driver.Value, error) {
+	return c.code, nil
+}
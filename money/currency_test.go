@@ -0,0 +1,182 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestCurrency_ZeroValue(t *testing.T) {
+	c := Currency{}
+	if !c.IsZero() {
+		t.Errorf("Currency{}.IsZero() = false, want true")
+	}
+	if c.String() != "" {
+		t.Errorf("Currency{}.String() = %q, want %q", c, "")
+	}
+}
+
+func TestParseCurrency(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			code      string
+			wantNum   int
+			wantScale int
+			wantCash  string
+		}{
+			{"USD", 840, 2, "0.01"},
+			{"EUR", 978, 2, "0.01"},
+			{"JPY", 392, 0, "1"},
+			{"BHD", 48, 3, "0.005"},
+			{"CHF", 756, 2, "0.05"},
+			{"DKK", 208, 2, "0.5"},
+			{"HUF", 348, 2, "5"},
+		}
+		for _, tt := range tests {
+			got, err := ParseCurrency(tt.code)
+			if err != nil {
+				t.Errorf("ParseCurrency(%q) failed: %v", tt.code, err)
+				continue
+			}
+			if got.Code() != tt.code {
+				t.Errorf("ParseCurrency(%q).Code() = %q, want %q", tt.code, got.Code(), tt.code)
+			}
+			if got.Num() != tt.wantNum {
+				t.Errorf("ParseCurrency(%q).Num() = %v, want %v", tt.code, got.Num(), tt.wantNum)
+			}
+			if got.Scale() != tt.wantScale {
+				t.Errorf("ParseCurrency(%q).Scale() = %v, want %v", tt.code, got.Scale(), tt.wantScale)
+			}
+			if got.CashIncrement().String() != tt.wantCash {
+				t.Errorf("ParseCurrency(%q).CashIncrement() = %q, want %q", tt.code, got.CashIncrement(), tt.wantCash)
+			}
+			byNum, err := CurrencyByNum(tt.wantNum)
+			if err != nil {
+				t.Errorf("CurrencyByNum(%v) failed: %v", tt.wantNum, err)
+				continue
+			}
+			if byNum != got {
+				t.Errorf("CurrencyByNum(%v) = %v, want %v", tt.wantNum, byNum, got)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []string{"", "usd", "US", "USDT", "XYZ"}
+		for _, code := range tests {
+			_, err := ParseCurrency(code)
+			if !errors.Is(err, ErrUnknownCurrency) {
+				t.Errorf("ParseCurrency(%q) = %v, want %v", code, err, ErrUnknownCurrency)
+			}
+		}
+		for _, num := range []int{-1, 0, 1, 999, 1000} {
+			_, err := CurrencyByNum(num)
+			if !errors.Is(err, ErrUnknownCurrency) {
+				t.Errorf("CurrencyByNum(%v) = %v, want %v", num, err, ErrUnknownCurrency)
+			}
+		}
+	})
+}
+
+func TestRegisterCurrency(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		got, err := RegisterCurrency("XTB", 0, 8, decimal.MustNew(1, 8))
+		if err != nil {
+			t.Fatalf("RegisterCurrency(\"XTB\", 0, 8, 0.00000001) failed: %v", err)
+		}
+		want, err := ParseCurrency("XTB")
+		if err != nil {
+			t.Fatalf("ParseCurrency(\"XTB\") failed: %v", err)
+		}
+		if got != want {
+			t.Errorf("ParseCurrency(\"XTB\") = %v, want %v", want, got)
+		}
+		_, err = RegisterCurrency("XTC", 0, 0, decimal.MustNew(1, 0))
+		if err != nil {
+			t.Errorf("RegisterCurrency(\"XTC\", 0, 0, 1) failed: %v", err)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			code       string
+			num, scale int
+			cash       string
+			wantErr    error
+		}{
+			"code 1":  {"", 0, 2, "0.01", nil},
+			"code 2":  {"usd", 0, 2, "0.01", nil},
+			"code 3":  {"USD", 0, 2, "0.01", nil},
+			"num 1":   {"XTD", -1, 2, "0.01", nil},
+			"num 2":   {"XTD", 1000, 2, "0.01", nil},
+			"num 3":   {"XTD", 840, 2, "0.01", nil},
+			"scale 1": {"XTD", 0, -1, "0.01", decimal.ErrScaleRange},
+			"scale 2": {"XTD", 0, decimal.MaxScale + 1, "0.01", decimal.ErrScaleRange},
+			"cash 1":  {"XTD", 0, 2, "0", nil},
+			"cash 2":  {"XTD", 0, 2, "-0.01", nil},
+			"cash 3":  {"XTD", 0, 2, "0.001", nil},
+		}
+		for name, tt := range tests {
+			cash := decimal.MustNewFromString(tt.cash)
+			_, err := RegisterCurrency(tt.code, tt.num, tt.scale, cash)
+			if err == nil {
+				t.Errorf("%v: RegisterCurrency(%q, %v, %v, %v) did not fail", name, tt.code, tt.num, tt.scale, cash)
+				continue
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: RegisterCurrency(%q, %v, %v, %v) = %v, want %v", name, tt.code, tt.num, tt.scale, cash, err, tt.wantErr)
+			}
+		}
+		if _, err := ParseCurrency("XTD"); err == nil {
+			t.Errorf("ParseCurrency(\"XTD\") did not fail after failed registrations")
+		}
+	})
+}
+
+func TestCurrency_Marshaling(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		for _, want := range []Currency{USD, EUR, JPY} {
+			text, err := want.MarshalText()
+			if err != nil {
+				t.Errorf("%v.MarshalText() failed: %v", want, err)
+				continue
+			}
+			var got Currency
+			err = got.UnmarshalText(text)
+			if err != nil {
+				t.Errorf("UnmarshalText(%q) failed: %v", text, err)
+				continue
+			}
+			if got != want {
+				t.Errorf("UnmarshalText(%q) = %v, want %v", text, got, want)
+			}
+
+			value, err := want.Value()
+			if err != nil {
+				t.Errorf("%v.Value() failed: %v", want, err)
+				continue
+			}
+			got = Currency{}
+			err = got.Scan(value)
+			if err != nil {
+				t.Errorf("Scan(%v) failed: %v", value, err)
+				continue
+			}
+			if got != want {
+				t.Errorf("Scan(%v) = %v, want %v", value, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := []any{nil, int64(840), "XYZ", []byte("usd")}
+		for _, value := range tests {
+			var got Currency
+			err := got.Scan(value)
+			if err == nil {
+				t.Errorf("Scan(%v) did not fail", value)
+			}
+		}
+	})
+}
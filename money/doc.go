@@ -0,0 +1,43 @@
+/*
+Package money implements immutable monetary amounts for Go.
+It pairs a [decimal.Decimal] with an [ISO 4217] currency, so that amounts
+are always kept at the scale of the currency's minor units, and amounts
+in different currencies cannot be mixed by accident.
+
+# Representation
+
+[Currency] describes a currency: its alphabetic code, its numeric code,
+the number of digits in its minor unit, and its cash rounding increment,
+which is the smallest coin or banknote in circulation.
+The most widely used currencies are predefined, for example [USD] or [EUR].
+Currencies that are not predefined, such as cryptocurrencies or loyalty points,
+can be added using [RegisterCurrency].
+
+[Money] is an amount in a particular currency.
+The scale of its amount is always equal to the scale of the currency,
+for example, US dollars always have exactly two digits after the decimal point.
+Constructors round amounts with more digits using [rounding half to even]
+(banker's rounding).
+
+# Operations
+
+[Money.Add] and [Money.Sub] return an [ErrCurrencyMismatch] error if the
+amounts are in different currencies.
+[Money.Mul] and [Money.Quo] multiply or divide an amount by a plain decimal
+factor and round the result to the scale of the currency.
+[Money.RoundToCash] rounds an amount to the cash rounding increment of
+the currency, for example, to 0.05 for Swiss francs.
+
+# Encoding
+
+Money implements the same encoding interfaces as [decimal.Decimal].
+The text and SQL representation is the currency code followed by a space
+and the amount, for example "USD 12.34".
+The JSON representation is an object with two fields, for example
+{"currency":"USD","amount":"12.34"}, where the amount is encoded
+by [decimal.Decimal.MarshalJSON] according to [decimal.SetJSONOptions].
+
+[ISO 4217]: https://en.wikipedia.org/wiki/ISO_4217
+[rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+*/
+package money
@@ -0,0 +1,87 @@
+package money_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/govalues/decimal"
+	"github.com/govalues/decimal/money"
+)
+
+func ExampleNew() {
+	m, err := money.New(money.USD, decimal.MustNew(12345, 3))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(m)
+	// Output: USD 12.34
+}
+
+func ExampleParse() {
+	m, err := money.Parse("JPY 1234")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(m.Currency(), m.Amount())
+	// Output: JPY 1234
+}
+
+func ExampleNewFromMinorUnits() {
+	m, err := money.NewFromMinorUnits(money.EUR, 1999)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(m)
+	fmt.Println(m.MinorUnits())
+	// Output:
+	// EUR 19.99
+	// 1999 true
+}
+
+func ExampleRegisterCurrency() {
+	xbt, err := money.RegisterCurrency("XBT", 0, 8, decimal.MustNew(1, 8))
+	if err != nil {
+		panic(err)
+	}
+	m := money.MustNew(xbt, decimal.MustNew(5, 1))
+	fmt.Println(m)
+	// Output: XBT 0.50000000
+}
+
+func ExampleMoney_Add() {
+	m := money.MustParse("USD 1.50")
+	n := money.MustParse("USD 2.75")
+	fmt.Println(m.Add(n))
+	_, err := m.Add(money.MustParse("EUR 2.75"))
+	fmt.Println(err)
+	// Output:
+	// USD 4.25 <nil>
+	// computing [USD 1.50 + EUR 2.75]: currency mismatch
+}
+
+func ExampleMoney_Quo() {
+	m := money.MustParse("USD 10.00")
+	fmt.Println(m.Quo(decimal.MustNew(3, 0)))
+	fmt.Println(m.QuoMode(decimal.MustNew(3, 0), decimal.Up))
+	// Output:
+	// USD 3.33 <nil>
+	// USD 3.34 <nil>
+}
+
+func ExampleMoney_RoundToCash() {
+	m := money.MustParse("CHF 12.73")
+	fmt.Println(m.RoundToCash(decimal.HalfEven))
+	// Output: CHF 12.75 <nil>
+}
+
+func ExampleMoney_MarshalJSON() {
+	type Invoice struct {
+		Total money.Money `json:"total"`
+	}
+	data, err := json.Marshal(Invoice{Total: money.MustParse("EUR 99.90")})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(data))
+	// Output: {"total":{"currency":"EUR","amount":"99.90"}}
+}
@@ -0,0 +1,442 @@
+package money
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/govalues/decimal"
+)
+
+// Money represents an immutable monetary amount in a particular currency.
+// The scale of the amount is always equal to the scale of the currency.
+// Its zero value has no currency and is not valid for arithmetic.
+// Money is designed to be safe for concurrent use by multiple goroutines.
+type Money struct {
+	curr   Currency
+	amount decimal.Decimal
+}
+
+// New returns a monetary amount in the given currency.
+// If the amount has more digits after the decimal point than the currency,
+// it is rounded using [rounding half to even] (banker's rounding).
+// If the amount has fewer digits, it is zero-padded.
+//
+// New returns an error if:
+//   - the currency is the zero value;
+//   - the integer part of the amount is too large to be padded
+//     to the scale of the currency.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func New(curr Currency, amount decimal.Decimal) (Money, error) {
+	if curr.IsZero() {
+		return Money{}, fmt.Errorf("converting %v: %w", amount, ErrUnknownCurrency)
+	}
+	a := amount.Rescale(curr.Scale())
+	if a.Scale() != curr.Scale() {
+		return Money{}, fmt.Errorf("converting %v to %v: %w", amount, curr, decimal.ErrOverflow)
+	}
+	return Money{curr: curr, amount: a}, nil
+}
+
+// MustNew is like [New] but panics if the amount cannot be constructed.
+// It simplifies safe initialization of global variables holding amounts.
+func MustNew(curr Currency, amount decimal.Decimal) Money {
+	m, err := New(curr, amount)
+	if err != nil {
+		panic(fmt.Sprintf("New(%v, %v) failed: %v", curr, amount, err))
+	}
+	return m
+}
+
+// NewFromMinorUnits returns a monetary amount from the number of minor units
+// of the currency, for example, cents for the US Dollar.
+//
+// NewFromMinorUnits returns an error if the currency is the zero value.
+func NewFromMinorUnits(curr Currency, units int64) (Money, error) {
+	if curr.IsZero() {
+		return Money{}, fmt.Errorf("converting %v minor units: %w", units, ErrUnknownCurrency)
+	}
+	a, err := decimal.New(units, curr.Scale())
+	if err != nil {
+		return Money{}, fmt.Errorf("converting %v minor units to %v: %w", units, curr, err)
+	}
+	return Money{curr: curr, amount: a}, nil
+}
+
+// Parse converts a string to a monetary amount.
+// The string must consist of a registered currency code, a single space,
+// and an amount in any format accepted by [decimal.NewFromString],
+// for example "USD 12.34".
+// The amount is rounded or zero-padded to the scale of the currency, see [New].
+//
+// Parse returns an error if:
+//   - the string does not have the format described above;
+//   - the currency is not registered;
+//   - the amount is not a valid decimal or is too large.
+func Parse(s string) (Money, error) {
+	if len(s) < 5 || s[3] != ' ' {
+		return Money{}, fmt.Errorf("parsing money %q: %w", s, decimal.ErrInvalidDecimal)
+	}
+	curr, err := ParseCurrency(s[:3])
+	if err != nil {
+		return Money{}, fmt.Errorf("parsing money %q: %w", s, err)
+	}
+	a, err := decimal.NewFromString(s[4:])
+	if err != nil {
+		return Money{}, fmt.Errorf("parsing money %q: %w", s, err)
+	}
+	m, err := New(curr, a)
+	if err != nil {
+		return Money{}, fmt.Errorf("parsing money %q: %w", s, err)
+	}
+	return m, nil
+}
+
+// MustParse is like [Parse] but panics if the string cannot be parsed.
+// It simplifies safe initialization of global variables holding amounts.
+func MustParse(s string) Money {
+	m, err := Parse(s)
+	if err != nil {
+		panic(fmt.Sprintf("Parse(%q) failed: %v", s, err))
+	}
+	return m
+}
+
+// Currency returns the currency of the amount.
+func (m Money) Currency() Currency {
+	return m.curr
+}
+
+// Amount returns the amount as a decimal.
+// The scale of the result is equal to the scale of the currency.
+func (m Money) Amount() decimal.Decimal {
+	return m.amount
+}
+
+// MinorUnits returns the amount as a number of minor units of the currency,
+// for example, cents for the US Dollar.
+// If the result cannot be represented as an int64, ok is false.
+func (m Money) MinorUnits() (units int64, ok bool) {
+	coef := m.amount.Coef()
+	if m.amount.IsNeg() {
+		if coef > math.MaxInt64+1 {
+			return 0, false
+		}
+		return -int64(coef-1) - 1, true //nolint:gosec
+	}
+	if coef > math.MaxInt64 {
+		return 0, false
+	}
+	return int64(coef), true //nolint:gosec
+}
+
+// Add returns the (exact) sum of amounts m and n.
+//
+// Add returns an error if:
+//   - the amounts are in different currencies;
+//   - the integer part of the result has more than [decimal.MaxPrec] digits.
+func (m Money) Add(n Money) (Money, error) {
+	if m.curr != n.curr {
+		return Money{}, fmt.Errorf("computing [%v + %v]: %w", m, n, ErrCurrencyMismatch)
+	}
+	a, err := m.amount.AddExact(n.amount, m.curr.Scale())
+	if err != nil {
+		return Money{}, fmt.Errorf("computing [%v + %v]: %w", m, n, err)
+	}
+	return Money{curr: m.curr, amount: a}, nil
+}
+
+// Sub returns the (exact) difference between amounts m and n.
+//
+// Sub returns an error if:
+//   - the amounts are in different currencies;
+//   - the integer part of the result has more than [decimal.MaxPrec] digits.
+func (m Money) Sub(n Money) (Money, error) {
+	if m.curr != n.curr {
+		return Money{}, fmt.Errorf("computing [%v - %v]: %w", m, n, ErrCurrencyMismatch)
+	}
+	a, err := m.amount.SubExact(n.amount, m.curr.Scale())
+	if err != nil {
+		return Money{}, fmt.Errorf("computing [%v - %v]: %w", m, n, err)
+	}
+	return Money{curr: m.curr, amount: a}, nil
+}
+
+// Mul returns the product of amount m and factor e, rounded to the scale
+// of the currency using [rounding half to even] (banker's rounding).
+// See also method [Money.MulMode].
+//
+// Mul returns an error if the integer part of the result has more than
+// [decimal.MaxPrec] digits.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (m Money) Mul(e decimal.Decimal) (Money, error) {
+	return m.MulMode(e, decimal.HalfEven)
+}
+
+// MulMode is similar to [Money.Mul], but it allows you to specify
+// the rounding mode that is used if the result has to be rounded.
+func (m Money) MulMode(e decimal.Decimal, mode decimal.RoundingMode) (Money, error) {
+	a, err := m.round(func(mode decimal.RoundingMode) (decimal.Decimal, error) {
+		return m.amount.MulExactMode(e, m.curr.Scale(), mode)
+	}, mode)
+	if err != nil {
+		return Money{}, fmt.Errorf("computing [%v * %v]: %w", m, e, err)
+	}
+	return Money{curr: m.curr, amount: a}, nil
+}
+
+// Quo returns the quotient of amount m and divisor e, rounded to the scale
+// of the currency using [rounding half to even] (banker's rounding).
+// See also method [Money.QuoMode].
+//
+// Quo returns an error if:
+//   - the divisor is 0;
+//   - the integer part of the result has more than [decimal.MaxPrec] digits.
+//
+// [rounding half to even]: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+func (m Money) Quo(e decimal.Decimal) (Money, error) {
+	return m.QuoMode(e, decimal.HalfEven)
+}
+
+// QuoMode is similar to [Money.Quo], but it allows you to specify
+// the rounding mode that is used if the result has to be rounded.
+func (m Money) QuoMode(e decimal.Decimal, mode decimal.RoundingMode) (Money, error) {
+	a, err := m.round(func(mode decimal.RoundingMode) (decimal.Decimal, error) {
+		return m.amount.QuoExactMode(e, m.curr.Scale(), mode)
+	}, mode)
+	if err != nil {
+		return Money{}, fmt.Errorf("computing [%v / %v]: %w", m, e, err)
+	}
+	return Money{curr: m.curr, amount: a}, nil
+}
+
+// round returns the result of function f rounded to the scale of the currency
+// using the given rounding mode.
+// Function f computes the result with at least as many digits after
+// the decimal point as the currency has, but it rounds the result
+// to [decimal.MaxPrec] digits using the given rounding mode.
+// Rounding such a result again would round it twice, so it is computed
+// using [decimal.ZeroFiveUp] instead, since rounding it to the scale
+// of the currency gives the same result as rounding the exact result once.
+func (m Money) round(f func(decimal.RoundingMode) (decimal.Decimal, error), mode decimal.RoundingMode) (decimal.Decimal, error) {
+	a, err := f(mode)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if a.Scale() == m.curr.Scale() {
+		return a, nil
+	}
+	a, err = f(decimal.ZeroFiveUp)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	return a.RoundMode(m.curr.Scale(), mode), nil
+}
+
+// RoundToCash returns an amount rounded to a multiple of the cash rounding
+// increment of the currency using the given rounding mode, for example,
+// to 0.05 for the Swiss Franc.
+// The scale of the result is still equal to the scale of the currency.
+// See also method [Currency.CashIncrement].
+//
+// RoundToCash returns an error if:
+//   - the rounding mode is not valid;
+//   - the integer part of the result has more than [decimal.MaxPrec] digits.
+func (m Money) RoundToCash(mode decimal.RoundingMode) (Money, error) {
+	cash := m.curr.CashIncrement()
+	if cash.IsZero() {
+		return Money{}, fmt.Errorf("rounding %v to cash: %w", m, ErrUnknownCurrency)
+	}
+	q, r, err := m.amount.QuoRem(cash)
+	if err != nil {
+		return Money{}, fmt.Errorf("rounding %v to cash: %w", m, err)
+	}
+	// The quotient is truncated, so it is rounded in the same way
+	// as a coefficient, with the remainder compared to half of the increment.
+	h, err := r.Abs().Add(r.Abs())
+	if err != nil {
+		return Money{}, fmt.Errorf("rounding %v to cash: %w", m, err)
+	}
+	half := h.Cmp(cash)
+	neg := r.IsNeg()
+	var up bool
+	switch mode {
+	case decimal.HalfEven:
+		up = half > 0 || (half == 0 && q.Coef()%2 != 0)
+	case decimal.HalfUp:
+		up = half >= 0
+	case decimal.HalfDown:
+		up = half > 0
+	case decimal.Up:
+		up = true
+	case decimal.Down:
+		up = false
+	case decimal.Ceiling:
+		up = !neg
+	case decimal.Floor:
+		up = neg
+	case decimal.ZeroFiveUp:
+		up = q.Coef()%5 == 0
+	default:
+		return Money{}, fmt.Errorf("rounding %v to cash: %w", m, decimal.ErrModeRange)
+	}
+	if up && !r.IsZero() {
+		one := decimal.One
+		if neg {
+			one = decimal.NegOne
+		}
+		q, err = q.Add(one)
+		if err != nil {
+			return Money{}, fmt.Errorf("rounding %v to cash: %w", m, err)
+		}
+	}
+	a, err := q.MulExact(cash, m.curr.Scale())
+	if err != nil {
+		return Money{}, fmt.Errorf("rounding %v to cash: %w", m, err)
+	}
+	return New(m.curr, a)
+}
+
+// Neg returns an amount with the opposite sign.
+func (m Money) Neg() Money {
+	return Money{curr: m.curr, amount: m.amount.Neg()}
+}
+
+// Abs returns the absolute value of the amount.
+func (m Money) Abs() Money {
+	return Money{curr: m.curr, amount: m.amount.Abs()}
+}
+
+// Sign returns:
+//
+//	-1 if m < 0
+//	 0 if m = 0
+//	+1 if m > 0
+func (m Money) Sign() int {
+	return m.amount.Sign()
+}
+
+// IsZero returns true if m = 0.
+func (m Money) IsZero() bool {
+	return m.amount.IsZero()
+}
+
+// IsPos returns true if m > 0.
+func (m Money) IsPos() bool {
+	return m.amount.IsPos()
+}
+
+// IsNeg returns true if m < 0.
+func (m Money) IsNeg() bool {
+	return m.amount.IsNeg()
+}
+
+// Cmp compares amounts and returns:
+//
+//	-1 if m < n
+//	 0 if m = n
+//	+1 if m > n
+//
+// Cmp returns an [ErrCurrencyMismatch] error if the amounts are in
+// different currencies.
+func (m Money) Cmp(n Money) (int, error) {
+	if m.curr != n.curr {
+		return 0, fmt.Errorf("comparing [%v] and [%v]: %w", m, n, ErrCurrencyMismatch)
+	}
+	return m.amount.Cmp(n.amount), nil
+}
+
+// Equal returns true if the amounts are in the same currency and
+// are numerically equal.
+func (m Money) Equal(n Money) bool {
+	return m.curr == n.curr && m.amount.Equal(n.amount)
+}
+
+// String implements the [fmt.Stringer] interface and returns a string
+// consisting of the currency code, a single space and the amount
+// with exactly as many digits after the decimal point as the currency has,
+// for example "USD 12.30".
+// See also constructor [Parse].
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (m Money) String() string {
+	return fmt.Sprintf("%v %f", m.curr, m.amount)
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// See also constructor [Parse].
+//
+// [encoding.TextUnmarshaler]: https://pkg.go.dev/encoding#TextUnmarshaler
+func (m *Money) UnmarshalText(text []byte) error {
+	var err error
+	*m, err = Parse(string(text))
+	return err
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+// See also method [Money.String].
+//
+// [encoding.TextMarshaler]: https://pkg.go.dev/encoding#TextMarshaler
+func (m Money) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// jsonMoney is the JSON representation of [Money].
+type jsonMoney struct {
+	Currency Currency        `json:"currency"`
+	Amount   decimal.Decimal `json:"amount"`
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// The amount is rounded or zero-padded to the scale of the currency, see [New].
+//
+// [json.Unmarshaler]: https://pkg.go.dev/encoding/json#Unmarshaler
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var v jsonMoney
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	var err error
+	*m, err = New(v.Currency, v.Amount)
+	return err
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+//
+// [json.Marshaler]: https://pkg.go.dev/encoding/json#Marshaler
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonMoney{Currency: m.curr, Amount: m.amount})
+}
+
+// Scan implements the [sql.Scanner] interface.
+// See also constructor [Parse].
+//
+// [sql.Scanner]: https://pkg.go.dev/database/sql#Scanner
+func (m *Money) Scan(value any) error {
+	var err error
+	switch value := value.(type) {
+	case string:
+		*m, err = Parse(value)
+	case []byte:
+		*m, err = Parse(string(value))
+	case nil:
+		err = fmt.Errorf("converting to %T: nil is not supported", m)
+	default:
+		err = fmt.Errorf("converting from %T to %T: type %T is not supported", value, m, value)
+	}
+	return err
+}
+
+// Value implements the [driver.Valuer] interface.
+// See also method [Money.String].
+//
+// [driver.Valuer]: https://pkg.go.dev/database/sql/driver#Valuer
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
@@ -0,0 +1,490 @@
+package money
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/govalues/decimal"
+)
+
+func TestMoney_Interfaces(t *testing.T) {
+	var m any
+
+	m = Money{}
+	_, ok := m.(fmt.Stringer)
+	if !ok {
+		t.Errorf("%T does not implement fmt.Stringer", m)
+	}
+	_, ok = m.(encoding.TextMarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.TextMarshaler", m)
+	}
+	_, ok = m.(json.Marshaler)
+	if !ok {
+		t.Errorf("%T does not implement json.Marshaler", m)
+	}
+	_, ok = m.(driver.Valuer)
+	if !ok {
+		t.Errorf("%T does not implement driver.Valuer", m)
+	}
+
+	m = &Money{}
+	_, ok = m.(encoding.TextUnmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement encoding.TextUnmarshaler", m)
+	}
+	_, ok = m.(json.Unmarshaler)
+	if !ok {
+		t.Errorf("%T does not implement json.Unmarshaler", m)
+	}
+	_, ok = m.(sql.Scanner)
+	if !ok {
+		t.Errorf("%T does not implement sql.Scanner", m)
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			curr   Currency
+			amount string
+			want   string
+		}{
+			{USD, "0", "USD 0.00"},
+			{USD, "1", "USD 1.00"},
+			{USD, "1.005", "USD 1.00"},
+			{USD, "1.015", "USD 1.02"},
+			{USD, "-1.015", "USD -1.02"},
+			{JPY, "1234.5", "JPY 1234"},
+			{BHD, "0.1", "BHD 0.100"},
+			{USD, "99999999999999999.99", "USD 99999999999999999.99"},
+		}
+		for _, tt := range tests {
+			amount := decimal.MustNewFromString(tt.amount)
+			got, err := New(tt.curr, amount)
+			if err != nil {
+				t.Errorf("New(%v, %v) failed: %v", tt.curr, amount, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("New(%v, %v) = %q, want %q", tt.curr, amount, got, tt.want)
+			}
+			if got.Amount().Scale() != tt.curr.Scale() {
+				t.Errorf("New(%v, %v).Amount().Scale() = %v, want %v", tt.curr, amount, got.Amount().Scale(), tt.curr.Scale())
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			curr    Currency
+			amount  string
+			wantErr error
+		}{
+			"currency": {Currency{}, "1", ErrUnknownCurrency},
+			"overflow": {USD, "999999999999999999", decimal.ErrOverflow},
+		}
+		for name, tt := range tests {
+			amount := decimal.MustNewFromString(tt.amount)
+			_, err := New(tt.curr, amount)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: New(%v, %v) = %v, want %v", name, tt.curr, amount, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestNewFromMinorUnits(t *testing.T) {
+	tests := []struct {
+		curr  Currency
+		units int64
+		want  string
+	}{
+		{USD, 0, "USD 0.00"},
+		{USD, 1234, "USD 12.34"},
+		{USD, -5, "USD -0.05"},
+		{JPY, 1234, "JPY 1234"},
+		{KWD, 1234, "KWD 1.234"},
+		{USD, math.MaxInt64, "USD 92233720368547758.07"},
+		{USD, math.MinInt64, "USD -92233720368547758.08"},
+	}
+	for _, tt := range tests {
+		got, err := NewFromMinorUnits(tt.curr, tt.units)
+		if err != nil {
+			t.Errorf("NewFromMinorUnits(%v, %v) failed: %v", tt.curr, tt.units, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("NewFromMinorUnits(%v, %v) = %q, want %q", tt.curr, tt.units, got, tt.want)
+		}
+		units, ok := got.MinorUnits()
+		if !ok || units != tt.units {
+			t.Errorf("%q.MinorUnits() = [%v %v], want [%v true]", got, units, ok, tt.units)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			s, want string
+		}{
+			{"USD 12.34", "USD 12.34"},
+			{"USD 12", "USD 12.00"},
+			{"USD -0.005", "USD 0.00"},
+			{"EUR 1e3", "EUR 1000.00"},
+			{"JPY 100.50", "JPY 100"},
+		}
+		for _, tt := range tests {
+			got, err := Parse(tt.s)
+			if err != nil {
+				t.Errorf("Parse(%q) failed: %v", tt.s, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("Parse(%q) = %q, want %q", tt.s, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			s       string
+			wantErr error
+		}{
+			"empty":    {"", decimal.ErrInvalidDecimal},
+			"no space": {"USD12.34", decimal.ErrInvalidDecimal},
+			"no value": {"USD ", decimal.ErrInvalidDecimal},
+			"spaces":   {"USD  12.34", decimal.ErrInvalidDecimal},
+			"currency": {"XYZ 12.34", ErrUnknownCurrency},
+			"case":     {"usd 12.34", ErrUnknownCurrency},
+			"overflow": {"USD 999999999999999999", decimal.ErrOverflow},
+		}
+		for name, tt := range tests {
+			_, err := Parse(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: Parse(%q) = %v, want %v", name, tt.s, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestMoney_Add_Sub(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, n, wantSum, wantDiff string
+		}{
+			{"USD 1.00", "USD 2.00", "USD 3.00", "USD -1.00"},
+			{"USD 0.10", "USD 0.20", "USD 0.30", "USD -0.10"},
+			{"JPY 100", "JPY -50", "JPY 50", "JPY 150"},
+			{"BHD 1.005", "BHD 0.005", "BHD 1.010", "BHD 1.000"},
+		}
+		for _, tt := range tests {
+			m := MustParse(tt.m)
+			n := MustParse(tt.n)
+			got, err := m.Add(n)
+			if err != nil {
+				t.Errorf("%q.Add(%q) failed: %v", m, n, err)
+				continue
+			}
+			if got.String() != tt.wantSum {
+				t.Errorf("%q.Add(%q) = %q, want %q", m, n, got, tt.wantSum)
+			}
+			got, err = m.Sub(n)
+			if err != nil {
+				t.Errorf("%q.Sub(%q) failed: %v", m, n, err)
+				continue
+			}
+			if got.String() != tt.wantDiff {
+				t.Errorf("%q.Sub(%q) = %q, want %q", m, n, got, tt.wantDiff)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			m, n    string
+			wantErr error
+		}{
+			"mismatch": {"USD 1.00", "EUR 1.00", ErrCurrencyMismatch},
+			"overflow": {"USD 99999999999999999.99", "USD 0.01", decimal.ErrOverflow},
+		}
+		for name, tt := range tests {
+			m := MustParse(tt.m)
+			n := MustParse(tt.n)
+			_, err := m.Add(n)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: %q.Add(%q) = %v, want %v", name, m, n, err, tt.wantErr)
+			}
+			_, err = m.Neg().Sub(n)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%v: %q.Sub(%q) = %v, want %v", name, m.Neg(), n, err, tt.wantErr)
+			}
+		}
+	})
+}
+
+func TestMoney_Mul_Quo(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, e     string
+			mode     decimal.RoundingMode
+			wantProd string
+			wantQuo  string
+		}{
+			{"USD 10.00", "3", decimal.HalfEven, "USD 30.00", "USD 3.33"},
+			{"USD 10.00", "3", decimal.Up, "USD 30.00", "USD 3.34"},
+			{"USD 10.00", "0.125", decimal.HalfEven, "USD 1.25", "USD 80.00"},
+			{"USD 0.05", "0.5", decimal.HalfEven, "USD 0.02", "USD 0.10"},
+			{"USD 0.05", "0.5", decimal.HalfUp, "USD 0.03", "USD 0.10"},
+			{"USD -0.05", "0.5", decimal.Floor, "USD -0.03", "USD -0.10"},
+			{"JPY 1000", "0.0825", decimal.HalfEven, "JPY 82", "JPY 12121"},
+			// Results must not be rounded twice
+			{"USD 0.01", "0.4999999999999999999", decimal.HalfUp, "USD 0.00", "USD 0.02"},
+			{"USD 10.00", "2000.000000000000001", decimal.HalfUp, "USD 20000.00", "USD 0.00"},
+		}
+		for _, tt := range tests {
+			m := MustParse(tt.m)
+			e := decimal.MustNewFromString(tt.e)
+			got, err := m.MulMode(e, tt.mode)
+			if err != nil {
+				t.Errorf("%q.MulMode(%v, %v) failed: %v", m, e, tt.mode, err)
+				continue
+			}
+			if got.String() != tt.wantProd {
+				t.Errorf("%q.MulMode(%v, %v) = %q, want %q", m, e, tt.mode, got, tt.wantProd)
+			}
+			got, err = m.QuoMode(e, tt.mode)
+			if err != nil {
+				t.Errorf("%q.QuoMode(%v, %v) failed: %v", m, e, tt.mode, err)
+				continue
+			}
+			if got.String() != tt.wantQuo {
+				t.Errorf("%q.QuoMode(%v, %v) = %q, want %q", m, e, tt.mode, got, tt.wantQuo)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		m := MustParse("USD 99999999999999999.99")
+		_, err := m.Mul(decimal.MustNew(2, 0))
+		if !errors.Is(err, decimal.ErrOverflow) {
+			t.Errorf("%q.Mul(2) = %v, want %v", m, err, decimal.ErrOverflow)
+		}
+		_, err = m.Quo(decimal.MustNew(5, 1))
+		if !errors.Is(err, decimal.ErrOverflow) {
+			t.Errorf("%q.Quo(0.5) = %v, want %v", m, err, decimal.ErrOverflow)
+		}
+		_, err = m.Quo(decimal.Decimal{})
+		if !errors.Is(err, decimal.ErrDivisionByZero) {
+			t.Errorf("%q.Quo(0) = %v, want %v", m, err, decimal.ErrDivisionByZero)
+		}
+		_, err = m.MulMode(decimal.MustNew(1, 0), decimal.RoundingMode(-1))
+		if !errors.Is(err, decimal.ErrModeRange) {
+			t.Errorf("%q.MulMode(1, -1) = %v, want %v", m, err, decimal.ErrModeRange)
+		}
+	})
+}
+
+func TestMoney_RoundToCash(t *testing.T) {
+	tests := []struct {
+		m    string
+		mode decimal.RoundingMode
+		want string
+	}{
+		{"CHF 1.22", decimal.HalfEven, "CHF 1.20"},
+		{"CHF 1.23", decimal.HalfEven, "CHF 1.25"},
+		{"CHF 1.27", decimal.HalfEven, "CHF 1.25"},
+		{"CHF 1.28", decimal.HalfEven, "CHF 1.30"},
+		{"CHF 1.21", decimal.Up, "CHF 1.25"},
+		{"CHF -1.21", decimal.Up, "CHF -1.25"},
+		{"CHF -1.21", decimal.Ceiling, "CHF -1.20"},
+		{"CHF -0.03", decimal.HalfEven, "CHF -0.05"},
+		{"SEK 12.50", decimal.HalfEven, "SEK 12.00"},
+		{"SEK 13.50", decimal.HalfEven, "SEK 14.00"},
+		{"SEK 12.50", decimal.HalfUp, "SEK 13.00"},
+		{"HUF 1237.50", decimal.HalfEven, "HUF 1240.00"},
+		{"DKK 10.24", decimal.HalfEven, "DKK 10.00"},
+		{"DKK 10.25", decimal.HalfEven, "DKK 10.00"},
+		{"DKK 10.26", decimal.HalfEven, "DKK 10.50"},
+		{"HUF 12.51", decimal.ZeroFiveUp, "HUF 10.00"},
+		{"HUF 15.01", decimal.ZeroFiveUp, "HUF 15.00"},
+		{"HUF 10.00", decimal.ZeroFiveUp, "HUF 10.00"},
+		{"HUF 2.50", decimal.ZeroFiveUp, "HUF 5.00"},
+		{"HUF -2.50", decimal.ZeroFiveUp, "HUF -5.00"},
+		{"CHF 1.26", decimal.Up, "CHF 1.30"},
+		{"CHF 1.25", decimal.Up, "CHF 1.25"},
+		{"CHF 1.29", decimal.Down, "CHF 1.25"},
+		{"CHF -1.29", decimal.Down, "CHF -1.25"},
+		{"CHF -1.21", decimal.Floor, "CHF -1.25"},
+		{"SEK 12.50", decimal.HalfDown, "SEK 12.00"},
+		{"USD 1.23", decimal.HalfEven, "USD 1.23"},
+		{"JPY 7", decimal.HalfEven, "JPY 7"},
+	}
+	for _, tt := range tests {
+		m := MustParse(tt.m)
+		got, err := m.RoundToCash(tt.mode)
+		if err != nil {
+			t.Errorf("%q.RoundToCash(%v) failed: %v", m, tt.mode, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("%q.RoundToCash(%v) = %q, want %q", m, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestMoney_Cmp(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			m, n string
+			want int
+		}{
+			{"USD 1.00", "USD 2.00", -1},
+			{"USD 2.00", "USD 2.00", 0},
+			{"USD 2.01", "USD 2.00", 1},
+			{"USD -2.01", "USD 2.00", -1},
+		}
+		for _, tt := range tests {
+			m := MustParse(tt.m)
+			n := MustParse(tt.n)
+			got, err := m.Cmp(n)
+			if err != nil {
+				t.Errorf("%q.Cmp(%q) failed: %v", m, n, err)
+				continue
+			}
+			if got != tt.want {
+				t.Errorf("%q.Cmp(%q) = %v, want %v", m, n, got, tt.want)
+			}
+			if m.Equal(n) != (tt.want == 0) {
+				t.Errorf("%q.Equal(%q) = %v, want %v", m, n, m.Equal(n), tt.want == 0)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		m := MustParse("USD 1.00")
+		n := MustParse("EUR 1.00")
+		_, err := m.Cmp(n)
+		if !errors.Is(err, ErrCurrencyMismatch) {
+			t.Errorf("%q.Cmp(%q) = %v, want %v", m, n, err, ErrCurrencyMismatch)
+		}
+		if m.Equal(n) {
+			t.Errorf("%q.Equal(%q) = true, want false", m, n)
+		}
+	})
+}
+
+func TestMoney_Marshaling(t *testing.T) {
+	tests := []struct {
+		m, wantText, wantJSON string
+	}{
+		{"USD 12.34", "USD 12.34", `{"currency":"USD","amount":"12.34"}`},
+		{"USD -0.5", "USD -0.50", `{"currency":"USD","amount":"-0.50"}`},
+		{"JPY 1000", "JPY 1000", `{"currency":"JPY","amount":"1000"}`},
+		{"BHD 0.001", "BHD 0.001", `{"currency":"BHD","amount":"0.001"}`},
+	}
+	for _, tt := range tests {
+		want := MustParse(tt.m)
+
+		// Text
+		text, err := want.MarshalText()
+		if err != nil {
+			t.Errorf("%q.MarshalText() failed: %v", want, err)
+			continue
+		}
+		if string(text) != tt.wantText {
+			t.Errorf("%q.MarshalText() = %s, want %s", want, text, tt.wantText)
+		}
+		var got Money
+		err = got.UnmarshalText(text)
+		if err != nil {
+			t.Errorf("UnmarshalText(%s) failed: %v", text, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("UnmarshalText(%s) = %q, want %q", text, got, want)
+		}
+
+		// JSON
+		data, err := json.Marshal(want)
+		if err != nil {
+			t.Errorf("json.Marshal(%q) failed: %v", want, err)
+			continue
+		}
+		if string(data) != tt.wantJSON {
+			t.Errorf("json.Marshal(%q) = %s, want %s", want, data, tt.wantJSON)
+		}
+		got = Money{}
+		err = json.Unmarshal(data, &got)
+		if err != nil {
+			t.Errorf("json.Unmarshal(%s) failed: %v", data, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("json.Unmarshal(%s) = %q, want %q", data, got, want)
+		}
+
+		// SQL
+		value, err := want.Value()
+		if err != nil {
+			t.Errorf("%q.Value() failed: %v", want, err)
+			continue
+		}
+		got = Money{}
+		err = got.Scan(value)
+		if err != nil {
+			t.Errorf("Scan(%v) failed: %v", value, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("Scan(%v) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestMoney_UnmarshalJSON(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			data, want string
+		}{
+			{`{"currency":"USD","amount":"12.345"}`, "USD 12.34"},
+			{`{"currency":"EUR","amount":"1"}`, "EUR 1.00"},
+			{`{"amount":"7","currency":"JPY"}`, "JPY 7"},
+		}
+		for _, tt := range tests {
+			var got Money
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if err != nil {
+				t.Errorf("json.Unmarshal(%s) failed: %v", tt.data, err)
+				continue
+			}
+			if got.String() != tt.want {
+				t.Errorf("json.Unmarshal(%s) = %q, want %q", tt.data, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]string{
+			"currency 1": `{"currency":"XYZ","amount":"1"}`,
+			"currency 2": `{"amount":"1"}`,
+			"amount":     `{"currency":"USD","amount":"abc"}`,
+			"overflow":   `{"currency":"USD","amount":"999999999999999999"}`,
+			"type":       `[1, 2]`,
+		}
+		for name, data := range tests {
+			var got Money
+			err := json.Unmarshal([]byte(data), &got)
+			if err == nil {
+				t.Errorf("%v: json.Unmarshal(%s) did not fail", name, data)
+			}
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package decimal
+
+import "fmt"
+
+// RoundingMode determines how a decimal is rounded when some of its digits
+// have to be discarded.
+// The names and semantics of the rounding modes follow [ANSI X3.274-1996].
+// The zero value is [HalfEven], which is the rounding mode used
+// by all methods that do not accept a rounding mode explicitly.
+//
+// [ANSI X3.274-1996]: https://speleotrove.com/decimal/damodel.html
+type RoundingMode int8
+
+const (
+	// HalfEven rounds to the nearest neighbour, and if both neighbours are
+	// equidistant, rounds to the even neighbour (banker's rounding).
+	HalfEven RoundingMode = iota
+	// HalfUp rounds to the nearest neighbour, and if both neighbours are
+	// equidistant, rounds away from zero (commercial rounding).
+	HalfUp
+	// HalfDown rounds to the nearest neighbour, and if both neighbours are
+	// equidistant, rounds towards zero.
+	HalfDown
+	// Up rounds away from zero.
+	Up
+	// Down rounds towards zero (truncation).
+	Down
+	// Ceiling rounds towards positive infinity.
+	Ceiling
+	// Floor rounds towards negative infinity.
+	Floor
+	// ZeroFiveUp rounds towards zero, unless the last remaining digit
+	// is 0 or 5, in which case it rounds away from zero.
+	ZeroFiveUp
+)
+
+// HalfAwayFromZero is an alias for [HalfUp].
+// It is provided for readability, since some standards use the term
+// "half up" to describe rounding of equidistant values towards positive infinity.
+const HalfAwayFromZero = HalfUp
+
+// String implements the [fmt.Stringer] interface and returns
+// the name of the rounding mode.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (m RoundingMode) String() string {
+	switch m {
+	case HalfEven:
+		return "HalfEven"
+	case HalfUp:
+		return "HalfUp"
+	case HalfDown:
+		return "HalfDown"
+	case Up:
+		return "Up"
+	case Down:
+		return "Down"
+	case Ceiling:
+		return "Ceiling"
+	case Floor:
+		return "Floor"
+	case ZeroFiveUp:
+		return "ZeroFiveUp"
+	}
+	return fmt.Sprintf("RoundingMode(%d)", int8(m))
+}
+
+// valid returns true if m is one of the defined rounding modes.
+func (m RoundingMode) valid() bool {
+	return m >= HalfEven && m <= ZeroFiveUp
+}
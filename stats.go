@@ -0,0 +1,532 @@
+package decimal
+
+import (
+	"fmt"
+	"slices"
+)
+
+// fifty is the percentile that corresponds to the median.
+var fifty = MustNew(50, 0)
+
+// Mean returns the (possibly rounded) arithmetic mean of decimals.
+// The sum is accumulated without any intermediate rounding.
+//
+// Mean returns an error if no arguments are provided.
+func Mean(d ...Decimal) (Decimal, error) {
+	return mean(HalfEven, d...)
+}
+
+// mean is similar to [Mean], but it allows you to specify the rounding mode.
+func mean(mode RoundingMode, d ...Decimal) (Decimal, error) {
+	// Special cases
+	switch len(d) {
+	case 0:
+		return Decimal{}, newOpError("mean", 0, fmt.Errorf("%w: no arguments", ErrInvalidOperation))
+	case 1:
+		return d[0], nil
+	}
+
+	// General case
+	e, err := meanBint(mode, d...)
+	if err != nil {
+		return Decimal{}, newOpError("mean", 0, err, d...)
+	}
+
+	// Preferred scale
+	e = e.Trim(maxScale(d...))
+
+	return e, nil
+}
+
+// meanBint computes the arithmetic mean of decimals using *big.Int arithmetic.
+func meanBint(mode RoundingMode, d ...Decimal) (Decimal, error) {
+	escale := maxScale(d...)
+
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.sumDecimal(escale, d...)
+
+	ncoef := getBint()
+	defer putBint(ncoef)
+	ncoef.setInt64(int64(len(d)))
+
+	return newFromBintQuo(mode, ecoef, escale, ncoef)
+}
+
+// WeightedMean returns the (possibly rounded) weighted arithmetic mean
+// of values, where the i-th value has the i-th weight.
+// The sums are accumulated without any intermediate rounding.
+//
+// WeightedMean returns an error if:
+//   - no values are provided;
+//   - the numbers of values and weights are different;
+//   - the sum of the weights is zero.
+func WeightedMean(values, weights []Decimal) (Decimal, error) {
+	return weightedMean(HalfEven, values, weights)
+}
+
+// weightedMean is similar to [WeightedMean], but it allows you to specify
+// the rounding mode.
+func weightedMean(mode RoundingMode, values, weights []Decimal) (Decimal, error) {
+	// Special cases
+	switch {
+	case len(values) == 0:
+		return Decimal{}, newOpError("weightedmean", 0, fmt.Errorf("%w: no arguments", ErrInvalidOperation))
+	case len(values) != len(weights):
+		return Decimal{}, newOpError("weightedmean", 0, fmt.Errorf("%w: %v values and %v weights", ErrInvalidOperation, len(values), len(weights)))
+	}
+
+	// General case
+	e, err := weightedMeanBint(mode, values, weights)
+	if err != nil {
+		return Decimal{}, newOpError("weightedmean", 0, err, slices.Concat(values, weights)...)
+	}
+
+	// Preferred scale
+	e = e.Trim(maxScale(values...))
+
+	return e, nil
+}
+
+// weightedMeanBint computes the weighted arithmetic mean of decimals
+// using *big.Int arithmetic.
+func weightedMeanBint(mode RoundingMode, values, weights []Decimal) (Decimal, error) {
+	vscale := maxScale(values...)
+	wscale := maxScale(weights...)
+
+	// Compute w = Σ weights
+	wcoef := getBint()
+	defer putBint(wcoef)
+	wcoef.sumDecimal(wscale, weights...)
+	if wcoef.sign() == 0 {
+		return Decimal{}, ErrDivisionByZero
+	}
+
+	// Compute e = Σ values * weights
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.setFint(0)
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+
+	gcoef := getBint()
+	defer putBint(gcoef)
+
+	for i := range values {
+		fcoef.setDecimal(values[i], vscale)
+		gcoef.setDecimal(weights[i], wscale)
+		fcoef.mul(fcoef, gcoef)
+		ecoef.add(ecoef, fcoef)
+	}
+
+	// The scale of the weights cancels out in the quotient
+	return newFromBintQuo(mode, ecoef, vscale, wcoef)
+}
+
+// PopVariance returns the (possibly rounded) population variance of decimals.
+// The sums are accumulated without any intermediate rounding.
+//
+// PopVariance returns an error if:
+//   - no arguments are provided;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func PopVariance(d ...Decimal) (Decimal, error) {
+	return variance(HalfEven, false, false, d...)
+}
+
+// Variance returns the (possibly rounded) sample variance of decimals,
+// which uses n - 1 in the denominator (Bessel's correction).
+// The sums are accumulated without any intermediate rounding.
+//
+// Variance returns an error if:
+//   - fewer than two arguments are provided;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func Variance(d ...Decimal) (Decimal, error) {
+	return variance(HalfEven, true, false, d...)
+}
+
+// PopStdDev returns the (possibly rounded) population standard deviation
+// of decimals.
+// The sums are accumulated without any intermediate rounding.
+//
+// PopStdDev returns an error if no arguments are provided.
+func PopStdDev(d ...Decimal) (Decimal, error) {
+	return variance(HalfEven, false, true, d...)
+}
+
+// StdDev returns the (possibly rounded) sample standard deviation of decimals,
+// which uses n - 1 in the denominator (Bessel's correction).
+// The sums are accumulated without any intermediate rounding.
+//
+// StdDev returns an error if:
+//   - fewer than two arguments are provided;
+//   - the integer part of the result has more than [MaxPrec] digits.
+func StdDev(d ...Decimal) (Decimal, error) {
+	return variance(HalfEven, true, true, d...)
+}
+
+// variance is similar to [Variance], but it allows you to specify
+// the rounding mode, whether the variance is a sample or a population one,
+// and whether the standard deviation is returned instead of the variance.
+func variance(mode RoundingMode, sample, stddev bool, d ...Decimal) (Decimal, error) {
+	op := "variance"
+	if stddev {
+		op = "stddev"
+	}
+	if !sample {
+		op = "pop" + op
+	}
+
+	// Special cases
+	switch {
+	case len(d) == 0:
+		return Decimal{}, newOpError(op, 0, fmt.Errorf("%w: no arguments", ErrInvalidOperation))
+	case len(d) == 1 && sample:
+		return Decimal{}, newOpError(op, 0, fmt.Errorf("%w: at least two arguments are required", ErrInvalidOperation), d...)
+	}
+
+	// General case
+	e, err := varianceBint(mode, sample, stddev, d...)
+	if err != nil {
+		return Decimal{}, newOpError(op, 0, err, d...)
+	}
+
+	// Preferred scale
+	escale := maxScale(d...)
+	if !stddev {
+		escale = 2 * escale
+	}
+	e = e.Trim(escale)
+
+	return e, nil
+}
+
+// varianceBint computes the variance or the standard deviation of decimals
+// using *big.Int arithmetic.
+func varianceBint(mode RoundingMode, sample, stddev bool, d ...Decimal) (Decimal, error) {
+	dscale := maxScale(d...)
+	n := int64(len(d))
+
+	// Compute s = Σ d and q = Σ d²
+	scoef := getBint()
+	defer putBint(scoef)
+	scoef.setFint(0)
+
+	qcoef := getBint()
+	defer putBint(qcoef)
+	qcoef.setFint(0)
+
+	fcoef := getBint()
+	defer putBint(fcoef)
+
+	for _, f := range d {
+		fcoef.setDecimal(f, dscale)
+		scoef.add(scoef, fcoef)
+		fcoef.mul(fcoef, fcoef)
+		qcoef.add(qcoef, fcoef)
+	}
+
+	// Compute e = n * q - s², which has twice the scale of the decimals
+	// and is never negative
+	ecoef := getBint()
+	defer putBint(ecoef)
+	ecoef.mulInt64(qcoef, n)
+	scoef.mul(scoef, scoef)
+	ecoef.sub(ecoef, scoef)
+
+	// Compute the denominator n * n or n * (n - 1)
+	ncoef := getBint()
+	defer putBint(ncoef)
+	if sample {
+		ncoef.setInt64(n * (n - 1))
+	} else {
+		ncoef.setInt64(n * n)
+	}
+
+	if !stddev {
+		return newFromBintQuo(mode, ecoef, 2*dscale, ncoef)
+	}
+
+	// Compute √(e / n) with 2 * [MaxScale] digits after the decimal point
+	// of the decimals.
+	// Since ⌊√⌊x⌋⌋ = ⌊√x⌋, the integer quotient can be used.
+	rcoef := getBint()
+	defer putBint(rcoef)
+	ecoef.lsh(ecoef, 4*MaxScale)
+	ecoef.quoRem(ecoef, ncoef, rcoef)
+	inexact := rcoef.sign() != 0
+
+	fcoef.sqrt(ecoef)
+	rcoef.mul(fcoef, fcoef)
+	inexact = inexact || rcoef.cmp(ecoef) != 0
+	fscale := dscale + 2*MaxScale
+
+	// Sticky digit
+	if inexact {
+		fcoef.fsa(fcoef, 1, 1)
+		fscale = fscale + 1
+	}
+
+	return newFromBint(false, fcoef, fscale, 0, mode)
+}
+
+// Median returns the (possibly rounded) median of decimals.
+// For an even number of decimals, the median is the mean of the two middle ones.
+//
+// Median returns an error if no arguments are provided.
+func Median(d ...Decimal) (Decimal, error) {
+	return percentile("median", HalfEven, fifty, PercentileMidpoint, d...)
+}
+
+// PercentileMethod determines how [Percentile] computes a percentile
+// that falls between two decimals in sorted order.
+// The names follow the interpolation methods of [NumPy].
+//
+// [NumPy]: https://numpy.org/doc/stable/reference/generated/numpy.percentile.html
+type PercentileMethod int8
+
+const (
+	// PercentileLinear interpolates linearly between the two closest decimals.
+	// It is the method of Excel PERCENTILE.INC and the default method of NumPy.
+	PercentileLinear PercentileMethod = iota
+	// PercentileLower takes the lower of the two closest decimals.
+	PercentileLower
+	// PercentileHigher takes the higher of the two closest decimals.
+	PercentileHigher
+	// PercentileNearest takes the nearest of the two closest decimals,
+	// and if both are equidistant, the one with the even index.
+	PercentileNearest
+	// PercentileMidpoint takes the mean of the two closest decimals.
+	PercentileMidpoint
+	// PercentileExclusive interpolates linearly as Excel PERCENTILE.EXC does,
+	// which treats the decimals as a sample that excludes the extremes.
+	// It is defined only for percentiles between 100 / (n + 1) and 100 * n / (n + 1).
+	PercentileExclusive
+)
+
+// String implements the [fmt.Stringer] interface and returns
+// the name of the percentile method.
+//
+// [fmt.Stringer]: https://pkg.go.dev/fmt#Stringer
+func (m PercentileMethod) String() string {
+	switch m {
+	case PercentileLinear:
+		return "Linear"
+	case PercentileLower:
+		return "Lower"
+	case PercentileHigher:
+		return "Higher"
+	case PercentileNearest:
+		return "Nearest"
+	case PercentileMidpoint:
+		return "Midpoint"
+	case PercentileExclusive:
+		return "Exclusive"
+	}
+	return fmt.Sprintf("PercentileMethod(%d)", int8(m))
+}
+
+// Percentile returns the (possibly rounded) p-th percentile of decimals,
+// where p is between 0 and 100.
+// The method determines how the percentile is computed when it falls
+// between two decimals in sorted order.
+// The results of [PercentileLower], [PercentileHigher], and [PercentileNearest]
+// are always one of the decimals.
+//
+// Percentile returns an error if:
+//   - no arguments are provided;
+//   - p is less than 0 or greater than 100;
+//   - the method is unknown;
+//   - the method is [PercentileExclusive] and p is outside of its range.
+func Percentile(p Decimal, method PercentileMethod, d ...Decimal) (Decimal, error) {
+	return percentile("percentile", HalfEven, p, method, d...)
+}
+
+// percentile is similar to [Percentile], but it allows you to specify
+// the rounding mode.
+// The name of the operation is either "percentile" or "median", and only
+// the former records the percentile as the first operand.
+func percentile(op string, mode RoundingMode, p Decimal, method PercentileMethod, d ...Decimal) (Decimal, error) {
+	operands := d
+	if op == "percentile" {
+		operands = append([]Decimal{p}, d...)
+	}
+
+	// Special cases
+	switch {
+	case len(d) == 0:
+		return Decimal{}, newOpError(op, 0, fmt.Errorf("%w: no arguments", ErrInvalidOperation), operands...)
+	case p.IsNeg() || p.Cmp(Hundred) > 0:
+		return Decimal{}, newOpError(op, 0, fmt.Errorf("%w: percentile %v is out of range [0, 100]", ErrInvalidOperation, p), operands...)
+	case method < PercentileLinear || method > PercentileExclusive:
+		return Decimal{}, newOpError(op, 0, fmt.Errorf("%w: unknown percentile method %v", ErrInvalidOperation, method), operands...)
+	}
+
+	// General case
+	e, err := percentileBint(mode, p, method, d...)
+	if err != nil {
+		return Decimal{}, newOpError(op, 0, err, operands...)
+	}
+
+	return e, nil
+}
+
+// percentileBint computes the percentile of decimals using *big.Int arithmetic.
+func percentileBint(mode RoundingMode, p Decimal, method PercentileMethod, d ...Decimal) (Decimal, error) {
+	s := slices.Clone(d)
+	slices.SortStableFunc(s, Decimal.Cmp)
+	n := int64(len(s))
+
+	// The percentile is at the zero-based position h = k + r / den in the
+	// sorted decimals, where den = 100 * 10^p.Scale() and 0 <= r < den.
+	dencoef := getBint()
+	defer putBint(dencoef)
+	dencoef.setFint(Hundred.coef)
+	dencoef.lsh(dencoef, p.Scale())
+
+	hcoef := getBint()
+	defer putBint(hcoef)
+	hcoef.setFint(p.coef)
+	if method == PercentileExclusive {
+		// Compute h = (n + 1) * p / 100 - 1, which must be in [0, n - 1]
+		hcoef.mulInt64(hcoef, n+1)
+		if hcoef.cmp(dencoef) < 0 {
+			return Decimal{}, fmt.Errorf("%w: percentile %v is too small for n = %v", ErrInvalidOperation, p, n)
+		}
+		hcoef.sub(hcoef, dencoef)
+	} else {
+		// Compute h = (n - 1) * p / 100
+		hcoef.mulInt64(hcoef, n-1)
+	}
+
+	rcoef := getBint()
+	defer putBint(rcoef)
+	hcoef.quoRem(hcoef, dencoef, rcoef)
+	if !hcoef.isInt64() || hcoef.int64() > n-1 || hcoef.int64() == n-1 && rcoef.sign() != 0 {
+		return Decimal{}, fmt.Errorf("%w: percentile %v is too large for n = %v", ErrInvalidOperation, p, n)
+	}
+	k := int(hcoef.int64())
+
+	// Special case: the percentile is exactly at a decimal
+	if rcoef.sign() == 0 {
+		return s[k], nil
+	}
+
+	// General case
+	lo, hi := s[k], s[k+1]
+	switch method {
+	case PercentileLower:
+		return lo, nil
+	case PercentileHigher:
+		return hi, nil
+	case PercentileNearest:
+		rcoef.dbl(rcoef)
+		switch c := rcoef.cmp(dencoef); {
+		case c < 0, c == 0 && k%2 == 0:
+			return lo, nil
+		default:
+			return hi, nil
+		}
+	case PercentileMidpoint:
+		rcoef.setInt64(1)
+		dencoef.setInt64(2)
+	}
+
+	e, err := lerpBint(mode, lo, hi, rcoef, dencoef)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	// Preferred scale
+	e = e.Trim(max(lo.Scale(), hi.Scale()))
+
+	return e, nil
+}
+
+// lerpBint computes d + (e - d) * num / den, where 0 <= num <= den,
+// using *big.Int arithmetic.
+func lerpBint(mode RoundingMode, d, e Decimal, num, den *bint) (Decimal, error) {
+	fscale := max(d.Scale(), e.Scale())
+
+	// Compute f = d * (den - num) + e * num
+	fcoef := getBint()
+	defer putBint(fcoef)
+	fcoef.setDecimal(d, fscale)
+
+	gcoef := getBint()
+	defer putBint(gcoef)
+	gcoef.sub(den, num)
+	fcoef.mul(fcoef, gcoef)
+
+	gcoef.setDecimal(e, fscale)
+	gcoef.mul(gcoef, num)
+	fcoef.add(fcoef, gcoef)
+
+	return newFromBintQuo(mode, fcoef, fscale, den)
+}
+
+// Mode returns the most frequent decimal.
+// Decimals are compared by value, so 1.0 and 1.00 are counted together.
+// If several decimals are equally frequent, Mode returns the one that
+// occurs first.
+//
+// Mode returns an error if no arguments are provided.
+func Mode(d ...Decimal) (Decimal, error) {
+	if len(d) == 0 {
+		return Decimal{}, newOpError("mode", 0, fmt.Errorf("%w: no arguments", ErrInvalidOperation))
+	}
+
+	counts := make(map[Decimal]int, len(d))
+	var e Decimal
+	var emax int
+	for _, f := range d {
+		key := f.Trim(0)
+		counts[key]++
+		if counts[key] > emax {
+			e, emax = f, counts[key]
+		}
+	}
+
+	// Return the first occurrence of the most frequent decimal
+	for _, f := range d {
+		if counts[f.Trim(0)] == emax {
+			return f, nil
+		}
+	}
+
+	return e, nil
+}
+
+// maxScale returns the largest scale of decimals.
+func maxScale(d ...Decimal) int {
+	s := 0
+	for _, f := range d {
+		s = max(s, f.Scale())
+	}
+	return s
+}
+
+// newFromBintQuo returns a (possibly rounded) decimal equal to
+// (coef / 10^scale) / den, where den is a non-zero integer.
+// Both coef and den may be negative.
+// The value of coef is destroyed.
+func newFromBintQuo(mode RoundingMode, coef *bint, scale int, den *bint) (Decimal, error) {
+	neg := coef.sign() != den.sign() && coef.sign() != 0
+
+	dcoef := getBint()
+	defer putBint(dcoef)
+	dcoef.abs(den)
+	coef.abs(coef)
+
+	// Compute coef / den with 2 * [MaxScale] more digits after the decimal point
+	rcoef := getBint()
+	defer putBint(rcoef)
+	coef.lsh(coef, 2*MaxScale)
+	coef.quoRem(coef, dcoef, rcoef)
+
+	// Sticky digit
+	coef.fsa(coef, 1, sticky(rcoef))
+	scale = scale + 2*MaxScale + 1
+
+	return newFromBint(neg, coef, scale, 0, mode)
+}
@@ -0,0 +1,463 @@
+package decimal
+
+import (
+	"testing"
+)
+
+func requireFromStrings(ss []string) []Decimal {
+	d := make([]Decimal, len(ss))
+	for i, s := range ss {
+		d[i] = RequireFromString(s)
+	}
+	return d
+}
+
+func TestMean(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    []string
+			want string
+		}{
+			{[]string{"1"}, "1"},
+			{[]string{"1", "2"}, "1.5"},
+			{[]string{"1", "2", "3"}, "2"},
+			{[]string{"1", "2", "2"}, "1.666666666666666667"},
+			{[]string{"0.1", "0.2", "0.3"}, "0.2"},
+			{[]string{"-1", "1"}, "0"},
+			{[]string{"1.00", "2"}, "1.50"},
+			{[]string{"1", "2", "3", "4"}, "2.5"},
+			{[]string{"-5", "2.5", "7.25"}, "1.583333333333333333"},
+			{[]string{"0", "0.00"}, "0.00"},
+
+			// No intermediate overflow
+			{[]string{"9999999999999999999", "9999999999999999999"}, "9999999999999999999"},
+			{[]string{"9999999999999999999", "9999999999999999998"}, "9999999999999999998"},
+			{[]string{"-9999999999999999999", "-9999999999999999999", "-9999999999999999999"}, "-9999999999999999999"},
+
+			// Rounding
+			{[]string{"0.0000000000000000001", "0.0000000000000000002"}, "0.0000000000000000002"},
+			{[]string{"0.0000000000000000001", "0.0000000000000000002", "0.0000000000000000002"}, "0.0000000000000000002"},
+		}
+		for _, tt := range tests {
+			d := requireFromStrings(tt.d)
+			got, err := Mean(d...)
+			if err != nil {
+				t.Errorf("Mean(%v) failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("Mean(%v) = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := Mean()
+		if err == nil {
+			t.Errorf("Mean() did not fail")
+		}
+	})
+}
+
+func TestWeightedMean(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			v, w []string
+			want string
+		}{
+			{[]string{"1", "2", "3"}, []string{"1", "1", "1"}, "2"},
+			{[]string{"1", "2", "3"}, []string{"3", "2", "1"}, "1.666666666666666667"},
+			{[]string{"1", "2", "3"}, []string{"1", "0", "0"}, "1"},
+			{[]string{"10", "20"}, []string{"0.25", "0.75"}, "17.5"},
+			{[]string{"1.5", "2", "3.25", "-4", "10.125"}, []string{"1", "2", "1", "2", "1"}, "1.553571428571428571"},
+			{[]string{"1", "2"}, []string{"1", "-2"}, "3"},
+			{[]string{"5"}, []string{"0.001"}, "5"},
+		}
+		for _, tt := range tests {
+			v := requireFromStrings(tt.v)
+			w := requireFromStrings(tt.w)
+			got, err := WeightedMean(v, w)
+			if err != nil {
+				t.Errorf("WeightedMean(%v, %v) failed: %v", v, w, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("WeightedMean(%v, %v) = %q, want %q", v, w, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			v, w []string
+		}{
+			"no arguments":      {[]string{}, []string{}},
+			"length mismatch 1": {[]string{"1", "2"}, []string{"1"}},
+			"length mismatch 2": {[]string{"1"}, []string{"1", "2"}},
+			"zero weights 1":    {[]string{"1"}, []string{"0"}},
+			"zero weights 2":    {[]string{"1", "2"}, []string{"1", "-1"}},
+			"overflow 1":        {[]string{"9999999999999999999", "0"}, []string{"1", "-0.5"}},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				v := requireFromStrings(tt.v)
+				w := requireFromStrings(tt.w)
+				_, err := WeightedMean(v, w)
+				if err == nil {
+					t.Errorf("WeightedMean(%v, %v) did not fail", v, w)
+				}
+			})
+		}
+	})
+}
+
+func TestVariance(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d                                      []string
+			wantVar, wantPopVar, wantSD, wantPopSD string
+		}{
+			{[]string{"1", "2"}, "0.5", "0.25", "0.7071067811865475244", "0.5"},
+			{[]string{"1", "2", "2"}, "0.3333333333333333333", "0.2222222222222222222", "0.5773502691896257645", "0.4714045207910316829"},
+			{[]string{"1", "2", "3", "4"}, "1.666666666666666667", "1.25", "1.290994448735805628", "1.118033988749894848"},
+			{[]string{"2", "4", "4", "4", "5", "5", "7", "9"}, "4.571428571428571429", "4", "2.138089935299395077", "2"},
+			{[]string{"1.5", "2", "3.25", "-4", "10.125"}, "25.543750", "20.435000", "5.054082508230351397", "4.520508820918282425"},
+			{[]string{"0.1", "0.2", "0.3"}, "0.01", "0.0066666666666666667", "0.1", "0.0816496580927726033"},
+			{[]string{"5", "5", "5"}, "0", "0", "0", "0"},
+			{[]string{"-1", "1"}, "2", "1", "1.414213562373095049", "1"},
+			{[]string{"1000000000", "1000000001", "1000000002"}, "1", "0.6666666666666666667", "1", "0.8164965809277260327"},
+			{[]string{"0.0000000000000000001", "0.0000000000000000002"}, "0.0000000000000000000", "0.0000000000000000000", "0.0000000000000000001", "0.0000000000000000000"},
+		}
+		for _, tt := range tests {
+			d := requireFromStrings(tt.d)
+			funcs := []struct {
+				name string
+				f    func(...Decimal) (Decimal, error)
+				want string
+			}{
+				{"Variance", Variance, tt.wantVar},
+				{"PopVariance", PopVariance, tt.wantPopVar},
+				{"StdDev", StdDev, tt.wantSD},
+				{"PopStdDev", PopStdDev, tt.wantPopSD},
+			}
+			for _, fn := range funcs {
+				got, err := fn.f(d...)
+				if err != nil {
+					t.Errorf("%v(%v) failed: %v", fn.name, d, err)
+					continue
+				}
+				want := RequireFromString(fn.want)
+				if got != want {
+					t.Errorf("%v(%v) = %q, want %q", fn.name, d, got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			f func(...Decimal) (Decimal, error)
+			d []string
+		}{
+			"variance no arguments":    {Variance, []string{}},
+			"variance one argument":    {Variance, []string{"1"}},
+			"variance overflow 1":      {Variance, []string{"9999999999999999999", "-9999999999999999999"}},
+			"popvariance no arguments": {PopVariance, []string{}},
+			"popvariance overflow 1":   {PopVariance, []string{"9999999999999999999", "-9999999999999999999"}},
+			"stddev no arguments":      {StdDev, []string{}},
+			"stddev one argument":      {StdDev, []string{"1"}},
+			"stddev overflow 1":        {StdDev, []string{"9999999999999999999", "-9999999999999999999"}},
+			"popstddev no arguments":   {PopStdDev, []string{}},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				d := requireFromStrings(tt.d)
+				_, err := tt.f(d...)
+				if err == nil {
+					t.Errorf("%v(%v) did not fail", name, d)
+				}
+			})
+		}
+	})
+}
+
+func TestMedian(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    []string
+			want string
+		}{
+			{[]string{"1"}, "1"},
+			{[]string{"1", "2"}, "1.5"},
+			{[]string{"3", "1", "2"}, "2"},
+			{[]string{"1", "2", "3", "4"}, "2.5"},
+			{[]string{"0.1", "0.3"}, "0.2"},
+			{[]string{"-1", "-2"}, "-1.5"},
+			{[]string{"1.0", "2.00"}, "1.50"},
+			{[]string{"2.00", "1", "3"}, "2.00"},
+			{[]string{"9999999999999999999", "9999999999999999998"}, "9999999999999999998"},
+		}
+		for _, tt := range tests {
+			d := requireFromStrings(tt.d)
+			got, err := Median(d...)
+			if err != nil {
+				t.Errorf("Median(%v) failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("Median(%v) = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := Median()
+		if err == nil {
+			t.Errorf("Median() did not fail")
+		}
+	})
+}
+
+func TestPercentile(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d                                                   []string
+			p                                                   string
+			linear, lower, higher, nearest, midpoint, exclusive string
+		}{
+			{[]string{"15", "20", "35", "40", "50"}, "0", "15", "15", "15", "15", "15", ""},
+			{[]string{"15", "20", "35", "40", "50"}, "5", "16", "15", "20", "15", "17.5", ""},
+			{[]string{"15", "20", "35", "40", "50"}, "25", "20", "20", "20", "20", "20", "17.5"},
+			{[]string{"15", "20", "35", "40", "50"}, "30", "23", "20", "35", "20", "27.5", "19"},
+			{[]string{"15", "20", "35", "40", "50"}, "40", "29", "20", "35", "35", "27.5", "26"},
+			{[]string{"15", "20", "35", "40", "50"}, "50", "35", "35", "35", "35", "35", "35"},
+			{[]string{"15", "20", "35", "40", "50"}, "62.5", "37.5", "35", "40", "35", "37.5", "38.75"},
+			{[]string{"15", "20", "35", "40", "50"}, "75", "40", "40", "40", "40", "40", "45"},
+			{[]string{"15", "20", "35", "40", "50"}, "100", "50", "50", "50", "50", "50", ""},
+			{[]string{"15", "20", "35", "40", "50"}, "0.0000000000000000001", "15", "15", "20", "15", "17.5", ""},
+			{[]string{"15", "20", "35", "40", "50"}, "99.99999999999999999", "50", "40", "50", "50", "45", ""},
+			{[]string{"1.5", "2", "3.25", "-4", "10.125"}, "0", "-4", "-4", "-4", "-4", "-4", ""},
+			{[]string{"1.5", "2", "3.25", "-4", "10.125"}, "5", "-2.9", "-4", "1.5", "-4", "-1.25", ""},
+			{[]string{"1.5", "2", "3.25", "-4", "10.125"}, "25", "1.5", "1.5", "1.5", "1.5", "1.5", "-1.25"},
+			{[]string{"1.5", "2", "3.25", "-4", "10.125"}, "30", "1.6", "1.5", "2", "1.5", "1.75", "0.4"},
+			{[]string{"1.5", "2", "3.25", "-4", "10.125"}, "40", "1.8", "1.5", "2", "2", "1.75", "1.7"},
+			{[]string{"1.5", "2", "3.25", "-4", "10.125"}, "50", "2", "2", "2", "2", "2", "2"},
+			{[]string{"1.5", "2", "3.25", "-4", "10.125"}, "62.5", "2.625", "2", "3.25", "2", "2.625", "2.9375"},
+			{[]string{"1.5", "2", "3.25", "-4", "10.125"}, "75", "3.25", "3.25", "3.25", "3.25", "3.25", "6.6875"},
+			{[]string{"1.5", "2", "3.25", "-4", "10.125"}, "100", "10.125", "10.125", "10.125", "10.125", "10.125", ""},
+			{[]string{"1.5", "2", "3.25", "-4", "10.125"}, "0.0000000000000000001", "-4.0", "-4", "1.5", "-4", "-1.25", ""},
+			{[]string{"1.5", "2", "3.25", "-4", "10.125"}, "99.99999999999999999", "10.125", "3.25", "10.125", "10.125", "6.6875", ""},
+		}
+		for _, tt := range tests {
+			d := requireFromStrings(tt.d)
+			p := RequireFromString(tt.p)
+			methods := []struct {
+				m    PercentileMethod
+				want string
+			}{
+				{PercentileLinear, tt.linear},
+				{PercentileLower, tt.lower},
+				{PercentileHigher, tt.higher},
+				{PercentileNearest, tt.nearest},
+				{PercentileMidpoint, tt.midpoint},
+				{PercentileExclusive, tt.exclusive},
+			}
+			for _, mm := range methods {
+				got, err := Percentile(p, mm.m, d...)
+				if mm.want == "" {
+					if err == nil {
+						t.Errorf("Percentile(%v, %v, %v) did not fail", p, mm.m, d)
+					}
+					continue
+				}
+				if err != nil {
+					t.Errorf("Percentile(%v, %v, %v) failed: %v", p, mm.m, d, err)
+					continue
+				}
+				want := RequireFromString(mm.want)
+				if got != want {
+					t.Errorf("Percentile(%v, %v, %v) = %q, want %q", p, mm.m, d, got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		tests := map[string]struct {
+			p string
+			m PercentileMethod
+			d []string
+		}{
+			"no arguments":       {"50", PercentileLinear, []string{}},
+			"percentile range 1": {"-0.1", PercentileLinear, []string{"1", "2"}},
+			"percentile range 2": {"100.1", PercentileLinear, []string{"1", "2"}},
+			"unknown method 1":   {"50", PercentileMethod(-1), []string{"1", "2"}},
+			"unknown method 2":   {"50", PercentileExclusive + 1, []string{"1", "2"}},
+			"exclusive range 1":  {"0", PercentileExclusive, []string{"1"}},
+			"exclusive range 2":  {"49.9", PercentileExclusive, []string{"1"}},
+			"exclusive range 3":  {"33.3", PercentileExclusive, []string{"1", "2"}},
+			"exclusive range 4":  {"66.7", PercentileExclusive, []string{"1", "2"}},
+		}
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				p := RequireFromString(tt.p)
+				d := requireFromStrings(tt.d)
+				_, err := Percentile(p, tt.m, d...)
+				if err == nil {
+					t.Errorf("Percentile(%v, %v, %v) did not fail", p, tt.m, d)
+				}
+			})
+		}
+	})
+}
+
+func TestPercentileMethod_String(t *testing.T) {
+	tests := []struct {
+		m    PercentileMethod
+		want string
+	}{
+		{PercentileLinear, "Linear"},
+		{PercentileLower, "Lower"},
+		{PercentileHigher, "Higher"},
+		{PercentileNearest, "Nearest"},
+		{PercentileMidpoint, "Midpoint"},
+		{PercentileExclusive, "Exclusive"},
+		{PercentileMethod(-1), "PercentileMethod(-1)"},
+	}
+	for _, tt := range tests {
+		got := tt.m.String()
+		if got != tt.want {
+			t.Errorf("%v.String() = %q, want %q", tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestMode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			d    []string
+			want string
+		}{
+			{[]string{"1"}, "1"},
+			{[]string{"1", "2"}, "1"},
+			{[]string{"2", "1"}, "2"},
+			{[]string{"1", "2", "2"}, "2"},
+			{[]string{"1", "2", "2", "1"}, "1"},
+			{[]string{"3", "1.0", "2", "1", "2", "1.00"}, "1.0"},
+			{[]string{"-1", "1", "-1.0"}, "-1"},
+			{[]string{"0", "0.00", "5", "5"}, "0"},
+		}
+		for _, tt := range tests {
+			d := requireFromStrings(tt.d)
+			got, err := Mode(d...)
+			if err != nil {
+				t.Errorf("Mode(%v) failed: %v", d, err)
+				continue
+			}
+			want := RequireFromString(tt.want)
+			if got != want {
+				t.Errorf("Mode(%v) = %q, want %q", d, got, want)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := Mode()
+		if err == nil {
+			t.Errorf("Mode() did not fail")
+		}
+	})
+}
+
+func FuzzMean_Sum(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			got, err := Mean(d, e)
+			if err != nil {
+				t.Errorf("Mean(%q, %q) failed: %v", d, e, err)
+				return
+			}
+			// Half of the exact sum is exact too
+			s, err := d.BigDecimal().Add(e.BigDecimal()).Mul(MustNewBigDecimal(5, 1))
+			if err != nil {
+				t.Errorf("BigDecimal.Mul(%q, %q) failed: %v", d, e, err)
+				return
+			}
+			want, err := s.Decimal()
+			if err != nil {
+				t.Errorf("%q.Decimal() failed: %v", s, err)
+				return
+			}
+			want = want.Trim(max(d.Scale(), e.Scale()))
+
+			if got != want {
+				t.Errorf("Mean(%q, %q) = %q, want %q", d, e, got, want)
+			}
+		},
+	)
+}
+
+func FuzzPopStdDev_SubAbs(f *testing.F) {
+	for _, d := range corpus {
+		for _, e := range corpus {
+			f.Add(d.neg, d.scale, d.coef, e.neg, e.scale, e.coef)
+		}
+	}
+
+	f.Fuzz(
+		func(t *testing.T, dneg bool, dscale int, dcoef uint64, eneg bool, escale int, ecoef uint64) {
+			d, err := newSafe(dneg, fint(dcoef), dscale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+			e, err := newSafe(eneg, fint(ecoef), escale)
+			if err != nil {
+				t.Skip()
+				return
+			}
+
+			got, err := PopStdDev(d, e)
+			if err != nil {
+				t.Skip() // Standard deviation overflow is an expected error
+				return
+			}
+			// Population standard deviation of two decimals is |d - e| / 2
+			s, err := d.BigDecimal().Sub(e.BigDecimal()).Abs().Mul(MustNewBigDecimal(5, 1))
+			if err != nil {
+				t.Errorf("BigDecimal.Mul(%q, %q) failed: %v", d, e, err)
+				return
+			}
+			want, err := s.Decimal()
+			if err != nil {
+				t.Errorf("%q.Decimal() failed: %v", s, err)
+				return
+			}
+			want = want.Trim(max(d.Scale(), e.Scale()))
+
+			if got != want {
+				t.Errorf("PopStdDev(%q, %q) = %q, want %q", d, e, got, want)
+			}
+		},
+	)
+}